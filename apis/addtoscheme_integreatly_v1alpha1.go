@@ -3,6 +3,7 @@ package apis
 import (
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
 	creds "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
 )
 
@@ -12,5 +13,6 @@ func init() {
 		AddToSchemes,
 		v1alpha1.SchemeBuilder.AddToScheme,
 		configv1.Install,
+		operatorv1.Install,
 		creds.AddToScheme)
 }
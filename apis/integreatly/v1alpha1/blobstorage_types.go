@@ -21,6 +21,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// BlobStorageSpec defines the desired state of BlobStorage
+type BlobStorageSpec struct {
+	types.ResourceTypeSpec `json:",inline"`
+	// ServiceAccounts are the service accounts allowed to assume the role of the bucket on AWS STS clusters, as a name
+	// in the namespace of the cr or as namespace/name, by default every service account in the namespace of the cr is
+	// allowed
+	ServiceAccounts []string `json:"serviceAccounts,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=blobstorages,scope=Namespaced
@@ -29,7 +38,7 @@ import (
 type BlobStorage struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec              types.ResourceTypeSpec   `json:"spec,omitempty"`
+	Spec              BlobStorageSpec          `json:"spec,omitempty"`
 	Status            types.ResourceTypeStatus `json:"status,omitempty"`
 }
 
@@ -0,0 +1,98 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CloudNetworkName is the name of the single cloud network of a cluster
+const CloudNetworkName = "cluster"
+
+// CloudNetworkSpec defines the desired state of CloudNetwork
+type CloudNetworkSpec struct {
+	// Tier is the tier of the `_network` strategy the network is created with
+	Tier string `json:"tier"`
+}
+
+// CloudNetworkSubnet is a subnet of the network the cloud resources are created in
+type CloudNetworkSubnet struct {
+	ID        string `json:"id"`
+	CidrBlock string `json:"cidrBlock,omitempty"`
+	Zone      string `json:"zone,omitempty"`
+}
+
+// CloudNetworkStatus defines the observed state of CloudNetwork
+type CloudNetworkStatus struct {
+	Strategy string              `json:"strategy,omitempty"`
+	Provider string              `json:"provider,omitempty"`
+	Phase    types.StatusPhase   `json:"phase,omitempty"`
+	Message  types.StatusMessage `json:"message,omitempty"`
+	// NetworkID is the identifier of the network the cloud resources are created in, the standalone vpc on aws and the
+	// cluster vpc on gcp
+	NetworkID string `json:"networkID,omitempty"`
+	// CidrBlock is the cidr block of the standalone vpc on aws and of the ip address range reserved on gcp
+	CidrBlock string `json:"cidrBlock,omitempty"`
+	// Subnets are the subnets of the standalone vpc on aws
+	Subnets []CloudNetworkSubnet `json:"subnets,omitempty"`
+	// SecurityGroupID is the identifier of the security group of the standalone vpc on aws
+	SecurityGroupID string `json:"securityGroupID,omitempty"`
+	// IPRange is the name of the ip address range reserved for the cloud resources on gcp
+	IPRange string `json:"ipRange,omitempty"`
+	// ConnectionMethod is the method used to connect the network to the cluster network
+	ConnectionMethod string `json:"connectionMethod,omitempty"`
+	// ConnectionID is the identifier of the connection to the cluster network, such as the vpc peering connection
+	ConnectionID string `json:"connectionID,omitempty"`
+	// ConnectionState is the state of the connection to the cluster network reported by the cloud provider
+	ConnectionState string `json:"connectionState,omitempty"`
+	// Conditions report the observed state of the network, the Ready condition is true once cloud resources can be
+	// created in the network
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=cloudnetworks,scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'cluster'",message="the cloud network must be named cluster"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.status.networkID`
+// +kubebuilder:printcolumn:name="CIDR",type=string,JSONPath=`.status.cidrBlock`
+
+// CloudNetwork is the Schema for the cloudnetworks API
+type CloudNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudNetworkSpec   `json:"spec,omitempty"`
+	Status CloudNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudNetworkList contains a list of CloudNetwork
+type CloudNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudNetwork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CloudNetwork{}, &CloudNetworkList{})
+}
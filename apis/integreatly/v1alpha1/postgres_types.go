@@ -21,6 +21,23 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// PostgresSpec defines the desired state of Postgres
+type PostgresSpec struct {
+	types.ResourceTypeSpec `json:",inline"`
+	// NetworkAccess restricts the networks allowed to connect to the cloud resource, by default the whole cluster network
+	// is allowed
+	NetworkAccess *types.NetworkAccess `json:"networkAccess,omitempty"`
+	// ResetCredentials resets the master credentials of an adopted cloud resource to the credentials in the secret of
+	// the operator, for cloud resources whose master credentials are unknown
+	ResetCredentials bool `json:"resetCredentials,omitempty"`
+	// AllowStrategyMigration migrates the cloud resource, with its data, when the strategy for its type changes in the
+	// cloud-resource-config config map, by default the existing strategy is kept
+	AllowStrategyMigration bool `json:"allowStrategyMigration,omitempty"`
+	// ConfirmMigration confirms the migrated cloud resource works, the cloud resource migrated from is deleted once
+	// confirmed
+	ConfirmMigration bool `json:"confirmMigration,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=postgres,scope=Namespaced
@@ -30,7 +47,7 @@ type Postgres struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   types.ResourceTypeSpec   `json:"spec,omitempty"`
+	Spec   PostgresSpec             `json:"spec,omitempty"`
 	Status types.ResourceTypeStatus `json:"status,omitempty"`
 }
 
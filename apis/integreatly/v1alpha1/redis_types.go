@@ -21,6 +21,20 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// RedisSpec defines the desired state of Redis
+type RedisSpec struct {
+	types.ResourceTypeSpec `json:",inline"`
+	// NetworkAccess restricts the networks allowed to connect to the cloud resource, by default the whole cluster network
+	// is allowed
+	NetworkAccess *types.NetworkAccess `json:"networkAccess,omitempty"`
+	// AllowStrategyMigration migrates the cloud resource, with its data, when the strategy for its type changes in the
+	// cloud-resource-config config map, by default the existing strategy is kept
+	AllowStrategyMigration bool `json:"allowStrategyMigration,omitempty"`
+	// ConfirmMigration confirms the migrated cloud resource works, the cloud resource migrated from is deleted once
+	// confirmed
+	ConfirmMigration bool `json:"confirmMigration,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=redis,scope=Namespaced
@@ -30,7 +44,7 @@ type Redis struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   types.ResourceTypeSpec   `json:"spec,omitempty"`
+	Spec   RedisSpec                `json:"spec,omitempty"`
 	Status types.ResourceTypeStatus `json:"status,omitempty"`
 }
 
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var (
@@ -20,11 +22,79 @@ var (
 	StatusNetworkServiceConnectionPendingCreation StatusMessage = "service connection is pending creation"
 )
 
+const (
+	// DriftPolicyReport reports drift of the cloud resource from the strategy, drift is corrected in the maintenance window
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyRemediate reports drift of the cloud resource from the strategy and corrects it straight away
+	DriftPolicyRemediate DriftPolicy = "Remediate"
+)
+
+// DriftPolicy is how drift of the cloud resource from the strategy is handled
+// +kubebuilder:validation:Enum=Report;Remediate
+type DriftPolicy string
+
+const (
+	// DeletionPolicyRetain keeps the cloud resource, tagged as orphaned, when the custom resource is deleted
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyDelete deletes the cloud resource without a final snapshot when the custom resource is deleted
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicySnapshot takes a final snapshot of the cloud resource before deleting it
+	DeletionPolicySnapshot DeletionPolicy = "Snapshot"
+)
+
+// DeletionPolicy is what happens to the cloud resource when the custom resource is deleted
+// +kubebuilder:validation:Enum=Retain;Delete;Snapshot
+type DeletionPolicy string
+
+const (
+	// MigrationPhaseProvisioning is the phase in which the cloud resource of the new strategy is provisioned
+	MigrationPhaseProvisioning MigrationPhase = "Provisioning"
+	// MigrationPhaseCopying is the phase in which data is copied from the previous cloud resource to the new one
+	MigrationPhaseCopying MigrationPhase = "Copying"
+	// MigrationPhaseSwitched is the phase in which the connection secret points at the new cloud resource, the previous
+	// cloud resource is kept until the migration is confirmed
+	MigrationPhaseSwitched MigrationPhase = "Switched"
+	// MigrationPhaseComplete is the phase in which the previous cloud resource has been deleted
+	MigrationPhaseComplete MigrationPhase = "Complete"
+)
+
+// MigrationPhase is the phase of the migration of a cloud resource between strategies
+type MigrationPhase string
+
+// MigrationStatus describes the migration of a cloud resource from one strategy to another
+type MigrationStatus struct {
+	// FromStrategy is the strategy the cloud resource is migrated from
+	FromStrategy string `json:"fromStrategy"`
+	// FromProvider is the provider the cloud resource is migrated from
+	FromProvider string `json:"fromProvider,omitempty"`
+	// FromResourceIdentifier is the resource identifier of the cloud resource migrated from
+	FromResourceIdentifier string `json:"fromResourceIdentifier,omitempty"`
+	// ToStrategy is the strategy the cloud resource is migrated to
+	ToStrategy string `json:"toStrategy"`
+	// ToResourceIdentifier is the resource identifier of the cloud resource migrated to
+	ToResourceIdentifier string `json:"toResourceIdentifier,omitempty"`
+	// Phase is the phase of the migration
+	Phase MigrationPhase `json:"phase"`
+}
+
 type SecretRef struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace,omitempty"`
 }
 
+// NetworkAccess restricts which networks can connect to a cloud resource, on top of the access the network connection
+// of the cloud resource needs
+// +kubebuilder:object:generate=true
+type NetworkAccess struct {
+	// AllowedCIDRs are cidr blocks, outside of the cluster, allowed to connect to the cloud resource
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+	// AllowedSecurityGroupIDs are the ids of security groups allowed to connect to the cloud resource. Only applies to AWS
+	AllowedSecurityGroupIDs []string `json:"allowedSecurityGroupIDs,omitempty"`
+	// ClusterPodsOnly only allows the cluster nodes, and the pods running on them, to connect to the cloud resource
+	// instead of the whole cluster network. For the openshift strategy only pods are allowed
+	ClusterPodsOnly bool `json:"clusterPodsOnly,omitempty"`
+}
+
 // Duration is a custom time duration that can support any combination of the following units, in descending order: d, h, m.
 // Examples: `1d`, `12h`, `12h30m`, `7d12h15m`
 // +kubebuilder:validation:Pattern:="^(0|(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?)$"
@@ -47,6 +117,13 @@ type ResourceTypeSpec struct {
 	// SnapshotRetention is the number of days each snapshot is to be retained.
 	// Does not apply to BlobStorage
 	SnapshotRetention Duration `json:"snapshotRetention,omitempty"`
+	// DriftPolicy is how drift of the cloud resource from the strategy is handled, defaults to Report
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+	// DeletionPolicy is what happens to the cloud resource when the custom resource is deleted, defaults to the
+	// deletion behaviour of the strategy
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// ExternalResourceID is the identifier of an existing cloud resource to adopt instead of creating a new one
+	ExternalResourceID string `json:"externalResourceID,omitempty"`
 }
 
 type StatusPhase string
@@ -68,6 +145,21 @@ type ResourceTypeStatus struct {
 	SecretRef *SecretRef    `json:"secretRef,omitempty"`
 	Phase     StatusPhase   `json:"phase,omitempty"`
 	Message   StatusMessage `json:"message,omitempty"`
+	// PendingChanges are the modifications to the cloud resource computed in dry-run mode that have not been applied
+	PendingChanges []PendingChange `json:"pendingChanges,omitempty"`
+	// Conditions report the observed state of the cloud resource, such as drift from the strategy
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Migration reports the progress of a migration of the cloud resource between strategies
+	Migration *MigrationStatus `json:"migration,omitempty"`
+}
+
+// PendingChange is a field of the cloud resource that differs from the strategy, and the value it would be changed to
+type PendingChange struct {
+	Field   string `json:"field"`
+	Current string `json:"current,omitempty"`
+	Desired string `json:"desired,omitempty"`
 }
 
 type ResourceTypeSnapshotStatus struct {
@@ -21,7 +21,34 @@ limitations under the License.
 
 package types
 
-import ()
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkAccess) DeepCopyInto(out *NetworkAccess) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSecurityGroupIDs != nil {
+		in, out := &in.AllowedSecurityGroupIDs, &out.AllowedSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkAccess.
+func (in *NetworkAccess) DeepCopy() *NetworkAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkAccess)
+	in.DeepCopyInto(out)
+	return out
+}
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceTypeSpec) DeepCopyInto(out *ResourceTypeSpec) {
@@ -51,6 +78,23 @@ func (in *ResourceTypeStatus) DeepCopyInto(out *ResourceTypeStatus) {
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]PendingChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(MigrationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeStatus.
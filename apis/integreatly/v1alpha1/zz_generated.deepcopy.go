@@ -22,6 +22,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -84,6 +86,143 @@ func (in *BlobStorageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlobStorageSpec) DeepCopyInto(out *BlobStorageSpec) {
+	*out = *in
+	in.ResourceTypeSpec.DeepCopyInto(&out.ResourceTypeSpec)
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlobStorageSpec.
+func (in *BlobStorageSpec) DeepCopy() *BlobStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlobStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetwork) DeepCopyInto(out *CloudNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetwork.
+func (in *CloudNetwork) DeepCopy() *CloudNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkList) DeepCopyInto(out *CloudNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkList.
+func (in *CloudNetworkList) DeepCopy() *CloudNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkSpec) DeepCopyInto(out *CloudNetworkSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkSpec.
+func (in *CloudNetworkSpec) DeepCopy() *CloudNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkStatus) DeepCopyInto(out *CloudNetworkStatus) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]CloudNetworkSubnet, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkStatus.
+func (in *CloudNetworkStatus) DeepCopy() *CloudNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudNetworkSubnet) DeepCopyInto(out *CloudNetworkSubnet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudNetworkSubnet.
+func (in *CloudNetworkSubnet) DeepCopy() *CloudNetworkSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudNetworkSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Postgres) DeepCopyInto(out *Postgres) {
 	*out = *in
@@ -217,6 +356,27 @@ func (in *PostgresSnapshotSpec) DeepCopy() *PostgresSnapshotSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresSpec) DeepCopyInto(out *PostgresSpec) {
+	*out = *in
+	in.ResourceTypeSpec.DeepCopyInto(&out.ResourceTypeSpec)
+	if in.NetworkAccess != nil {
+		in, out := &in.NetworkAccess, &out.NetworkAccess
+		*out = new(types.NetworkAccess)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresSpec.
+func (in *PostgresSpec) DeepCopy() *PostgresSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Redis) DeepCopyInto(out *Redis) {
 	*out = *in
@@ -349,3 +509,24 @@ func (in *RedisSnapshotSpec) DeepCopy() *RedisSnapshotSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
+	*out = *in
+	in.ResourceTypeSpec.DeepCopyInto(&out.ResourceTypeSpec)
+	if in.NetworkAccess != nil {
+		in, out := &in.NetworkAccess, &out.NetworkAccess
+		*out = new(types.NetworkAccess)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSpec.
+func (in *RedisSpec) DeepCopy() *RedisSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -18,41 +18,29 @@ package blobstorage
 
 import (
 	"context"
-	"fmt"
 	"time"
 
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
-
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
-
-	"github.com/sirupsen/logrus"
-
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
-
-	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	errorUtil "github.com/pkg/errors"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-var log = logf.Log.WithName("controller_blobstorage")
-
 // BlobStorageReconciler reconciles a BlobStorage object
 type BlobStorageReconciler struct {
-	k8sclient.Client
-	scheme           *runtime.Scheme
-	logger           *logrus.Entry
-	resourceProvider *resources.ReconcileResourceProvider
-	providerList     []providers.BlobStorageProvider
+	*reconciler.Reconciler[*v1alpha1.BlobStorage, providers.BlobStorageInstance]
 }
 
 var _ reconcile.Reconciler = &BlobStorageReconciler{}
@@ -69,22 +57,45 @@ func New(mgr manager.Manager) (*BlobStorageReconciler, error) {
 	}
 
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_blobstorage"})
-	awsBlobStorageProvider, err := aws.NewAWSBlobStorageProvider(client, logger)
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsBlobStorageProvider, err := aws.NewAWSBlobStorageProvider(client, logger, recorder)
 	if err != nil {
 		return nil, err
 	}
-	providerList := []providers.BlobStorageProvider{
-		openshift.NewBlobStorageProvider(client, logger),
-		awsBlobStorageProvider,
-		gcp.NewGCPBlobStorageProvider(client),
+	gcpBlobStorageProvider, err := gcp.NewGCPBlobStorageProvider(client, recorder)
+	if err != nil {
+		return nil, err
 	}
 	rp := resources.NewResourceProvider(client, mgr.GetScheme(), logger)
 	return &BlobStorageReconciler{
-		Client:           client,
-		scheme:           mgr.GetScheme(),
-		logger:           logger,
-		resourceProvider: rp,
-		providerList:     providerList,
+		Reconciler: reconciler.New(client, logger, recorder, reconciler.Config[*v1alpha1.BlobStorage, providers.BlobStorageInstance]{
+			Name:      "blob storage",
+			NewObject: func() *v1alpha1.BlobStorage { return &v1alpha1.BlobStorage{} },
+			Providers: reconciler.BlobStorageProviders(
+				openshift.NewBlobStorageProvider(client, logger),
+				awsBlobStorageProvider,
+				gcpBlobStorageProvider,
+			),
+			Strategy: reconciler.StrategyFromConfig(client, func(_ context.Context, instance *v1alpha1.BlobStorage) (string, croType.StatusMessage, error) {
+				return instance.Spec.Type, croType.StatusEmpty, nil
+			}, func(stratMap *providers.DeploymentStrategyMapping) string {
+				return stratMap.BlobStorage
+			}),
+			Status: func(instance *v1alpha1.BlobStorage) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+			},
+			OnPending: func(instance *v1alpha1.BlobStorage) {
+				instance.Status.SecretRef = &croType.SecretRef{}
+			},
+			OnResult: func(ctx context.Context, instance *v1alpha1.BlobStorage, result *providers.BlobStorageInstance) error {
+				// return the connection secret
+				if err := rp.ReconcileResultSecret(ctx, instance, result.DeploymentDetails.Data()); err != nil {
+					return errorUtil.Wrap(err, "failed to reconcile secret")
+				}
+				instance.Status.SecretRef = instance.Spec.SecretRef
+				return nil
+			},
+		}),
 	}, nil
 }
 
@@ -92,107 +103,22 @@ func (r *BlobStorageReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.BlobStorage{}).
 		Watches(&v1alpha1.BlobStorage{}, &handler.EnqueueRequestForObject{}).
-		Complete(r)
-}
-
-func (r *BlobStorageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	r.logger.Info("reconciling BlobStorage")
-	cfgMgr := providers.NewConfigManager(providers.DefaultProviderConfigMapName, req.Namespace, r.Client)
-
-	// Fetch the BlobStorage instance
-	instance := &v1alpha1.BlobStorage{}
-	err := r.Client.Get(ctx, req.NamespacedName, instance)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
-		}
-		// Error reading the object - requeue the request.
-		return ctrl.Result{}, err
-	}
-
-	stratMap, err := cfgMgr.GetStrategyMappingForDeploymentType(ctx, instance.Spec.Type)
-	if err != nil {
-		if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusDeploymentConfigNotFound.WrapError(err)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, err
-	}
-
-	// Check the CR for existing Strategy
-	strategyToUse := stratMap.BlobStorage
-	if instance.Status.Strategy != "" {
-		strategyToUse = instance.Status.Strategy
-		if strategyToUse != stratMap.BlobStorage {
-			r.logger.Infof("strategy and provider already set, changing of cloud-resource-config config maps not allowed in existing installation. the existing strategy is '%s' , cloud-resource-config is now set to '%s'. operator will continue to use existing strategy", strategyToUse, stratMap.BlobStorage)
-		}
-	}
-
-	for _, p := range r.providerList {
-		if !p.SupportsStrategy(strategyToUse) {
-			continue
-		}
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if instance.Status.Strategy != strategyToUse || instance.Status.Provider != p.GetName() {
-			if err = r.Client.Status().Update(ctx, instance); err != nil {
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-			}
-		}
-
-		if instance.GetDeletionTimestamp() != nil {
-			msg, err := p.DeleteStorage(ctx, instance)
-			if err != nil {
-				if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-					return ctrl.Result{}, updateErr
+		Watches(&corev1.ConfigMap{}, r.ConfigMapHandler(reconciler.Watch[*v1alpha1.BlobStorage]{
+			ResourceType: providers.BlobStorageResourceType,
+			List: func(ctx context.Context, c k8sclient.Client, namespace string) ([]*v1alpha1.BlobStorage, error) {
+				list := &v1alpha1.BlobStorageList{}
+				if err := c.List(ctx, list, k8sclient.InNamespace(namespace)); err != nil {
+					return nil, err
 				}
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to perform provider-specific storage deletion")
-			}
-
-			r.logger.Info("waiting on blob storage to successfully delete")
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseDeleteInProgress, msg.WrapError(err)); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		bsi, msg, err := p.CreateStorage(ctx, instance)
-		if err != nil {
-			instance.Status.SecretRef = &croType.SecretRef{}
-			if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{}, err
-		}
-		if bsi == nil {
-			r.logger.Info("secret data is still reconciling, blob storage is nil")
-			instance.Status.SecretRef = &croType.SecretRef{}
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		if err := r.resourceProvider.ReconcileResultSecret(ctx, instance, bsi.DeploymentDetails.Data()); err != nil {
-			return ctrl.Result{}, errorUtil.Wrap(err, "failed to reconcile secret")
-		}
-
-		instance.Status.Phase = croType.PhaseComplete
-		instance.Status.Message = msg
-		instance.Status.SecretRef = instance.Spec.SecretRef
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if err = r.Client.Status().Update(ctx, instance); err != nil {
-			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-	}
-
-	// unsupported strategy
-	if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusUnsupportedType.WrapError(err)); err != nil {
-		return ctrl.Result{}, err
-	}
-	return ctrl.Result{}, errorUtil.New(fmt.Sprintf("unsupported deployment strategy %s", stratMap.BlobStorage))
+				instances := make([]*v1alpha1.BlobStorage, 0, len(list.Items))
+				for i := range list.Items {
+					instances = append(instances, &list.Items[i])
+				}
+				return instances, nil
+			},
+			Spec: func(instance *v1alpha1.BlobStorage) reconciler.Spec {
+				return reconciler.Spec{Type: instance.Spec.Type, Tier: instance.Spec.Tier}
+			},
+		})).
+		Complete(r)
 }
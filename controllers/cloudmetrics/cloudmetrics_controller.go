@@ -1,12 +1,16 @@
-// This controller reconciles metrics for cloud resources (currently redis and postgres)
+// This controller reconciles metrics for cloud resources (currently redis, postgres and blob storage)
 // It takes a sync the world approach, reconciling all cloud resources every set period
 // of time (currently every 5 minutes)
+//
+// Additional metrics can be defined at runtime in the `cloud-resource-metrics` config map,
+// these are registered and scraped alongside the built-in metrics on the next reconcile
 package cloudmetrics
 
 import (
 	"context"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sort"
 	"time"
 
 	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
@@ -15,7 +19,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
@@ -58,6 +64,10 @@ var postgresGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "cloudsql.googleapis.com/database/disk/quota-cloudsql.googleapis.com/database/disk/bytes_used",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.PostgresFreeStorageAverageMetricName,
+				ProviderMetricName:   openshift.DataVolumeAvailableMetric,
+			},
 		},
 	},
 	{
@@ -79,6 +89,10 @@ var postgresGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "cloudsql.googleapis.com/database/cpu/utilization",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.PostgresCPUUtilizationAverageMetricName,
+				ProviderMetricName:   openshift.PodCPUUtilizationMetric,
+			},
 		},
 	},
 	{
@@ -100,6 +114,10 @@ var postgresGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "cloudsql.googleapis.com/database/memory/quota-cloudsql.googleapis.com/database/memory/total_usage",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.PostgresFreeableMemoryAverageMetricName,
+				ProviderMetricName:   openshift.PodMemoryAvailableMetric,
+			},
 		},
 	},
 	{
@@ -116,6 +134,10 @@ var postgresGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "cloudsql.googleapis.com/database/memory/quota",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.PostgresMaxMemoryMetricName,
+				ProviderMetricName:   openshift.PodMemoryLimitMetric,
+			},
 		},
 	},
 	{
@@ -132,6 +154,10 @@ var postgresGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "cloudsql.googleapis.com/database/disk/bytes_used",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.PostgresAllocatedStorageMetricName,
+				ProviderMetricName:   openshift.PostgresVolumeCapacityMetric,
+			},
 		},
 	},
 }
@@ -159,6 +185,10 @@ var redisGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "redis.googleapis.com/stats/memory/usage_ratio",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.RedisMemoryUsagePercentageAverageMetricName,
+				ProviderMetricName:   openshift.RedisMemoryUsagePercentageMetric,
+			},
 		},
 	},
 	{
@@ -180,6 +210,10 @@ var redisGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "redis.googleapis.com/stats/memory/maxmemory-redis.googleapis.com/stats/memory/usage",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.RedisFreeableMemoryAverageMetricName,
+				ProviderMetricName:   openshift.RedisFreeableMemoryMetric,
+			},
 		},
 	},
 	{
@@ -201,6 +235,10 @@ var redisGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "redis.googleapis.com/stats/cpu_utilization",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.RedisCPUUtilizationAverageMetricName,
+				ProviderMetricName:   openshift.PodCPUUtilizationMetric,
+			},
 		},
 	},
 	{
@@ -222,17 +260,125 @@ var redisGaugeMetrics = []CroGaugeMetric{
 				ProviderMetricName:   "redis.googleapis.com/stats/cpu_utilization_main_thread",
 				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
 			},
+			providers.OpenShiftDeploymentStrategy: {
+				PrometheusMetricName: resources.RedisEngineCPUUtilizationAverageMetricName,
+				ProviderMetricName:   openshift.RedisEngineCPUUtilizationMetric,
+			},
+		},
+	},
+}
+
+// blobStorageGaugeMetrics stores a mapping between an exposed (blob storage) prometheus metric and multiple cloud provider specific metric
+// to add any addition metrics simply add to this mapping and it will be scraped and exposed
+var blobStorageGaugeMetrics = []CroGaugeMetric{
+	{
+		Name: resources.BlobStorageSizeBytesAverageMetricName,
+		GaugeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: resources.BlobStorageSizeBytesAverageMetricName,
+				Help: "The amount of data stored in the bucket, reported daily. Units: Bytes",
+			},
+			genericMetricLabelNames()),
+		ProviderType: map[string]providers.CloudProviderMetricType{
+			providers.AWSDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageSizeBytesAverageMetricName,
+				ProviderMetricName:   "BucketSizeBytes",
+				Statistic:            cloudwatch.StatisticAverage,
+				Period:               24 * time.Hour,
+			},
+			providers.GCPDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageSizeBytesAverageMetricName,
+				ProviderMetricName:   "storage.googleapis.com/storage/total_bytes",
+				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
+				Period:               24 * time.Hour,
+			},
+		},
+	},
+	{
+		Name: resources.BlobStorageObjectCountAverageMetricName,
+		GaugeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: resources.BlobStorageObjectCountAverageMetricName,
+				Help: "The number of objects stored in the bucket, reported daily. Units: Count",
+			},
+			genericMetricLabelNames()),
+		ProviderType: map[string]providers.CloudProviderMetricType{
+			providers.AWSDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageObjectCountAverageMetricName,
+				ProviderMetricName:   "NumberOfObjects",
+				Statistic:            cloudwatch.StatisticAverage,
+				Period:               24 * time.Hour,
+			},
+			providers.GCPDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageObjectCountAverageMetricName,
+				ProviderMetricName:   "storage.googleapis.com/storage/object_count",
+				Statistic:            monitoringpb.Aggregation_ALIGN_MEAN.String(),
+				Period:               24 * time.Hour,
+			},
+		},
+	},
+	{
+		Name: resources.BlobStorageRequestsSumMetricName,
+		GaugeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: resources.BlobStorageRequestsSumMetricName,
+				Help: "The number of requests made to the bucket during the metric period. Units: Count",
+			},
+			genericMetricLabelNames()),
+		ProviderType: map[string]providers.CloudProviderMetricType{
+			providers.AWSDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageRequestsSumMetricName,
+				ProviderMetricName:   "AllRequests",
+				Statistic:            cloudwatch.StatisticSum,
+			},
+			providers.GCPDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageRequestsSumMetricName,
+				ProviderMetricName:   "storage.googleapis.com/api/request_count",
+				Statistic:            monitoringpb.Aggregation_ALIGN_SUM.String(),
+			},
+		},
+	},
+	{
+		Name: resources.BlobStorageServerErrorsSumMetricName,
+		GaugeVec: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: resources.BlobStorageServerErrorsSumMetricName,
+				Help: "The number of requests made to the bucket which failed with a server error during the metric period. Units: Count",
+			},
+			genericMetricLabelNames()),
+		ProviderType: map[string]providers.CloudProviderMetricType{
+			providers.AWSDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageServerErrorsSumMetricName,
+				ProviderMetricName:   "5xxErrors",
+				Statistic:            cloudwatch.StatisticSum,
+			},
+			providers.GCPDeploymentStrategy: {
+				PrometheusMetricName: resources.BlobStorageServerErrorsSumMetricName,
+				ProviderMetricName:   gcp.GCSServerErrorCountMetric,
+				Statistic:            monitoringpb.Aggregation_ALIGN_SUM.String(),
+			},
 		},
 	},
 }
 
+// customGaugeMetric is a CroGaugeMetric defined in the metrics config map
+type customGaugeMetric struct {
+	CroGaugeMetric
+	help string
+}
+
 // PostgresReconciler reconciles a Postgres object
 type CloudMetricsReconciler struct {
 	k8sclient.Client
-	scheme               *runtime.Scheme
-	logger               *logrus.Entry
-	postgresProviderList []providers.PostgresMetricsProvider
-	redisProviderList    []providers.RedisMetricsProvider
+	scheme                     *runtime.Scheme
+	logger                     *logrus.Entry
+	postgresProviderList       []providers.PostgresMetricsProvider
+	redisProviderList          []providers.RedisMetricsProvider
+	blobStorageProviderList    []providers.BlobStorageMetricsProvider
+	metricsConfigManager       providers.MetricsConfigManager
+	customPostgresGaugeMetrics map[string]*customGaugeMetric
+	customRedisGaugeMetrics    map[string]*customGaugeMetric
+	customBlobStorageMetrics   map[string]*customGaugeMetric
 }
 
 // blank assignment to verify that ReconcileCloudMetrics implements reconcile.Reconciler
@@ -248,6 +394,10 @@ func New(mgr manager.Manager) (*CloudMetricsReconciler, error) {
 	if err != nil {
 		return nil, err
 	}
+	clientSet, err := resources.GetK8Client()
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to build client set")
+	}
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_cloudmetrics"})
 	awsPostgresMetricsProvider, err := aws.NewAWSPostgresMetricsProvider(client, logger)
 	if err != nil {
@@ -258,6 +408,7 @@ func New(mgr manager.Manager) (*CloudMetricsReconciler, error) {
 		return nil, err
 	}
 	postgresProviderList := []providers.PostgresMetricsProvider{
+		openshift.NewOpenShiftPostgresMetricsProvider(client, clientSet, logger),
 		awsPostgresMetricsProvider,
 		gcpPostgresMetricsProvider,
 	}
@@ -270,20 +421,38 @@ func New(mgr manager.Manager) (*CloudMetricsReconciler, error) {
 		return nil, err
 	}
 	redisProviderList := []providers.RedisMetricsProvider{
+		openshift.NewOpenShiftRedisMetricsProvider(client, clientSet, logger),
 		awsRedisMetricsProvider,
 		gcpRedisMetricsProvider,
 	}
+	awsBlobStorageMetricsProvider, err := aws.NewAWSBlobStorageMetricsProvider(client, logger)
+	if err != nil {
+		return nil, err
+	}
+	gcpBlobStorageMetricsProvider, err := gcp.NewGCPBlobStorageMetricsProvider(client, logger)
+	if err != nil {
+		return nil, err
+	}
+	blobStorageProviderList := []providers.BlobStorageMetricsProvider{
+		awsBlobStorageMetricsProvider,
+		gcpBlobStorageMetricsProvider,
+	}
 
 	// we only wish to register metrics once when the new reconciler is created
 	// as the metrics we want to expose are known in advance we can register them all
 	// they will only be exposed if there is a value returned for the vector for a provider
 	registerGaugeVectorMetrics(logger)
 	return &CloudMetricsReconciler{
-		Client:               mgr.GetClient(),
-		scheme:               mgr.GetScheme(),
-		logger:               logger,
-		postgresProviderList: postgresProviderList,
-		redisProviderList:    redisProviderList,
+		Client:                     mgr.GetClient(),
+		scheme:                     mgr.GetScheme(),
+		logger:                     logger,
+		postgresProviderList:       postgresProviderList,
+		redisProviderList:          redisProviderList,
+		blobStorageProviderList:    blobStorageProviderList,
+		metricsConfigManager:       providers.NewMetricsConfigManager(providers.DefaultMetricsConfigMapName, providers.DefaultConfigNamespace, client),
+		customPostgresGaugeMetrics: map[string]*customGaugeMetric{},
+		customRedisGaugeMetrics:    map[string]*customGaugeMetric{},
+		customBlobStorageMetrics:   map[string]*customGaugeMetric{},
 	}, nil
 }
 
@@ -324,6 +493,11 @@ func (r *CloudMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	// scrapedMetrics stores the GenericCloudMetric which are returned from the providers
 	var scrapedMetrics []*providers.GenericCloudMetric
 
+	// combine the built-in metrics with any metrics defined in the metrics config map
+	redisMetrics := r.reconcileCustomGaugeMetrics(ctx, providers.RedisResourceType, redisGaugeMetrics, r.customRedisGaugeMetrics)
+	postgresMetrics := r.reconcileCustomGaugeMetrics(ctx, providers.PostgresResourceType, postgresGaugeMetrics, r.customPostgresGaugeMetrics)
+	blobStorageMetrics := r.reconcileCustomGaugeMetrics(ctx, providers.BlobStorageResourceType, blobStorageGaugeMetrics, r.customBlobStorageMetrics)
+
 	// fetch all redis crs
 	redisInstances := &integreatlyv1alpha1.RedisList{}
 	err := r.Client.List(ctx, redisInstances)
@@ -341,7 +515,7 @@ func (r *CloudMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request
 				continue
 			}
 			var redisMetricTypes []providers.CloudProviderMetricType
-			for _, gaugeMetric := range redisGaugeMetrics {
+			for _, gaugeMetric := range redisMetrics {
 				for provider, metricType := range gaugeMetric.ProviderType {
 					if provider == redis.Status.Strategy {
 						redisMetricTypes = append(redisMetricTypes, metricType)
@@ -362,7 +536,7 @@ func (r *CloudMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 	// for each scraped metric value we check redisGaugeMetrics for a match and set the value and labels
-	r.setGaugeMetrics(redisGaugeMetrics, scrapedMetrics)
+	r.setGaugeMetrics(redisMetrics, scrapedMetrics)
 
 	// Fetch all postgres crs
 	postgresInstances := &integreatlyv1alpha1.PostgresList{}
@@ -379,9 +553,9 @@ func (r *CloudMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request
 				continue
 			}
 
-			// filter out the provider specific metric from the postgresGaugeMetrics map which defines the metrics we want to scrape
+			// filter out the provider specific metric from the postgres metrics which defines the metrics we want to scrape
 			var postgresMetricTypes []providers.CloudProviderMetricType
-			for _, gaugeMetric := range postgresGaugeMetrics {
+			for _, gaugeMetric := range postgresMetrics {
 				for provider, metricType := range gaugeMetric.ProviderType {
 					if provider == postgres.Status.Strategy {
 						postgresMetricTypes = append(postgresMetricTypes, metricType)
@@ -403,8 +577,45 @@ func (r *CloudMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
-	// for each scraped metric value we check postgresGaugeMetrics for a match and set the value and labels
-	r.setGaugeMetrics(postgresGaugeMetrics, scrapedMetrics)
+	// for each scraped metric value we check the postgres metrics for a match and set the value and labels
+	r.setGaugeMetrics(postgresMetrics, scrapedMetrics)
+
+	// fetch all blob storage crs
+	blobStorageInstances := &integreatlyv1alpha1.BlobStorageList{}
+	err = r.Client.List(ctx, blobStorageInstances)
+	if err != nil {
+		r.logger.Error(err)
+	}
+	for index := range blobStorageInstances.Items {
+		blobStorage := blobStorageInstances.Items[index]
+		r.logger.Infof("beginning to scrape metrics for blob storage cr: %s", blobStorage.Name)
+		for _, p := range r.blobStorageProviderList {
+			// only scrape metrics on supported strategies
+			if !p.SupportsStrategy(blobStorage.Status.Strategy) {
+				continue
+			}
+
+			// filter out the provider specific metric from the blob storage metrics which defines the metrics we want to scrape
+			var blobStorageMetricTypes []providers.CloudProviderMetricType
+			for _, gaugeMetric := range blobStorageMetrics {
+				if metricType, ok := gaugeMetric.ProviderType[blobStorage.Status.Strategy]; ok {
+					blobStorageMetricTypes = append(blobStorageMetricTypes, metricType)
+				}
+			}
+
+			scrapedMetricsOutput, err := p.ScrapeBlobStorageMetrics(ctx, &blobStorage, blobStorageMetricTypes)
+			if err != nil {
+				r.logger.Errorf("failed to scrape metrics for blob storage %v", err)
+				continue
+			}
+
+			// add the returned scraped metrics to the list of metrics
+			scrapedMetrics = append(scrapedMetrics, scrapedMetricsOutput.Metrics...)
+		}
+	}
+
+	// for each scraped metric value we check the blob storage metrics for a match and set the value and labels
+	r.setGaugeMetrics(blobStorageMetrics, scrapedMetrics)
 
 	// we want full control over when we scrape metrics
 	// to allow for this we only have a single requeue
@@ -426,6 +637,110 @@ func registerGaugeVectorMetrics(logger *logrus.Entry) {
 		customMetrics.Registry.MustRegister(metric.GaugeVec)
 		resources.MetricVecs[metric.Name] = *metric.GaugeVec
 	}
+	for _, metric := range blobStorageGaugeMetrics {
+		logger.Infof("registering metric: %s ", metric.Name)
+		customMetrics.Registry.MustRegister(metric.GaugeVec)
+		resources.MetricVecs[metric.Name] = *metric.GaugeVec
+	}
+}
+
+// reconcileCustomGaugeMetrics reads the metrics defined for a resource type in the metrics config map
+// new metrics are registered, changed metrics are re-registered and removed metrics are unregistered
+// the built-in metrics are returned along with all registered custom metrics
+func (r *CloudMetricsReconciler) reconcileCustomGaugeMetrics(ctx context.Context, rt providers.ResourceType, builtInMetrics []CroGaugeMetric, registered map[string]*customGaugeMetric) []CroGaugeMetric {
+	definitions, err := r.metricsConfigManager.GetMetricDefinitionsForResourceType(ctx, rt)
+	if err != nil {
+		// keep scraping the previously registered metrics until the config is fixed
+		r.logger.Errorf("failed to read custom %s metrics, continuing with previously registered metrics: %v", rt, err)
+		return combineGaugeMetrics(builtInMetrics, registered)
+	}
+
+	defined := map[string]bool{}
+	for _, definition := range definitions {
+		if isBuiltInGaugeMetric(definition.Name) {
+			r.logger.Errorf("custom %s metric %s conflicts with a built-in metric, skipping", rt, definition.Name)
+			continue
+		}
+		metricTypes, err := definition.MetricTypes()
+		if err != nil {
+			r.logger.Errorf("invalid custom %s metric %s, skipping: %v", rt, definition.Name, err)
+			continue
+		}
+		defined[definition.Name] = true
+
+		// the help text is part of the metric descriptor, so the metric must be re-registered if it changes
+		existing, ok := registered[definition.Name]
+		if ok && existing.help == definition.Help {
+			existing.ProviderType = metricTypes
+			continue
+		}
+		if ok {
+			unregisterGaugeMetric(existing.CroGaugeMetric)
+			delete(registered, definition.Name)
+		}
+		gaugeMetric := CroGaugeMetric{
+			Name: definition.Name,
+			GaugeVec: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: definition.Name,
+					Help: definition.Help,
+				},
+				genericMetricLabelNames()),
+			ProviderType: metricTypes,
+		}
+		r.logger.Infof("registering custom metric: %s ", definition.Name)
+		if err := customMetrics.Registry.Register(gaugeMetric.GaugeVec); err != nil {
+			r.logger.Errorf("failed to register custom %s metric %s, skipping: %v", rt, definition.Name, err)
+			delete(defined, definition.Name)
+			continue
+		}
+		resources.MetricVecs[gaugeMetric.Name] = *gaugeMetric.GaugeVec
+		registered[definition.Name] = &customGaugeMetric{
+			CroGaugeMetric: gaugeMetric,
+			help:           definition.Help,
+		}
+	}
+
+	// unregister any metrics which have been removed from the config map
+	for name, metric := range registered {
+		if defined[name] {
+			continue
+		}
+		r.logger.Infof("unregistering custom metric: %s ", name)
+		unregisterGaugeMetric(metric.CroGaugeMetric)
+		delete(registered, name)
+	}
+	return combineGaugeMetrics(builtInMetrics, registered)
+}
+
+// combineGaugeMetrics returns the built-in metrics followed by the custom metrics, ordered by name
+func combineGaugeMetrics(builtInMetrics []CroGaugeMetric, custom map[string]*customGaugeMetric) []CroGaugeMetric {
+	names := make([]string, 0, len(custom))
+	for name := range custom {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	gaugeMetrics := make([]CroGaugeMetric, 0, len(builtInMetrics)+len(custom))
+	gaugeMetrics = append(gaugeMetrics, builtInMetrics...)
+	for _, name := range names {
+		gaugeMetrics = append(gaugeMetrics, custom[name].CroGaugeMetric)
+	}
+	return gaugeMetrics
+}
+
+func isBuiltInGaugeMetric(name string) bool {
+	builtInMetrics := append(append(append([]CroGaugeMetric{}, postgresGaugeMetrics...), redisGaugeMetrics...), blobStorageGaugeMetrics...)
+	for _, metric := range builtInMetrics {
+		if metric.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func unregisterGaugeMetric(metric CroGaugeMetric) {
+	customMetrics.Registry.Unregister(metric.GaugeVec)
+	delete(resources.MetricVecs, metric.Name)
 }
 
 // func setGaugeMetrics sets the value on exposed metrics with labels
@@ -0,0 +1,110 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudnetwork
+
+import (
+	"context"
+	"time"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/sirupsen/logrus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CloudNetworkReconciler reconciles a CloudNetwork object
+type CloudNetworkReconciler struct {
+	*reconciler.Reconciler[*v1alpha1.CloudNetwork, providers.CloudNetworkInstance]
+}
+
+var _ reconcile.Reconciler = &CloudNetworkReconciler{}
+
+// New returns a new reconcile.Reconciler
+func New(mgr manager.Manager) (*CloudNetworkReconciler, error) {
+	restConfig := ctrl.GetConfigOrDie()
+	restConfig.Timeout = time.Second * 10
+
+	client, err := k8sclient.New(restConfig, k8sclient.Options{
+		Scheme: mgr.GetScheme(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger := logrus.WithFields(logrus.Fields{"controller": "controller_cloud_network"})
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsCloudNetworkProvider, err := aws.NewAWSCloudNetworkProvider(client, logger, recorder)
+	if err != nil {
+		return nil, err
+	}
+	gcpCloudNetworkProvider, err := gcp.NewGCPCloudNetworkProvider(client, logger, recorder)
+	if err != nil {
+		return nil, err
+	}
+	return &CloudNetworkReconciler{
+		Reconciler: reconciler.New(client, logger, recorder, reconciler.Config[*v1alpha1.CloudNetwork, providers.CloudNetworkInstance]{
+			Name:      "cloud network",
+			NewObject: func() *v1alpha1.CloudNetwork { return &v1alpha1.CloudNetwork{} },
+			Providers: reconciler.CloudNetworkProviders(
+				awsCloudNetworkProvider,
+				gcpCloudNetworkProvider,
+			),
+			// the network is created on the cloud provider the cluster runs on
+			Strategy: func(ctx context.Context, _ *v1alpha1.CloudNetwork) (string, croType.StatusMessage, error) {
+				platformType, err := resources.GetPlatformType(ctx, client)
+				if err != nil {
+					return "", "failed to get cluster platform type", err
+				}
+				switch platformType {
+				case configv1.AWSPlatformType:
+					return providers.AWSDeploymentStrategy, croType.StatusEmpty, nil
+				case configv1.GCPPlatformType:
+					return providers.GCPDeploymentStrategy, croType.StatusEmpty, nil
+				}
+				return string(platformType), croType.StatusEmpty, nil
+			},
+			Status: func(instance *v1alpha1.CloudNetwork) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+			},
+			OnPending: func(instance *v1alpha1.CloudNetwork) {
+				resources.SetCloudNetworkReady(instance, false, resources.CloudNetworkReasonNotReady, "cloud resources can not be created until the network is reconciled")
+			},
+			OnResult: func(_ context.Context, instance *v1alpha1.CloudNetwork, _ *providers.CloudNetworkInstance) error {
+				resources.SetCloudNetworkReady(instance, true, resources.CloudNetworkReasonReady, "cloud resources can be created in the network")
+				return nil
+			},
+		}),
+	}, nil
+}
+
+func (r *CloudNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.CloudNetwork{}).
+		Complete(r)
+}
+
+// ClusterRole permissions
+
+// +kubebuilder:rbac:groups=integreatly.org,resources=cloudnetworks;cloudnetworks/status;cloudnetworks/finalizers,verbs="*"
@@ -19,43 +19,29 @@ package postgres
 import (
 	"context"
 	"fmt"
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
-
-	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
-
-	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
-
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
-
 	"time"
 
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
-	"github.com/sirupsen/logrus"
-
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-var log = logf.Log.WithName("controller_postgres")
-
 // PostgresReconciler reconciles a Postgres object
 type PostgresReconciler struct {
-	k8sclient.Client
-	scheme           *runtime.Scheme
-	logger           *logrus.Entry
-	resourceProvider *resources.ReconcileResourceProvider
-	providerList     []providers.PostgresProvider
+	*reconciler.Reconciler[*v1alpha1.Postgres, providers.PostgresInstance]
 }
 
 var _ reconcile.Reconciler = &PostgresReconciler{}
@@ -78,22 +64,69 @@ func New(mgr manager.Manager) (*PostgresReconciler, error) {
 	}
 
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_postgres"})
-	awsPostgresProvider, err := aws.NewAWSPostgresProvider(client, logger)
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsPostgresProvider, err := aws.NewAWSPostgresProvider(client, logger, recorder)
 	if err != nil {
 		return nil, err
 	}
-	providerList := []providers.PostgresProvider{
-		openshift.NewOpenShiftPostgresProvider(client, clientSet, logger),
-		awsPostgresProvider,
-		gcp.NewGCPPostgresProvider(client, logger),
+	gcpPostgresProvider, err := gcp.NewGCPPostgresProvider(client, logger, recorder)
+	if err != nil {
+		return nil, err
 	}
 	rp := resources.NewResourceProvider(client, mgr.GetScheme(), logger)
 	return &PostgresReconciler{
-		Client:           client,
-		scheme:           mgr.GetScheme(),
-		logger:           logger,
-		resourceProvider: rp,
-		providerList:     providerList,
+		Reconciler: reconciler.New(client, logger, recorder, reconciler.Config[*v1alpha1.Postgres, providers.PostgresInstance]{
+			Name:      "postgres",
+			NewObject: func() *v1alpha1.Postgres { return &v1alpha1.Postgres{} },
+			Providers: reconciler.PostgresProviders(
+				openshift.NewOpenShiftPostgresProvider(client, clientSet, logger, recorder),
+				awsPostgresProvider,
+				gcpPostgresProvider,
+			),
+			Strategy: reconciler.StrategyFromConfig(client, func(_ context.Context, instance *v1alpha1.Postgres) (string, croType.StatusMessage, error) {
+				return instance.Spec.Type, croType.StatusEmpty, nil
+			}, func(stratMap *providers.DeploymentStrategyMapping) string {
+				return stratMap.Postgres
+			}),
+			Status: func(instance *v1alpha1.Postgres) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+			},
+			SkipCreate: func(instance *v1alpha1.Postgres) bool {
+				return instance.Spec.SkipCreate
+			},
+			OnPending: func(instance *v1alpha1.Postgres) {
+				instance.Status.SecretRef = &croType.SecretRef{}
+			},
+			OnResult: func(ctx context.Context, instance *v1alpha1.Postgres, ps *providers.PostgresInstance) error {
+				// return the connection secret
+				if err := rp.ReconcileResultSecret(ctx, instance, ps.DeploymentDetails.Data()); err != nil {
+					return errorUtil.Wrap(err, "failed to reconcile secret")
+				}
+				instance.Status.SecretRef = instance.Spec.SecretRef
+				return nil
+			},
+			Migration: &reconciler.Migration[*v1alpha1.Postgres, providers.PostgresInstance]{
+				Enabled: func(instance *v1alpha1.Postgres) bool {
+					return instance.Spec.AllowStrategyMigration
+				},
+				Confirmed: func(instance *v1alpha1.Postgres) bool {
+					return instance.Spec.ConfirmMigration
+				},
+				Status: func(instance *v1alpha1.Postgres) **croType.MigrationStatus {
+					return &instance.Status.Migration
+				},
+				CopyData: func(ctx context.Context, instance *v1alpha1.Postgres, from, to *providers.PostgresInstance) (bool, croType.StatusMessage, error) {
+					done, err := rp.ReconcileMigrationJob(ctx, instance, reconciler.PostgresMigrationJob(from, to))
+					if err != nil {
+						return false, "failed to reconcile migration job", err
+					}
+					return done, croType.StatusMessage(fmt.Sprintf("waiting on job %s", resources.BuildMigrationJobName(instance.Name))), nil
+				},
+				Cleanup: func(ctx context.Context, instance *v1alpha1.Postgres) error {
+					return rp.DeleteMigrationJob(ctx, instance)
+				},
+			},
+		}),
 	}, nil
 }
 
@@ -101,13 +134,31 @@ func (r *PostgresReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Postgres{}).
 		Watches(&v1alpha1.Postgres{}, &handler.EnqueueRequestForObject{}).
+		Watches(&corev1.ConfigMap{}, r.ConfigMapHandler(reconciler.Watch[*v1alpha1.Postgres]{
+			ResourceType: providers.PostgresResourceType,
+			List: func(ctx context.Context, c k8sclient.Client, namespace string) ([]*v1alpha1.Postgres, error) {
+				list := &v1alpha1.PostgresList{}
+				if err := c.List(ctx, list, k8sclient.InNamespace(namespace)); err != nil {
+					return nil, err
+				}
+				instances := make([]*v1alpha1.Postgres, 0, len(list.Items))
+				for i := range list.Items {
+					instances = append(instances, &list.Items[i])
+				}
+				return instances, nil
+			},
+			Spec: func(instance *v1alpha1.Postgres) reconciler.Spec {
+				return reconciler.Spec{Type: instance.Spec.Type, Tier: instance.Spec.Tier}
+			},
+		})).
 		Watches(&corev1.Pod{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1alpha1.Postgres{}, handler.OnlyControllerOwner())).
 		Complete(r)
 }
 
 // ClusterRole permissions
 
-// +kubebuilder:rbac:groups="config.openshift.io",resources=infrastructures;networks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="config.openshift.io",resources=authentications;infrastructures;networks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="operator.openshift.io",resources=cloudcredentials,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=persistentvolumes;configmaps,verbs="*"
 // +kubebuilder:rbac:groups="monitoring.coreos.com",resources=prometheusrules,verbs="*"
 // +kubebuilder:rbac:groups=integreatly.org,resources=postgres;postgressnapshots;redis;redissnapshots,verbs=list;watch
@@ -117,8 +168,11 @@ func (r *PostgresReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 // +kubebuilder:rbac:groups="",resources=pods;pods/exec;services;services/finalizers;endpoints;persistentvolumeclaims;events;configmaps;secrets,verbs="*",namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="apps",resources="*",verbs="*",namespace=cloud-resource-operator
+// +kubebuilder:rbac:groups="batch",resources=jobs,verbs="*",namespace=cloud-resource-operator
+// +kubebuilder:rbac:groups="metrics.k8s.io",resources=pods,verbs=get,namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="monitoring.coreos.com",resources=servicemonitors,verbs=get;create,namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="monitoring.coreos.com",resources=prometheusrules,verbs="*",namespace=cloud-resource-operator
+// +kubebuilder:rbac:groups="networking.k8s.io",resources=networkpolicies,verbs="*",namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="cloud-resource-operator",resources=deployments/finalizers,verbs=update,namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="integreatly",resources="*",verbs="*",namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups=integreatly.org,resources="*";smtpcredentialset;redis;postgres;redissnapshots;postgressnapshots,verbs="*",namespace=cloud-resource-operator
@@ -127,117 +181,3 @@ func (r *PostgresReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups="config.openshift.io",resources="*";infrastructures;schedulers;featuregates;networks;ingresses;clusteroperators;authentications;builds,verbs="*",namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups="cloudcredential.openshift.io",resources=credentialsrequests,verbs="*",namespace=cloud-resource-operator
 // +kubebuilder:rbac:groups=operators.coreos.com,resources=catalogsources,verbs=get;update;patch,namespace=cloud-resource-operator
-
-func (r *PostgresReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	r.logger.Info("reconciling Postgres")
-	cfgMgr := providers.NewConfigManager(providers.DefaultProviderConfigMapName, req.Namespace, r.Client)
-
-	// Fetch the Postgres instance
-	instance := &v1alpha1.Postgres{}
-	err := r.Client.Get(context.TODO(), req.NamespacedName, instance)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
-		}
-		// Error reading the object - requeue the request.
-		return ctrl.Result{}, err
-	}
-
-	stratMap, err := cfgMgr.GetStrategyMappingForDeploymentType(ctx, instance.Spec.Type)
-	if err != nil {
-		if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusDeploymentConfigNotFound.WrapError(err)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to read deployment type config for deployment %s", instance.Spec.Type)
-	}
-
-	// Check the CR for existing Strategy
-	strategyToUse := stratMap.Postgres
-	if instance.Status.Strategy != "" {
-		strategyToUse = instance.Status.Strategy
-		if strategyToUse != stratMap.Postgres {
-			r.logger.Infof("strategy and provider already set, changing of cloud-resource-config config maps not allowed in existing installation. the existing strategy is '%s' , cloud-resource-config is now set to '%s'. operator will continue to use existing strategy", strategyToUse, stratMap.Postgres)
-		}
-	}
-
-	for _, p := range r.providerList {
-		if !p.SupportsStrategy(strategyToUse) {
-			continue
-		}
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if instance.Status.Strategy != strategyToUse || instance.Status.Provider != p.GetName() {
-			if err = r.Client.Status().Update(ctx, instance); err != nil {
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-			}
-		}
-
-		// delete the postgres if the deletion timestamp exists
-		if instance.DeletionTimestamp != nil {
-			msg, err := p.DeletePostgres(ctx, instance)
-			if err != nil {
-				if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-					return ctrl.Result{}, updateErr
-				}
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to perform provider-specific storage deletion")
-			}
-
-			r.logger.Info("waiting on Postgres to successfully delete")
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseDeleteInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// handle skip create
-		if instance.Spec.SkipCreate {
-			r.logger.Info("skipCreate found, skipping postgres reconcile")
-			if err := resources.UpdatePhase(ctx, r.Client, instance, croType.PhasePaused, croType.StatusSkipCreate); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// create the postgres instance
-		ps, msg, err := p.ReconcilePostgres(ctx, instance)
-		if err != nil {
-			instance.Status.SecretRef = &croType.SecretRef{}
-			if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{}, err
-		}
-		if ps == nil {
-			r.logger.Info(msg)
-			instance.Status.SecretRef = &croType.SecretRef{}
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// return the connection secret
-		if err := r.resourceProvider.ReconcileResultSecret(ctx, instance, ps.DeploymentDetails.Data()); err != nil {
-			return ctrl.Result{}, errorUtil.Wrap(err, "failed to reconcile secret")
-		}
-
-		instance.Status.Phase = croType.PhaseComplete
-		instance.Status.Message = msg
-		instance.Status.SecretRef = instance.Spec.SecretRef
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if err = r.Client.Status().Update(ctx, instance); err != nil {
-			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-	}
-
-	// unsupported strategy
-	if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusUnsupportedType.WrapError(err)); err != nil {
-		return ctrl.Result{}, err
-	}
-	return ctrl.Result{}, errorUtil.New(fmt.Sprintf("unsupported deployment strategy %s", stratMap.Postgres))
-}
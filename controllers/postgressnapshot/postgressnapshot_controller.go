@@ -18,22 +18,17 @@ package postgressnapshot
 
 import (
 	"context"
-	"fmt"
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
 	"time"
 
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
-
 	integreatlyv1alpha1 "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	croAws "github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
-	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -47,13 +42,7 @@ const (
 
 // PostgresSnapshotReconciler reconciles a PostgresSnapshot object
 type PostgresSnapshotReconciler struct {
-	// This client, initialized using mgr.Client() above, is a split client
-	// that reads objects from the cache and writes to the apiserver
-	k8sclient.Client
-	scheme        *runtime.Scheme
-	logger        *logrus.Entry
-	providerList  []providers.PostgresSnapshotProvider
-	ConfigManager croAws.ConfigManager
+	*reconciler.Reconciler[*integreatlyv1alpha1.PostgresSnapshot, providers.PostgresSnapshotInstance]
 }
 
 var _ reconcile.Reconciler = &PostgresSnapshotReconciler{}
@@ -70,20 +59,51 @@ func New(mgr manager.Manager) (*PostgresSnapshotReconciler, error) {
 		return nil, err
 	}
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_postgres_snapshot"})
-	awsPostgresSnapshotProvider, err := croAws.NewAWSPostgresSnapshotProvider(client, logger)
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsPostgresSnapshotProvider, err := croAws.NewAWSPostgresSnapshotProvider(client, logger, recorder)
 	if err != nil {
 		return nil, err
 	}
-	providerList := []providers.PostgresSnapshotProvider{
-		awsPostgresSnapshotProvider,
-		gcp.NewGCPPostgresSnapshotProvider(client, logger),
+	gcpPostgresSnapshotProvider, err := gcp.NewGCPPostgresSnapshotProvider(client, logger, recorder)
+	if err != nil {
+		return nil, err
 	}
 	return &PostgresSnapshotReconciler{
-		Client:        client,
-		scheme:        mgr.GetScheme(),
-		logger:        logger,
-		providerList:  providerList,
-		ConfigManager: croAws.NewDefaultConfigMapConfigManager(mgr.GetClient()),
+		Reconciler: reconciler.New(client, logger, recorder, reconciler.Config[*integreatlyv1alpha1.PostgresSnapshot, providers.PostgresSnapshotInstance]{
+			Name:      "postgres snapshot",
+			NewObject: func() *integreatlyv1alpha1.PostgresSnapshot { return &integreatlyv1alpha1.PostgresSnapshot{} },
+			Providers: reconciler.PostgresSnapshotProviders(client,
+				awsPostgresSnapshotProvider,
+				gcpPostgresSnapshotProvider,
+			),
+			Strategy: reconciler.StrategyFromConfig(client, func(ctx context.Context, instance *integreatlyv1alpha1.PostgresSnapshot) (string, croType.StatusMessage, error) {
+				postgresCr, err := reconciler.GetSnapshotPostgres(ctx, client, instance)
+				if err != nil {
+					return "", "failed to get postgres resource", err
+				}
+				return postgresCr.Spec.Type, croType.StatusEmpty, nil
+			}, func(stratMap *providers.DeploymentStrategyMapping) string {
+				return stratMap.Postgres
+			}),
+			Status: func(instance *integreatlyv1alpha1.PostgresSnapshot) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy}
+			},
+			OnResult: func(ctx context.Context, instance *integreatlyv1alpha1.PostgresSnapshot, _ *providers.PostgresSnapshotInstance) error {
+				// the status phase is updated after the result hook, only record the first completion
+				if instance.Status.Phase == croType.PhaseComplete {
+					return nil
+				}
+				postgresCr, err := reconciler.GetSnapshotPostgres(ctx, client, instance)
+				if err != nil {
+					return err
+				}
+				resources.RecordEvent(recorder, postgresCr, resources.EventReasonSnapshotTaken, "snapshot %s taken from postgres snapshot %s", instance.Status.SnapshotID, instance.Name)
+				return nil
+			},
+			Finally: func(ctx context.Context, instance *integreatlyv1alpha1.PostgresSnapshot) {
+				exposePostgresSnapshotMetrics(ctx, client, instance)
+			},
+		}),
 	}, nil
 }
 
@@ -95,94 +115,6 @@ func (r *PostgresSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *PostgresSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	r.logger.Info("reconciling postgres snapshot")
-	instance := &integreatlyv1alpha1.PostgresSnapshot{}
-	err := r.Client.Get(ctx, req.NamespacedName, instance)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
-		}
-		return ctrl.Result{}, err
-	}
-	defer r.exposePostgresSnapshotMetrics(ctx, instance)
-	postgresCr := &integreatlyv1alpha1.Postgres{}
-	err = r.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.ResourceName, Namespace: instance.Namespace}, postgresCr)
-	if err != nil {
-		errMsg := fmt.Sprintf("failed to get postgres resource: %s", err.Error())
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusMessage(errMsg)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.New(errMsg)
-	}
-	cfgMgr := providers.NewConfigManager(providers.DefaultProviderConfigMapName, req.Namespace, r.Client)
-	stratMap, err := cfgMgr.GetStrategyMappingForDeploymentType(ctx, postgresCr.Spec.Type)
-	if err != nil {
-		if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusDeploymentConfigNotFound.WrapError(err)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to read deployment type config for deployment %s", postgresCr.Spec.Type)
-	}
-	strategyToUse := stratMap.Postgres
-	if instance.Status.Strategy != "" {
-		strategyToUse = instance.Status.Strategy
-		if strategyToUse != stratMap.Postgres {
-			r.logger.Infof("strategy and provider already set, changing of cloud-resource-config config maps not allowed in existing installation. the existing strategy is '%s' , cloud-resource-config is now set to '%s'. operator will continue to use existing strategy", strategyToUse, stratMap.Postgres)
-		}
-	}
-	for _, p := range r.providerList {
-		if !p.SupportsStrategy(strategyToUse) {
-			continue
-		}
-		if instance.Status.Strategy != strategyToUse {
-			instance.Status.Strategy = strategyToUse
-			if err = r.Client.Status().Update(ctx, instance); err != nil {
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-			}
-		}
-		if instance.DeletionTimestamp != nil {
-			msg, err := p.DeletePostgresSnapshot(ctx, instance, postgresCr)
-			if err != nil {
-				if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-					return ctrl.Result{}, updateErr
-				}
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to delete postgres snapshot")
-			}
-			r.logger.Info("waiting on postgres snapshot to successfully delete")
-			if err = resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseDeleteInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-		snap, msg, err := p.CreatePostgresSnapshot(ctx, instance, postgresCr)
-		if err != nil {
-			if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, msg); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{}, err
-		}
-		if snap == nil {
-			if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseInProgress, msg); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-		instance.Status.Message = msg
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseComplete, msg); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-	}
-	// unsupported strategy
-	if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusUnsupportedType.WrapError(err)); err != nil {
-		return ctrl.Result{}, err
-	}
-	return ctrl.Result{}, errorUtil.New(fmt.Sprintf("unsupported deployment strategy %s", stratMap.Postgres))
-}
-
 func buildPostgresSnapshotStatusMetricLabels(cr *integreatlyv1alpha1.PostgresSnapshot, clusterID, snapshotName string, phase croType.StatusPhase) map[string]string {
 	labels := map[string]string{}
 	labels[resources.LabelClusterIDKey] = clusterID
@@ -195,13 +127,13 @@ func buildPostgresSnapshotStatusMetricLabels(cr *integreatlyv1alpha1.PostgresSna
 	return labels
 }
 
-func (r *PostgresSnapshotReconciler) exposePostgresSnapshotMetrics(ctx context.Context, cr *integreatlyv1alpha1.PostgresSnapshot) {
+func exposePostgresSnapshotMetrics(ctx context.Context, client k8sclient.Client, cr *integreatlyv1alpha1.PostgresSnapshot) {
 	// build instance name
 	snapshotName := cr.Status.SnapshotID
 
 	// get Cluster Id
 	logrus.Info("setting postgres snapshot information metric")
-	clusterID, err := resources.GetClusterID(ctx, r.Client)
+	clusterID, err := resources.GetClusterID(ctx, client)
 	if err != nil {
 		logrus.Errorf("failed to get cluster id while exposing information metric for %v", snapshotName)
 		return
@@ -21,36 +21,27 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
-	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
-
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-var log = logf.Log.WithName("controller_redis")
-
 // RedisReconciler reconciles a Redis object
 type RedisReconciler struct {
-	k8sclient.Client
-	scheme           *runtime.Scheme
-	logger           *logrus.Entry
-	resourceProvider *resources.ReconcileResourceProvider
-	providerList     []providers.RedisProvider
+	*reconciler.Reconciler[*v1alpha1.Redis, providers.RedisCluster]
 }
 
 var _ reconcile.Reconciler = &RedisReconciler{}
@@ -59,30 +50,77 @@ var _ reconcile.Reconciler = &RedisReconciler{}
 func New(mgr manager.Manager) (*RedisReconciler, error) {
 	restConfig := ctrl.GetConfigOrDie()
 	restConfig.Timeout = time.Second * 10
-
 	client, err := k8sclient.New(restConfig, k8sclient.Options{
 		Scheme: mgr.GetScheme(),
 	})
 	if err != nil {
 		return nil, err
 	}
+
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_redis"})
-	awsRedisProvider, err := aws.NewAWSRedisProvider(client, logger)
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsRedisProvider, err := aws.NewAWSRedisProvider(client, logger, recorder)
 	if err != nil {
 		return nil, err
 	}
-	providerList := []providers.RedisProvider{
-		openshift.NewOpenShiftRedisProvider(client, logger),
-		awsRedisProvider,
-		gcp.NewGCPRedisProvider(client, logger),
+	gcpRedisProvider, err := gcp.NewGCPRedisProvider(client, logger, recorder)
+	if err != nil {
+		return nil, err
 	}
 	rp := resources.NewResourceProvider(client, mgr.GetScheme(), logger)
 	return &RedisReconciler{
-		Client:           mgr.GetClient(),
-		scheme:           mgr.GetScheme(),
-		logger:           logger,
-		resourceProvider: rp,
-		providerList:     providerList,
+		Reconciler: reconciler.New(mgr.GetClient(), logger, recorder, reconciler.Config[*v1alpha1.Redis, providers.RedisCluster]{
+			Name:      "redis",
+			NewObject: func() *v1alpha1.Redis { return &v1alpha1.Redis{} },
+			Providers: reconciler.RedisProviders(
+				openshift.NewOpenShiftRedisProvider(client, logger, recorder),
+				awsRedisProvider,
+				gcpRedisProvider,
+			),
+			Strategy: reconciler.StrategyFromConfig(mgr.GetClient(), func(_ context.Context, instance *v1alpha1.Redis) (string, croType.StatusMessage, error) {
+				return instance.Spec.Type, croType.StatusEmpty, nil
+			}, func(stratMap *providers.DeploymentStrategyMapping) string {
+				return stratMap.Redis
+			}),
+			Status: func(instance *v1alpha1.Redis) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+			},
+			SkipCreate: func(instance *v1alpha1.Redis) bool {
+				return instance.Spec.SkipCreate
+			},
+			OnPending: func(instance *v1alpha1.Redis) {
+				instance.Status.SecretRef = &croType.SecretRef{}
+			},
+			OnResult: func(ctx context.Context, instance *v1alpha1.Redis, result *providers.RedisCluster) error {
+				// create the secret with the redis cluster connection details
+				if err := rp.ReconcileResultSecret(ctx, instance, result.DeploymentDetails.Data()); err != nil {
+					return errorUtil.Wrap(err, "failed to reconcile secret")
+				}
+				instance.Status.SecretRef = instance.Spec.SecretRef
+				return nil
+			},
+			Migration: &reconciler.Migration[*v1alpha1.Redis, providers.RedisCluster]{
+				Enabled: func(instance *v1alpha1.Redis) bool {
+					return instance.Spec.AllowStrategyMigration
+				},
+				Confirmed: func(instance *v1alpha1.Redis) bool {
+					return instance.Spec.ConfirmMigration
+				},
+				Status: func(instance *v1alpha1.Redis) **croType.MigrationStatus {
+					return &instance.Status.Migration
+				},
+				CopyData: func(ctx context.Context, instance *v1alpha1.Redis, from, to *providers.RedisCluster) (bool, croType.StatusMessage, error) {
+					done, err := rp.ReconcileMigrationJob(ctx, instance, reconciler.RedisMigrationJob(from, to))
+					if err != nil {
+						return false, "failed to reconcile migration job", err
+					}
+					return done, croType.StatusMessage(fmt.Sprintf("waiting on job %s", resources.BuildMigrationJobName(instance.Name))), nil
+				},
+				Cleanup: func(ctx context.Context, instance *v1alpha1.Redis) error {
+					return rp.DeleteMigrationJob(ctx, instance)
+				},
+			},
+		}),
 	}, nil
 }
 
@@ -90,121 +128,23 @@ func (r *RedisReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Redis{}).
 		Watches(&v1alpha1.Redis{}, &handler.EnqueueRequestForObject{}).
+		Watches(&corev1.ConfigMap{}, r.ConfigMapHandler(reconciler.Watch[*v1alpha1.Redis]{
+			ResourceType: providers.RedisResourceType,
+			List: func(ctx context.Context, c k8sclient.Client, namespace string) ([]*v1alpha1.Redis, error) {
+				list := &v1alpha1.RedisList{}
+				if err := c.List(ctx, list, k8sclient.InNamespace(namespace)); err != nil {
+					return nil, err
+				}
+				instances := make([]*v1alpha1.Redis, 0, len(list.Items))
+				for i := range list.Items {
+					instances = append(instances, &list.Items[i])
+				}
+				return instances, nil
+			},
+			Spec: func(instance *v1alpha1.Redis) reconciler.Spec {
+				return reconciler.Spec{Type: instance.Spec.Type, Tier: instance.Spec.Tier}
+			},
+		})).
 		Watches(&corev1.Pod{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1alpha1.Redis{}, handler.OnlyControllerOwner())).
 		Complete(r)
 }
-
-func (r *RedisReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	r.logger.Info("reconciling Redis")
-	cfgMgr := providers.NewConfigManager(providers.DefaultProviderConfigMapName, req.Namespace, r.Client)
-
-	// Fetch the Redis instance
-	instance := &v1alpha1.Redis{}
-	err := r.Client.Get(ctx, req.NamespacedName, instance)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
-		}
-		// Error reading the object - requeue the request.
-		return ctrl.Result{}, err
-	}
-
-	stratMap, err := cfgMgr.GetStrategyMappingForDeploymentType(ctx, instance.Spec.Type)
-	if err != nil {
-		if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusDeploymentConfigNotFound.WrapError(err)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to read deployment type config for deployment %s", instance.Spec.Type)
-	}
-
-	// Check the CR for existing Strategy
-	strategyToUse := stratMap.Redis
-	if instance.Status.Strategy != "" {
-		strategyToUse = instance.Status.Strategy
-		if strategyToUse != stratMap.Redis {
-			r.logger.Infof("strategy and provider already set, changing of cloud-resource-config config maps not allowed in existing installation. the existing strategy is '%s' , cloud-resource-config is now set to '%s'. operator will continue to use existing strategy", strategyToUse, stratMap.Redis)
-		}
-	}
-
-	for _, p := range r.providerList {
-		if !p.SupportsStrategy(strategyToUse) {
-			continue
-		}
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if instance.Status.Strategy != strategyToUse || instance.Status.Provider != p.GetName() {
-			if err = r.Client.Status().Update(ctx, instance); err != nil {
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-			}
-		}
-
-		// handle deletion of redis and remove any finalizers added
-		if instance.GetDeletionTimestamp() != nil {
-			msg, err := p.DeleteRedis(ctx, instance)
-			if err != nil {
-				if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-					return ctrl.Result{}, updateErr
-				}
-				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to perform provider specific cluster deletion")
-			}
-
-			r.logger.Info("waiting for redis cluster to successfully delete")
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseDeleteInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// handle skip create
-		if instance.Spec.SkipCreate {
-			r.logger.Info("skipCreate found, skipping redis reconcile")
-			if err := resources.UpdatePhase(ctx, r.Client, instance, croType.PhasePaused, croType.StatusSkipCreate); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// handle creation of redis and apply any finalizers to instance required for deletion
-		redis, msg, err := p.CreateRedis(ctx, instance)
-		if err != nil {
-			instance.Status.SecretRef = &croType.SecretRef{}
-			if updateErr := resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{}, err
-		}
-		if redis == nil {
-			instance.Status.SecretRef = &croType.SecretRef{}
-			r.logger.Info("waiting for redis cluster to become available")
-			if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseInProgress, msg); err != nil {
-				return ctrl.Result{}, err
-			}
-			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-		}
-
-		// create the secret with the redis cluster connection details
-		if err := r.resourceProvider.ReconcileResultSecret(ctx, instance, redis.DeploymentDetails.Data()); err != nil {
-			return ctrl.Result{}, errorUtil.Wrap(err, "failed to reconcile secret")
-		}
-
-		// update the redis custom resource
-		instance.Status.Phase = croType.PhaseComplete
-		instance.Status.Message = msg
-		instance.Status.SecretRef = instance.Spec.SecretRef
-		instance.Status.Strategy = strategyToUse
-		instance.Status.Provider = p.GetName()
-		if err = r.Client.Status().Update(ctx, instance); err != nil {
-			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.Name, instance.Namespace)
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
-	}
-
-	// unsupported strategy
-	if err = resources.UpdatePhase(ctx, r.Client, instance, croType.PhaseInProgress, croType.StatusUnsupportedType.WrapError(err)); err != nil {
-		return ctrl.Result{}, err
-	}
-	return ctrl.Result{}, errorUtil.New(fmt.Sprintf("unsupported deployment strategy %s", stratMap.Redis))
-}
@@ -18,20 +18,16 @@ package redissnapshot
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	integreatlyv1alpha1 "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	croAws "github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/reconciler"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
-	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -45,15 +41,12 @@ const (
 
 // RedisSnapshotReconciler reconciles a RedisSnapshot object
 type RedisSnapshotReconciler struct {
-	k8sclient.Client
-	scheme        *runtime.Scheme
-	logger        *logrus.Entry
-	provider      providers.RedisSnapshotProvider
-	ConfigManager croAws.ConfigManager
+	*reconciler.Reconciler[*integreatlyv1alpha1.RedisSnapshot, providers.RedisSnapshotInstance]
 }
 
 var _ reconcile.Reconciler = &RedisSnapshotReconciler{}
 
+// New returns a new reconcile.Reconciler
 func New(mgr manager.Manager) (*RedisSnapshotReconciler, error) {
 	restConfig := ctrl.GetConfigOrDie()
 	restConfig.Timeout = time.Second * 10
@@ -65,16 +58,45 @@ func New(mgr manager.Manager) (*RedisSnapshotReconciler, error) {
 		return nil, err
 	}
 	logger := logrus.WithFields(logrus.Fields{"controller": "controller_redis_snapshot"})
-	redisSnapshotProvider, err := croAws.NewAWSRedisSnapshotProvider(client, logger)
+	recorder := mgr.GetEventRecorderFor(resources.EventRecorderName)
+	awsRedisSnapshotProvider, err := croAws.NewAWSRedisSnapshotProvider(client, logger, recorder)
 	if err != nil {
 		return nil, err
 	}
 	return &RedisSnapshotReconciler{
-		Client:        client,
-		scheme:        mgr.GetScheme(),
-		logger:        logger,
-		provider:      redisSnapshotProvider,
-		ConfigManager: croAws.NewDefaultConfigMapConfigManager(mgr.GetClient()),
+		Reconciler: reconciler.New(client, logger, recorder, reconciler.Config[*integreatlyv1alpha1.RedisSnapshot, providers.RedisSnapshotInstance]{
+			Name:      "redis snapshot",
+			NewObject: func() *integreatlyv1alpha1.RedisSnapshot { return &integreatlyv1alpha1.RedisSnapshot{} },
+			Providers: reconciler.RedisSnapshotProviders(client,
+				awsRedisSnapshotProvider,
+			),
+			// snapshots are taken using the strategy the redis resource was created with
+			Strategy: func(ctx context.Context, instance *integreatlyv1alpha1.RedisSnapshot) (string, croType.StatusMessage, error) {
+				redisCr, err := reconciler.GetSnapshotRedis(ctx, client, instance)
+				if err != nil {
+					return "", "failed to get redis resource", err
+				}
+				return redisCr.Status.Strategy, croType.StatusEmpty, nil
+			},
+			Status: func(instance *integreatlyv1alpha1.RedisSnapshot) reconciler.Status {
+				return reconciler.Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy}
+			},
+			OnResult: func(ctx context.Context, instance *integreatlyv1alpha1.RedisSnapshot, _ *providers.RedisSnapshotInstance) error {
+				// the status phase is updated after the result hook, only record the first completion
+				if instance.Status.Phase == croType.PhaseComplete {
+					return nil
+				}
+				redisCr, err := reconciler.GetSnapshotRedis(ctx, client, instance)
+				if err != nil {
+					return err
+				}
+				resources.RecordEvent(recorder, redisCr, resources.EventReasonSnapshotTaken, "snapshot %s taken from redis snapshot %s", instance.Status.SnapshotID, instance.Name)
+				return nil
+			},
+			Finally: func(ctx context.Context, instance *integreatlyv1alpha1.RedisSnapshot) {
+				exposeRedisSnapshotMetrics(ctx, client, instance)
+			},
+		}),
 	}, nil
 }
 
@@ -86,94 +108,6 @@ func (r *RedisSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *RedisSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	r.logger.Info("reconciling redis snapshot")
-
-	// Fetch the RedisSnapshot instance
-	instance := &integreatlyv1alpha1.RedisSnapshot{}
-	err := r.Client.Get(ctx, req.NamespacedName, instance)
-	if err != nil {
-		if errors.IsNotFound(err) {
-			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
-			// Return and don't requeue
-			return ctrl.Result{}, nil
-		}
-		// Error reading the object - requeue the request.
-		return ctrl.Result{}, err
-	}
-
-	// generate info metrics
-	defer r.exposeRedisSnapshotMetrics(ctx, instance)
-
-	// get redis cr
-	redisCr := &integreatlyv1alpha1.Redis{}
-	err = r.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.ResourceName, Namespace: instance.Namespace}, redisCr)
-	if err != nil {
-		errMsg := fmt.Sprintf("failed to get redis cr : %s", err.Error())
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusMessage(errMsg)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.New(errMsg)
-	}
-
-	// check redis cr deployment type is aws
-	if !r.provider.SupportsStrategy(redisCr.Status.Strategy) {
-		errMsg := fmt.Sprintf("the resource %s uses an unsupported provider strategy %s, only resources using the aws provider are valid", instance.Spec.ResourceName, redisCr.Status.Strategy)
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, croType.StatusMessage(errMsg)); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, errorUtil.New(errMsg)
-	}
-
-	if instance.DeletionTimestamp != nil {
-		msg, err := r.provider.DeleteRedisSnapshot(ctx, instance, redisCr)
-		if err != nil {
-			if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
-				return ctrl.Result{}, updateErr
-			}
-			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to delete redis snapshot")
-		}
-
-		r.logger.Info("waiting on redis snapshot to successfully delete")
-		if err = resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseDeleteInProgress, msg); err != nil {
-			return ctrl.Result{}, err
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: r.provider.GetReconcileTime(instance)}, nil
-	}
-
-	// check status, if complete return
-	if instance.Status.Phase == croType.PhaseComplete {
-		r.logger.Infof("skipping creation of snapshot for %s as phase is complete", instance.Name)
-		return ctrl.Result{Requeue: true, RequeueAfter: r.provider.GetReconcileTime(instance)}, nil
-	}
-
-	// create the snapshot and return the phase
-	snap, msg, err := r.provider.CreateRedisSnapshot(ctx, instance, redisCr)
-
-	// error trying to create snapshot
-	if err != nil {
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseFailed, msg); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{}, err
-	}
-
-	// no error but the snapshot doesn't exist yet
-	if snap == nil {
-		if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseInProgress, msg); updateErr != nil {
-			return ctrl.Result{}, updateErr
-		}
-		return ctrl.Result{Requeue: true, RequeueAfter: r.provider.GetReconcileTime(instance)}, nil
-	}
-
-	// no error, snapshot exists
-	if updateErr := resources.UpdateSnapshotPhase(ctx, r.Client, instance, croType.PhaseComplete, msg); updateErr != nil {
-		return ctrl.Result{}, updateErr
-	}
-	return ctrl.Result{Requeue: true, RequeueAfter: r.provider.GetReconcileTime(instance)}, nil
-}
-
 func buildRedisSnapshotStatusMetricLabels(cr *integreatlyv1alpha1.RedisSnapshot, clusterID, snapshotName string, phase croType.StatusPhase) map[string]string {
 	labels := map[string]string{}
 	labels[resources.LabelClusterIDKey] = clusterID
@@ -186,13 +120,13 @@ func buildRedisSnapshotStatusMetricLabels(cr *integreatlyv1alpha1.RedisSnapshot,
 	return labels
 }
 
-func (r *RedisSnapshotReconciler) exposeRedisSnapshotMetrics(ctx context.Context, cr *integreatlyv1alpha1.RedisSnapshot) {
+func exposeRedisSnapshotMetrics(ctx context.Context, client k8sclient.Client, cr *integreatlyv1alpha1.RedisSnapshot) {
 	// build instance name
 	snapshotName := cr.Status.SnapshotID
 
 	// get Cluster Id
 	logrus.Info("setting redis snapshot information metric")
-	clusterID, err := resources.GetClusterID(ctx, r.Client)
+	clusterID, err := resources.GetClusterID(ctx, client)
 	if err != nil {
 		logrus.Errorf("failed to get cluster id while exposing information metric for %v", snapshotName)
 		return
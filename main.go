@@ -19,6 +19,7 @@ package main
 import (
 	"flag"
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -34,6 +35,7 @@ import (
 	integreatlyv1alpha1 "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	blobstorageController "github.com/integr8ly/cloud-resource-operator/controllers/blobstorage"
 	cloudmetricsController "github.com/integr8ly/cloud-resource-operator/controllers/cloudmetrics"
+	cloudnetworkController "github.com/integr8ly/cloud-resource-operator/controllers/cloudnetwork"
 	postgresController "github.com/integr8ly/cloud-resource-operator/controllers/postgres"
 	postgressnapshotController "github.com/integr8ly/cloud-resource-operator/controllers/postgressnapshot"
 	redisController "github.com/integr8ly/cloud-resource-operator/controllers/redis"
@@ -59,11 +61,15 @@ func main() {
 	var metricsAddr string
 	var probeAddr string
 	var enableLeaderElection bool
+	var dryRun bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8383", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"Compute modifications to existing cloud resources and record them in the status of the custom resources "+
+			"instead of applying them.")
 	flag.Parse()
 
 	opts := zap.Options{
@@ -73,6 +79,7 @@ func main() {
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	resources.SetDryRun(dryRun)
 
 	namespace, err := k8sutil.GetWatchNamespace()
 	if err != nil {
@@ -134,6 +141,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	cloudnetworkCtrl, err := cloudnetworkController.New(mgr)
+	if err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "CloudNetwork")
+		os.Exit(1)
+	}
+	if err = cloudnetworkCtrl.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to setup controller", "controller", "CloudNetwork")
+		os.Exit(1)
+	}
+
 	postgresCtrl, err := postgresController.New(mgr)
 	if err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Postgres")
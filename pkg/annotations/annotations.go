@@ -4,6 +4,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ResourceIdentifier is the annotation the providers set to the identifier of the cloud resource of a custom resource
+const ResourceIdentifier = "resourceIdentifier"
+
 // Add makes sure that the provided key/value are set as an annotation
 func Add(instance metav1.Object, key, value string) {
 	annotations := instance.GetAnnotations()
@@ -46,3 +49,14 @@ func Get(instance metav1.Object, key string) string {
 
 	return ""
 }
+
+// Remove makes sure that the provided key is not set as an annotation
+func Remove(instance metav1.Object, key string) {
+	annotations := instance.GetAnnotations()
+	if annotations == nil {
+		return
+	}
+
+	delete(annotations, key)
+	instance.SetAnnotations(annotations)
+}
@@ -48,6 +48,9 @@ func NewSigsClientMoqWithScheme(clientScheme *runtime.Scheme, initObjs ...runtim
 		ListFunc: func(ctx context.Context, list k8sclient.ObjectList, opts ...k8sclient.ListOption) error {
 			return sigsClient.List(ctx, list, opts...)
 		},
+		PatchFunc: func(ctx context.Context, obj k8sclient.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
+			return sigsClient.Patch(ctx, obj, patch, opts...)
+		},
 		StatusFunc: func() k8sclient.StatusWriter {
 			return sigsClient.Status()
 		},
@@ -83,12 +83,14 @@ func TestReconcileBlobStorage(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.BlobStorageSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -117,12 +119,14 @@ func TestReconcileBlobStorage(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "gcp",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.BlobStorageSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "gcp",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -156,12 +160,14 @@ func TestReconcileBlobStorage(t *testing.T) {
 						"cro": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.BlobStorageSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -195,12 +201,14 @@ func TestReconcileBlobStorage(t *testing.T) {
 						"cro": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "gcp",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.BlobStorageSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "gcp",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -301,15 +309,17 @@ func TestReconcilePostgres(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.PostgresSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
+						SnapshotFrequency: "1h",
+						SnapshotRetention: "30d",
 					},
-					SnapshotFrequency: "1h",
-					SnapshotRetention: "30d",
 				},
 			},
 			wantErr: false,
@@ -343,14 +353,16 @@ func TestReconcilePostgres(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.PostgresSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
+						ApplyImmediately: true,
 					},
-					ApplyImmediately: true,
 				},
 			},
 			wantErr: false,
@@ -399,12 +411,14 @@ func TestReconcilePostgres(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.PostgresSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -439,14 +453,16 @@ func TestReconcilePostgres(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.PostgresSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
+						ApplyImmediately: true,
 					},
-					ApplyImmediately: true,
 				},
 			},
 			wantErr: false,
@@ -536,12 +552,14 @@ func TestReconcileRedis(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -570,12 +588,14 @@ func TestReconcileRedis(t *testing.T) {
 						"productName": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "gcp",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "gcp",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
@@ -612,16 +632,18 @@ func TestReconcileRedis(t *testing.T) {
 						"cro": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "aws",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "aws",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
+						Size:              "test",
+						ApplyImmediately:  true,
+						MaintenanceWindow: true,
 					},
-					Size:              "test",
-					ApplyImmediately:  true,
-					MaintenanceWindow: true,
 				},
 			},
 			wantErr: false,
@@ -654,12 +676,14 @@ func TestReconcileRedis(t *testing.T) {
 						"cro": "test",
 					},
 				},
-				Spec: croType.ResourceTypeSpec{
-					Type: "gcp",
-					Tier: "production",
-					SecretRef: &croType.SecretRef{
-						Name:      "test",
-						Namespace: "test",
+				Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Type: "gcp",
+						Tier: "production",
+						SecretRef: &croType.SecretRef{
+							Name:      "test",
+							Namespace: "test",
+						},
 					},
 				},
 			},
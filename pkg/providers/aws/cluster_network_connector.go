@@ -0,0 +1,1122 @@
+// connectors for the standalone vpc, each connector handles a method of reaching the cloud resources in the standalone
+// vpc from the openshift cluster vpc.
+//
+// the connection method is selected with `ConnectionMethod` in the create strategy of the `_network` strategy, e.g.
+// {"CidrBlock": "10.1.0.0/26", "ConnectionMethod": "transitGateway", "TransitGatewayId": "tgw-0123456789abcdef0"}
+//
+// peering: the standalone vpc is peered with the cluster vpc, this is the default
+// transitGateway: the standalone vpc is attached to a transit gateway the cluster vpc is already attached to
+// privateLink: each cloud resource is exposed to the cluster vpc through a vpc endpoint service, no route exists
+// between the vpcs
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// NetworkConnectionMethod the method used to connect the standalone vpc to the cluster vpc
+type NetworkConnectionMethod string
+
+const (
+	NetworkConnectionMethodPeering        NetworkConnectionMethod = "peering"
+	NetworkConnectionMethodTransitGateway NetworkConnectionMethod = "transitGateway"
+	NetworkConnectionMethodPrivateLink    NetworkConnectionMethod = "privateLink"
+
+	defaultTransitGatewayAttachmentNameTagValue = "Cloud Resource Transit Gateway Attachment"
+	defaultLoadBalancerNameTagValue             = "Cloud Resource Load Balancer"
+	defaultEndpointServiceNameTagValue          = "Cloud Resource Endpoint Service"
+	defaultVpcEndpointNameTagValue              = "Cloud Resource VPC Endpoint"
+	defaultEndpointSecurityGroupNameTagValue    = "Cloud Resource Endpoint Security Group"
+	defaultEndpointSecurityGroupPostfix         = "endpoint-security-group"
+	// load balancer and target group names are limited to 32 characters
+	defaultLoadBalancerNameLength = 32
+	// filter names for transit gateway vpc attachments and vpc endpoints
+	// see https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeTransitGatewayVpcAttachments.html
+	filterTransitGatewayId = "transit-gateway-id"
+	filterVpcId            = "vpc-id"
+	filterServiceName      = "service-name"
+	filterState            = "state"
+)
+
+// NetworkConnectionConfig the connection settings of the `_network` strategy
+type NetworkConnectionConfig struct {
+	ConnectionMethod NetworkConnectionMethod
+	// TransitGatewayId the transit gateway the cluster vpc is attached to, required by the transitGateway method
+	TransitGatewayId string
+}
+
+// NetworkEndpoint the address a cloud resource is reached on from the cluster vpc
+type NetworkEndpoint struct {
+	Host string
+	Port int64
+}
+
+// NetworkConnectionStatus the identifier and state of the connection between the vpcs reported by aws
+type NetworkConnectionStatus struct {
+	ID    string
+	State string
+}
+
+// NetworkConnector creates, checks and removes the connection between the standalone vpc and the cluster vpc
+type NetworkConnector interface {
+	// Method returns the connection method handled by the connector
+	Method() NetworkConnectionMethod
+	// Create reconciles the connection, it is called on every reconcile and is expected to be idempotent
+	Create(context.Context, *Network) error
+	// IsReady returns true once the cloud resources in the standalone vpc can be reached from the cluster vpc
+	IsReady(context.Context, *Network) (bool, error)
+	// Describe returns the identifier and state of the connection, nil while no connection exists
+	Describe(context.Context, *Network) (*NetworkConnectionStatus, error)
+	// Delete removes the connection, it returns true once the connection is removed
+	Delete(context.Context) (bool, error)
+	// ExposeEndpoint returns the endpoint a cloud resource is reached on from the cluster vpc, nil while it is not ready
+	ExposeEndpoint(ctx context.Context, name string, endpoint *NetworkEndpoint) (*NetworkEndpoint, error)
+	// RemoveEndpoint removes what ExposeEndpoint created for a cloud resource, it returns true once it is removed
+	RemoveEndpoint(ctx context.Context, name string) (bool, error)
+}
+
+//go:generate moq -out cluster_network_connector_moq.go . NetworkConnector
+
+var _ NetworkConnector = (*peeringNetworkConnector)(nil)
+var _ NetworkConnector = (*transitGatewayNetworkConnector)(nil)
+var _ NetworkConnector = (*privateLinkNetworkConnector)(nil)
+
+// GetNetworkConnector returns the connector for the connection method in the `_network` strategy of the tier
+func (n *NetworkProvider) GetNetworkConnector(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnector, error) {
+	stratCfg, err := configManager.ReadStorageStrategy(ctx, providers.NetworkResourceType, tier)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to read _network strategy config")
+	}
+	connectionConfig := &NetworkConnectionConfig{}
+	if err = json.Unmarshal(stratCfg.CreateStrategy, connectionConfig); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to unmarshal aws network connection config")
+	}
+
+	// only a peering connection can be accepted by the cluster account, the other methods expect both vpcs in one account
+	if n.isCrossAccount() && connectionConfig.ConnectionMethod != "" && connectionConfig.ConnectionMethod != NetworkConnectionMethodPeering {
+		return nil, errorUtil.New(fmt.Sprintf("connection method %s does not support a strategy roleArn, please update `_network` strategy", connectionConfig.ConnectionMethod))
+	}
+	switch connectionConfig.ConnectionMethod {
+	case "", NetworkConnectionMethodPeering:
+		return &peeringNetworkConnector{provider: n}, nil
+	case NetworkConnectionMethodTransitGateway:
+		if connectionConfig.TransitGatewayId == "" {
+			return nil, errorUtil.New(fmt.Sprintf("TransitGatewayId is required by connection method %s, please update `_network` strategy", connectionConfig.ConnectionMethod))
+		}
+		return &transitGatewayNetworkConnector{provider: n, transitGatewayId: connectionConfig.TransitGatewayId}, nil
+	case NetworkConnectionMethodPrivateLink:
+		return &privateLinkNetworkConnector{provider: n}, nil
+	}
+	return nil, errorUtil.New(fmt.Sprintf("unsupported connection method %s, expected one of %s, %s or %s, please update `_network` strategy", connectionConfig.ConnectionMethod, NetworkConnectionMethodPeering, NetworkConnectionMethodTransitGateway, NetworkConnectionMethodPrivateLink))
+}
+
+// reconcileStandaloneNetwork creates the standalone vpc from the `_network` strategy of the tier and connects it to the
+// cluster vpc, a nil connector is returned with a status message while the connection is not ready
+func reconcileStandaloneNetwork(ctx context.Context, networkManager *NetworkProvider, configManager ConfigManager, tier string, logger *logrus.Entry) (*Network, NetworkConnector, croType.StatusMessage, error) {
+	// get cidr block from _network strat map, based on the tier
+	vpcCidrBlock, err := networkManager.ReconcileNetworkProviderConfig(ctx, configManager, tier, logger)
+	if err != nil {
+		errMsg := "failed to reconcile network provider config"
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	logger.Debug("standalone network provider enabled, reconciling standalone vpc")
+
+	// create the standalone vpc, subnets and subnet groups
+	standaloneNetwork, err := networkManager.CreateNetwork(ctx, vpcCidrBlock)
+	if err != nil {
+		errMsg := "failed to create resource network"
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	logger.Infof("created standalone network %s", aws.StringValue(standaloneNetwork.Vpc.VpcId))
+
+	// we've created the standalone vpc, now we connect it to the cluster vpc with the method from the _network strategy
+	networkConnector, err := networkManager.GetNetworkConnector(ctx, configManager, tier)
+	if err != nil {
+		errMsg := "failed to get standalone network connector"
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	logger.Infof("creating network connection with method %s", networkConnector.Method())
+	if err = networkConnector.Create(ctx, standaloneNetwork); err != nil {
+		errMsg := fmt.Sprintf("failed to create standalone network connection with method %s", networkConnector.Method())
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	ready, err := networkConnector.IsReady(ctx, standaloneNetwork)
+	if err != nil {
+		errMsg := "failed to check standalone network connection"
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if !ready {
+		return standaloneNetwork, nil, croType.StatusMessage(fmt.Sprintf("waiting for standalone network connection with method %s to become ready", networkConnector.Method())), nil
+	}
+	return standaloneNetwork, networkConnector, croType.StatusEmpty, nil
+}
+
+// getStandaloneNetworkConnector returns the connector of the standalone vpc cloud resources of the tier are created in.
+// the standalone vpc is created and connected by the cloud network when one exists, otherwise by the cloud resources
+// themselves. a nil connector is returned with a status message while the connection is not ready
+func getStandaloneNetworkConnector(ctx context.Context, networkManager *NetworkProvider, configManager ConfigManager, cloudNetwork *v1alpha1.CloudNetwork, tier string, logger *logrus.Entry) (NetworkConnector, croType.StatusMessage, error) {
+	if cloudNetwork != nil {
+		networkConnector, err := networkManager.GetNetworkConnector(ctx, configManager, cloudNetwork.Spec.Tier)
+		if err != nil {
+			errMsg := "failed to get standalone network connector"
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		return networkConnector, croType.StatusEmpty, nil
+	}
+	_, networkConnector, msg, err := reconcileStandaloneNetwork(ctx, networkManager, configManager, tier, logger)
+	return networkConnector, msg, err
+}
+
+// standaloneNetworkTier returns the tier of the `_network` strategy the standalone vpc is created with, the tier of the
+// cloud network when one exists
+func standaloneNetworkTier(cloudNetwork *v1alpha1.CloudNetwork, tier string) string {
+	if cloudNetwork != nil {
+		return cloudNetwork.Spec.Tier
+	}
+	return tier
+}
+
+// routedNetworkEndpoints is embedded by the connectors routing traffic between the vpcs, the cloud resources are
+// reached on their own endpoints
+type routedNetworkEndpoints struct{}
+
+func (routedNetworkEndpoints) ExposeEndpoint(_ context.Context, _ string, endpoint *NetworkEndpoint) (*NetworkEndpoint, error) {
+	return endpoint, nil
+}
+
+func (routedNetworkEndpoints) RemoveEndpoint(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+// peeringNetworkConnector peers the standalone vpc with the cluster vpc
+type peeringNetworkConnector struct {
+	routedNetworkEndpoints
+	provider *NetworkProvider
+}
+
+func (c *peeringNetworkConnector) Method() NetworkConnectionMethod {
+	return NetworkConnectionMethodPeering
+}
+
+// Create peers the vpcs and creates the security group and routes
+func (c *peeringNetworkConnector) Create(ctx context.Context, network *Network) error {
+	logger := resources.NewActionLogger(c.provider.Logger, "CreatePeeringConnection")
+
+	networkPeering, err := c.provider.CreateNetworkPeering(ctx, network)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to peer standalone network")
+	}
+	logger.Infof("created network peering %s", aws.StringValue(networkPeering.PeeringConnection.VpcPeeringConnectionId))
+
+	// we have created the peering connection we must now create the security groups and update the route tables
+	securityGroup, err := c.provider.CreateNetworkConnection(ctx, network)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to create standalone network connection")
+	}
+	logger.Infof("created security group %s", aws.StringValue(securityGroup.StandaloneSecurityGroup.GroupName))
+	return nil
+}
+
+func (c *peeringNetworkConnector) IsReady(ctx context.Context, network *Network) (bool, error) {
+	peeringConnection, err := c.provider.getNetworkPeering(ctx, network)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get peering connection")
+	}
+	if peeringConnection == nil {
+		return false, nil
+	}
+	return (&NetworkPeering{PeeringConnection: peeringConnection}).IsReady(), nil
+}
+
+func (c *peeringNetworkConnector) Describe(ctx context.Context, network *Network) (*NetworkConnectionStatus, error) {
+	peeringConnection, err := c.provider.getNetworkPeering(ctx, network)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get peering connection")
+	}
+	if peeringConnection == nil {
+		return nil, nil
+	}
+	status := &NetworkConnectionStatus{ID: aws.StringValue(peeringConnection.VpcPeeringConnectionId)}
+	if peeringConnection.Status != nil {
+		status.State = aws.StringValue(peeringConnection.Status.Code)
+	}
+	return status, nil
+}
+
+func (c *peeringNetworkConnector) Delete(ctx context.Context) (bool, error) {
+	networkPeering, err := c.provider.GetClusterNetworkPeering(ctx)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get cluster network peering")
+	}
+	if err = c.provider.DeleteNetworkConnection(ctx, networkPeering); err != nil {
+		return false, errorUtil.Wrap(err, "failed to delete network connection")
+	}
+	if err = c.provider.DeleteNetworkPeering(networkPeering); err != nil {
+		return false, errorUtil.Wrap(err, "failed to delete cluster network peering")
+	}
+	return true, nil
+}
+
+// transitGatewayNetworkConnector attaches the standalone vpc to the transit gateway the cluster vpc is attached to,
+// traffic between the vpcs is routed through the transit gateway
+//
+// the transit gateway route table is expected to propagate routes between the attachments, as the transit gateway is
+// usually shared and managed outside of the cluster, e.g. by a landing zone, it is not modified
+type transitGatewayNetworkConnector struct {
+	routedNetworkEndpoints
+	provider         *NetworkProvider
+	transitGatewayId string
+}
+
+func (c *transitGatewayNetworkConnector) Method() NetworkConnectionMethod {
+	return NetworkConnectionMethodTransitGateway
+}
+
+// Create attaches the standalone vpc to the transit gateway, once the attachment is available the security group and
+// routes to the transit gateway are created
+func (c *transitGatewayNetworkConnector) Create(ctx context.Context, network *Network) error {
+	logger := resources.NewActionLogger(c.provider.Logger, "CreateTransitGatewayConnection").WithField("transit_gateway", c.transitGatewayId)
+
+	clusterVpc, err := getClusterVpc(ctx, c.provider.Client, c.provider.Ec2Api, logger)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	clusterAttachment, err := c.getVpcAttachment(clusterVpc.VpcId, ec2.TransitGatewayAttachmentStateAvailable)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get cluster vpc transit gateway attachment")
+	}
+	if clusterAttachment == nil {
+		return errorUtil.New(fmt.Sprintf("cluster vpc %s is not attached to transit gateway %s", aws.StringValue(clusterVpc.VpcId), c.transitGatewayId))
+	}
+
+	attachment, err := c.getVpcAttachment(network.Vpc.VpcId, activeTransitGatewayAttachmentStates()...)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get standalone vpc transit gateway attachment")
+	}
+	if attachment == nil {
+		tagSpec, err := getDefaultTagSpec(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultTransitGatewayAttachmentNameTagValue}, ec2.ResourceTypeTransitGatewayAttachment)
+		if err != nil {
+			return errorUtil.Wrap(err, "failed to get default tag spec")
+		}
+		var subnetIds []*string
+		for _, subnet := range network.Subnets {
+			subnetIds = append(subnetIds, subnet.SubnetId)
+		}
+		logger.Infof("attaching standalone vpc %s to transit gateway", aws.StringValue(network.Vpc.VpcId))
+		createAttachmentOutput, err := c.provider.Ec2Api.CreateTransitGatewayVpcAttachment(&ec2.CreateTransitGatewayVpcAttachmentInput{
+			TransitGatewayId:  aws.String(c.transitGatewayId),
+			VpcId:             network.Vpc.VpcId,
+			SubnetIds:         subnetIds,
+			TagSpecifications: tagSpec,
+		})
+		if err != nil {
+			return errorUtil.Wrap(err, "failed to create transit gateway vpc attachment")
+		}
+		attachment = createAttachmentOutput.TransitGatewayVpcAttachment
+	}
+
+	// routes can only be created to the transit gateway once the attachment is available
+	if aws.StringValue(attachment.State) != ec2.TransitGatewayAttachmentStateAvailable {
+		logger.Infof("waiting for transit gateway attachment %s in state %s to become available", aws.StringValue(attachment.TransitGatewayAttachmentId), aws.StringValue(attachment.State))
+		return nil
+	}
+
+	if _, err = c.provider.reconcileStandaloneSecurityGroup(ctx, logger); err != nil {
+		return errorUtil.Wrap(err, "failure while reconciling standalone security group")
+	}
+
+	clusterVpcRouteTables, err := c.provider.getClusterRouteTables(ctx)
+	if err != nil {
+		return errorUtil.Wrap(err, "failure while getting cluster vpc route tables")
+	}
+	if err = c.reconcileRoutes(clusterVpcRouteTables, network.Vpc.CidrBlock, logger); err != nil {
+		return errorUtil.Wrap(err, "failure while adding routes to cluster vpc route tables")
+	}
+	standaloneVpcRouteTables, err := c.provider.getCRORouteTables(ctx)
+	if err != nil {
+		return errorUtil.Wrap(err, "failure while getting standalone vpc route tables")
+	}
+	if err = c.reconcileRoutes(standaloneVpcRouteTables, clusterVpc.CidrBlock, logger); err != nil {
+		return errorUtil.Wrap(err, "failure while adding routes to standalone vpc route tables")
+	}
+	return nil
+}
+
+func (c *transitGatewayNetworkConnector) IsReady(_ context.Context, network *Network) (bool, error) {
+	attachment, err := c.getVpcAttachment(network.Vpc.VpcId, ec2.TransitGatewayAttachmentStateAvailable)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get standalone vpc transit gateway attachment")
+	}
+	return attachment != nil, nil
+}
+
+func (c *transitGatewayNetworkConnector) Describe(_ context.Context, network *Network) (*NetworkConnectionStatus, error) {
+	attachment, err := c.getVpcAttachment(network.Vpc.VpcId, activeTransitGatewayAttachmentStates()...)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get standalone vpc transit gateway attachment")
+	}
+	if attachment == nil {
+		return nil, nil
+	}
+	return &NetworkConnectionStatus{ID: aws.StringValue(attachment.TransitGatewayAttachmentId), State: aws.StringValue(attachment.State)}, nil
+}
+
+// Delete removes the routes to the standalone vpc and the security group, then detaches the standalone vpc from the
+// transit gateway
+func (c *transitGatewayNetworkConnector) Delete(ctx context.Context) (bool, error) {
+	logger := resources.NewActionLogger(c.provider.Logger, "DeleteTransitGatewayConnection").WithField("transit_gateway", c.transitGatewayId)
+
+	standaloneVpc, err := getStandaloneVpc(ctx, c.provider.Client, c.provider.Ec2Api, logger)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get standalone vpc")
+	}
+	if standaloneVpc == nil {
+		return true, nil
+	}
+
+	// the routes in the standalone vpc route tables are removed with the standalone vpc
+	clusterVpcRouteTables, err := c.provider.getClusterRouteTables(ctx)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failure while getting cluster vpc route tables")
+	}
+	for _, routeTable := range clusterVpcRouteTables {
+		if !transitGatewayRouteExists(routeTable.Routes, standaloneVpc.CidrBlock, c.transitGatewayId) {
+			continue
+		}
+		logger.Infof("deleting route for standalone vpc %s in route table %s", aws.StringValue(standaloneVpc.VpcId), aws.StringValue(routeTable.RouteTableId))
+		if _, err = c.provider.Ec2Api.DeleteRoute(&ec2.DeleteRouteInput{
+			DestinationCidrBlock: standaloneVpc.CidrBlock,
+			RouteTableId:         routeTable.RouteTableId,
+		}); err != nil {
+			return false, errorUtil.Wrap(err, "failure while deleting route from route table")
+		}
+	}
+
+	if err = c.provider.deleteStandaloneSecurityGroup(ctx, logger); err != nil {
+		return false, err
+	}
+
+	attachment, err := c.getVpcAttachment(standaloneVpc.VpcId, append(activeTransitGatewayAttachmentStates(), ec2.TransitGatewayAttachmentStateDeleting)...)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get standalone vpc transit gateway attachment")
+	}
+	if attachment == nil {
+		logger.Info("standalone vpc transit gateway attachment deleted")
+		return true, nil
+	}
+	if aws.StringValue(attachment.State) == ec2.TransitGatewayAttachmentStateDeleting {
+		logger.Infof("transit gateway attachment %s deletion in progress", aws.StringValue(attachment.TransitGatewayAttachmentId))
+		return false, nil
+	}
+	logger.Infof("deleting transit gateway attachment %s", aws.StringValue(attachment.TransitGatewayAttachmentId))
+	if _, err = c.provider.Ec2Api.DeleteTransitGatewayVpcAttachment(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: attachment.TransitGatewayAttachmentId,
+	}); err != nil {
+		return false, errorUtil.Wrap(err, "failed to delete transit gateway vpc attachment")
+	}
+	return false, nil
+}
+
+// getVpcAttachment returns the attachment of the vpc to the transit gateway in one of the states, nil if none exists
+func (c *transitGatewayNetworkConnector) getVpcAttachment(vpcId *string, states ...string) (*ec2.TransitGatewayVpcAttachment, error) {
+	describeAttachmentsOutput, err := c.provider.Ec2Api.DescribeTransitGatewayVpcAttachments(&ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filterTransitGatewayId),
+				Values: aws.StringSlice([]string{c.transitGatewayId}),
+			},
+			{
+				Name:   aws.String(filterVpcId),
+				Values: []*string{vpcId},
+			},
+			{
+				Name:   aws.String(filterState),
+				Values: aws.StringSlice(states),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to describe transit gateway vpc attachments")
+	}
+	if len(describeAttachmentsOutput.TransitGatewayVpcAttachments) == 0 {
+		return nil, nil
+	}
+	return describeAttachmentsOutput.TransitGatewayVpcAttachments[0], nil
+}
+
+// reconcileRoutes ensures a route to the destination cidr block through the transit gateway exists in each route table
+func (c *transitGatewayNetworkConnector) reconcileRoutes(routeTables []*ec2.RouteTable, destinationCidrBlock *string, logger *logrus.Entry) error {
+	for _, routeTable := range routeTables {
+		if transitGatewayRouteExists(routeTable.Routes, destinationCidrBlock, c.transitGatewayId) {
+			continue
+		}
+		logger.Infof("creating route to %s through transit gateway in route table %s", aws.StringValue(destinationCidrBlock), aws.StringValue(routeTable.RouteTableId))
+		if _, err := c.provider.Ec2Api.CreateRoute(&ec2.CreateRouteInput{
+			TransitGatewayId:     aws.String(c.transitGatewayId),
+			DestinationCidrBlock: destinationCidrBlock,
+			RouteTableId:         routeTable.RouteTableId,
+		}); err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "RouteNotSupported" {
+				logger.Infof("not adding route to %s route table because it is not supported/required", aws.StringValue(routeTable.RouteTableId))
+				continue
+			}
+			return errorUtil.Wrap(err, "failure while adding route to route table")
+		}
+	}
+	return nil
+}
+
+// privateLinkNetworkConnector exposes each cloud resource to the cluster vpc through a vpc endpoint service
+//
+// for each cloud resource an internal network load balancer is created in the standalone vpc, forwarding the port of the
+// cloud resource to the ip addresses of its endpoint. the load balancer is exposed by a vpc endpoint service, with an
+// interface vpc endpoint in the cluster vpc. the cloud resource is reached on the dns name of the vpc endpoint.
+//
+// the ip addresses of a cloud resource can change, e.g. on a multi-az failover, they are re-registered on every
+// reconcile of the cloud resource
+type privateLinkNetworkConnector struct {
+	provider *NetworkProvider
+}
+
+func (c *privateLinkNetworkConnector) Method() NetworkConnectionMethod {
+	return NetworkConnectionMethodPrivateLink
+}
+
+// Create ensures the standalone security group accepts traffic from the load balancers in the standalone vpc and a
+// security group for the vpc endpoints exists in the cluster vpc
+func (c *privateLinkNetworkConnector) Create(ctx context.Context, network *Network) error {
+	logger := resources.NewActionLogger(c.provider.Logger, "CreatePrivateLinkConnection")
+
+	standaloneSecGroup, err := c.provider.reconcileStandaloneSecurityGroup(ctx, logger)
+	if err != nil {
+		return errorUtil.Wrap(err, "failure while reconciling standalone security group")
+	}
+	// network load balancers do not preserve the client ip for private link traffic, the cloud resources are reached from
+	// the load balancer ip addresses in the standalone vpc
+	if err = authorizeSecurityGroupIngress(c.provider.Ec2Api, standaloneSecGroup, network.Vpc.CidrBlock, logger); err != nil {
+		return errorUtil.Wrap(err, "failure while authorizing standalone vpc ingress")
+	}
+
+	if _, err = c.reconcileEndpointSecurityGroup(ctx, logger); err != nil {
+		return errorUtil.Wrap(err, "failure while reconciling endpoint security group")
+	}
+	return nil
+}
+
+func (c *privateLinkNetworkConnector) IsReady(ctx context.Context, _ *Network) (bool, error) {
+	endpointSecGroup, err := c.getEndpointSecurityGroup(ctx)
+	if err != nil {
+		return false, err
+	}
+	return endpointSecGroup != nil, nil
+}
+
+// Describe reports the endpoint security group, the vpc endpoints are created per cloud resource
+func (c *privateLinkNetworkConnector) Describe(ctx context.Context, _ *Network) (*NetworkConnectionStatus, error) {
+	endpointSecGroup, err := c.getEndpointSecurityGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if endpointSecGroup == nil {
+		return nil, nil
+	}
+	return &NetworkConnectionStatus{ID: aws.StringValue(endpointSecGroup.GroupId), State: "available"}, nil
+}
+
+// Delete removes the security groups, the endpoints of the cloud resources are expected to be removed already
+func (c *privateLinkNetworkConnector) Delete(ctx context.Context) (bool, error) {
+	logger := resources.NewActionLogger(c.provider.Logger, "DeletePrivateLinkConnection")
+
+	endpointSecGroup, err := c.getEndpointSecurityGroup(ctx)
+	if err != nil {
+		return false, err
+	}
+	if endpointSecGroup != nil {
+		logger.Infof("deleting endpoint security group %s", aws.StringValue(endpointSecGroup.GroupId))
+		if _, err = c.provider.Ec2Api.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+			GroupId: endpointSecGroup.GroupId,
+		}); err != nil {
+			return false, errorUtil.Wrap(err, "failed to delete endpoint security group")
+		}
+	}
+	if err = c.provider.deleteStandaloneSecurityGroup(ctx, logger); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExposeEndpoint reconciles the load balancer, endpoint service and vpc endpoint of a cloud resource, the returned
+// endpoint is the dns name of the vpc endpoint
+func (c *privateLinkNetworkConnector) ExposeEndpoint(ctx context.Context, name string, endpoint *NetworkEndpoint) (*NetworkEndpoint, error) {
+	logger := resources.NewActionLogger(c.provider.Logger, "ExposePrivateLinkEndpoint").WithField("endpoint", name)
+	loadBalancerName := resources.ShortenString(name, defaultLoadBalancerNameLength)
+
+	standaloneVpc, err := getStandaloneVpc(ctx, c.provider.Client, c.provider.Ec2Api, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get standalone vpc")
+	}
+	if standaloneVpc == nil {
+		return nil, errorUtil.New("standalone vpc can not be nil")
+	}
+
+	loadBalancer, err := c.reconcileLoadBalancer(ctx, loadBalancerName, standaloneVpc, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile load balancer")
+	}
+	if aws.StringValue(loadBalancer.State.Code) != elbv2.LoadBalancerStateEnumActive {
+		logger.Infof("waiting for load balancer %s in state %s to become active", loadBalancerName, aws.StringValue(loadBalancer.State.Code))
+		return nil, nil
+	}
+
+	targetGroup, err := c.reconcileTargetGroup(ctx, loadBalancerName, standaloneVpc, endpoint, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile target group")
+	}
+	if err = c.reconcileListener(ctx, loadBalancer, targetGroup, endpoint.Port, logger); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile load balancer listener")
+	}
+
+	endpointService, err := c.reconcileEndpointService(ctx, loadBalancer, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile vpc endpoint service")
+	}
+	if aws.StringValue(endpointService.ServiceState) != ec2.ServiceStateAvailable {
+		logger.Infof("waiting for vpc endpoint service %s in state %s to become available", aws.StringValue(endpointService.ServiceId), aws.StringValue(endpointService.ServiceState))
+		return nil, nil
+	}
+
+	vpcEndpoint, err := c.reconcileVpcEndpoint(ctx, endpointService, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile vpc endpoint")
+	}
+	if aws.StringValue(vpcEndpoint.State) != ec2.StateAvailable || len(vpcEndpoint.DnsEntries) == 0 {
+		logger.Infof("waiting for vpc endpoint %s in state %s to become available", aws.StringValue(vpcEndpoint.VpcEndpointId), aws.StringValue(vpcEndpoint.State))
+		return nil, nil
+	}
+
+	return &NetworkEndpoint{
+		Host: aws.StringValue(vpcEndpoint.DnsEntries[0].DnsName),
+		Port: endpoint.Port,
+	}, nil
+}
+
+// RemoveEndpoint removes the vpc endpoint, endpoint service, load balancer and target group of a cloud resource, one at
+// a time as each depends on the next
+func (c *privateLinkNetworkConnector) RemoveEndpoint(ctx context.Context, name string) (bool, error) {
+	logger := resources.NewActionLogger(c.provider.Logger, "RemovePrivateLinkEndpoint").WithField("endpoint", name)
+	loadBalancerName := resources.ShortenString(name, defaultLoadBalancerNameLength)
+
+	loadBalancer, err := c.getLoadBalancer(loadBalancerName)
+	if err != nil {
+		return false, err
+	}
+	if loadBalancer != nil {
+		endpointService, err := c.getEndpointService(loadBalancer)
+		if err != nil {
+			return false, err
+		}
+		if endpointService != nil {
+			vpcEndpoints, err := c.getVpcEndpoints(ctx, endpointService)
+			if err != nil {
+				return false, err
+			}
+			if len(vpcEndpoints) > 0 {
+				var vpcEndpointIds []*string
+				for _, vpcEndpoint := range vpcEndpoints {
+					if aws.StringValue(vpcEndpoint.State) != ec2.StateDeleting {
+						vpcEndpointIds = append(vpcEndpointIds, vpcEndpoint.VpcEndpointId)
+					}
+				}
+				if len(vpcEndpointIds) > 0 {
+					logger.Infof("deleting vpc endpoints %s", aws.StringValueSlice(vpcEndpointIds))
+					if _, err = c.provider.Ec2Api.DeleteVpcEndpoints(&ec2.DeleteVpcEndpointsInput{
+						VpcEndpointIds: vpcEndpointIds,
+					}); err != nil {
+						return false, errorUtil.Wrap(err, "failed to delete vpc endpoints")
+					}
+				}
+				return false, nil
+			}
+			logger.Infof("deleting vpc endpoint service %s", aws.StringValue(endpointService.ServiceId))
+			if _, err = c.provider.Ec2Api.DeleteVpcEndpointServiceConfigurations(&ec2.DeleteVpcEndpointServiceConfigurationsInput{
+				ServiceIds: []*string{endpointService.ServiceId},
+			}); err != nil {
+				return false, errorUtil.Wrap(err, "failed to delete vpc endpoint service")
+			}
+			return false, nil
+		}
+		// the listeners are deleted with the load balancer
+		logger.Infof("deleting load balancer %s", loadBalancerName)
+		if _, err = c.provider.Elbv2Api.DeleteLoadBalancer(&elbv2.DeleteLoadBalancerInput{
+			LoadBalancerArn: loadBalancer.LoadBalancerArn,
+		}); err != nil {
+			return false, errorUtil.Wrap(err, "failed to delete load balancer")
+		}
+		return false, nil
+	}
+
+	targetGroup, err := c.getTargetGroup(loadBalancerName)
+	if err != nil {
+		return false, err
+	}
+	if targetGroup != nil {
+		logger.Infof("deleting target group %s", loadBalancerName)
+		if _, err = c.provider.Elbv2Api.DeleteTargetGroup(&elbv2.DeleteTargetGroupInput{
+			TargetGroupArn: targetGroup.TargetGroupArn,
+		}); err != nil {
+			// the target group is in use until the deletion of the load balancer completes
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeResourceInUseException {
+				logger.Infof("target group %s still in use, waiting for load balancer deletion", loadBalancerName)
+				return false, nil
+			}
+			return false, errorUtil.Wrap(err, "failed to delete target group")
+		}
+	}
+	logger.Info("private link endpoint removed")
+	return true, nil
+}
+
+func (c *privateLinkNetworkConnector) getLoadBalancer(name string) (*elbv2.LoadBalancer, error) {
+	describeLoadBalancersOutput, err := c.provider.Elbv2Api.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		Names: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException {
+			return nil, nil
+		}
+		return nil, errorUtil.Wrap(err, "failed to describe load balancers")
+	}
+	if len(describeLoadBalancersOutput.LoadBalancers) == 0 {
+		return nil, nil
+	}
+	return describeLoadBalancersOutput.LoadBalancers[0], nil
+}
+
+func (c *privateLinkNetworkConnector) reconcileLoadBalancer(ctx context.Context, name string, standaloneVpc *ec2.Vpc, logger *logrus.Entry) (*elbv2.LoadBalancer, error) {
+	loadBalancer, err := c.getLoadBalancer(name)
+	if err != nil {
+		return nil, err
+	}
+	if loadBalancer != nil {
+		return loadBalancer, nil
+	}
+
+	standaloneSubnets, err := getVPCAssociatedSubnets(c.provider.Ec2Api, logger, standaloneVpc)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get standalone vpc subnets")
+	}
+	var subnetIds []*string
+	for _, subnet := range standaloneSubnets {
+		subnetIds = append(subnetIds, subnet.SubnetId)
+	}
+	tags, err := getDefaultNetworkTags(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultLoadBalancerNameTagValue})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get default tags for load balancer")
+	}
+	logger.Infof("creating load balancer %s", name)
+	createLoadBalancerOutput, err := c.provider.Elbv2Api.CreateLoadBalancer(&elbv2.CreateLoadBalancerInput{
+		Name:    aws.String(name),
+		Type:    aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+		Scheme:  aws.String(elbv2.LoadBalancerSchemeEnumInternal),
+		Subnets: subnetIds,
+		Tags:    genericListToElbv2TagList(tags),
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to create load balancer")
+	}
+	if len(createLoadBalancerOutput.LoadBalancers) == 0 {
+		return nil, errorUtil.New(fmt.Sprintf("expected to find created load balancer %s", name))
+	}
+	return createLoadBalancerOutput.LoadBalancers[0], nil
+}
+
+func (c *privateLinkNetworkConnector) getTargetGroup(name string) (*elbv2.TargetGroup, error) {
+	describeTargetGroupsOutput, err := c.provider.Elbv2Api.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
+		Names: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == elbv2.ErrCodeTargetGroupNotFoundException {
+			return nil, nil
+		}
+		return nil, errorUtil.Wrap(err, "failed to describe target groups")
+	}
+	if len(describeTargetGroupsOutput.TargetGroups) == 0 {
+		return nil, nil
+	}
+	return describeTargetGroupsOutput.TargetGroups[0], nil
+}
+
+// reconcileTargetGroup ensures the target group exists and its targets are the current ip addresses of the endpoint
+func (c *privateLinkNetworkConnector) reconcileTargetGroup(ctx context.Context, name string, standaloneVpc *ec2.Vpc, endpoint *NetworkEndpoint, logger *logrus.Entry) (*elbv2.TargetGroup, error) {
+	targetGroup, err := c.getTargetGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if targetGroup == nil {
+		tags, err := getDefaultNetworkTags(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultLoadBalancerNameTagValue})
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get default tags for target group")
+		}
+		logger.Infof("creating target group %s", name)
+		createTargetGroupOutput, err := c.provider.Elbv2Api.CreateTargetGroup(&elbv2.CreateTargetGroupInput{
+			Name:       aws.String(name),
+			Protocol:   aws.String(elbv2.ProtocolEnumTcp),
+			Port:       aws.Int64(endpoint.Port),
+			TargetType: aws.String(elbv2.TargetTypeEnumIp),
+			VpcId:      standaloneVpc.VpcId,
+			Tags:       genericListToElbv2TagList(tags),
+		})
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to create target group")
+		}
+		if len(createTargetGroupOutput.TargetGroups) == 0 {
+			return nil, errorUtil.New(fmt.Sprintf("expected to find created target group %s", name))
+		}
+		targetGroup = createTargetGroupOutput.TargetGroups[0]
+	}
+
+	ipAddrs, err := net.DefaultResolver.LookupIPAddr(ctx, endpoint.Host)
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to resolve endpoint %s", endpoint.Host)
+	}
+	expectedTargets := map[string]bool{}
+	for _, ipAddr := range ipAddrs {
+		if ipAddr.IP.To4() != nil {
+			expectedTargets[ipAddr.IP.String()] = true
+		}
+	}
+
+	targetHealthOutput, err := c.provider.Elbv2Api.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: targetGroup.TargetGroupArn,
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to describe target health")
+	}
+	var staleTargets []*elbv2.TargetDescription
+	for _, targetHealth := range targetHealthOutput.TargetHealthDescriptions {
+		targetId := aws.StringValue(targetHealth.Target.Id)
+		if expectedTargets[targetId] {
+			delete(expectedTargets, targetId)
+			continue
+		}
+		staleTargets = append(staleTargets, targetHealth.Target)
+	}
+	if len(expectedTargets) > 0 {
+		var targets []*elbv2.TargetDescription
+		for targetId := range expectedTargets {
+			targets = append(targets, &elbv2.TargetDescription{Id: aws.String(targetId), Port: aws.Int64(endpoint.Port)})
+		}
+		logger.Infof("registering %d targets in target group %s", len(targets), name)
+		if _, err = c.provider.Elbv2Api.RegisterTargets(&elbv2.RegisterTargetsInput{
+			TargetGroupArn: targetGroup.TargetGroupArn,
+			Targets:        targets,
+		}); err != nil {
+			return nil, errorUtil.Wrap(err, "failed to register targets")
+		}
+	}
+	if len(staleTargets) > 0 {
+		logger.Infof("deregistering %d stale targets in target group %s", len(staleTargets), name)
+		if _, err = c.provider.Elbv2Api.DeregisterTargets(&elbv2.DeregisterTargetsInput{
+			TargetGroupArn: targetGroup.TargetGroupArn,
+			Targets:        staleTargets,
+		}); err != nil {
+			return nil, errorUtil.Wrap(err, "failed to deregister targets")
+		}
+	}
+	return targetGroup, nil
+}
+
+func (c *privateLinkNetworkConnector) reconcileListener(ctx context.Context, loadBalancer *elbv2.LoadBalancer, targetGroup *elbv2.TargetGroup, port int64, logger *logrus.Entry) error {
+	describeListenersOutput, err := c.provider.Elbv2Api.DescribeListeners(&elbv2.DescribeListenersInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+	})
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to describe listeners")
+	}
+	for _, listener := range describeListenersOutput.Listeners {
+		if aws.Int64Value(listener.Port) == port {
+			return nil
+		}
+	}
+	tags, err := getDefaultNetworkTags(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultLoadBalancerNameTagValue})
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get default tags for listener")
+	}
+	logger.Infof("creating listener on port %d for load balancer %s", port, aws.StringValue(loadBalancer.LoadBalancerName))
+	if _, err = c.provider.Elbv2Api.CreateListener(&elbv2.CreateListenerInput{
+		LoadBalancerArn: loadBalancer.LoadBalancerArn,
+		Protocol:        aws.String(elbv2.ProtocolEnumTcp),
+		Port:            aws.Int64(port),
+		Tags:            genericListToElbv2TagList(tags),
+		DefaultActions: []*elbv2.Action{
+			{
+				Type:           aws.String(elbv2.ActionTypeEnumForward),
+				TargetGroupArn: targetGroup.TargetGroupArn,
+			},
+		},
+	}); err != nil {
+		return errorUtil.Wrap(err, "failed to create listener")
+	}
+	return nil
+}
+
+// getEndpointService returns the vpc endpoint service of the load balancer, nil if none exists
+func (c *privateLinkNetworkConnector) getEndpointService(loadBalancer *elbv2.LoadBalancer) (*ec2.ServiceConfiguration, error) {
+	describeServicesOutput, err := c.provider.Ec2Api.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to describe vpc endpoint services")
+	}
+	for _, service := range describeServicesOutput.ServiceConfigurations {
+		if aws.StringValue(service.ServiceState) == ec2.ServiceStateDeleted {
+			continue
+		}
+		if contains(service.NetworkLoadBalancerArns, loadBalancer.LoadBalancerArn) {
+			return service, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *privateLinkNetworkConnector) reconcileEndpointService(ctx context.Context, loadBalancer *elbv2.LoadBalancer, logger *logrus.Entry) (*ec2.ServiceConfiguration, error) {
+	endpointService, err := c.getEndpointService(loadBalancer)
+	if err != nil {
+		return nil, err
+	}
+	if endpointService != nil {
+		return endpointService, nil
+	}
+	tagSpec, err := getDefaultTagSpec(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultEndpointServiceNameTagValue}, ec2.ResourceTypeVpcEndpointService)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get default tag spec")
+	}
+	logger.Infof("creating vpc endpoint service for load balancer %s", aws.StringValue(loadBalancer.LoadBalancerName))
+	createServiceOutput, err := c.provider.Ec2Api.CreateVpcEndpointServiceConfiguration(&ec2.CreateVpcEndpointServiceConfigurationInput{
+		// the only vpc endpoint is created by the operator in the cluster vpc of the same account
+		AcceptanceRequired:      aws.Bool(false),
+		NetworkLoadBalancerArns: []*string{loadBalancer.LoadBalancerArn},
+		TagSpecifications:       tagSpec,
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to create vpc endpoint service")
+	}
+	return createServiceOutput.ServiceConfiguration, nil
+}
+
+// getVpcEndpoints returns the vpc endpoints of the endpoint service in the cluster vpc
+func (c *privateLinkNetworkConnector) getVpcEndpoints(ctx context.Context, endpointService *ec2.ServiceConfiguration) ([]*ec2.VpcEndpoint, error) {
+	clusterVpc, err := getClusterVpc(ctx, c.provider.Client, c.provider.Ec2Api, c.provider.Logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	describeEndpointsOutput, err := c.provider.Ec2Api.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String(filterVpcId),
+				Values: []*string{clusterVpc.VpcId},
+			},
+			{
+				Name:   aws.String(filterServiceName),
+				Values: []*string{endpointService.ServiceName},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to describe vpc endpoints")
+	}
+	var vpcEndpoints []*ec2.VpcEndpoint
+	for _, vpcEndpoint := range describeEndpointsOutput.VpcEndpoints {
+		if aws.StringValue(vpcEndpoint.State) != ec2.StateDeleted {
+			vpcEndpoints = append(vpcEndpoints, vpcEndpoint)
+		}
+	}
+	return vpcEndpoints, nil
+}
+
+// reconcileVpcEndpoint ensures an interface vpc endpoint of the endpoint service exists in the private subnets of the
+// cluster vpc, in the availability zones of the endpoint service
+func (c *privateLinkNetworkConnector) reconcileVpcEndpoint(ctx context.Context, endpointService *ec2.ServiceConfiguration, logger *logrus.Entry) (*ec2.VpcEndpoint, error) {
+	vpcEndpoints, err := c.getVpcEndpoints(ctx, endpointService)
+	if err != nil {
+		return nil, err
+	}
+	if len(vpcEndpoints) > 0 {
+		return vpcEndpoints[0], nil
+	}
+
+	clusterVpc, err := getClusterVpc(ctx, c.provider.Client, c.provider.Ec2Api, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	clusterSubnets, err := GetVPCSubnets(c.provider.Ec2Api, logger, clusterVpc)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc subnets")
+	}
+	// a single subnet is allowed per availability zone
+	subnetsByZone := map[string]*string{}
+	for _, subnet := range clusterSubnets {
+		zone := aws.StringValue(subnet.AvailabilityZone)
+		if !contains(endpointService.AvailabilityZones, subnet.AvailabilityZone) || subnetsByZone[zone] != nil {
+			continue
+		}
+		for _, tag := range subnet.Tags {
+			if aws.StringValue(tag.Key) == defaultAWSPrivateSubnetTagKey {
+				subnetsByZone[zone] = subnet.SubnetId
+				break
+			}
+		}
+	}
+	if len(subnetsByZone) == 0 {
+		return nil, errorUtil.New(fmt.Sprintf("no private cluster subnet found in the availability zones %s of vpc endpoint service %s", aws.StringValueSlice(endpointService.AvailabilityZones), aws.StringValue(endpointService.ServiceId)))
+	}
+	var subnetIds []*string
+	for _, subnetId := range subnetsByZone {
+		subnetIds = append(subnetIds, subnetId)
+	}
+
+	endpointSecGroup, err := c.getEndpointSecurityGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if endpointSecGroup == nil {
+		return nil, errorUtil.New("endpoint security group can not be nil")
+	}
+	tagSpec, err := getDefaultTagSpec(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultVpcEndpointNameTagValue}, ec2.ResourceTypeVpcEndpoint)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get default tag spec")
+	}
+	logger.Infof("creating vpc endpoint for vpc endpoint service %s in cluster vpc %s", aws.StringValue(endpointService.ServiceId), aws.StringValue(clusterVpc.VpcId))
+	createEndpointOutput, err := c.provider.Ec2Api.CreateVpcEndpoint(&ec2.CreateVpcEndpointInput{
+		VpcEndpointType:   aws.String(ec2.VpcEndpointTypeInterface),
+		VpcId:             clusterVpc.VpcId,
+		ServiceName:       endpointService.ServiceName,
+		SubnetIds:         subnetIds,
+		SecurityGroupIds:  []*string{endpointSecGroup.GroupId},
+		TagSpecifications: tagSpec,
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to create vpc endpoint")
+	}
+	return createEndpointOutput.VpcEndpoint, nil
+}
+
+func (c *privateLinkNetworkConnector) getEndpointSecurityGroup(ctx context.Context) (*ec2.SecurityGroup, error) {
+	endpointSecurityGroupName, err := resources.BuildInfraName(ctx, c.provider.Client, defaultEndpointSecurityGroupPostfix, defaultAwsIdentifierLength)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "error building endpoint security group name")
+	}
+	endpointSecGroup, err := getSecurityGroup(c.provider.Ec2Api, endpointSecurityGroupName)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to find endpoint security group")
+	}
+	return endpointSecGroup, nil
+}
+
+// reconcileEndpointSecurityGroup ensures the security group of the vpc endpoints accepts traffic from the cluster vpc
+func (c *privateLinkNetworkConnector) reconcileEndpointSecurityGroup(ctx context.Context, logger *logrus.Entry) (*ec2.SecurityGroup, error) {
+	clusterVpc, err := getClusterVpc(ctx, c.provider.Client, c.provider.Ec2Api, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	endpointSecGroup, err := c.getEndpointSecurityGroup(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if endpointSecGroup == nil {
+		endpointSecurityGroupName, err := resources.BuildInfraName(ctx, c.provider.Client, defaultEndpointSecurityGroupPostfix, defaultAwsIdentifierLength)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "error building endpoint security group name")
+		}
+		tagSpec, err := getDefaultTagSpec(ctx, c.provider.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultEndpointSecurityGroupNameTagValue}, ec2.ResourceTypeSecurityGroup)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get default tag spec")
+		}
+		logger.Infof("creating endpoint security group %s in cluster vpc %s", endpointSecurityGroupName, aws.StringValue(clusterVpc.VpcId))
+		createdSecurityGroupOutput, err := c.provider.Ec2Api.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+			Description:       aws.String("rhmi cro security group for cro vpc endpoints"),
+			GroupName:         aws.String(endpointSecurityGroupName),
+			VpcId:             clusterVpc.VpcId,
+			TagSpecifications: tagSpec,
+		})
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "error creating endpoint security group")
+		}
+		endpointSecGroup = &ec2.SecurityGroup{
+			GroupId:   createdSecurityGroupOutput.GroupId,
+			GroupName: aws.String(endpointSecurityGroupName),
+			VpcId:     clusterVpc.VpcId,
+		}
+	}
+	if err = authorizeSecurityGroupIngress(c.provider.Ec2Api, endpointSecGroup, clusterVpc.CidrBlock, logger); err != nil {
+		return nil, errorUtil.Wrap(err, "failure while authorizing cluster vpc ingress")
+	}
+	return endpointSecGroup, nil
+}
+
+// activeTransitGatewayAttachmentStates the states of a transit gateway attachment which is neither deleted nor failed
+func activeTransitGatewayAttachmentStates() []string {
+	return []string{
+		ec2.TransitGatewayAttachmentStateInitiating,
+		ec2.TransitGatewayAttachmentStateInitiatingRequest,
+		ec2.TransitGatewayAttachmentStatePendingAcceptance,
+		ec2.TransitGatewayAttachmentStatePending,
+		ec2.TransitGatewayAttachmentStateAvailable,
+		ec2.TransitGatewayAttachmentStateModifying,
+	}
+}
+
+// transitGatewayRouteExists verifies if a route to the destination cidr block through the transit gateway exists
+func transitGatewayRouteExists(routes []*ec2.Route, destinationCidrBlock *string, transitGatewayId string) bool {
+	for _, route := range routes {
+		if aws.StringValue(route.DestinationCidrBlock) == aws.StringValue(destinationCidrBlock) && aws.StringValue(route.TransitGatewayId) == transitGatewayId {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeSecurityGroupIngress ensures the security group accepts all traffic from the cidr block
+func authorizeSecurityGroupIngress(ec2Svc ec2iface.EC2API, securityGroup *ec2.SecurityGroup, cidrBlock *string, logger *logrus.Entry) error {
+	for _, perm := range securityGroup.IpPermissions {
+		if aws.StringValue(perm.IpProtocol) != "-1" {
+			continue
+		}
+		for _, ipRange := range perm.IpRanges {
+			if aws.StringValue(ipRange.CidrIp) == aws.StringValue(cidrBlock) {
+				return nil
+			}
+		}
+	}
+	if _, err := ec2Svc.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId: securityGroup.GroupId,
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				IpRanges: []*ec2.IpRange{
+					{
+						CidrIp: cidrBlock,
+					},
+				},
+			},
+		},
+	}); err != nil {
+		return errorUtil.Wrap(err, "error authorizing security group ingress")
+	}
+	logger.Infof("authorized ingress from %s for security group %s", aws.StringValue(cidrBlock), aws.StringValue(securityGroup.GroupId))
+	return nil
+}
@@ -0,0 +1,398 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTransitGatewayId       = "tgw-test"
+	defaultLoadBalancerArn        = "arn:aws:elasticloadbalancing:test:loadbalancer/net/test"
+	defaultTargetGroupArn         = "arn:aws:elasticloadbalancing:test:targetgroup/test"
+	defaultTransitGatewayAttachId = "tgw-attach-test"
+)
+
+type mockElbv2Client struct {
+	elbv2iface.ELBV2API
+	describeLoadBalancersFn func(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
+	deleteLoadBalancerFn    func(*elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error)
+	describeTargetGroupsFn  func(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	deleteTargetGroupFn     func(*elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error)
+}
+
+func buildMockElbv2Client(modifyFn func(*mockElbv2Client)) *mockElbv2Client {
+	mock := &mockElbv2Client{}
+	mock.describeLoadBalancersFn = func(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+		return nil, awserr.New(elbv2.ErrCodeLoadBalancerNotFoundException, "not found", nil)
+	}
+	mock.describeTargetGroupsFn = func(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+		return nil, awserr.New(elbv2.ErrCodeTargetGroupNotFoundException, "not found", nil)
+	}
+	if modifyFn != nil {
+		modifyFn(mock)
+	}
+	return mock
+}
+
+func (m *mockElbv2Client) DescribeLoadBalancers(input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+	return m.describeLoadBalancersFn(input)
+}
+
+func (m *mockElbv2Client) DeleteLoadBalancer(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
+	return m.deleteLoadBalancerFn(input)
+}
+
+func (m *mockElbv2Client) DescribeTargetGroups(input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+	return m.describeTargetGroupsFn(input)
+}
+
+func (m *mockElbv2Client) DeleteTargetGroup(input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+	return m.deleteTargetGroupFn(input)
+}
+
+func TestNetworkProvider_GetNetworkConnector(t *testing.T) {
+	tests := []struct {
+		name           string
+		createStrategy string
+		readErr        error
+		crossAccount   bool
+		want           NetworkConnectionMethod
+		wantErr        bool
+	}{
+		{
+			name:           "test peering is the default connection method",
+			createStrategy: "{\"CidrBlock\": \"10.0.0.0/26\"}",
+			want:           NetworkConnectionMethodPeering,
+		},
+		{
+			name:           "test peering connection method",
+			createStrategy: "{\"ConnectionMethod\": \"peering\"}",
+			want:           NetworkConnectionMethodPeering,
+		},
+		{
+			name:           "test transit gateway connection method",
+			createStrategy: "{\"ConnectionMethod\": \"transitGateway\", \"TransitGatewayId\": \"tgw-test\"}",
+			want:           NetworkConnectionMethodTransitGateway,
+		},
+		{
+			name:           "test transit gateway connection method requires a transit gateway id",
+			createStrategy: "{\"ConnectionMethod\": \"transitGateway\"}",
+			wantErr:        true,
+		},
+		{
+			name:           "test private link connection method",
+			createStrategy: "{\"ConnectionMethod\": \"privateLink\"}",
+			want:           NetworkConnectionMethodPrivateLink,
+		},
+		{
+			name:           "test unsupported connection method",
+			createStrategy: "{\"ConnectionMethod\": \"vpn\"}",
+			wantErr:        true,
+		},
+		{
+			name:           "test invalid strategy",
+			createStrategy: "{\"ConnectionMethod\": 1}",
+			wantErr:        true,
+		},
+		{
+			name:    "test error reading strategy",
+			readErr: errors.New("read error"),
+			wantErr: true,
+		},
+		{
+			name:           "test peering connection method with a standalone vpc in another account",
+			createStrategy: "{\"CidrBlock\": \"10.0.0.0/26\"}",
+			crossAccount:   true,
+			want:           NetworkConnectionMethodPeering,
+		},
+		{
+			name:           "test transit gateway connection method is not supported with a standalone vpc in another account",
+			createStrategy: "{\"ConnectionMethod\": \"transitGateway\", \"TransitGatewayId\": \"tgw-test\"}",
+			crossAccount:   true,
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &NetworkProvider{
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			}
+			if tt.crossAccount {
+				n.ClusterEc2Api = buildMockEc2Client(nil)
+			}
+			configManager := buildTestConfigManager(func(m *ConfigManagerMock) {
+				m.ReadStorageStrategyFunc = func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+					if tt.readErr != nil {
+						return nil, tt.readErr
+					}
+					return &StrategyConfig{
+						CreateStrategy: json.RawMessage(tt.createStrategy),
+					}, nil
+				}
+			})
+			got, err := n.GetNetworkConnector(context.TODO(), configManager, "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNetworkConnector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Method() != tt.want {
+				t.Errorf("GetNetworkConnector() method = %v, want %v", got.Method(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayNetworkConnector_IsReady(t *testing.T) {
+	tests := []struct {
+		name        string
+		attachments []*ec2.TransitGatewayVpcAttachment
+		describeErr error
+		want        bool
+		wantErr     bool
+	}{
+		{
+			name: "test attachment available",
+			attachments: []*ec2.TransitGatewayVpcAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String(defaultTransitGatewayAttachId),
+					State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "test attachment not available",
+			want: false,
+		},
+		{
+			name:        "test error describing attachments",
+			describeErr: errors.New("describe error"),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ec2Client := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+				ec2Client.describeTgwVpcAttachmentsFn = func(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+					for _, filter := range input.Filters {
+						if aws.StringValue(filter.Name) == filterState && !contains(filter.Values, aws.String(ec2.TransitGatewayAttachmentStateAvailable)) {
+							t.Errorf("expected attachments to be filtered by available state, got %v", aws.StringValueSlice(filter.Values))
+						}
+					}
+					return &ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: tt.attachments}, tt.describeErr
+				}
+			})
+			c := &transitGatewayNetworkConnector{
+				provider: &NetworkProvider{
+					Ec2Api: ec2Client,
+					Logger: logrus.NewEntry(logrus.StandardLogger()),
+				},
+				transitGatewayId: defaultTransitGatewayId,
+			}
+			got, err := c.IsReady(context.TODO(), &Network{Vpc: buildMockVpc(func(vpc *ec2.Vpc) {})})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsReady() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayNetworkConnector_Describe(t *testing.T) {
+	tests := []struct {
+		name        string
+		attachments []*ec2.TransitGatewayVpcAttachment
+		want        *NetworkConnectionStatus
+	}{
+		{
+			name: "test attachment reported",
+			attachments: []*ec2.TransitGatewayVpcAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String(defaultTransitGatewayAttachId),
+					State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+				},
+			},
+			want: &NetworkConnectionStatus{ID: defaultTransitGatewayAttachId, State: ec2.TransitGatewayAttachmentStatePending},
+		},
+		{
+			name: "test no attachment",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ec2Client := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+				ec2Client.describeTgwVpcAttachmentsFn = func(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+					return &ec2.DescribeTransitGatewayVpcAttachmentsOutput{TransitGatewayVpcAttachments: tt.attachments}, nil
+				}
+			})
+			c := &transitGatewayNetworkConnector{
+				provider: &NetworkProvider{
+					Ec2Api: ec2Client,
+					Logger: logrus.NewEntry(logrus.StandardLogger()),
+				},
+				transitGatewayId: defaultTransitGatewayId,
+			}
+			got, err := c.Describe(context.TODO(), &Network{Vpc: buildMockVpc(func(vpc *ec2.Vpc) {})})
+			if err != nil {
+				t.Fatalf("Describe() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Describe() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteExists(t *testing.T) {
+	routes := []*ec2.Route{
+		{
+			DestinationCidrBlock:   aws.String("10.0.0.0/26"),
+			VpcPeeringConnectionId: aws.String("pcx-test"),
+		},
+		{
+			DestinationCidrBlock: aws.String("10.1.0.0/26"),
+			TransitGatewayId:     aws.String(defaultTransitGatewayId),
+		},
+	}
+	tests := []struct {
+		name      string
+		cidrBlock string
+		want      bool
+	}{
+		{
+			name:      "test route through transit gateway exists",
+			cidrBlock: "10.1.0.0/26",
+			want:      true,
+		},
+		{
+			name:      "test route through peering connection is ignored",
+			cidrBlock: "10.0.0.0/26",
+			want:      false,
+		},
+		{
+			name:      "test route does not exist",
+			cidrBlock: "10.2.0.0/26",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := transitGatewayRouteExists(routes, aws.String(tt.cidrBlock), defaultTransitGatewayId); got != tt.want {
+				t.Errorf("transitGatewayRouteExists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrivateLinkNetworkConnector_RemoveEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		ec2Api   ec2iface.EC2API
+		elbv2Api elbv2iface.ELBV2API
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "test endpoint removed",
+			ec2Api:   buildMockEc2Client(nil),
+			elbv2Api: buildMockElbv2Client(nil),
+			want:     true,
+		},
+		{
+			name: "test load balancer without endpoint service is deleted",
+			ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+				ec2Client.describeVpcEndpointServicesFn = func(*ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+					return &ec2.DescribeVpcEndpointServiceConfigurationsOutput{}, nil
+				}
+			}),
+			elbv2Api: buildMockElbv2Client(func(elbv2Client *mockElbv2Client) {
+				elbv2Client.describeLoadBalancersFn = func(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+					return &elbv2.DescribeLoadBalancersOutput{
+						LoadBalancers: []*elbv2.LoadBalancer{{LoadBalancerArn: aws.String(defaultLoadBalancerArn)}},
+					}, nil
+				}
+				elbv2Client.deleteLoadBalancerFn = func(input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
+					if aws.StringValue(input.LoadBalancerArn) != defaultLoadBalancerArn {
+						t.Errorf("unexpected load balancer deleted %s", aws.StringValue(input.LoadBalancerArn))
+					}
+					return &elbv2.DeleteLoadBalancerOutput{}, nil
+				}
+			}),
+			want: false,
+		},
+		{
+			name:   "test target group in use waits for load balancer deletion",
+			ec2Api: buildMockEc2Client(nil),
+			elbv2Api: buildMockElbv2Client(func(elbv2Client *mockElbv2Client) {
+				elbv2Client.describeTargetGroupsFn = func(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+					return &elbv2.DescribeTargetGroupsOutput{
+						TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(defaultTargetGroupArn)}},
+					}, nil
+				}
+				elbv2Client.deleteTargetGroupFn = func(*elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+					return nil, awserr.New(elbv2.ErrCodeResourceInUseException, "in use", nil)
+				}
+			}),
+			want: false,
+		},
+		{
+			name:   "test target group deleted",
+			ec2Api: buildMockEc2Client(nil),
+			elbv2Api: buildMockElbv2Client(func(elbv2Client *mockElbv2Client) {
+				elbv2Client.describeTargetGroupsFn = func(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+					return &elbv2.DescribeTargetGroupsOutput{
+						TargetGroups: []*elbv2.TargetGroup{{TargetGroupArn: aws.String(defaultTargetGroupArn)}},
+					}, nil
+				}
+				elbv2Client.deleteTargetGroupFn = func(*elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+					return &elbv2.DeleteTargetGroupOutput{}, nil
+				}
+			}),
+			want: true,
+		},
+		{
+			name:   "test error describing load balancers",
+			ec2Api: buildMockEc2Client(nil),
+			elbv2Api: buildMockElbv2Client(func(elbv2Client *mockElbv2Client) {
+				elbv2Client.describeLoadBalancersFn = func(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+					return nil, errors.New("describe error")
+				}
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &privateLinkNetworkConnector{
+				provider: &NetworkProvider{
+					Ec2Api:   tt.ec2Api,
+					Elbv2Api: tt.elbv2Api,
+					Logger:   logrus.NewEntry(logrus.StandardLogger()),
+				},
+			}
+			got, err := c.RemoveEndpoint(context.TODO(), "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RemoveEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RemoveEndpoint() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
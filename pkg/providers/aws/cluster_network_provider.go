@@ -28,7 +28,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
-	"reflect"
 	"sort"
 	"strings"
 
@@ -39,8 +38,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
@@ -53,7 +55,9 @@ import (
 const (
 	clusterOwnedTagKeyPrefix                = "kubernetes.io/cluster/"
 	clusterOwnedTagValue                    = "owned"
-	defaultCIDRMask                         = "26"
+	defaultCIDRMask                         = 26
+	defaultIpv4Length                       = 8 * net.IPv4len
+	defaultRouteCIDR                        = "0.0.0.0/0"
 	defaultNumberOfExpectedSubnets          = 2
 	defaultRouteTableNameTagValue           = "Cloud Resource Route Table"
 	defaultSecurityGroupNameTagValue        = "Cloud Resource Security Group"
@@ -72,11 +76,6 @@ type Network struct {
 	Subnets []*ec2.Subnet
 }
 
-type cidrList struct {
-	cidr       string
-	defaultVal string
-}
-
 // NetworkAZSubnet used to map expected ip addresses to availability zones
 type NetworkAZSubnet struct {
 	IP net.IPNet
@@ -107,6 +106,7 @@ type NetworkManager interface {
 	DeleteNetworkPeering(*NetworkPeering) error
 	IsEnabled(context.Context) (bool, error)
 	DeleteBundledCloudResources(context.Context) error
+	GetNetworkConnector(context.Context, ConfigManager, string) (NetworkConnector, error)
 }
 
 var _ NetworkManager = (*NetworkProvider)(nil)
@@ -116,8 +116,12 @@ type NetworkProvider struct {
 	RdsApi         rdsiface.RDSAPI
 	Ec2Api         ec2iface.EC2API
 	ElasticacheApi elasticacheiface.ElastiCacheAPI
+	Elbv2Api       elbv2iface.ELBV2API
 	Logger         *logrus.Entry
 	IsSTSCluster   bool
+	// ClusterEc2Api is the ec2 client of the cluster account, it is only set when the standalone vpc is created in
+	// another account with the role of a strategy
+	ClusterEc2Api ec2iface.EC2API
 }
 
 func NewNetworkManager(session *session.Session, client client.Client, logger *logrus.Entry, isSTSCluster bool) *NetworkProvider {
@@ -129,11 +133,59 @@ func NewNetworkManager(session *session.Session, client client.Client, logger *l
 		RdsApi:         rds.New(session),
 		Ec2Api:         ec2.New(session),
 		ElasticacheApi: elasticache.New(session),
+		Elbv2Api:       elbv2.New(session),
 		Logger:         logger.WithField("provider", "standalone_network_provider"),
 		IsSTSCluster:   isSTSCluster,
 	}
 }
 
+// newNetworkManagerFromStrategy returns a network manager creating the standalone vpc with the session of a strategy,
+// the cluster vpc is handled with a session of the cluster account when the strategy assumes a role in another account.
+// the `_network` strategy the standalone vpc is created from must set the same roleArn as the strategy
+func newNetworkManagerFromStrategy(ctx context.Context, c client.Client, configManager ConfigManager, tier string, credentials *Credentials, strategy *StrategyConfig, sess *session.Session, logger *logrus.Entry) (*NetworkProvider, croType.StatusMessage, error) {
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, c)
+	if err != nil {
+		msg := "failed to get cloud network"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	networkTier := standaloneNetworkTier(cloudNetwork, tier)
+	networkStrategy, err := configManager.ReadStorageStrategy(ctx, providers.NetworkResourceType, networkTier)
+	if err != nil {
+		msg := "failed to read _network strategy config"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if networkStrategy.RoleArn != strategy.RoleArn {
+		msg := fmt.Sprintf("roleArn %q of the strategy does not match roleArn %q of the `_network` strategy of tier %s, the standalone vpc must be created in the same account, please update the strategies", strategy.RoleArn, networkStrategy.RoleArn, networkTier)
+		return nil, croType.StatusMessage(msg), errorUtil.New(msg)
+	}
+
+	networkManager := NewNetworkManager(sess, c, logger, isSTSCluster(ctx, c))
+	if !strategy.IsCrossAccount() {
+		return networkManager, croType.StatusEmpty, nil
+	}
+	clusterSess, err := CreateClusterSessionFromStrategy(ctx, c, credentials, strategy)
+	if err != nil {
+		msg := "failed to create aws session of the cluster account"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	networkManager.ClusterEc2Api = ec2.New(clusterSess)
+	return networkManager, croType.StatusEmpty, nil
+}
+
+// clusterEc2Api returns the ec2 client the cluster vpc, its route tables and the peering connection acceptance are
+// handled with
+func (n *NetworkProvider) clusterEc2Api() ec2iface.EC2API {
+	if n.ClusterEc2Api != nil {
+		return n.ClusterEc2Api
+	}
+	return n.Ec2Api
+}
+
+// isCrossAccount returns true when the standalone vpc is created in another account than the cluster vpc
+func (n *NetworkProvider) isCrossAccount() bool {
+	return n.ClusterEc2Api != nil
+}
+
 // CreateNetwork returns a Network type or error
 //
 // VPC's created by the cloud resource operator are identified by having a tag with the name `<organizationTag>/clusterID`.
@@ -161,21 +213,20 @@ func (n *NetworkProvider) CreateNetwork(ctx context.Context, vpcCidrBlock *net.I
 		//By default, `integreatly.org/clusterID`.
 		//
 		//NOTE - Once a VPC is created we do not want to update it. To avoid changing cidr block
-		clusterVPC, err := getClusterVpc(ctx, n.Client, n.Ec2Api, n.Logger)
-		if err != nil {
-			return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
-		}
-
-		_, clusterVPCCidr, err := net.ParseCIDR(*clusterVPC.CidrBlock)
+		//
+		//The cidr block is validated against the same reserved cidr blocks an allocated cidr block avoids,
+		//so a cidr block set in the `_network` strategy can not overlap the cluster pod and service networks,
+		//or the networks peered and routed from the cluster vpc
+		reserved, err := n.getReservedCIDRs(ctx)
 		if err != nil {
-			return nil, errorUtil.Wrap(err, "failed to parse cluster vpc cidr block")
+			return nil, errorUtil.Wrap(err, "failed to get cidr blocks in use")
 		}
 
 		// standalone vpc cidr block can not overlap with existing cluster vpc cidr block
 		// issue arises when trying to peer both vpcs with invalid vpc error - `overlapping CIDR range`
 		// we need to ensure both cidr ranges do not intersect before creating the standalone vpc
 		// as the creation of a standalone vpc is designed to be a one shot pass and not to be updated after the fact
-		if err := validateStandaloneCidrBlock(vpcCidrBlock, clusterVPCCidr); err != nil {
+		if err := validateStandaloneCidrBlock(vpcCidrBlock, reserved); err != nil {
 			return nil, errorUtil.Wrap(err, "vpc validation failure")
 		}
 		logger.Infof("cidr %s is valid 👍", vpcCidrBlock.String())
@@ -413,7 +464,7 @@ func (n *NetworkProvider) CreateNetworkConnection(ctx context.Context, network *
 		logger.Infof("checking if route already exists for vpc peering connection id %s in route table %s", aws.StringValue(clusterVpcRoute.VpcPeeringConnectionId), aws.StringValue(routeTable.RouteTableId))
 		if !routeExists(routeTable.Routes, clusterVpcRoute) {
 			logger.Infof("creating route for vpc peering connection id %s in route table %s", aws.StringValue(clusterVpcRoute.VpcPeeringConnectionId), aws.StringValue(routeTable.RouteTableId))
-			if _, err := n.Ec2Api.CreateRoute(&ec2.CreateRouteInput{
+			if _, err := n.clusterEc2Api().CreateRoute(&ec2.CreateRouteInput{
 				VpcPeeringConnectionId: clusterVpcRoute.VpcPeeringConnectionId,
 				DestinationCidrBlock:   clusterVpcRoute.DestinationCidrBlock,
 				RouteTableId:           routeTable.RouteTableId,
@@ -437,7 +488,7 @@ func (n *NetworkProvider) CreateNetworkConnection(ctx context.Context, network *
 	}
 
 	// we require the cluster vpc cidr block for standalone vpc route
-	clusterVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, logger)
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), logger)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "error getting standalone vpc route tables")
 	}
@@ -474,24 +525,8 @@ func (n *NetworkProvider) CreateNetworkConnection(ctx context.Context, network *
 // DeleteNetworkConnection removes the security group created by cro
 func (n *NetworkProvider) DeleteNetworkConnection(ctx context.Context, networkPeering *NetworkPeering) error {
 	logger := n.Logger.WithField("action", "DeleteNetworkConnection")
-	// build security group name
-	standaloneSecurityGroupName, err := resources.BuildInfraName(ctx, n.Client, defaultSecurityGroupPostfix, defaultAwsIdentifierLength)
-	logger.Info(fmt.Sprintf("setting resource security group %s", standaloneSecurityGroupName))
-	if err != nil {
-		return errorUtil.Wrap(err, "error building subnet group name")
-	}
-
-	// get standalone security group
-	standaloneSecGroup, err := getSecurityGroup(n.Ec2Api, standaloneSecurityGroupName)
-	if err != nil {
-		return errorUtil.Wrap(err, "failed to find standalone security group")
-	}
-	if standaloneSecGroup != nil {
-		if _, err := n.Ec2Api.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
-			GroupId: standaloneSecGroup.GroupId,
-		}); err != nil {
-			return errorUtil.Wrap(err, "failed to delete standalone security group")
-		}
+	if err := n.deleteStandaloneSecurityGroup(ctx, logger); err != nil {
+		return err
 	}
 
 	// find cluster vpc route tables using cluster vpcID
@@ -522,7 +557,7 @@ func (n *NetworkProvider) DeleteNetworkConnection(ctx context.Context, networkPe
 			VpcPeeringConnectionId: networkPeering.PeeringConnection.VpcPeeringConnectionId,
 		}) {
 			logger.Infof("deleting route for standalone vpc id %s in route table %s", aws.StringValue(standaloneVpc.VpcId), aws.StringValue(routeTable.RouteTableId))
-			if _, err := n.Ec2Api.DeleteRoute(&ec2.DeleteRouteInput{
+			if _, err := n.clusterEc2Api().DeleteRoute(&ec2.DeleteRouteInput{
 				DestinationCidrBlock: standaloneVpc.CidrBlock,
 				RouteTableId:         routeTable.RouteTableId,
 			}); err != nil {
@@ -534,13 +569,37 @@ func (n *NetworkProvider) DeleteNetworkConnection(ctx context.Context, networkPe
 	return nil
 }
 
+// deleteStandaloneSecurityGroup removes the security group created by cro in the standalone vpc
+func (n *NetworkProvider) deleteStandaloneSecurityGroup(ctx context.Context, logger *logrus.Entry) error {
+	// build security group name
+	standaloneSecurityGroupName, err := resources.BuildInfraName(ctx, n.Client, defaultSecurityGroupPostfix, defaultAwsIdentifierLength)
+	logger.Info(fmt.Sprintf("setting resource security group %s", standaloneSecurityGroupName))
+	if err != nil {
+		return errorUtil.Wrap(err, "error building subnet group name")
+	}
+
+	// get standalone security group
+	standaloneSecGroup, err := getSecurityGroup(n.Ec2Api, standaloneSecurityGroupName)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to find standalone security group")
+	}
+	if standaloneSecGroup != nil {
+		if _, err := n.Ec2Api.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{
+			GroupId: standaloneSecGroup.GroupId,
+		}); err != nil {
+			return errorUtil.Wrap(err, "failed to delete standalone security group")
+		}
+	}
+	return nil
+}
+
 // CreateNetworkPeering creates a peering connection between a provided vpc and the openshift cluster vpc
 // used to enable network connectivity between the vpcs, so services in the openshift cluster can reach databases in
 // the provided vpc
 func (n *NetworkProvider) CreateNetworkPeering(ctx context.Context, network *Network) (*NetworkPeering, error) {
 	logger := resources.NewActionLogger(n.Logger, "CreateNetworkPeering")
 
-	clusterVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, n.Logger)
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), n.Logger)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get cluster vpc, no vpc found")
 	}
@@ -550,20 +609,22 @@ func (n *NetworkProvider) CreateNetworkPeering(ctx context.Context, network *Net
 		return nil, errorUtil.Wrap(err, "failed to get peering connection")
 	}
 
-	// create the vpc, we make an assumption they're in the same aws account and use the aws region in the aws client
-	// provided to the NetworkProvider struct
+	// create the peering connection, we make an assumption the vpcs are in the same region and use the aws region in the
+	// aws client provided to the NetworkProvider struct. the cluster account owns the peer vpc when the standalone vpc is
+	// created in another account
 	if peeringConnection == nil {
 		peeringInput := &ec2.CreateVpcPeeringConnectionInput{
 			PeerVpcId: clusterVpc.VpcId,
 			VpcId:     network.Vpc.VpcId,
 		}
-		if n.IsSTSCluster {
-			tagSpec, err := getDefaultTagSpec(ctx, n.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultVpcPeeringConnectionNameTagValue}, ec2.ResourceTypeVpcPeeringConnection)
-			if err != nil {
-				return nil, errorUtil.Wrap(err, "failed to get default tag spec")
-			}
-			peeringInput.SetTagSpecifications(tagSpec)
+		if n.isCrossAccount() {
+			peeringInput.PeerOwnerId = clusterVpc.OwnerId
+		}
+		tagSpec, err := getDefaultTagSpec(ctx, n.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultVpcPeeringConnectionNameTagValue}, ec2.ResourceTypeVpcPeeringConnection)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get default tag spec")
 		}
+		peeringInput.SetTagSpecifications(tagSpec)
 		logger.Infof("creating cluster peering connection for vpc %s", aws.StringValue(network.Vpc.VpcId))
 		createPeeringConnOutput, err := n.Ec2Api.CreateVpcPeeringConnection(peeringInput)
 		if err != nil {
@@ -601,8 +662,9 @@ func (n *NetworkProvider) CreateNetworkPeering(ctx context.Context, network *Net
 	logger.Infof("handling peering connection status %s", aws.StringValue(peeringConnection.Status.Code))
 	switch aws.StringValue(peeringConnection.Status.Code) {
 	case ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance:
+		// the peering connection is accepted by the account of the cluster vpc
 		logger.Info("accepting peering connection")
-		_, err = n.Ec2Api.AcceptVpcPeeringConnection(&ec2.AcceptVpcPeeringConnectionInput{
+		_, err = n.clusterEc2Api().AcceptVpcPeeringConnection(&ec2.AcceptVpcPeeringConnectionInput{
 			VpcPeeringConnectionId: peeringConnection.VpcPeeringConnectionId,
 		})
 		if err != nil {
@@ -693,8 +755,13 @@ func (n *NetworkProvider) DeleteNetworkPeering(peering *NetworkPeering) error {
 func (n *NetworkProvider) IsEnabled(ctx context.Context) (bool, error) {
 	logger := n.Logger.WithField("action", "isEnabled")
 
+	// cloud resources in another account can not be bundled in the cluster vpc
+	if n.isCrossAccount() {
+		return true, nil
+	}
+
 	//check if there is a cluster vpc already created.
-	foundVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, logger)
+	foundVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), logger)
 	if err != nil {
 		return false, errorUtil.Wrap(err, "unable to get vpc")
 	}
@@ -705,7 +772,7 @@ func (n *NetworkProvider) IsEnabled(ctx context.Context) (bool, error) {
 
 	// returning subnets from cluster vpc
 	logger.Info("getting cluster vpc subnets")
-	vpcSubnets, err := GetVPCSubnets(n.Ec2Api, logger, foundVpc)
+	vpcSubnets, err := GetVPCSubnets(n.clusterEc2Api(), logger, foundVpc)
 	if err != nil {
 		return false, errorUtil.Wrap(err, "error happened while returning vpc subnets")
 	}
@@ -775,7 +842,7 @@ func (n *NetworkProvider) DeleteBundledCloudResources(ctx context.Context) error
 	if securityGroup == nil {
 		return nil
 	}
-	vpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, logger)
+	vpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), logger)
 	if err != nil {
 		return errorUtil.Wrap(err, "error getting cluster vpc")
 	}
@@ -793,7 +860,7 @@ func (n *NetworkProvider) getNetworkPeering(ctx context.Context, network *Networ
 	logger := resources.NewActionLogger(n.Logger, "getNetworkPeering")
 	// we will always peer with the openshift/kubernetes cluster vpc that this operator is running on
 	logger.Info("getting cluster vpc")
-	clusterVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, logger)
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), logger)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
 	}
@@ -864,7 +931,7 @@ func (n *NetworkProvider) reconcileStandaloneSecurityGroup(ctx context.Context,
 	}
 
 	// get the cluster bundled vpc
-	clusterVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, logger)
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), logger)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
 	}
@@ -877,13 +944,11 @@ func (n *NetworkProvider) reconcileStandaloneSecurityGroup(ctx context.Context,
 			VpcId:       standaloneVpc.VpcId,
 		}
 
-		if n.IsSTSCluster {
-			tagSpec, err := getDefaultTagSpec(ctx, n.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultSecurityGroupNameTagValue}, ec2.ResourceTypeSecurityGroup)
-			if err != nil {
-				return nil, errorUtil.Wrap(err, "failed to get default tag spec")
-			}
-			securityGroup.SetTagSpecifications(tagSpec)
+		tagSpec, err := getDefaultTagSpec(ctx, n.Client, &resources.Tag{Key: resources.TagDisplayName, Value: defaultSecurityGroupNameTagValue}, ec2.ResourceTypeSecurityGroup)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get default tag spec")
 		}
+		securityGroup.SetTagSpecifications(tagSpec)
 		// create security group
 		logger.Infof("creating security group for standalone vpc")
 		createdSecurityGroupOutput, err := n.Ec2Api.CreateSecurityGroup(securityGroup)
@@ -938,34 +1003,12 @@ func (n *NetworkProvider) reconcileStandaloneSecurityGroup(ctx context.Context,
 	// see for more -> https://docs.aws.amazon.com/vpc/latest/peering/vpc-peering-security-groups.html
 	// it is recommended by aws docs to use the cidr block from the peered vpc
 
-	// build ip permission
-	ipPermission := &ec2.IpPermission{
-		IpProtocol: aws.String("-1"),
-		IpRanges: []*ec2.IpRange{
-			{
-				CidrIp: clusterVpc.CidrBlock,
-			},
-		},
-	}
-
-	// ensure ip permission correct and valid in the standalone security group
-	for _, perm := range standaloneSecGroup.IpPermissions {
-		if reflect.DeepEqual(perm, ipPermission) {
-			logger.Infof("ip permissions are correct for security group %s", *standaloneSecGroup.GroupName)
-			return standaloneSecGroup, nil
-		}
-	}
-
-	// authorize the security group ingres if it is not as expected
-	if _, err := n.Ec2Api.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
-		GroupId: aws.String(*standaloneSecGroup.GroupId),
-		IpPermissions: []*ec2.IpPermission{
-			ipPermission,
-		},
-	}); err != nil {
-		return nil, errorUtil.Wrap(err, "error authorizing security group ingress")
+	// the security group can accept traffic from other ranges depending on the connection method, only the cluster vpc
+	// range is ensured here
+	if err := authorizeSecurityGroupIngress(n.Ec2Api, standaloneSecGroup, clusterVpc.CidrBlock, logger); err != nil {
+		return nil, err
 	}
-	logger.Infof("ip permissions have been updated to expected permissions for security group %s", *standaloneSecGroup.GroupName)
+	logger.Infof("ip permissions are correct for security group %s", *standaloneSecGroup.GroupName)
 	return standaloneSecGroup, nil
 }
 
@@ -1130,17 +1173,14 @@ func (n *NetworkProvider) reconcileStandaloneVPCSubnets(ctx context.Context, log
 		return nil, errorUtil.Wrap(err, "error getting vpc subnets")
 	}
 
-	tagSpec := &ec2.TagSpecification{}
-	if n.IsSTSCluster {
-		subnetTags, err := getDefaultSubnetTags(ctx, n.Client)
-		if err != nil {
-			errMsg := "failed to get default tags for subnet"
-			return nil, errorUtil.Wrap(err, errMsg)
-		}
-		tagSpec = &ec2.TagSpecification{
-			ResourceType: aws.String("subnet"),
-			Tags:         subnetTags,
-		}
+	subnetTags, err := getDefaultSubnetTags(ctx, n.Client)
+	if err != nil {
+		errMsg := "failed to get default tags for subnet"
+		return nil, errorUtil.Wrap(err, errMsg)
+	}
+	tagSpec := &ec2.TagSpecification{
+		ResourceType: aws.String("subnet"),
+		Tags:         subnetTags,
 	}
 
 	// for create a subnet for every expected subnet to exist
@@ -1153,11 +1193,9 @@ func (n *NetworkProvider) reconcileStandaloneVPCSubnets(ctx context.Context, log
 				CidrBlock:        aws.String(expectedAZSubnet.IP.String()),
 				VpcId:            aws.String(*vpc.VpcId),
 			}
-			if n.IsSTSCluster {
-				subnetConfig.SetTagSpecifications([]*ec2.TagSpecification{
-					tagSpec,
-				})
-			}
+			subnetConfig.SetTagSpecifications([]*ec2.TagSpecification{
+				tagSpec,
+			})
 			createOutput, err := n.Ec2Api.CreateSubnet(subnetConfig)
 			ec2err, isAwsErr := err.(awserr.Error)
 			if err != nil && isAwsErr && ec2err.Code() == "InvalidSubnet.Conflict" {
@@ -1202,12 +1240,12 @@ func (n *NetworkProvider) reconcileStandaloneVPCSubnets(ctx context.Context, log
 }
 
 func (n *NetworkProvider) getClusterRouteTables(ctx context.Context) ([]*ec2.RouteTable, error) {
-	routeTables, err := n.Ec2Api.DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
+	routeTables, err := n.clusterEc2Api().DescribeRouteTables(&ec2.DescribeRouteTablesInput{})
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get route tables")
 	}
 
-	clusterVPC, err := getClusterVpc(ctx, n.Client, n.Ec2Api, n.Logger)
+	clusterVPC, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), n.Logger)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
 	}
@@ -1564,8 +1602,9 @@ func getElasticacheSubnetByGroup(elasticacheApi elasticacheiface.ElastiCacheAPI,
 //
 // the _network strategy config is unmarshalled into a ec2 create vpc input struct
 // from the struct the cidr block is parsed to ensure validity
-// if there is no entry for cidrblock in the _network block a sensible default which doesn't overlap with the cluster vpc
-// if cro is unable to find a valid non-overlapping cidr block it will return an error
+// if there is no entry for cidrblock in the _network block a free cidr block of the `Ipv4NetmaskLength` size, /26 by
+// default, is allocated and persisted to the _network block so the same block is used on every reconcile
+// if cro is unable to find a free cidr block it will return an error
 func (n *NetworkProvider) ReconcileNetworkProviderConfig(ctx context.Context, configManager ConfigManager, tier string, logger *logrus.Entry) (*net.IPNet, error) {
 	logger.Infof("fetching _network strategy config for tier %s", tier)
 
@@ -1589,154 +1628,138 @@ func (n *NetworkProvider) ReconcileNetworkProviderConfig(ctx context.Context, co
 		return vpcCidr, nil
 	}
 
-	//if vpcCreateConfig.CidrBlock is nil or an empty string we can go ahead and set a sensible non overlapping default with a mask size of /26
-	defaultCIDR, err := n.getNonOverlappingDefaultCIDR(ctx)
+	// if vpcCreateConfig.CidrBlock is nil or an empty string we can go ahead and allocate a free cidr block
+	vpcCidr, err := n.allocateStandaloneCIDR(ctx, vpcCreateConfig)
 	if err != nil {
-		return nil, errorUtil.Wrap(err, "failed to generate default CIDR")
+		return nil, errorUtil.Wrap(err, "failed to allocate cidr block")
 	}
 
-	// a default cidr is generated and updated to the config map, that is returned for use in the network creation
-	return defaultCIDR, nil
+	// the allocated cidr block is updated to the config map, that is returned for use in the network creation
+	createStrategy, err := resources.SetJSONField(stratCfg.CreateStrategy, "CidrBlock", vpcCidr.String())
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to set cidr block in _network strategy")
+	}
+	if err = configManager.WriteCreateStrategy(ctx, providers.NetworkResourceType, tier, createStrategy); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to persist allocated cidr block to _network strategy")
+	}
+	logger.Infof("allocated vpc cidr block %s for network strategy tier %s", vpcCidr.String(), tier)
+	return vpcCidr, nil
 }
 
-// getNonOverlappingDefaultCIDR returns a non overlapping cidr block based on the OpenShift vpc cidr block
-// the default mask is /26
-// for other masks the user is required to provide their own via config
-func (n *NetworkProvider) getNonOverlappingDefaultCIDR(ctx context.Context) (*net.IPNet, error) {
-	clusterVpc, err := getClusterVpc(ctx, n.Client, n.Ec2Api, n.Logger)
+// allocateStandaloneCIDR returns the cidr block of the standalone vpc if it already exists, otherwise the first free
+// block of the requested size within the private ranges available to vpcs in aws
+// See aws docs https://docs.aws.amazon.com/vpc/latest/userguide/VPC_Subnets.html#vpc-sizing-ipv4
+func (n *NetworkProvider) allocateStandaloneCIDR(ctx context.Context, vpcCreateConfig *ec2.CreateVpcInput) (*net.IPNet, error) {
+	// a standalone vpc created before cidr blocks were persisted keeps its cidr block
+	standaloneVpc, err := getStandaloneVpc(ctx, n.Client, n.Ec2Api, n.Logger)
 	if err != nil {
-		return nil, errorUtil.Wrap(err, "failed to get cluster vpc for cidr block")
+		return nil, errorUtil.Wrap(err, "failed to get standalone vpc")
+	}
+	if standaloneVpc != nil {
+		_, vpcCidr, err := net.ParseCIDR(aws.StringValue(standaloneVpc.CidrBlock))
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to parse standalone vpc cidr block")
+		}
+		return vpcCidr, nil
 	}
-	//parse the cidr to an ipnet cidr in order to manipulate the ip
-	_, clusterNet, err := net.ParseCIDR(aws.StringValue(clusterVpc.CidrBlock))
 
+	mask := defaultCIDRMask
+	if vpcCreateConfig.Ipv4NetmaskLength != nil {
+		mask = int(*vpcCreateConfig.Ipv4NetmaskLength)
+	}
+	if !isValidCIDRRange(&net.IPNet{Mask: net.CIDRMask(mask, defaultIpv4Length)}) {
+		return nil, errorUtil.New(fmt.Sprintf("/%d is out of range, block sizes must be between `/16` and `/26`, please update `_network` strategy", mask))
+	}
+
+	reserved, err := n.getReservedCIDRs(ctx)
 	if err != nil {
-		return nil, errorUtil.Wrap(err, "error parsing cluster cidr block")
+		return nil, errorUtil.Wrap(err, "failed to get cidr blocks in use")
 	}
+	return resources.AllocateCIDR(resources.PrivateCIDRPools(), mask, reserved)
+}
 
-	// this list is used to loop through the available options for a vpc cidr range in aws
-	// See aws docs https://docs.aws.amazon.com/vpc/latest/userguide/VPC_Subnets.html#vpc-sizing-ipv4
-	cidrRanges := []*cidrList{
-		{
-			"10.255.255.255/8",
-			fmt.Sprintf("10.0.0.0/%s", defaultCIDRMask),
-		},
-		{
-			"172.31.255.255/12",
-			fmt.Sprintf("172.16.0.0/%s", defaultCIDRMask),
-		},
+// getReservedCIDRs returns the cidr blocks a standalone vpc can not overlap with
+//   - the cluster vpc cidr blocks
+//   - the cluster pod and service cidr blocks
+//   - the cidr blocks of vpcs peered with the cluster vpc
+//   - the destinations of routes in the cluster vpc, such as transit gateways and vpn connections to other networks
+func (n *NetworkProvider) getReservedCIDRs(ctx context.Context) ([]*net.IPNet, error) {
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.clusterEc2Api(), n.Logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc for cidr block")
 	}
+	reserved, err := getVpcCidrBlocks(clusterVpc)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to parse cluster vpc cidr block")
+	}
+	var cidrs []string
 
 	//getting the network cr called from the cluster
 	networkConf := &configv1.Network{}
-	err = n.Client.Get(ctx, client.ObjectKey{Name: "cluster"}, networkConf)
-	if err != nil {
+	if err = n.Client.Get(ctx, client.ObjectKey{Name: "cluster"}, networkConf); err != nil {
 		return nil, errorUtil.Wrap(err, "failed to get network kind")
 	}
-
-	podCIDR, foundPodNet, err := getPodCIDR(networkConf)
-	if err != nil {
-		return nil, errorUtil.Wrap(err, "failed to get pod CIDR")
+	for _, entry := range networkConf.Spec.ClusterNetwork {
+		cidrs = append(cidrs, entry.CIDR)
 	}
+	cidrs = append(cidrs, networkConf.Spec.ServiceNetwork...)
 
-	serviceCIDR, foundServiceNet, err := getServiceCIDR(networkConf)
+	peerings, err := n.clusterEc2Api().DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{})
 	if err != nil {
-		return nil, errorUtil.Wrap(err, "failed to get service CIDR")
+		return nil, errorUtil.Wrap(err, "failed to describe vpc peering connections")
 	}
-
-	// in this loop we loop through the available cidr ranges for vpcs in aws
-	// in each range the ip of the cidr block is incremented only in the A and B range
-	// the reason for this is that cro allows a maximum mask size of /16
-	// the default cidr mask is set by defaultCIDRMask env and is currently 26
-	//
-	// the current logic checks that the cidr block does not overlap with the cluster machine,
-	//  pod and service cidr range
-	for _, cidrList := range cidrRanges {
-		_, cidrRangeNet, err := net.ParseCIDR(cidrList.cidr)
-		if err != nil {
-			fmt.Println("error parsing cidr range for default cidr block", err)
-		}
-		// our potential default
-		potentialDefaultIP, _, err := net.ParseCIDR(cidrList.defaultVal)
-		if err != nil {
-			fmt.Println("error parsing potential default cidr range for default cidr block", err)
-		}
-
-		// loop for as long as potential default is a valid CIDR in range
-		for cidrRangeNet.Contains(potentialDefaultIP) {
-
-			// create CIDR adding the default /26 mask
-			_, defaultNet, err := net.ParseCIDR(fmt.Sprintf("%s/26", potentialDefaultIP.String()))
-			if err != nil {
+	for _, peering := range peerings.VpcPeeringConnections {
+		for _, peer := range []*ec2.VpcPeeringConnectionVpcInfo{peering.AccepterVpcInfo, peering.RequesterVpcInfo} {
+			if peer == nil || aws.StringValue(peer.VpcId) == aws.StringValue(clusterVpc.VpcId) {
 				continue
 			}
-			// increment potential IP
-			potentialDefaultIP = incrementIPForDefaultCIDR(potentialDefaultIP)
-
-			if clusterNet.Contains(defaultNet.IP) || defaultNet.Contains(clusterNet.IP) {
-				continue
+			cidrs = append(cidrs, aws.StringValue(peer.CidrBlock))
+			for _, block := range peer.CidrBlockSet {
+				cidrs = append(cidrs, aws.StringValue(block.CidrBlock))
 			}
-
-			if foundPodNet {
-				if podCIDR.Contains(defaultNet.IP) || defaultNet.Contains(podCIDR.IP) {
-					continue
-				}
-
-			}
-
-			if foundServiceNet {
-				if serviceCIDR.Contains(defaultNet.IP) || defaultNet.Contains(serviceCIDR.IP) {
-					continue
-				}
-
-			}
-
-			// return the first available option that does not overlap
-			return defaultNet, nil
 		}
 	}
 
-	// if the loop finishes, it means that we have gone through all available cidr blocks in the available ranges in aws
-	// return an error that cro was unable to find an option
-	return nil, errorUtil.New("could not find a default cidr block")
-}
-
-// Makes a call to the cluster to get the pod cidr from the network CR, parses it into a ip notation
-// then checks to see if the value is actually being returned in case of a scenario where the cidr is empty in the CR
-func getPodCIDR(networkConf *configv1.Network) (*net.IPNet, bool, error) {
-	var podNet *net.IPNet
-	var err error
-
-	for _, entry := range networkConf.Spec.ClusterNetwork {
-		_, podNet, err = net.ParseCIDR(entry.CIDR)
-		if err != nil {
-			return nil, false, errorUtil.Wrap(err, "error parsing pod cidr")
+	routeTables, err := n.clusterEc2Api().DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{clusterVpc.VpcId},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to describe cluster vpc route tables")
+	}
+	for _, routeTable := range routeTables.RouteTables {
+		for _, route := range routeTable.Routes {
+			// the default route and the local route of the vpc itself do not reserve a network
+			if aws.StringValue(route.GatewayId) == "local" || aws.StringValue(route.DestinationCidrBlock) == defaultRouteCIDR {
+				continue
+			}
+			cidrs = append(cidrs, aws.StringValue(route.DestinationCidrBlock))
 		}
 	}
-	if podNet == nil {
-		return nil, false, err
+	routed, err := resources.ParseCIDRs(cidrs...)
+	if err != nil {
+		return nil, err
 	}
-	return podNet, true, nil
+	return append(reserved, routed...), nil
 }
 
-// Makes a call to the cluster to get the service cidr from the network CR, parses it into a ip notation
-// then checks to see if the value is actually being returned in case of a scenario where the cidr is empty in the CR
-func getServiceCIDR(networkConf *configv1.Network) (*net.IPNet, bool, error) {
-	var err error
-	var serviceNet *net.IPNet
-
-	for _, entry := range networkConf.Spec.ServiceNetwork {
-
-		_, serviceNet, err = net.ParseCIDR(entry)
-		if err != nil {
-			return nil, false, errorUtil.Wrap(err, "error parsing service cidr")
-		}
+// getVpcCidrBlocks returns the primary and associated cidr blocks of a vpc
+func getVpcCidrBlocks(vpc *ec2.Vpc) ([]*net.IPNet, error) {
+	if aws.StringValue(vpc.CidrBlock) == "" {
+		return nil, errorUtil.New("vpc cidr block can't be empty")
 	}
-	if serviceNet == nil {
-		return nil, false, err
+	cidrs := []string{aws.StringValue(vpc.CidrBlock)}
+	for _, association := range vpc.CidrBlockAssociationSet {
+		// the primary cidr block is also listed in the associations
+		if aws.StringValue(association.CidrBlock) != aws.StringValue(vpc.CidrBlock) {
+			cidrs = append(cidrs, aws.StringValue(association.CidrBlock))
+		}
 	}
-
-	return serviceNet, true, nil
+	return resources.ParseCIDRs(cidrs...)
 }
 
 // subnetExists is a helper function for checking if a subnet exists with a specific cidr block
@@ -1757,25 +1780,11 @@ func isValidCIDRRange(CIDR *net.IPNet) bool {
 	return mask > 15 && mask < 27
 }
 
-// Increment an IP address only by B and A class.
-// as cro offers a maximum mask size of /16
-// we reduce the number of increments by skipping the C and D class.
-func incrementIPForDefaultCIDR(ip net.IP) net.IP {
-	ipv4 := ip.To4()
-	if ipv4[1] < 255 {
-		ipv4[1] = ipv4[1] + byte(1)
-	} else {
-		ipv4[1] = byte(0)
-		ipv4[0] = ipv4[0] + byte(1)
-	}
-	return ipv4
-}
-
 // validateStandaloneCidrBlock validates the standalone cidr block before creation, returning an error if the cidr is not valid
 // checks carried out :
 //   - has a cidr range between \16 and \26
-//   - does not overlap with cluster vpc cidr block
-func validateStandaloneCidrBlock(validateCIDR *net.IPNet, clusterCIDR *net.IPNet) error {
+//   - does not overlap with the reserved cidr blocks, see getReservedCIDRs
+func validateStandaloneCidrBlock(validateCIDR *net.IPNet, reserved []*net.IPNet) error {
 	// validate has a cidr range between \16 and \26
 	if !isValidCIDRRange(validateCIDR) {
 		return errorUtil.New(fmt.Sprintf("%s is out of range, block sizes must be between `/16` and `/26`, please update `_network` strategy", validateCIDR.String()))
@@ -1783,9 +1792,8 @@ func validateStandaloneCidrBlock(validateCIDR *net.IPNet, clusterCIDR *net.IPNet
 
 	// standalone vpc cidr block can not overlap with existing cluster vpc cidr block
 	// issue arises when trying to peer both vpcs with invalid vpc error - `overlapping CIDR range`
-	// this utility function returns true if either cidr range intersect
-	if clusterCIDR.Contains(validateCIDR.IP) || validateCIDR.Contains(clusterCIDR.IP) {
-		return errorUtil.New(fmt.Sprintf("standalone vpc creation failed: standalone cidr block %s overlaps with cluster vpc cidr block %s, update _network strategy to continue vpc creation", validateCIDR.String(), clusterCIDR.IP))
+	if overlapping := resources.FindOverlappingCIDR(validateCIDR, reserved); overlapping != nil {
+		return errorUtil.New(fmt.Sprintf("standalone vpc creation failed: standalone cidr block %s overlaps with cidr block %s in use by the cluster network, update _network strategy to continue vpc creation", validateCIDR.String(), overlapping.String()))
 	}
 	return nil
 }
@@ -43,6 +43,9 @@ var _ NetworkManager = &NetworkManagerMock{}
 //			GetClusterNetworkPeeringFunc: func(contextMoqParam context.Context) (*NetworkPeering, error) {
 //				panic("mock out the GetClusterNetworkPeering method")
 //			},
+//			GetNetworkConnectorFunc: func(contextMoqParam context.Context, configManager ConfigManager, s string) (NetworkConnector, error) {
+//				panic("mock out the GetNetworkConnector method")
+//			},
 //			IsEnabledFunc: func(contextMoqParam context.Context) (bool, error) {
 //				panic("mock out the IsEnabled method")
 //			},
@@ -77,6 +80,9 @@ type NetworkManagerMock struct {
 	// GetClusterNetworkPeeringFunc mocks the GetClusterNetworkPeering method.
 	GetClusterNetworkPeeringFunc func(contextMoqParam context.Context) (*NetworkPeering, error)
 
+	// GetNetworkConnectorFunc mocks the GetNetworkConnector method.
+	GetNetworkConnectorFunc func(contextMoqParam context.Context, configManager ConfigManager, s string) (NetworkConnector, error)
+
 	// IsEnabledFunc mocks the IsEnabled method.
 	IsEnabledFunc func(contextMoqParam context.Context) (bool, error)
 
@@ -130,6 +136,15 @@ type NetworkManagerMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// GetNetworkConnector holds details about calls to the GetNetworkConnector method.
+		GetNetworkConnector []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ConfigManager is the configManager argument value.
+			ConfigManager ConfigManager
+			// S is the s argument value.
+			S string
+		}
 		// IsEnabled holds details about calls to the IsEnabled method.
 		IsEnabled []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -144,6 +159,7 @@ type NetworkManagerMock struct {
 	lockDeleteNetworkConnection     sync.RWMutex
 	lockDeleteNetworkPeering        sync.RWMutex
 	lockGetClusterNetworkPeering    sync.RWMutex
+	lockGetNetworkConnector         sync.RWMutex
 	lockIsEnabled                   sync.RWMutex
 }
 
@@ -419,6 +435,46 @@ func (mock *NetworkManagerMock) GetClusterNetworkPeeringCalls() []struct {
 	return calls
 }
 
+// GetNetworkConnector calls GetNetworkConnectorFunc.
+func (mock *NetworkManagerMock) GetNetworkConnector(contextMoqParam context.Context, configManager ConfigManager, s string) (NetworkConnector, error) {
+	if mock.GetNetworkConnectorFunc == nil {
+		panic("NetworkManagerMock.GetNetworkConnectorFunc: method is nil but NetworkManager.GetNetworkConnector was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		ConfigManager   ConfigManager
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		ConfigManager:   configManager,
+		S:               s,
+	}
+	mock.lockGetNetworkConnector.Lock()
+	mock.calls.GetNetworkConnector = append(mock.calls.GetNetworkConnector, callInfo)
+	mock.lockGetNetworkConnector.Unlock()
+	return mock.GetNetworkConnectorFunc(contextMoqParam, configManager, s)
+}
+
+// GetNetworkConnectorCalls gets all the calls that were made to GetNetworkConnector.
+// Check the length with:
+//
+//	len(mockedNetworkManager.GetNetworkConnectorCalls())
+func (mock *NetworkManagerMock) GetNetworkConnectorCalls() []struct {
+	ContextMoqParam context.Context
+	ConfigManager   ConfigManager
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		ConfigManager   ConfigManager
+		S               string
+	}
+	mock.lockGetNetworkConnector.RLock()
+	calls = mock.calls.GetNetworkConnector
+	mock.lockGetNetworkConnector.RUnlock()
+	return calls
+}
+
 // IsEnabled calls IsEnabledFunc.
 func (mock *NetworkManagerMock) IsEnabled(contextMoqParam context.Context) (bool, error) {
 	if mock.IsEnabledFunc == nil {
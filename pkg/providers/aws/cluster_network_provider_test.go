@@ -15,17 +15,20 @@ import (
 	errorUtil "github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -98,7 +101,11 @@ func buildMockVpcPeeringConnection(modifyFn func(*ec2.VpcPeeringConnection)) *ec
 }
 
 func buildTestConfigManager(modifyFn func(m *ConfigManagerMock)) *ConfigManagerMock {
-	mock := &ConfigManagerMock{}
+	mock := &ConfigManagerMock{
+		WriteCreateStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+			return nil
+		},
+	}
 	if modifyFn != nil {
 		modifyFn(mock)
 	}
@@ -615,6 +622,15 @@ func TestNetworkProvider_IsEnabled(t *testing.T) {
 	}
 }
 
+// buildTestDefaultNetwork returns the network config of a cluster using the openshift default pod and service networks,
+// these do not overlap the standalone vpc cidr blocks used by the tests
+func buildTestDefaultNetwork() *configv1.Network {
+	return buildTestNetwork(func(network *configv1.Network) {
+		network.Spec.ClusterNetwork[0].CIDR = "10.128.0.0/14"
+		network.Spec.ServiceNetwork = []string{"172.30.0.0/16"}
+	})
+}
+
 func TestNetworkProvider_CreateNetwork(t *testing.T) {
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -642,7 +658,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully error on invalid cidr params standalone vpc network - CIDR /15",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: &mockEc2Client{
 					describeVpcsFn: func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -668,7 +684,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully build standalone vpc network  - CIDR /16",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -702,7 +718,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully build standalone vpc network  - CIDR /16 (sts cluster)",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -737,7 +753,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully build standalone vpc network - CIDR /26",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -771,7 +787,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "fail if trying to build standalone vpc network - CIDR /27",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -811,7 +827,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "verify ec2 error when describing vpcs",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -830,7 +846,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on standalone vpc",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -875,7 +891,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on non tagged standalone vpc",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(nil),
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -911,7 +927,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully timed out to check if VPC exists and failed the deletion",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(nil),
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -951,7 +967,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on already created rds and elasticache subnet groups for standalone vpc",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{
@@ -1033,7 +1049,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on standalone vpc - create subnets in correct azs",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1081,7 +1097,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on standalone vpc - create subnets in large unsorted az zones list - zone one and two",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1147,7 +1163,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully reconcile on standalone vpc - create correct subnets for vpc cidr block 10.0.50.0/23",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1193,25 +1209,24 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "verify cluster vpc cidr block and standalone vpc cidr block overlaps return an error",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
 					}
 				}),
-				Ec2Api: &mockEc2Client{
-					describeVpcsFn: func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
 						return &ec2.DescribeVpcsOutput{
 							Vpcs: []*ec2.Vpc{buildValidClusterVPC(validCIDRSixteen)[0]},
 						}, nil
-					},
-					subnets: []*ec2.Subnet{},
-					describeSubnetsFn: func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+					}
+					ec2Client.describeSubnetsFn = func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
 						return &ec2.DescribeSubnetsOutput{
 							Subnets: buildValidBundleSubnets(),
 						}, nil
-					},
-				},
+					}
+				}),
 				ElasticacheApi: buildMockElasticacheClient(nil),
 				Logger:         logrus.NewEntry(logrus.StandardLogger()),
 			},
@@ -1221,10 +1236,53 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "verify standalone vpc cidr block overlapping a vpc peered with the cluster vpc returns an error",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
+				RdsApi: &mockRdsClient{},
+				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+						return &ec2.DescribeVpcsOutput{
+							Vpcs: buildValidClusterVPC(defaultNonOverlappingCidr),
+						}, nil
+					}
+					ec2Client.describeVpcPeeringConnectionFn = func(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+						return &ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								buildMockVpcPeeringConnection(func(conn *ec2.VpcPeeringConnection) {
+									conn.AccepterVpcInfo = &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("corporate"), CidrBlock: aws.String(validCIDRSixteen)}
+									conn.RequesterVpcInfo = &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(defaultVpcId), CidrBlock: aws.String(defaultNonOverlappingCidr)}
+								}),
+							},
+						}, nil
+					}
+					ec2Client.createVpcFn = func(input *ec2.CreateVpcInput) (*ec2.CreateVpcOutput, error) {
+						return &ec2.CreateVpcOutput{
+							Vpc: buildValidStandaloneVPC(validCIDRTwentySix),
+						}, nil
+					}
+					ec2Client.describeSubnetsFn = func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+						return &ec2.DescribeSubnetsOutput{
+							Subnets: []*ec2.Subnet{
+								buildValidClusterSubnet(nil),
+							},
+						}, nil
+					}
+				}),
+				ElasticacheApi: &mockElasticacheClient{},
+				Logger:         logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				ctx:  context.TODO(),
+				CIDR: buildValidCIDR(validCIDRTwentySix),
+			},
+			wantErr: true,
+		},
 		{
 			name: "verify ec2 VpcLimitExceeded returns an error",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1257,7 +1315,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "verify ec2 InvalidVpcRange returns an error",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1290,7 +1348,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "successfully error if vpc route table does not exist",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1327,7 +1385,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 		{
 			name: "fail when not enough availability zones support default node types",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()),
 				RdsApi: buildMockRdsClient(func(rdsClient *mockRdsClient) {
 					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
 						return &rds.DescribeDBSubnetGroupsOutput{}, nil
@@ -1436,7 +1494,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 						return &ec2.DescribeRouteTablesOutput{
 							RouteTables: []*ec2.RouteTable{
 								buildMockEc2RouteTable(func(table *ec2.RouteTable) {
-									tags, _ := getDefaultTagSpec(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()), &resources.Tag{Key: resources.TagDisplayName, Value: defaultRouteTableNameTagValue}, ec2.ResourceTypeRouteTable)
+									tags, _ := getDefaultTagSpec(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestDefaultNetwork()), &resources.Tag{Key: resources.TagDisplayName, Value: defaultRouteTableNameTagValue}, ec2.ResourceTypeRouteTable)
 									table.Tags = tags[0].Tags
 								}),
 							},
@@ -1482,7 +1540,7 @@ func TestNetworkProvider_CreateNetwork(t *testing.T) {
 					Status: configv1.InfrastructureStatus{
 						InfrastructureName: defaultInfraName,
 					},
-				}),
+				}, buildTestDefaultNetwork()),
 				RdsApi: &mockRdsClient{},
 				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
 					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
@@ -1794,11 +1852,12 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 		Logger         *logrus.Entry
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    *net.IPNet
-		wantErr bool
+		name               string
+		fields             fields
+		args               args
+		want               *net.IPNet
+		wantCreateStrategy string
+		wantErr            bool
 	}{
 		{
 			name: "verify successful reconcile",
@@ -1924,8 +1983,132 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 				logger: logrus.NewEntry(logrus.StandardLogger()),
 				tier:   "test",
 			},
-			wantErr: false,
-			want:    buildValidIpNet("10.6.0.0/26"),
+			wantErr:            false,
+			want:               buildValidIpNet("10.6.0.0/26"),
+			wantCreateStrategy: `{"CidrBlock":"10.6.0.0/26"}`,
+		},
+		{
+			name: "verify allocated cidr block does not overlap peered vpcs and routes",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestNetwork(func(network *configv1.Network) {})),
+				RdsApi: &mockRdsClient{},
+				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+						return &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{
+							buildMockVpc(func(vpc *ec2.Vpc) {
+								vpc.CidrBlock = aws.String("10.4.0.0/16")
+							}),
+						}}, nil
+					}
+					ec2Client.describeSubnetsFn = func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+						return &ec2.DescribeSubnetsOutput{
+							Subnets: []*ec2.Subnet{
+								buildValidClusterSubnet(nil),
+							},
+						}, nil
+					}
+					ec2Client.describeVpcPeeringConnectionFn = func(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+						return &ec2.DescribeVpcPeeringConnectionsOutput{
+							VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+								buildMockVpcPeeringConnection(func(conn *ec2.VpcPeeringConnection) {
+									conn.AccepterVpcInfo = &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("corporate"), CidrBlock: aws.String("10.6.0.0/16")}
+									conn.RequesterVpcInfo = &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(defaultVpcId), CidrBlock: aws.String("10.4.0.0/16")}
+								}),
+							},
+						}, nil
+					}
+					ec2Client.describeRouteTablesFn = func(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
+						return &ec2.DescribeRouteTablesOutput{
+							RouteTables: []*ec2.RouteTable{
+								{
+									Routes: []*ec2.Route{
+										{DestinationCidrBlock: aws.String("10.4.0.0/16"), GatewayId: aws.String("local")},
+										{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw")},
+										{DestinationCidrBlock: aws.String("10.7.0.0/16"), TransitGatewayId: aws.String("tgw")},
+									},
+								},
+							},
+						}, nil
+					}
+				}),
+				ElasticacheApi: &mockElasticacheClient{},
+				Logger:         logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				ctx: context.TODO(),
+				configManager: buildTestConfigManager(func(m *ConfigManagerMock) {
+					m.ReadStorageStrategyFunc = func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+						return &StrategyConfig{
+							CreateStrategy: json.RawMessage(`{ "Ipv4NetmaskLength": 24 }`),
+						}, nil
+					}
+				}),
+				logger: logrus.NewEntry(logrus.StandardLogger()),
+				tier:   "test",
+			},
+			wantErr:            false,
+			want:               buildValidIpNet("10.8.0.0/24"),
+			wantCreateStrategy: `{"CidrBlock":"10.8.0.0/24","Ipv4NetmaskLength":24}`,
+		},
+		{
+			name: "verify cidr block of existing standalone vpc is persisted",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestNetwork(func(network *configv1.Network) {})),
+				RdsApi: &mockRdsClient{},
+				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+						return &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{
+							buildValidStandaloneVPC(validCIDRTwentySix),
+						}}, nil
+					}
+				}),
+				ElasticacheApi: &mockElasticacheClient{},
+				Logger:         logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				ctx: context.TODO(),
+				configManager: buildTestConfigManager(func(m *ConfigManagerMock) {
+					m.ReadStorageStrategyFunc = func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+						return &StrategyConfig{
+							CreateStrategy: json.RawMessage("{  }"),
+						}, nil
+					}
+				}),
+				logger: logrus.NewEntry(logrus.StandardLogger()),
+				tier:   "test",
+			},
+			wantErr:            false,
+			want:               buildValidIpNet(validCIDRTwentySix),
+			wantCreateStrategy: `{"CidrBlock":"10.0.0.0/26"}`,
+		},
+		{
+			name: "verify error on invalid requested cidr block size",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestNetwork(func(network *configv1.Network) {})),
+				RdsApi: &mockRdsClient{},
+				Ec2Api: buildMockEc2Client(func(ec2Client *mockEc2Client) {
+					ec2Client.describeVpcsFn = func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+						return &ec2.DescribeVpcsOutput{Vpcs: []*ec2.Vpc{
+							buildMockVpc(func(vpc *ec2.Vpc) {}),
+						}}, nil
+					}
+				}),
+				ElasticacheApi: &mockElasticacheClient{},
+				Logger:         logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				ctx: context.TODO(),
+				configManager: buildTestConfigManager(func(m *ConfigManagerMock) {
+					m.ReadStorageStrategyFunc = func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+						return &StrategyConfig{
+							CreateStrategy: json.RawMessage(`{ "Ipv4NetmaskLength": 28 }`),
+						}, nil
+					}
+				}),
+				logger: logrus.NewEntry(logrus.StandardLogger()),
+				tier:   "test",
+			},
+			wantErr: true,
 		},
 		{
 			name: "verify empty cidr blocks returns a error",
@@ -2024,6 +2207,13 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ReconcileNetworkProviderConfig() got = %v, want %v", got, tt.want)
 			}
+			var gotCreateStrategy string
+			if calls := tt.args.configManager.(*ConfigManagerMock).WriteCreateStrategyCalls(); len(calls) > 0 {
+				gotCreateStrategy = string(calls[0].CreateStrategy)
+			}
+			if gotCreateStrategy != tt.wantCreateStrategy {
+				t.Errorf("ReconcileNetworkProviderConfig() persisted create strategy = %s, want %s", gotCreateStrategy, tt.wantCreateStrategy)
+			}
 		})
 	}
 }
@@ -2286,6 +2476,152 @@ func TestNetworkProvider_CreateNetworkPeering(t *testing.T) {
 	}
 }
 
+func TestNetworkProvider_CreateNetworkPeeringCrossAccount(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	clusterAccountID := "123456789012"
+	var peeringInput *ec2.CreateVpcPeeringConnectionInput
+	var acceptedByCluster bool
+	ec2Client := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+		ec2Client.describeVpcPeeringConnectionFn = func(*ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+			return &ec2.DescribeVpcPeeringConnectionsOutput{VpcPeeringConnections: []*ec2.VpcPeeringConnection{}}, nil
+		}
+		ec2Client.createVpcPeeringConnectionFn = func(input *ec2.CreateVpcPeeringConnectionInput) (*ec2.CreateVpcPeeringConnectionOutput, error) {
+			peeringInput = input
+			return &ec2.CreateVpcPeeringConnectionOutput{VpcPeeringConnection: buildMockVpcPeeringConnection(func(mock *ec2.VpcPeeringConnection) {
+				mock.Status.Code = aws.String(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)
+			})}, nil
+		}
+		ec2Client.createTagsFn = func(*ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+			return nil, nil
+		}
+		ec2Client.acceptVpcPeeringConnectionFn = func(*ec2.AcceptVpcPeeringConnectionInput) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+			return nil, errors.New("the requester account can not accept the peering connection")
+		}
+	})
+	clusterEc2Client := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+		ec2Client.describeVpcsFn = func(*ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+			vpcs := buildVpcs()
+			vpcs[0].OwnerId = aws.String(clusterAccountID)
+			return &ec2.DescribeVpcsOutput{Vpcs: vpcs}, nil
+		}
+		ec2Client.describeSubnetsFn = func(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+			return &ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{buildValidClusterSubnet(nil)}}, nil
+		}
+		ec2Client.acceptVpcPeeringConnectionFn = func(*ec2.AcceptVpcPeeringConnectionInput) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+			acceptedByCluster = true
+			return &ec2.AcceptVpcPeeringConnectionOutput{}, nil
+		}
+	})
+	n := &NetworkProvider{
+		Ec2Api:        ec2Client,
+		ClusterEc2Api: clusterEc2Client,
+		Client:        moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+		Logger:        logrus.NewEntry(logrus.StandardLogger()),
+	}
+	if _, err := n.CreateNetworkPeering(context.TODO(), buildMockNetwork(nil)); err != nil {
+		t.Fatalf("CreateNetworkPeering() unexpected error = %v", err)
+	}
+	if peeringInput == nil || aws.StringValue(peeringInput.PeerOwnerId) != clusterAccountID {
+		t.Errorf("CreateNetworkPeering() peering input = %v, want peer owner %s", peeringInput, clusterAccountID)
+	}
+	if !acceptedByCluster {
+		t.Error("CreateNetworkPeering() peering connection was not accepted by the cluster account")
+	}
+	enabled, err := n.IsEnabled(context.TODO())
+	if err != nil || !enabled {
+		t.Errorf("IsEnabled() = %v, %v, want the standalone vpc to be used in another account", enabled, err)
+	}
+}
+
+func TestNewNetworkManagerFromStrategy(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	roleArn := "arn:aws:iam::123456789012:role/cro-resources"
+	cloudNetwork := &v1alpha1.CloudNetwork{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.CloudNetworkName}, Spec: v1alpha1.CloudNetworkSpec{Tier: "production"}}
+	sess := session.Must(session.NewSession(&aws.Config{Region: aws.String("eu-west-1")}))
+	tests := []struct {
+		name              string
+		client            client.Client
+		strategy          *StrategyConfig
+		networkRoleArns   map[string]string
+		wantClusterEc2Api bool
+		wantMsg           string
+		wantErr           bool
+	}{
+		{
+			name:            "test no strategy sets a roleArn",
+			client:          moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			strategy:        &StrategyConfig{},
+			networkRoleArns: map[string]string{"development": ""},
+		},
+		{
+			name:              "test strategies set the same roleArn",
+			client:            moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			strategy:          &StrategyConfig{RoleArn: roleArn},
+			networkRoleArns:   map[string]string{"development": roleArn},
+			wantClusterEc2Api: true,
+		},
+		{
+			name:            "test strategy roleArn not set in _network strategy",
+			client:          moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			strategy:        &StrategyConfig{RoleArn: roleArn},
+			networkRoleArns: map[string]string{"development": ""},
+			wantMsg:         fmt.Sprintf("roleArn %q of the strategy does not match roleArn \"\" of the `_network` strategy of tier development, the standalone vpc must be created in the same account, please update the strategies", roleArn),
+			wantErr:         true,
+		},
+		{
+			name:            "test _network strategy roleArn not set in strategy",
+			client:          moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			strategy:        &StrategyConfig{},
+			networkRoleArns: map[string]string{"development": roleArn},
+			wantMsg:         fmt.Sprintf("roleArn \"\" of the strategy does not match roleArn %q of the `_network` strategy of tier development, the standalone vpc must be created in the same account, please update the strategies", roleArn),
+			wantErr:         true,
+		},
+		{
+			name:            "test _network strategy of the cloud network tier is compared",
+			client:          moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), cloudNetwork),
+			strategy:        &StrategyConfig{RoleArn: roleArn},
+			networkRoleArns: map[string]string{"development": roleArn, "production": ""},
+			wantMsg:         fmt.Sprintf("roleArn %q of the strategy does not match roleArn \"\" of the `_network` strategy of tier production, the standalone vpc must be created in the same account, please update the strategies", roleArn),
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configManager := buildTestConfigManager(func(m *ConfigManagerMock) {
+				m.ReadStorageStrategyFunc = func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+					if rt != providers.NetworkResourceType {
+						return nil, fmt.Errorf("unexpected resource type %s", rt)
+					}
+					networkRoleArn, ok := tt.networkRoleArns[tier]
+					if !ok {
+						return nil, fmt.Errorf("unexpected tier %s", tier)
+					}
+					return &StrategyConfig{RoleArn: networkRoleArn}, nil
+				}
+			})
+			networkManager, msg, err := newNetworkManagerFromStrategy(context.TODO(), tt.client, configManager, "development", &Credentials{}, tt.strategy, sess, logrus.NewEntry(logrus.StandardLogger()))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newNetworkManagerFromStrategy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(msg) != tt.wantMsg {
+				t.Errorf("newNetworkManagerFromStrategy() msg = %v, want %v", msg, tt.wantMsg)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (networkManager.ClusterEc2Api != nil) != tt.wantClusterEc2Api {
+				t.Errorf("newNetworkManagerFromStrategy() ClusterEc2Api = %v, want cluster session %v", networkManager.ClusterEc2Api, tt.wantClusterEc2Api)
+			}
+		})
+	}
+}
+
 func TestNetworkProvider_GetClusterNetworkPeering(t *testing.T) {
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -78,12 +78,17 @@ func configureSecurityGroup(ctx context.Context, c client.Client, ec2Svc ec2ifac
 	}
 
 	if foundSecGroup == nil {
+		tagSpec, err := getDefaultTagSpec(ctx, c, &resources.Tag{Key: resources.TagDisplayName, Value: defaultSecurityGroupNameTagValue}, ec2.ResourceTypeSecurityGroup)
+		if err != nil {
+			return errorUtil.Wrap(err, "failed to get default tag spec")
+		}
 		// create security group
 		logger.Infof("creating security group from cluster %s", clusterID)
 		if _, err := ec2Svc.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
-			Description: aws.String(fmt.Sprintf("security group for cluster %s", clusterID)),
-			GroupName:   aws.String(secName),
-			VpcId:       aws.String(vpcID),
+			Description:       aws.String(fmt.Sprintf("security group for cluster %s", clusterID)),
+			GroupName:         aws.String(secName),
+			VpcId:             aws.String(vpcID),
+			TagSpecifications: tagSpec,
 		}); err != nil {
 			return errorUtil.Wrap(err, "error creating security group")
 		}
@@ -226,6 +231,10 @@ func createPrivateSubnet(ctx context.Context, c client.Client, ec2Svc ec2iface.E
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to build subnets")
 	}
+	subnetTags, err := getDefaultSubnetTags(ctx, c)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get default tags for subnet")
+	}
 
 	// create subnet looping through potential subnet list
 	var subnet *ec2.Subnet
@@ -235,6 +244,12 @@ func createPrivateSubnet(ctx context.Context, c client.Client, ec2Svc ec2iface.E
 			AvailabilityZone: aws.String(zone),
 			CidrBlock:        aws.String(ip.String()),
 			VpcId:            aws.String(*vpc.VpcId),
+			TagSpecifications: []*ec2.TagSpecification{
+				{
+					ResourceType: aws.String(ec2.ResourceTypeSubnet),
+					Tags:         subnetTags,
+				},
+			},
 		})
 		ec2err, isAwsErr := err.(awserr.Error)
 		if err != nil && isAwsErr && ec2err.Code() == "InvalidSubnet.Conflict" {
@@ -355,84 +370,13 @@ func buildSubnetAddress(vpc *ec2.Vpc, logger *logrus.Entry) ([]net.IPNet, error)
 	return networks, nil
 }
 
+// generateAvailableSubnets returns every sub-network with the mask of toCIDR within fromCIDR
+// e.g.
+// Cluster VPC (fromCIDR) is 10.0.0.0/8
+// toCIDR is 10.0.0.0/24
+// we want all possible /24 networks that are valid between fromCIDR and toCIDR
 func generateAvailableSubnets(fromCIDR, toCIDR *net.IPNet) []net.IPNet {
-	toIPv4 := toCIDR.IP.To4()
-	networks := []net.IPNet{
-		{
-			IP:   toIPv4,
-			Mask: toCIDR.Mask,
-		},
-	}
-	// The #Contains check here is done to ensure we don't bother generating subnet addresses outside of the scope of
-	// the fromCIDR
-	// e.g. If fromCIDR is 10.0.0.0/8, there's no reason to try to generate any ranges outside of 10.x.x.x as they
-	// won't be valid sub-network addresses of fromCIDR
-	for i := 0; fromCIDR.Contains(incrementIP(toIPv4, i)); i++ {
-		// Get the next IP address
-		nextFoundNetwork := incrementIP(toIPv4, i)
-		// Ensure the network is a valid sub-network in toCIDR
-		// We only want valid sub-network addresses between toCIDR and fromCIDR, we don't want to store host addresses
-		// We don't need duplicates
-		nextFoundNetworkMasked := nextFoundNetwork.Mask(toCIDR.Mask)
-		if containsNetwork(networks, nextFoundNetworkMasked) {
-			continue
-		}
-
-		// Ensure the network is added with the mask of toCIDR
-		// e.g.
-		// Cluster VPC (fromCIDR) is 10.0.0.0/8
-		// toCIDR is 10.0.0.0/24
-		// we want all possible /24 networks that are valid between fromCIDR and toCIDR
-		networks = append(networks, net.IPNet{
-			IP:   nextFoundNetworkMasked,
-			Mask: toCIDR.Mask,
-		})
-	}
-	return networks
-}
-
-func containsNetwork(networks []net.IPNet, toFind net.IP) bool {
-	for _, n := range networks {
-		if n.IP.Equal(toFind) {
-			return true
-		}
-	}
-	return false
-}
-
-// Increment an IP address by a provided increment value
-// Makes cycling through IP addresses simple as we can keep incrememnting by 1 to iterate through a range of IPs
-func incrementIP(ip net.IP, inc int) net.IP {
-	// It's not guaranteed that a provided IP will be in IPv4 format, we need to be able to split it into bytes. So
-	// ensure it is formatted correctly first.
-	ipv4 := ip.To4()
-	// Join the four separate bytes of the IP address into one int, this makes cycling through IP addresses easy as
-	// when we overflow on one byte, the byte will be reset to 00000000 for us and the next byte will be incremented
-	//
-	// The << operator is shifting the byte up the int, so the bits don't override one another
-	// e.g. uint8(byte(1)) << 4, would be 16, because the original byte (00000001) would be shifted by 4 bits (00010000)
-	//
-	// Use an unsigned integer so that we don't have a wasted bit on the integer
-	joinedBytes := uint(ipv4[0])<<24 + uint(ipv4[1])<<16 + uint(ipv4[2])<<8 + uint(ipv4[3])
-	// Add inc to the joined bytes
-	joinedBytes += uint(inc)
-	// Unshift the joined bytes integer back into the original 4 bytes of an IP address
-	//
-	// The >> operator is the reverse shifting direction to <<
-	// e.g. uint(byte(16)) >> 4 would be 1, because the original byte (00010000) would be shifted by 4 bits (00000001)
-	//
-	// The & operator is a bitwise AND operation, it's used to mask (keep) the last byte in the uint and ignore the
-	// rest. 0xFF is all 0's except the last 2 nibbles (8 bits, 1 byte)
-	// e.g. byte(17) & OxF would be 1, because the original byte (00010001) would be AND'd with (00001111), leaving
-	// (00000001)
-	//
-	// The final byte conversion just ensures we're only taking the last 8 bits of the shifted and AND'd uint
-	byte3 := byte(joinedBytes & 0xFF)
-	byte2 := byte((joinedBytes >> 8) & 0xFF)
-	byte1 := byte((joinedBytes >> 16) & 0xFF)
-	byte0 := byte((joinedBytes >> 24) & 0xFF)
-	// Convert the 4 bytes into an IP address
-	return net.IPv4(byte0, byte1, byte2, byte3)
+	return resources.SplitCIDR(fromCIDR, toCIDR)
 }
 
 // returns vpc id and cidr block for found vpc
@@ -33,6 +33,9 @@ const (
 	defaultReconcileTime = time.Second * 30
 
 	ResourceIdentifierAnnotation = "resourceIdentifier"
+
+	// defaultCrossAccountSessionName is the session name the role of a strategy is assumed with
+	defaultCrossAccountSessionName = "Red-Hat-cloud-resources-operator-cross-account"
 )
 
 // DefaultConfigMapNamespace is the default namespace that Configmaps will be created in
@@ -43,17 +46,29 @@ StrategyConfig provides the configuration necessary to create/modify/delete aws
 Region -> required to create aws sessions, if no region is provided we default to cluster infrastructure
 CreateStrategy -> maps to resource specific create parameters, uses as a source of truth to the state we expect the resource to be in
 DeleteStrategy -> maps to resource specific delete parameters
+RoleArn -> optional role assumed to create the resources, allows resources to be created in an account other than the cluster account
+ExternalID -> optional external id required by the trust policy of the role
 */
 type StrategyConfig struct {
 	Region         string          `json:"region"`
 	CreateStrategy json.RawMessage `json:"createStrategy"`
 	DeleteStrategy json.RawMessage `json:"deleteStrategy"`
 	ServiceUpdates json.RawMessage `json:"serviceUpdates"`
+	RoleArn        string          `json:"roleArn,omitempty"`
+	ExternalID     string          `json:"externalId,omitempty"`
+}
+
+// IsCrossAccount returns true when the resources of the strategy are created with an assumed role, in an account which
+// may not be the cluster account
+func (s *StrategyConfig) IsCrossAccount() bool {
+	return s != nil && s.RoleArn != ""
 }
 
 //go:generate moq -out config_moq.go . ConfigManager
 type ConfigManager interface {
 	ReadStorageStrategy(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error)
+	ReadStorageStrategies(ctx context.Context, rt providers.ResourceType) (map[string]*StrategyConfig, error)
+	WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error
 }
 
 var _ ConfigManager = (*ConfigMapConfigManager)(nil)
@@ -90,7 +105,37 @@ func (m *ConfigMapConfigManager) ReadStorageStrategy(ctx context.Context, rt pro
 	return stratCfg, nil
 }
 
+// ReadStorageStrategies returns the strategies of every tier of a resource type, keyed by tier
+func (m *ConfigMapConfigManager) ReadStorageStrategies(ctx context.Context, rt providers.ResourceType) (map[string]*StrategyConfig, error) {
+	strategyMapping, err := m.getStrategiesForProvider(ctx, string(rt))
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get tier to strategy mapping for resource type %s", string(rt))
+	}
+	return strategyMapping, nil
+}
+
+// WriteCreateStrategy persists the create strategy for the tier of a resource type, so a value chosen by the operator
+// is used on every reconcile
+func (m *ConfigMapConfigManager) WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+	name := types.NamespacedName{Name: m.configMapName, Namespace: m.configMapNamespace}
+	if err := resources.UpdateTierStrategy(ctx, m.client, name, BuildDefaultConfigMap(name.Name, name.Namespace), string(rt), tier, "createStrategy", createStrategy); err != nil {
+		return errorUtil.Wrapf(err, "failed to write create strategy for resource type %s", string(rt))
+	}
+	return nil
+}
+
 func (m *ConfigMapConfigManager) getTierStrategyForProvider(ctx context.Context, rt string, tier string) (*StrategyConfig, error) {
+	strategyMapping, err := m.getStrategiesForProvider(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	if strategyMapping[tier] == nil {
+		return nil, errorUtil.New(fmt.Sprintf("no strategy found for deployment type %s and deployment tier %s", rt, tier))
+	}
+	return strategyMapping[tier], nil
+}
+
+func (m *ConfigMapConfigManager) getStrategiesForProvider(ctx context.Context, rt string) (map[string]*StrategyConfig, error) {
 	cm, err := resources.GetConfigMapOrDefault(ctx, m.client, types.NamespacedName{Name: m.configMapName, Namespace: m.configMapNamespace}, BuildDefaultConfigMap(m.configMapName, m.configMapNamespace))
 	if err != nil {
 		return nil, errorUtil.Wrapf(err, "failed to get aws strategy config map %s in namespace %s", m.configMapName, m.configMapNamespace)
@@ -103,10 +148,7 @@ func (m *ConfigMapConfigManager) getTierStrategyForProvider(ctx context.Context,
 	if err = json.Unmarshal([]byte(rawStrategyMapping), &strategyMapping); err != nil {
 		return nil, errorUtil.Wrapf(err, "failed to unmarshal strategy mapping for resource type %s", rt)
 	}
-	if strategyMapping[tier] == nil {
-		return nil, errorUtil.New(fmt.Sprintf("no strategy found for deployment type %s and deployment tier %s", rt, tier))
-	}
-	return strategyMapping[tier], nil
+	return strategyMapping, nil
 }
 
 func BuildDefaultConfigMap(name, namespace string) *v1.ConfigMap {
@@ -139,18 +181,38 @@ func CreateSessionFromStrategy(ctx context.Context, c client.Client, credentials
 		// Local IAM user must be a principle in the role created with the sts:AssumeRole action
 		// Otherwise assume running in a pod in STS cluster
 		if k8sutil.IsRunModeLocal() {
-			sess := session.Must(session.NewSession(&awsConfig))
+			sess := instrumentSession(session.Must(session.NewSession(&awsConfig)))
 			awsConfig.Credentials = stscreds.NewCredentials(sess, credentials.RoleArn)
 		} else {
-			svc := sts.New(session.Must(session.NewSession(&awsConfig)))
+			svc := sts.New(instrumentSession(session.Must(session.NewSession(&awsConfig))))
 			credentialsProvider := stscreds.NewWebIdentityRoleProviderWithOptions(svc, credentials.RoleArn, "Red-Hat-cloud-resources-operator", stscreds.FetchTokenPath(credentials.TokenFilePath))
 			awsConfig.Credentials = awsCredentials.NewCredentials(credentialsProvider)
 		}
 	} else {
 		awsConfig.Credentials = awsCredentials.NewStaticCredentials(credentials.AccessKeyID, credentials.SecretAccessKey, "")
 	}
-	sess := session.Must(session.NewSession(&awsConfig))
-	return sess, nil
+	sess := instrumentSession(session.Must(session.NewSession(&awsConfig)))
+	if !strategy.IsCrossAccount() {
+		return sess, nil
+	}
+	// the role of the strategy is assumed with the operator credentials, the trust policy of the role must allow the
+	// operator role or user as a principal
+	awsConfig.Credentials = stscreds.NewCredentials(sess, strategy.RoleArn, func(p *stscreds.AssumeRoleProvider) {
+		p.RoleSessionName = defaultCrossAccountSessionName
+		if strategy.ExternalID != "" {
+			p.ExternalID = aws.String(strategy.ExternalID)
+		}
+	})
+	return instrumentSession(session.Must(session.NewSession(&awsConfig))), nil
+}
+
+// CreateClusterSessionFromStrategy creates a session in the cluster account, the role of the strategy is not assumed.
+// it is used for the cluster vpc when the resources of the strategy are created in another account
+func CreateClusterSessionFromStrategy(ctx context.Context, c client.Client, credentials *Credentials, strategy *StrategyConfig) (*session.Session, error) {
+	clusterStrategy := *strategy
+	clusterStrategy.RoleArn = ""
+	clusterStrategy.ExternalID = ""
+	return CreateSessionFromStrategy(ctx, c, credentials, &clusterStrategy)
 }
 
 func GetRegionFromStrategyOrDefault(ctx context.Context, c client.Client, strategy *StrategyConfig) (string, error) {
@@ -5,6 +5,7 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"sync"
 )
@@ -19,9 +20,15 @@ var _ ConfigManager = &ConfigManagerMock{}
 //
 //		// make and configure a mocked ConfigManager
 //		mockedConfigManager := &ConfigManagerMock{
+//			ReadStorageStrategiesFunc: func(ctx context.Context, rt providers.ResourceType) (map[string]*StrategyConfig, error) {
+//				panic("mock out the ReadStorageStrategies method")
+//			},
 //			ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
 //				panic("mock out the ReadStorageStrategy method")
 //			},
+//			WriteCreateStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+//				panic("mock out the WriteCreateStrategy method")
+//			},
 //		}
 //
 //		// use mockedConfigManager in code that requires ConfigManager
@@ -29,11 +36,24 @@ var _ ConfigManager = &ConfigManagerMock{}
 //
 //	}
 type ConfigManagerMock struct {
+	// ReadStorageStrategiesFunc mocks the ReadStorageStrategies method.
+	ReadStorageStrategiesFunc func(ctx context.Context, rt providers.ResourceType) (map[string]*StrategyConfig, error)
+
 	// ReadStorageStrategyFunc mocks the ReadStorageStrategy method.
 	ReadStorageStrategyFunc func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error)
 
+	// WriteCreateStrategyFunc mocks the WriteCreateStrategy method.
+	WriteCreateStrategyFunc func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// ReadStorageStrategies holds details about calls to the ReadStorageStrategies method.
+		ReadStorageStrategies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rt is the rt argument value.
+			Rt providers.ResourceType
+		}
 		// ReadStorageStrategy holds details about calls to the ReadStorageStrategy method.
 		ReadStorageStrategy []struct {
 			// Ctx is the ctx argument value.
@@ -43,8 +63,57 @@ type ConfigManagerMock struct {
 			// Tier is the tier argument value.
 			Tier string
 		}
+		// WriteCreateStrategy holds details about calls to the WriteCreateStrategy method.
+		WriteCreateStrategy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rt is the rt argument value.
+			Rt providers.ResourceType
+			// Tier is the tier argument value.
+			Tier string
+			// CreateStrategy is the createStrategy argument value.
+			CreateStrategy json.RawMessage
+		}
+	}
+	lockReadStorageStrategies sync.RWMutex
+	lockReadStorageStrategy   sync.RWMutex
+	lockWriteCreateStrategy   sync.RWMutex
+}
+
+// ReadStorageStrategies calls ReadStorageStrategiesFunc.
+func (mock *ConfigManagerMock) ReadStorageStrategies(ctx context.Context, rt providers.ResourceType) (map[string]*StrategyConfig, error) {
+	if mock.ReadStorageStrategiesFunc == nil {
+		panic("ConfigManagerMock.ReadStorageStrategiesFunc: method is nil but ConfigManager.ReadStorageStrategies was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Rt  providers.ResourceType
+	}{
+		Ctx: ctx,
+		Rt:  rt,
 	}
-	lockReadStorageStrategy sync.RWMutex
+	mock.lockReadStorageStrategies.Lock()
+	mock.calls.ReadStorageStrategies = append(mock.calls.ReadStorageStrategies, callInfo)
+	mock.lockReadStorageStrategies.Unlock()
+	return mock.ReadStorageStrategiesFunc(ctx, rt)
+}
+
+// ReadStorageStrategiesCalls gets all the calls that were made to ReadStorageStrategies.
+// Check the length with:
+//
+//	len(mockedConfigManager.ReadStorageStrategiesCalls())
+func (mock *ConfigManagerMock) ReadStorageStrategiesCalls() []struct {
+	Ctx context.Context
+	Rt  providers.ResourceType
+} {
+	var calls []struct {
+		Ctx context.Context
+		Rt  providers.ResourceType
+	}
+	mock.lockReadStorageStrategies.RLock()
+	calls = mock.calls.ReadStorageStrategies
+	mock.lockReadStorageStrategies.RUnlock()
+	return calls
 }
 
 // ReadStorageStrategy calls ReadStorageStrategyFunc.
@@ -86,3 +155,47 @@ func (mock *ConfigManagerMock) ReadStorageStrategyCalls() []struct {
 	mock.lockReadStorageStrategy.RUnlock()
 	return calls
 }
+
+// WriteCreateStrategy calls WriteCreateStrategyFunc.
+func (mock *ConfigManagerMock) WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+	if mock.WriteCreateStrategyFunc == nil {
+		panic("ConfigManagerMock.WriteCreateStrategyFunc: method is nil but ConfigManager.WriteCreateStrategy was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		Rt             providers.ResourceType
+		Tier           string
+		CreateStrategy json.RawMessage
+	}{
+		Ctx:            ctx,
+		Rt:             rt,
+		Tier:           tier,
+		CreateStrategy: createStrategy,
+	}
+	mock.lockWriteCreateStrategy.Lock()
+	mock.calls.WriteCreateStrategy = append(mock.calls.WriteCreateStrategy, callInfo)
+	mock.lockWriteCreateStrategy.Unlock()
+	return mock.WriteCreateStrategyFunc(ctx, rt, tier, createStrategy)
+}
+
+// WriteCreateStrategyCalls gets all the calls that were made to WriteCreateStrategy.
+// Check the length with:
+//
+//	len(mockedConfigManager.WriteCreateStrategyCalls())
+func (mock *ConfigManagerMock) WriteCreateStrategyCalls() []struct {
+	Ctx            context.Context
+	Rt             providers.ResourceType
+	Tier           string
+	CreateStrategy json.RawMessage
+} {
+	var calls []struct {
+		Ctx            context.Context
+		Rt             providers.ResourceType
+		Tier           string
+		CreateStrategy json.RawMessage
+	}
+	mock.lockWriteCreateStrategy.RLock()
+	calls = mock.calls.WriteCreateStrategy
+	mock.lockWriteCreateStrategy.RUnlock()
+	return calls
+}
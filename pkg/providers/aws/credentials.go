@@ -2,10 +2,17 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -31,82 +38,292 @@ const (
 )
 
 var (
-	operatorEntries = []v1.StatementEntry{
+	// describeEntries are the read only actions every provider credential requires, they do not support resource level
+	// permissions
+	describeEntries = []string{
+		"ec2:DescribeVpcs",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeSecurityGroups",
+		"ec2:DescribeInstanceTypes",
+		"ec2:DescribeInstanceTypeOfferings",
+		"ec2:DescribeAvailabilityZones",
+		"ec2:DescribeRouteTables",
+		"ec2:DescribeVpcPeeringConnections",
+		"ec2:DescribeTransitGatewayVpcAttachments",
+		"ec2:DescribeVpcEndpoints",
+		"ec2:DescribeVpcEndpointServiceConfigurations",
+		"elasticloadbalancing:DescribeLoadBalancers",
+		"elasticloadbalancing:DescribeTargetGroups",
+		"elasticloadbalancing:DescribeTargetHealth",
+		"elasticloadbalancing:DescribeListeners",
+		"rds:DescribeDBInstances",
+		"rds:DescribeDBSubnetGroups",
+		"rds:ListTagsForResource",
+		"rds:DescribeAccountAttributes",
+		"rds:DescribeOrderableDBInstanceOptions",
+		"elasticache:DescribeCacheSubnetGroups",
+		"elasticache:DescribeReplicationGroups",
+		"elasticache:DescribeCacheClusters",
+		"elasticache:DescribeCacheEngineVersions",
+		"cloudwatch:ListMetrics",
+		"cloudwatch:GetMetricData",
+		"iam:SimulatePrincipalPolicy",
+	}
+	// assumeRoleEntries assume the roles the strategies of a resource type create their resources with in another account,
+	// they are only allowed on those roles
+	assumeRoleEntries = []string{
+		"sts:AssumeRole",
+	}
+	// networkCreateEntries create the standalone network postgres and redis instances are created in, new resources
+	// have no tags to restrict on, so the request has to tag them with the cluster id
+	networkCreateEntries = []string{
+		"ec2:CreateVpc",
+		"ec2:CreateSubnet",
+		"ec2:CreateSecurityGroup",
+		"ec2:CreateVpcPeeringConnection",
+		"ec2:CreateTransitGatewayVpcAttachment",
+		"ec2:CreateVpcEndpoint",
+		"ec2:CreateVpcEndpointServiceConfiguration",
+		"elasticloadbalancing:CreateLoadBalancer",
+		"elasticloadbalancing:CreateTargetGroup",
+		"elasticloadbalancing:CreateListener",
+		"rds:CreateDBSubnetGroup",
+		"elasticache:CreateCacheSubnetGroup",
+	}
+	// tagEntries tag new resources in the request creating them, and existing resources which are adopted or were created
+	// without tags by previous versions
+	tagEntries = []string{
+		"ec2:CreateTags",
+		"elasticloadbalancing:AddTags",
+		"rds:AddTagsToResource",
+		"elasticache:AddTagsToResource",
+	}
+	// networkModifyEntries change or remove the standalone network and the routes of the standalone vpc
+	networkModifyEntries = []string{
+		"ec2:DeleteVpc",
+		"ec2:DeleteSubnet",
+		"ec2:DeleteSecurityGroup",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupIngress",
+		"ec2:AcceptVpcPeeringConnection",
+		"ec2:DeleteVpcPeeringConnection",
+		"ec2:CreateRoute",
+		"ec2:DeleteRoute",
+		"ec2:DeleteTransitGatewayVpcAttachment",
+		"ec2:DeleteVpcEndpoints",
+		"ec2:DeleteVpcEndpointServiceConfigurations",
+		"elasticloadbalancing:DeleteLoadBalancer",
+		"elasticloadbalancing:DeleteTargetGroup",
+		"elasticloadbalancing:RegisterTargets",
+		"elasticloadbalancing:DeregisterTargets",
+		"rds:RemoveTagsFromResource",
+		"rds:ModifyDBSubnetGroup",
+		"rds:DeleteDBSubnetGroup",
+		"elasticache:ModifyCacheSubnetGroup",
+		"elasticache:DeleteCacheSubnetGroup",
+	}
+	// clusterNetworkEntries connect the standalone vpc to the cluster vpc, the route tables and vpc of the cluster are
+	// tagged as owned by the cluster instead of with the cluster id
+	clusterNetworkEntries = []string{
+		"ec2:AcceptVpcPeeringConnection",
+		"ec2:CreateRoute",
+		"ec2:DeleteRoute",
+	}
+	postgresDescribeEntries = []string{
+		"rds:DescribeDBSnapshots",
+		"rds:DescribePendingMaintenanceActions",
+		"iam:CreateServiceLinkedRole",
+	}
+	postgresCreateEntries = []string{
+		"rds:CreateDBInstance",
+		"rds:CreateDBSnapshot",
+	}
+	postgresModifyEntries = []string{
+		"rds:ModifyDBInstance",
+		"rds:DeleteDBInstance",
+		"rds:RemoveTagsFromResource",
+		"rds:CreateDBSnapshot",
+		"rds:DeleteDBSnapshot",
+		"rds:ApplyPendingMaintenanceAction",
+	}
+	redisDescribeEntries = []string{
+		"elasticache:DescribeUpdateActions",
+		"elasticache:DescribeSnapshots",
+		"elasticache:ListTagsForResource",
+		"iam:CreateServiceLinkedRole",
+	}
+	redisCreateEntries = []string{
+		"elasticache:CreateReplicationGroup",
+		"elasticache:CreateSnapshot",
+	}
+	redisModifyEntries = []string{
+		"elasticache:ModifyReplicationGroup",
+		"elasticache:DeleteReplicationGroup",
+		"elasticache:BatchApplyUpdateAction",
+		"elasticache:CreateSnapshot",
+		"elasticache:DeleteSnapshot",
+	}
+	// blobStorageDescribeEntries do not support resource level permissions
+	blobStorageDescribeEntries = []string{
+		"s3:ListAllMyBuckets",
+		"cloudwatch:ListMetrics",
+		"cloudwatch:GetMetricData",
+	}
+	// blobStorageBucketEntries s3 buckets do not support conditions on their tags, they are restricted to the buckets of
+	// the blob storage crs
+	blobStorageBucketEntries = []string{
+		"s3:CreateBucket",
+		"s3:DeleteBucket",
+		"s3:ListBucket",
+		"s3:GetBucketTagging",
+		"s3:PutBucketTagging",
+		"s3:GetBucketPublicAccessBlock",
+		"s3:PutBucketPublicAccessBlock",
+		"s3:GetEncryptionConfiguration",
+		"s3:PutEncryptionConfiguration",
+		"s3:GetMetricsConfiguration",
+		"s3:PutMetricsConfiguration",
+	}
+	blobStorageObjectEntries = []string{
+		"s3:GetObject",
+		"s3:DeleteObject",
+	}
+	timeOut = time.Minute * 5
+)
+
+// buildResourceEntries returns the statements of the credential of a resource type. resources can only be created when
+// the request tags them with the id of this cluster, and existing resources can only be changed or removed when they are
+// tagged with it, so resources of other clusters in the same account can not be changed. untagged resources can only be
+// tagged with the id of this cluster before they are changed. roles can only be assumed when they are set in a strategy
+// of the resource type, and s3 actions are only allowed on the buckets of the blob storage crs
+func buildResourceEntries(rt providers.ResourceType, clusterID string, roleArns, buckets []string) []v1.StatementEntry {
+	if rt == providers.BlobStorageResourceType {
+		entries := []v1.StatementEntry{
+			{
+				Effect:   "Allow",
+				Action:   blobStorageDescribeEntries,
+				Resource: "*",
+			},
+		}
+		for _, bucket := range buckets {
+			entries = append(entries, v1.StatementEntry{
+				Effect:   "Allow",
+				Action:   blobStorageBucketEntries,
+				Resource: fmt.Sprintf("arn:aws:s3:::%s", bucket),
+			}, v1.StatementEntry{
+				Effect:   "Allow",
+				Action:   blobStorageObjectEntries,
+				Resource: fmt.Sprintf("arn:aws:s3:::%s/*", bucket),
+			})
+		}
+		return append(entries, buildAssumeRoleEntries(roleArns)...)
+	}
+
+	describe := append([]string{}, describeEntries...)
+	createEntries := append([]string{}, networkCreateEntries...)
+	modifyEntries := append(append([]string{}, networkModifyEntries...), tagEntries...)
+	switch rt {
+	case providers.PostgresResourceType:
+		describe = append(describe, postgresDescribeEntries...)
+		createEntries = append(createEntries, postgresCreateEntries...)
+		modifyEntries = append(modifyEntries, postgresModifyEntries...)
+	case providers.RedisResourceType:
+		describe = append(describe, redisDescribeEntries...)
+		createEntries = append(createEntries, redisCreateEntries...)
+		modifyEntries = append(modifyEntries, redisModifyEntries...)
+	}
+	clusterIDTagKey := fmt.Sprintf("%sclusterID", resources.GetOrganizationTag())
+	entries := []v1.StatementEntry{
 		{
-			Effect: "Allow",
-			Action: []string{
-				"s3:CreateBucket",
-				"s3:DeleteBucket",
-				"s3:ListBucket",
-				"s3:ListAllMyBuckets",
-				"s3:GetObject",
-				"s3:DeleteObject",
-				"s3:PutBucketTagging",
-				"s3:PutBucketPublicAccessBlock",
-				"s3:PutEncryptionConfiguration",
-				"ec2:DescribeVpcs",
-				"ec2:DescribeSubnets",
-				"ec2:DescribeSecurityGroups",
-				"ec2:DescribeInstanceTypes",
-				"ec2:CreateSecurityGroup",
-				"ec2:DeleteSecurityGroup",
-				"ec2:AuthorizeSecurityGroupIngress",
-				"ec2:AuthorizeSecurityGroupEgress",
-				"ec2:DescribeAvailabilityZones",
-				"ec2:CreateSubnet",
-				"ec2:CreateTags",
-				"ec2:CreateVpc",
-				"ec2:DeleteVpc",
-				"ec2:DeleteSubnet",
-				"ec2:CreateVpcPeeringConnection",
-				"ec2:DescribeVpcPeeringConnections",
-				"ec2:AcceptVpcPeeringConnection",
-				"ec2:DeleteVpcPeeringConnection",
-				"ec2:DescribeRouteTables",
-				"ec2:CreateRoute",
-				"ec2:DeleteRoute",
-				"ec2:DescribeInstanceTypeOfferings",
-				"elasticache:CreateReplicationGroup",
-				"elasticache:DeleteReplicationGroup",
-				"elasticache:DescribeReplicationGroups",
-				"elasticache:DescribeUpdateActions",
-				"elasticache:BatchApplyUpdateAction",
-				"elasticache:AddTagsToResource",
-				"elasticache:DescribeSnapshots",
-				"elasticache:CreateSnapshot",
-				"elasticache:DeleteSnapshot",
-				"elasticache:DescribeCacheClusters",
-				"elasticache:DescribeCacheSubnetGroups",
-				"elasticache:CreateCacheSubnetGroup",
-				"elasticache:ModifyCacheSubnetGroup",
-				"elasticache:DeleteCacheSubnetGroup",
-				"elasticache:ModifyReplicationGroup",
-				"rds:DescribeDBInstances",
-				"rds:CreateDBInstance",
-				"rds:DeleteDBInstance",
-				"rds:ModifyDBInstance",
-				"rds:AddTagsToResource",
-				"rds:DescribeDBSnapshots",
-				"rds:CreateDBSnapshot",
-				"rds:DeleteDBSnapshot",
-				"rds:DescribePendingMaintenanceActions",
-				"rds:CreateDBSubnetGroup",
-				"rds:DescribeDBSubnetGroups",
-				"rds:DeleteDBSubnetGroup",
-				"rds:ModifyDBSubnetGroup",
-				"rds:ListTagsForResource",
-				"rds:RemoveTagsFromResource",
-				"rds:ApplyPendingMaintenanceAction",
-				//"sts:GetCallerIdentity",
-				"iam:CreateServiceLinkedRole",
-				"cloudwatch:ListMetrics",
-				"cloudwatch:GetMetricData",
+			Effect:   "Allow",
+			Action:   uniqueActions(describe),
+			Resource: "*",
+		},
+		{
+			Effect:   "Allow",
+			Action:   uniqueActions(createEntries),
+			Resource: "*",
+			PolicyCondition: v1.IAMPolicyCondition{
+				"StringEquals": v1.IAMPolicyConditionKeyValue{
+					fmt.Sprintf("aws:RequestTag/%s", clusterIDTagKey): clusterID,
+				},
 			},
+		},
+		{
+			Effect:   "Allow",
+			Action:   tagEntries,
+			Resource: "*",
+			PolicyCondition: v1.IAMPolicyCondition{
+				"StringEquals": v1.IAMPolicyConditionKeyValue{
+					fmt.Sprintf("aws:RequestTag/%s", clusterIDTagKey): clusterID,
+				},
+				"StringEqualsIfExists": v1.IAMPolicyConditionKeyValue{
+					fmt.Sprintf("aws:ResourceTag/%s", clusterIDTagKey): clusterID,
+				},
+			},
+		},
+		{
+			Effect:   "Allow",
+			Action:   uniqueActions(modifyEntries),
 			Resource: "*",
+			PolicyCondition: v1.IAMPolicyCondition{
+				"StringEquals": v1.IAMPolicyConditionKeyValue{
+					fmt.Sprintf("aws:ResourceTag/%s", clusterIDTagKey): clusterID,
+				},
+			},
+		},
+		{
+			Effect:   "Allow",
+			Action:   clusterNetworkEntries,
+			Resource: "*",
+			PolicyCondition: v1.IAMPolicyCondition{
+				"StringEquals": v1.IAMPolicyConditionKeyValue{
+					fmt.Sprintf("aws:ResourceTag/%s", getOSDClusterTagKey(clusterID)): clusterOwnedTagValue,
+				},
+			},
 		},
 	}
-	timeOut = time.Minute * 5
-)
+	return append(entries, buildAssumeRoleEntries(roleArns)...)
+}
+
+// buildAssumeRoleEntries returns a statement for each role set in the strategies of a resource type
+func buildAssumeRoleEntries(roleArns []string) []v1.StatementEntry {
+	var entries []v1.StatementEntry
+	for _, roleArn := range roleArns {
+		entries = append(entries, v1.StatementEntry{
+			Effect:   "Allow",
+			Action:   assumeRoleEntries,
+			Resource: roleArn,
+		})
+	}
+	return entries
+}
+
+// buildResourceCredentialName returns the name of the credential request and secret of a resource type
+func buildResourceCredentialName(prefix string, rt providers.ResourceType) string {
+	return fmt.Sprintf("%s-%s", prefix, strings.TrimPrefix(string(rt), "_"))
+}
+
+// uniqueSorted returns the values without duplicates in a stable order, so the statements of a credential request only
+// change when the values do
+func uniqueSorted(values []string) []string {
+	unique := uniqueActions(values)
+	sort.Strings(unique)
+	return unique
+}
+
+func uniqueActions(actions []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, action := range actions {
+		if !seen[action] {
+			seen[action] = true
+			unique = append(unique, action)
+		}
+	}
+	return unique
+}
 
 func buildPutBucketObjectEntries(bucket string) []v1.StatementEntry {
 	return []v1.StatementEntry{
@@ -138,8 +355,8 @@ type Credentials struct {
 
 //go:generate moq -out credentials_moq.go . CredentialManager
 type CredentialManager interface {
-	ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error)
-	ReconcileBucketOwnerCredentials(ctx context.Context, name, ns, bucket string) (*Credentials, error)
+	ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error)
+	ReconcileBucketOwnerCredentials(ctx context.Context, name, ns, bucket string, serviceAccounts []string) (*Credentials, error)
 }
 
 func NewCredentialManager(client client.Client) (CredentialManager, error) {
@@ -168,25 +385,87 @@ var _ CredentialManager = (*CredentialMinterCredentialManager)(nil)
 type CredentialMinterCredentialManager struct {
 	ProviderCredentialName string
 	Client                 client.Client
+	ConfigManager          ConfigManager
 }
 
 func NewCredentialMinterCredentialManager(client client.Client) *CredentialMinterCredentialManager {
 	return &CredentialMinterCredentialManager{
 		ProviderCredentialName: defaultProviderCredentialName,
 		Client:                 client,
+		ConfigManager:          NewDefaultConfigMapConfigManager(client),
 	}
 }
 
-// ReconcileProviderCredentials Ensure the credentials the AWS provider requires are available
-func (m *CredentialMinterCredentialManager) ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error) {
-	creds, err := m.reconcileCredentials(ctx, m.ProviderCredentialName, ns, operatorEntries)
+// ReconcileProviderCredentials Ensure the credentials the AWS provider requires for a resource type are available, the
+// credential request granting every resource type the same actions is removed
+func (m *CredentialMinterCredentialManager) ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
+	clusterID, err := resources.GetClusterID(ctx, m.Client)
 	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster id")
+	}
+	strategies, err := m.ConfigManager.ReadStorageStrategies(ctx, rt)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to read aws strategy config")
+	}
+	var buckets []string
+	if rt == providers.BlobStorageResourceType {
+		if buckets, err = m.getBucketNames(ctx, ns, strategies); err != nil {
+			return nil, err
+		}
+	}
+	creds, err := m.reconcileCredentials(ctx, buildResourceCredentialName(m.ProviderCredentialName, rt), ns, buildResourceEntries(rt, clusterID, getStrategyRoleArns(strategies), buckets))
+	if err != nil {
+		return nil, err
+	}
+	if err := m.deleteCredentialRequest(ctx, m.ProviderCredentialName, ns); err != nil {
 		return nil, err
 	}
 	return creds, nil
 }
 
-func (m *CredentialMinterCredentialManager) ReconcileBucketOwnerCredentials(ctx context.Context, name, ns, bucket string) (*Credentials, error) {
+// getBucketNames returns the names of the buckets of the blob storage crs in a namespace, the name set in the strategy
+// of the tier of a cr, the external resource id of an adopted bucket or the name built from the cluster id
+func (m *CredentialMinterCredentialManager) getBucketNames(ctx context.Context, ns string, strategies map[string]*StrategyConfig) ([]string, error) {
+	blobStorages := &v1alpha1.BlobStorageList{}
+	if err := m.Client.List(ctx, blobStorages, client.InNamespace(ns)); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to list blob storage crs in namespace %s", ns)
+	}
+	var buckets []string
+	for i := range blobStorages.Items {
+		bs := &blobStorages.Items[i]
+		strategy := strategies[bs.Spec.Tier]
+		if strategy == nil {
+			continue
+		}
+		bucketCreateCfg := &s3.CreateBucketInput{}
+		if len(strategy.CreateStrategy) > 0 {
+			if err := json.Unmarshal(strategy.CreateStrategy, bucketCreateCfg); err != nil {
+				return nil, errorUtil.Wrapf(err, "failed to unmarshal aws s3 create strat configuration of tier %s", bs.Spec.Tier)
+			}
+		}
+		bucket, err := buildS3BucketName(ctx, m.Client, bs, bucketCreateCfg)
+		if err != nil {
+			return nil, errorUtil.Wrapf(err, "failed to build s3 bucket name of blob storage cr %s", bs.Name)
+		}
+		buckets = append(buckets, bucket)
+	}
+	return uniqueSorted(buckets), nil
+}
+
+// getStrategyRoleArns returns the roles set in the strategies of a resource type
+func getStrategyRoleArns(strategies map[string]*StrategyConfig) []string {
+	var roleArns []string
+	for _, strategy := range strategies {
+		if strategy.IsCrossAccount() {
+			roleArns = append(roleArns, strategy.RoleArn)
+		}
+	}
+	return uniqueSorted(roleArns)
+}
+
+// ReconcileBucketOwnerCredentials ensures an iam user with access to the bucket only is available, service accounts are
+// only used by the sts credential manager
+func (m *CredentialMinterCredentialManager) ReconcileBucketOwnerCredentials(ctx context.Context, name, ns, bucket string, _ []string) (*Credentials, error) {
 	creds, err := m.reconcileCredentials(ctx, name, ns, buildPutBucketObjectEntries(bucket))
 	if err != nil {
 		return nil, err
@@ -258,6 +537,19 @@ func (m *CredentialMinterCredentialManager) reconcileCredentialRequest(ctx conte
 	return cr, nil
 }
 
+func (m *CredentialMinterCredentialManager) deleteCredentialRequest(ctx context.Context, name string, ns string) error {
+	cr := &v1.CredentialsRequest{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+	}
+	if err := m.Client.Delete(ctx, cr); err != nil && !errors.IsNotFound(err) {
+		return errorUtil.Wrapf(err, "failed to delete credential request %s in namespace %s", name, ns)
+	}
+	return nil
+}
+
 func (m *CredentialMinterCredentialManager) reconcileAWSCredentials(ctx context.Context, cr *v1.CredentialsRequest) (string, string, error) {
 	sec := &v12.Secret{}
 	err := m.Client.Get(ctx, types.NamespacedName{Name: cr.Spec.SecretRef.Name, Namespace: cr.Spec.SecretRef.Namespace}, sec)
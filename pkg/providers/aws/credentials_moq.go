@@ -5,6 +5,7 @@ package aws
 
 import (
 	"context"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"sync"
 )
 
@@ -18,10 +19,10 @@ var _ CredentialManager = &CredentialManagerMock{}
 //
 //		// make and configure a mocked CredentialManager
 //		mockedCredentialManager := &CredentialManagerMock{
-//			ReconcileBucketOwnerCredentialsFunc: func(ctx context.Context, name string, ns string, bucket string) (*Credentials, error) {
+//			ReconcileBucketOwnerCredentialsFunc: func(ctx context.Context, name string, ns string, bucket string, serviceAccounts []string) (*Credentials, error) {
 //				panic("mock out the ReconcileBucketOwnerCredentials method")
 //			},
-//			ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+//			ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 //				panic("mock out the ReconcileProviderCredentials method")
 //			},
 //		}
@@ -32,10 +33,10 @@ var _ CredentialManager = &CredentialManagerMock{}
 //	}
 type CredentialManagerMock struct {
 	// ReconcileBucketOwnerCredentialsFunc mocks the ReconcileBucketOwnerCredentials method.
-	ReconcileBucketOwnerCredentialsFunc func(ctx context.Context, name string, ns string, bucket string) (*Credentials, error)
+	ReconcileBucketOwnerCredentialsFunc func(ctx context.Context, name string, ns string, bucket string, serviceAccounts []string) (*Credentials, error)
 
 	// ReconcileProviderCredentialsFunc mocks the ReconcileProviderCredentials method.
-	ReconcileProviderCredentialsFunc func(ctx context.Context, ns string) (*Credentials, error)
+	ReconcileProviderCredentialsFunc func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -49,6 +50,8 @@ type CredentialManagerMock struct {
 			Ns string
 			// Bucket is the bucket argument value.
 			Bucket string
+			// ServiceAccounts is the serviceAccounts argument value.
+			ServiceAccounts []string
 		}
 		// ReconcileProviderCredentials holds details about calls to the ReconcileProviderCredentials method.
 		ReconcileProviderCredentials []struct {
@@ -56,6 +59,8 @@ type CredentialManagerMock struct {
 			Ctx context.Context
 			// Ns is the ns argument value.
 			Ns string
+			// Rt is the rt argument value.
+			Rt providers.ResourceType
 		}
 	}
 	lockReconcileBucketOwnerCredentials sync.RWMutex
@@ -63,25 +68,27 @@ type CredentialManagerMock struct {
 }
 
 // ReconcileBucketOwnerCredentials calls ReconcileBucketOwnerCredentialsFunc.
-func (mock *CredentialManagerMock) ReconcileBucketOwnerCredentials(ctx context.Context, name string, ns string, bucket string) (*Credentials, error) {
+func (mock *CredentialManagerMock) ReconcileBucketOwnerCredentials(ctx context.Context, name string, ns string, bucket string, serviceAccounts []string) (*Credentials, error) {
 	if mock.ReconcileBucketOwnerCredentialsFunc == nil {
 		panic("CredentialManagerMock.ReconcileBucketOwnerCredentialsFunc: method is nil but CredentialManager.ReconcileBucketOwnerCredentials was just called")
 	}
 	callInfo := struct {
-		Ctx    context.Context
-		Name   string
-		Ns     string
-		Bucket string
+		Ctx             context.Context
+		Name            string
+		Ns              string
+		Bucket          string
+		ServiceAccounts []string
 	}{
-		Ctx:    ctx,
-		Name:   name,
-		Ns:     ns,
-		Bucket: bucket,
+		Ctx:             ctx,
+		Name:            name,
+		Ns:              ns,
+		Bucket:          bucket,
+		ServiceAccounts: serviceAccounts,
 	}
 	mock.lockReconcileBucketOwnerCredentials.Lock()
 	mock.calls.ReconcileBucketOwnerCredentials = append(mock.calls.ReconcileBucketOwnerCredentials, callInfo)
 	mock.lockReconcileBucketOwnerCredentials.Unlock()
-	return mock.ReconcileBucketOwnerCredentialsFunc(ctx, name, ns, bucket)
+	return mock.ReconcileBucketOwnerCredentialsFunc(ctx, name, ns, bucket, serviceAccounts)
 }
 
 // ReconcileBucketOwnerCredentialsCalls gets all the calls that were made to ReconcileBucketOwnerCredentials.
@@ -89,16 +96,18 @@ func (mock *CredentialManagerMock) ReconcileBucketOwnerCredentials(ctx context.C
 //
 //	len(mockedCredentialManager.ReconcileBucketOwnerCredentialsCalls())
 func (mock *CredentialManagerMock) ReconcileBucketOwnerCredentialsCalls() []struct {
-	Ctx    context.Context
-	Name   string
-	Ns     string
-	Bucket string
+	Ctx             context.Context
+	Name            string
+	Ns              string
+	Bucket          string
+	ServiceAccounts []string
 } {
 	var calls []struct {
-		Ctx    context.Context
-		Name   string
-		Ns     string
-		Bucket string
+		Ctx             context.Context
+		Name            string
+		Ns              string
+		Bucket          string
+		ServiceAccounts []string
 	}
 	mock.lockReconcileBucketOwnerCredentials.RLock()
 	calls = mock.calls.ReconcileBucketOwnerCredentials
@@ -107,21 +116,23 @@ func (mock *CredentialManagerMock) ReconcileBucketOwnerCredentialsCalls() []stru
 }
 
 // ReconcileProviderCredentials calls ReconcileProviderCredentialsFunc.
-func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error) {
+func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 	if mock.ReconcileProviderCredentialsFunc == nil {
 		panic("CredentialManagerMock.ReconcileProviderCredentialsFunc: method is nil but CredentialManager.ReconcileProviderCredentials was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
 		Ns  string
+		Rt  providers.ResourceType
 	}{
 		Ctx: ctx,
 		Ns:  ns,
+		Rt:  rt,
 	}
 	mock.lockReconcileProviderCredentials.Lock()
 	mock.calls.ReconcileProviderCredentials = append(mock.calls.ReconcileProviderCredentials, callInfo)
 	mock.lockReconcileProviderCredentials.Unlock()
-	return mock.ReconcileProviderCredentialsFunc(ctx, ns)
+	return mock.ReconcileProviderCredentialsFunc(ctx, ns, rt)
 }
 
 // ReconcileProviderCredentialsCalls gets all the calls that were made to ReconcileProviderCredentials.
@@ -131,10 +142,12 @@ func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Cont
 func (mock *CredentialManagerMock) ReconcileProviderCredentialsCalls() []struct {
 	Ctx context.Context
 	Ns  string
+	Rt  providers.ResourceType
 } {
 	var calls []struct {
 		Ctx context.Context
 		Ns  string
+		Rt  providers.ResourceType
 	}
 	mock.lockReconcileProviderCredentials.RLock()
 	calls = mock.calls.ReconcileProviderCredentials
@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
+	errorUtil "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultBucketOwnerRolePolicyName  = "cro-s3-bucket-access"
+	defaultServiceAccountAudience     = "openshift"
+	defaultRoleNameMaxLength          = 64
+	defaultRoleNameHashLength         = 8
+	defaultAuthenticationResourceName = "cluster"
+)
+
+// policyDocument an iam policy document, the element names of iam policies are case-sensitive so the statement entries
+// of credential requests can not be used
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Effect    string                            `json:"Effect"`
+	Principal map[string]string                 `json:"Principal,omitempty"`
+	Action    []string                          `json:"Action"`
+	Resource  []string                          `json:"Resource,omitempty"`
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// reconcileBucketOwnerRole ensures the role of a bucket exists, it can be assumed with the web identity tokens of the
+// service accounts issued by the cluster oidc provider and only allows access to the bucket. the arn of the role is
+// returned
+func reconcileBucketOwnerRole(ctx context.Context, c client.Client, iamSvc iamiface.IAMAPI, stsSvc stsiface.STSAPI, name, ns, bucket, issuer string, serviceAccounts []string) (string, error) {
+	identity, err := stsSvc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errorUtil.Wrap(err, "failed to get account identity")
+	}
+	callerArn, err := arn.Parse(aws.StringValue(identity.Arn))
+	if err != nil {
+		return "", errorUtil.Wrapf(err, "failed to parse caller arn %s", aws.StringValue(identity.Arn))
+	}
+	trustPolicy := buildBucketOwnerTrustPolicy(callerArn.Partition, aws.StringValue(identity.Account), issuer, ns, serviceAccounts)
+	trustPolicyDoc, err := json.Marshal(trustPolicy)
+	if err != nil {
+		return "", errorUtil.Wrap(err, "failed to marshal bucket owner trust policy")
+	}
+	accessPolicyDoc, err := json.Marshal(buildBucketOwnerAccessPolicy(callerArn.Partition, bucket))
+	if err != nil {
+		return "", errorUtil.Wrap(err, "failed to marshal bucket owner access policy")
+	}
+
+	roleName := buildBucketOwnerRoleName(name)
+	role, err := getBucketOwnerRole(iamSvc, roleName)
+	if err != nil {
+		return "", err
+	}
+	if role == nil {
+		clusterID, err := resources.GetClusterID(ctx, c)
+		if err != nil {
+			return "", errorUtil.Wrap(err, "failed to get cluster id")
+		}
+		output, err := iamSvc.CreateRole(&iam.CreateRoleInput{
+			RoleName:                 aws.String(roleName),
+			AssumeRolePolicyDocument: aws.String(string(trustPolicyDoc)),
+			Description:              aws.String(fmt.Sprintf("access to s3 bucket %s", bucket)),
+			Tags: []*iam.Tag{
+				{
+					Key:   aws.String(fmt.Sprintf("%sclusterID", resources.GetOrganizationTag())),
+					Value: aws.String(clusterID),
+				},
+			},
+		})
+		if err != nil {
+			return "", errorUtil.Wrapf(err, "failed to create bucket owner role %s", roleName)
+		}
+		role = output.Role
+	} else {
+		current, err := url.QueryUnescape(aws.StringValue(role.AssumeRolePolicyDocument))
+		if err != nil {
+			return "", errorUtil.Wrapf(err, "failed to decode trust policy of role %s", roleName)
+		}
+		currentPolicy := &policyDocument{}
+		if err := json.Unmarshal([]byte(current), currentPolicy); err != nil || !reflect.DeepEqual(currentPolicy, trustPolicy) {
+			if _, err := iamSvc.UpdateAssumeRolePolicy(&iam.UpdateAssumeRolePolicyInput{
+				RoleName:       aws.String(roleName),
+				PolicyDocument: aws.String(string(trustPolicyDoc)),
+			}); err != nil {
+				return "", errorUtil.Wrapf(err, "failed to update trust policy of role %s", roleName)
+			}
+		}
+	}
+	if _, err := iamSvc.PutRolePolicy(&iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(defaultBucketOwnerRolePolicyName),
+		PolicyDocument: aws.String(string(accessPolicyDoc)),
+	}); err != nil {
+		return "", errorUtil.Wrapf(err, "failed to put access policy of role %s", roleName)
+	}
+	return aws.StringValue(role.Arn), nil
+}
+
+// deleteBucketOwnerRole removes the access policy and the role of a bucket, a missing role is ignored
+func deleteBucketOwnerRole(iamSvc iamiface.IAMAPI, name string) error {
+	roleName := buildBucketOwnerRoleName(name)
+	if _, err := iamSvc.DeleteRolePolicy(&iam.DeleteRolePolicyInput{
+		RoleName:   aws.String(roleName),
+		PolicyName: aws.String(defaultBucketOwnerRolePolicyName),
+	}); err != nil && !isAwsErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+		return errorUtil.Wrapf(err, "failed to delete access policy of role %s", roleName)
+	}
+	if _, err := iamSvc.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String(roleName)}); err != nil && !isAwsErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+		return errorUtil.Wrapf(err, "failed to delete bucket owner role %s", roleName)
+	}
+	return nil
+}
+
+func getBucketOwnerRole(iamSvc iamiface.IAMAPI, roleName string) (*iam.Role, error) {
+	output, err := iamSvc.GetRole(&iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		if isAwsErrorCode(err, iam.ErrCodeNoSuchEntityException) {
+			return nil, nil
+		}
+		return nil, errorUtil.Wrapf(err, "failed to get bucket owner role %s", roleName)
+	}
+	return output.Role, nil
+}
+
+// buildBucketOwnerTrustPolicy allows the service accounts to assume the role with web identity tokens issued by the
+// cluster oidc provider, every service account in the namespace is allowed when none are defined
+func buildBucketOwnerTrustPolicy(partition, account, issuer, ns string, serviceAccounts []string) *policyDocument {
+	provider := strings.TrimSuffix(strings.TrimPrefix(issuer, "https://"), "/")
+	var subjects []string
+	for _, sa := range serviceAccounts {
+		saNs, saName := ns, sa
+		if parts := strings.SplitN(sa, "/", 2); len(parts) == 2 {
+			saNs, saName = parts[0], parts[1]
+		}
+		subjects = append(subjects, fmt.Sprintf("system:serviceaccount:%s:%s", saNs, saName))
+	}
+	if len(subjects) == 0 {
+		subjects = []string{fmt.Sprintf("system:serviceaccount:%s:*", ns)}
+	}
+	return &policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				Effect: "Allow",
+				Principal: map[string]string{
+					"Federated": fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", partition, account, provider),
+				},
+				Action: []string{"sts:AssumeRoleWithWebIdentity"},
+				Condition: map[string]map[string]interface{}{
+					"StringEquals": {fmt.Sprintf("%s:aud", provider): []interface{}{defaultServiceAccountAudience}},
+					"StringLike":   {fmt.Sprintf("%s:sub", provider): toInterfaceSlice(subjects)},
+				},
+			},
+		},
+	}
+}
+
+// buildBucketOwnerAccessPolicy allows every s3 action on the bucket and its objects, matching the policy of the bucket
+// owner credentials of the cloud credential operator
+func buildBucketOwnerAccessPolicy(partition, bucket string) *policyDocument {
+	return &policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				Effect: "Allow",
+				Action: []string{"s3:*"},
+				Resource: []string{
+					fmt.Sprintf("arn:%s:s3:::%s", partition, bucket),
+					fmt.Sprintf("arn:%s:s3:::%s/*", partition, bucket),
+				},
+			},
+		},
+	}
+}
+
+// buildBucketOwnerRoleName returns the role name of the bucket owner credentials, names longer than iam allows are
+// truncated and suffixed with a hash of the full name
+func buildBucketOwnerRoleName(name string) string {
+	if len(name) <= defaultRoleNameMaxLength {
+		return name
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:defaultRoleNameHashLength]
+	return fmt.Sprintf("%s-%s", name[:defaultRoleNameMaxLength-defaultRoleNameHashLength-1], hash)
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	var out []interface{}
+	for _, value := range values {
+		out = append(out, value)
+	}
+	return out
+}
+
+// getServiceAccountIssuer returns the issuer of the service account tokens of the cluster, it identifies the oidc
+// provider of sts clusters
+func getServiceAccountIssuer(ctx context.Context, c client.Client) (string, error) {
+	authentication := &configv1.Authentication{}
+	if err := c.Get(ctx, types.NamespacedName{Name: defaultAuthenticationResourceName}, authentication); err != nil {
+		return "", errorUtil.Wrap(err, "failed to get cluster authentication")
+	}
+	if authentication.Spec.ServiceAccountIssuer == "" {
+		return "", errorUtil.New("service account issuer is undefined in cluster authentication")
+	}
+	return authentication.Spec.ServiceAccountIssuer, nil
+}
@@ -0,0 +1,224 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
+)
+
+const testIssuer = "https://oidc.example.com/test-cluster"
+
+type mockIamClient struct {
+	iamiface.IAMAPI
+	role               *iam.Role
+	createRoleCalls    []*iam.CreateRoleInput
+	updateTrustCalls   []*iam.UpdateAssumeRolePolicyInput
+	putRolePolicyCalls []*iam.PutRolePolicyInput
+	deleteRoleCalls    []*iam.DeleteRoleInput
+	deniedActions      []string
+	// statements are simulated when set, instead of denying deniedActions
+	statements []v1.StatementEntry
+}
+
+func (m *mockIamClient) GetRole(*iam.GetRoleInput) (*iam.GetRoleOutput, error) {
+	if m.role == nil {
+		return nil, awserr.New(iam.ErrCodeNoSuchEntityException, "role not found", nil)
+	}
+	return &iam.GetRoleOutput{Role: m.role}, nil
+}
+
+func (m *mockIamClient) CreateRole(input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+	m.createRoleCalls = append(m.createRoleCalls, input)
+	return &iam.CreateRoleOutput{Role: &iam.Role{
+		RoleName: input.RoleName,
+		Arn:      aws.String("arn:aws:iam::test:role/" + aws.StringValue(input.RoleName)),
+	}}, nil
+}
+
+func (m *mockIamClient) UpdateAssumeRolePolicy(input *iam.UpdateAssumeRolePolicyInput) (*iam.UpdateAssumeRolePolicyOutput, error) {
+	m.updateTrustCalls = append(m.updateTrustCalls, input)
+	return &iam.UpdateAssumeRolePolicyOutput{}, nil
+}
+
+func (m *mockIamClient) PutRolePolicy(input *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	m.putRolePolicyCalls = append(m.putRolePolicyCalls, input)
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+func (m *mockIamClient) DeleteRolePolicy(*iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error) {
+	return nil, awserr.New(iam.ErrCodeNoSuchEntityException, "policy not found", nil)
+}
+
+func (m *mockIamClient) DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error) {
+	m.deleteRoleCalls = append(m.deleteRoleCalls, input)
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+func (m *mockIamClient) SimulatePrincipalPolicyPages(input *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error {
+	page := &iam.SimulatePolicyResponse{}
+	if m.statements != nil {
+		for _, action := range input.ActionNames {
+			page.EvaluationResults = append(page.EvaluationResults, simulateStatements(m.statements, aws.StringValue(action), input.ContextEntries))
+		}
+		fn(page, true)
+		return nil
+	}
+	for _, action := range input.ActionNames {
+		decision := iam.PolicyEvaluationDecisionTypeAllowed
+		for _, denied := range m.deniedActions {
+			if denied == aws.StringValue(action) {
+				decision = iam.PolicyEvaluationDecisionTypeImplicitDeny
+			}
+		}
+		page.EvaluationResults = append(page.EvaluationResults, &iam.EvaluationResult{EvalActionName: action, EvalDecision: aws.String(decision)})
+	}
+	fn(page, true)
+	return nil
+}
+
+func buildTestTrustPolicyDocument(t *testing.T, serviceAccounts []string) string {
+	doc, err := json.Marshal(buildBucketOwnerTrustPolicy("aws", "test", testIssuer, "testNamespace", serviceAccounts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return url.QueryEscape(string(doc))
+}
+
+func TestReconcileBucketOwnerRole(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cases := []struct {
+		name            string
+		iamSvc          *mockIamClient
+		serviceAccounts []string
+		wantCreate      bool
+		wantUpdate      bool
+		wantRoleArn     string
+	}{
+		{
+			name:        "role is created for the bucket",
+			iamSvc:      &mockIamClient{},
+			wantCreate:  true,
+			wantRoleArn: "arn:aws:iam::test:role/test-creds",
+		},
+		{
+			name: "trust policy of an existing role is updated when the service accounts change",
+			iamSvc: &mockIamClient{role: &iam.Role{
+				RoleName:                 aws.String("test-creds"),
+				Arn:                      aws.String("arn:aws:iam::test:role/test-creds"),
+				AssumeRolePolicyDocument: aws.String(buildTestTrustPolicyDocument(t, nil)),
+			}},
+			serviceAccounts: []string{"test-sa"},
+			wantUpdate:      true,
+			wantRoleArn:     "arn:aws:iam::test:role/test-creds",
+		},
+		{
+			name: "existing role with the expected trust policy is not updated",
+			iamSvc: &mockIamClient{role: &iam.Role{
+				RoleName:                 aws.String("test-creds"),
+				Arn:                      aws.String("arn:aws:iam::test:role/test-creds"),
+				AssumeRolePolicyDocument: aws.String(buildTestTrustPolicyDocument(t, []string{"test-sa"})),
+			}},
+			serviceAccounts: []string{"test-sa"},
+			wantRoleArn:     "arn:aws:iam::test:role/test-creds",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			roleArn, err := reconcileBucketOwnerRole(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()), tc.iamSvc, &mockStsClient{}, "test-creds", "testNamespace", "test-bucket", testIssuer, tc.serviceAccounts)
+			if err != nil {
+				t.Fatalf("reconcileBucketOwnerRole() error = %v", err)
+			}
+			if roleArn != tc.wantRoleArn {
+				t.Errorf("reconcileBucketOwnerRole() role arn = %s, want %s", roleArn, tc.wantRoleArn)
+			}
+			if (len(tc.iamSvc.createRoleCalls) > 0) != tc.wantCreate {
+				t.Errorf("reconcileBucketOwnerRole() created role = %v, want %v", len(tc.iamSvc.createRoleCalls) > 0, tc.wantCreate)
+			}
+			if (len(tc.iamSvc.updateTrustCalls) > 0) != tc.wantUpdate {
+				t.Errorf("reconcileBucketOwnerRole() updated trust policy = %v, want %v", len(tc.iamSvc.updateTrustCalls) > 0, tc.wantUpdate)
+			}
+			if len(tc.iamSvc.putRolePolicyCalls) != 1 || !strings.Contains(aws.StringValue(tc.iamSvc.putRolePolicyCalls[0].PolicyDocument), "arn:aws:s3:::test-bucket/*") {
+				t.Errorf("reconcileBucketOwnerRole() unexpected access policy %v", tc.iamSvc.putRolePolicyCalls)
+			}
+		})
+	}
+}
+
+func TestBuildBucketOwnerTrustPolicy(t *testing.T) {
+	cases := []struct {
+		name            string
+		serviceAccounts []string
+		wantSubjects    []interface{}
+	}{
+		{
+			name:         "every service account of the namespace is allowed by default",
+			wantSubjects: []interface{}{"system:serviceaccount:testNamespace:*"},
+		},
+		{
+			name:            "service accounts are allowed in the namespace of the cr or their own namespace",
+			serviceAccounts: []string{"test-sa", "other-ns/other-sa"},
+			wantSubjects:    []interface{}{"system:serviceaccount:testNamespace:test-sa", "system:serviceaccount:other-ns:other-sa"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := buildBucketOwnerTrustPolicy("aws", "test", testIssuer, "testNamespace", tc.serviceAccounts)
+			statement := policy.Statement[0]
+			if statement.Principal["Federated"] != "arn:aws:iam::test:oidc-provider/oidc.example.com/test-cluster" {
+				t.Errorf("unexpected principal %v", statement.Principal)
+			}
+			subjects := statement.Condition["StringLike"]["oidc.example.com/test-cluster:sub"]
+			if !equalInterfaceSlices(subjects.([]interface{}), tc.wantSubjects) {
+				t.Errorf("unexpected subjects %v, want %v", subjects, tc.wantSubjects)
+			}
+		})
+	}
+}
+
+func TestBuildBucketOwnerRoleName(t *testing.T) {
+	if name := buildBucketOwnerRoleName("cro-aws-s3-test-creds"); name != "cro-aws-s3-test-creds" {
+		t.Errorf("unexpected role name %s", name)
+	}
+	long := buildEndUserCredentialsNameFromBucket(strings.Repeat("a", 63))
+	name := buildBucketOwnerRoleName(long)
+	if len(name) != defaultRoleNameMaxLength || !strings.HasPrefix(name, "cro-aws-s3-") {
+		t.Errorf("unexpected role name %s", name)
+	}
+	if name == buildBucketOwnerRoleName(long+"b") {
+		t.Errorf("expected role names of different buckets to differ")
+	}
+}
+
+func TestDeleteBucketOwnerRole(t *testing.T) {
+	iamSvc := &mockIamClient{}
+	if err := deleteBucketOwnerRole(iamSvc, "test-creds"); err != nil {
+		t.Fatalf("deleteBucketOwnerRole() error = %v", err)
+	}
+	if len(iamSvc.deleteRoleCalls) != 1 || aws.StringValue(iamSvc.deleteRoleCalls[0].RoleName) != "test-creds" {
+		t.Errorf("deleteBucketOwnerRole() unexpected delete calls %v", iamSvc.deleteRoleCalls)
+	}
+}
+
+func equalInterfaceSlices(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -3,7 +3,12 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,25 +37,73 @@ func NewSTSCredentialManager(client client.Client, ns string) *STSCredentialMana
 	}
 }
 
-// ReconcileProviderCredentials Ensure the credentials the AWS provider requires are available
-func (m *STSCredentialManager) ReconcileProviderCredentials(ctx context.Context, _ string) (*Credentials, error) {
+// ReconcileProviderCredentials Ensure the credentials the AWS provider requires for a resource type are available, the
+// role of the resource type, e.g. `role_arn_postgres`, is used when it is defined in the secret, otherwise the role
+// shared by all resource types is used
+func (m *STSCredentialManager) ReconcileProviderCredentials(ctx context.Context, _ string, rt providers.ResourceType) (*Credentials, error) {
 	secret, err := getSTSCredentialsSecret(ctx, m.Client, m.OperatorNamespace)
 	if err != nil {
 		return nil, errorUtil.Wrapf(err, "failed to get aws sts credentials secret %s", defaultSTSCredentialSecretName)
 	}
 
 	credentials := &Credentials{
-		RoleArn:       string(secret.Data[defaultRoleARNKeyName]),
+		RoleArn:       string(secret.Data[buildResourceRoleARNKeyName(rt)]),
 		TokenFilePath: defaultTokenPath,
 	}
+	if credentials.RoleArn == "" {
+		credentials.RoleArn = string(secret.Data[defaultRoleARNKeyName])
+	}
 	if credentials.RoleArn == "" {
 		return nil, errorUtil.New(fmt.Sprintf("%s key is undefined in secret %s", defaultRoleARNKeyName, secret.Name))
 	}
 	return credentials, nil
 }
 
-func (m *STSCredentialManager) ReconcileBucketOwnerCredentials(_ context.Context, _, _, _ string) (*Credentials, error) {
-	return nil, nil
+// ReconcileBucketOwnerCredentials ensures a role with access to the bucket only is available, the role can be assumed by
+// the service accounts through the cluster oidc provider, no long-lived credentials are created
+func (m *STSCredentialManager) ReconcileBucketOwnerCredentials(ctx context.Context, name, ns, bucket string, serviceAccounts []string) (*Credentials, error) {
+	issuer, err := getServiceAccountIssuer(ctx, m.Client)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster oidc provider")
+	}
+	sess, err := m.createBlobStorageSession(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	roleArn, err := reconcileBucketOwnerRole(ctx, m.Client, iam.New(sess), sts.New(sess), name, ns, bucket, issuer, serviceAccounts)
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to reconcile bucket owner role for bucket %s", bucket)
+	}
+	return &Credentials{
+		RoleArn:       roleArn,
+		TokenFilePath: defaultTokenPath,
+	}, nil
+}
+
+// DeleteBucketOwnerCredentials removes the role of the bucket owner credentials
+func (m *STSCredentialManager) DeleteBucketOwnerCredentials(ctx context.Context, name, ns string) error {
+	sess, err := m.createBlobStorageSession(ctx, ns)
+	if err != nil {
+		return err
+	}
+	return deleteBucketOwnerRole(iam.New(sess), name)
+}
+
+func (m *STSCredentialManager) createBlobStorageSession(ctx context.Context, ns string) (*session.Session, error) {
+	providerCreds, err := m.ReconcileProviderCredentials(ctx, ns, providers.BlobStorageResourceType)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile blob storage provider credentials")
+	}
+	// iam is a global service, the default region of the cluster is used
+	sess, err := CreateSessionFromStrategy(ctx, m.Client, providerCreds, &StrategyConfig{})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to create aws session")
+	}
+	return sess, nil
+}
+
+func buildResourceRoleARNKeyName(rt providers.ResourceType) string {
+	return fmt.Sprintf("%s_%s", defaultRoleARNKeyName, strings.TrimPrefix(string(rt), "_"))
 }
 
 func getSTSCredentialsSecret(ctx context.Context, client client.Client, ns string) (*corev1.Secret, error) {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	configv1 "github.com/openshift/api/config/v1"
 	v12 "k8s.io/api/core/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +51,22 @@ func TestSTSCredentialManager_ReconcileProviderCredentials(t *testing.T) {
 			expectedRoleARN:   "ROLE_ARN",
 			expectedTokenPath: defaultTokenPath,
 		},
+		{
+			name: "role arn of the resource type is used when it is defined",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v12.Secret{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      defaultSTSCredentialSecretName,
+					Namespace: ns,
+				},
+				Data: map[string][]byte{
+					defaultRoleARNKeyName:               []byte("ROLE_ARN"),
+					defaultRoleARNKeyName + "_postgres": []byte("POSTGRES_ROLE_ARN"),
+				},
+			}),
+			wantErr:           false,
+			expectedRoleARN:   "POSTGRES_ROLE_ARN",
+			expectedTokenPath: defaultTokenPath,
+		},
 		{
 			name: "undefined role arn key in sts credentials secret",
 			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v12.Secret{
@@ -70,7 +88,7 @@ func TestSTSCredentialManager_ReconcileProviderCredentials(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unexpected error creating credential manager")
 			}
-			awsCreds, err := cm.(*STSCredentialManager).ReconcileProviderCredentials(context.TODO(), ns)
+			awsCreds, err := cm.(*STSCredentialManager).ReconcileProviderCredentials(context.TODO(), ns, providers.PostgresResourceType)
 			if tc.wantErr {
 				if !errorContains(err, tc.expectedErrMsg) {
 					t.Fatalf("unexpected error from STS ReconcileProviderCredentials(): %v", err)
@@ -113,7 +131,7 @@ func TestSTSCredentialManager_ReconcileBucketOwnerCredentials(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "successfully reconciled bucket owner credentials",
+			name: "error reconciling bucket owner credentials without a cluster oidc provider",
 			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v12.Secret{
 				ObjectMeta: controllerruntime.ObjectMeta{
 					Name:      defaultSTSCredentialSecretName,
@@ -123,7 +141,36 @@ func TestSTSCredentialManager_ReconcileBucketOwnerCredentials(t *testing.T) {
 					defaultRoleARNKeyName: []byte("ROLE_ARN"),
 				},
 			}),
-			wantErr: false,
+			args: args{
+				ctx:    context.TODO(),
+				name:   "test-creds",
+				ns:     ns,
+				bucket: "test-bucket",
+			},
+			wantErr: true,
+		},
+		{
+			name: "error reconciling bucket owner credentials with an undefined service account issuer",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v12.Secret{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      defaultSTSCredentialSecretName,
+					Namespace: ns,
+				},
+				Data: map[string][]byte{
+					defaultRoleARNKeyName: []byte("ROLE_ARN"),
+				},
+			}, &configv1.Authentication{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name: defaultAuthenticationResourceName,
+				},
+			}),
+			args: args{
+				ctx:    context.TODO(),
+				name:   "test-creds",
+				ns:     ns,
+				bucket: "test-bucket",
+			},
+			wantErr: true,
 		},
 	}
 	for _, tc := range cases {
@@ -132,7 +179,7 @@ func TestSTSCredentialManager_ReconcileBucketOwnerCredentials(t *testing.T) {
 			if err != nil {
 				t.Fatal(err.Error())
 			}
-			_, err = cm.(*STSCredentialManager).ReconcileBucketOwnerCredentials(tc.args.ctx, tc.args.name, tc.args.ns, tc.args.bucket)
+			_, err = cm.(*STSCredentialManager).ReconcileBucketOwnerCredentials(tc.args.ctx, tc.args.name, tc.args.ns, tc.args.bucket, nil)
 			if tc.wantErr {
 				if err == nil {
 					t.Fatalf("expected error from ReconcileBucketOwnerCredentials(), but got nil")
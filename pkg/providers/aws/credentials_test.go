@@ -2,15 +2,24 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
 	v12 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"os"
+	"reflect"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +34,7 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 	if k8sutil.IsRunModeLocal() {
 		_ = os.Setenv("WATCH_NAMESPACE", "test")
 	}
+	postgresCredentialName := buildResourceCredentialName(defaultProviderCredentialName, providers.PostgresResourceType)
 	cases := []struct {
 		name                string
 		client              client.Client
@@ -35,9 +45,9 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 	}{
 		{
 			name: "credentials are reconciled successfully",
-			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v1.CredentialsRequest{
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), &v1.CredentialsRequest{
 				ObjectMeta: controllerruntime.ObjectMeta{
-					Name:      defaultProviderCredentialName,
+					Name:      postgresCredentialName,
 					Namespace: "testNamespace",
 				},
 				Status: v1.CredentialsRequestStatus{
@@ -46,11 +56,16 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 						Raw: []byte("{ \"user\":\"test\", \"policy\":\"test\" }"),
 					},
 				},
-			}, &v12.Secret{
+			}, &v1.CredentialsRequest{
 				ObjectMeta: controllerruntime.ObjectMeta{
 					Name:      defaultProviderCredentialName,
 					Namespace: "testNamespace",
 				},
+			}, &v12.Secret{
+				ObjectMeta: controllerruntime.ObjectMeta{
+					Name:      postgresCredentialName,
+					Namespace: "testNamespace",
+				},
 				Data: map[string][]byte{
 					defaultCredentialsKeyIDName:     []byte("ACCESS_KEY_ID"),
 					defaultCredentialsSecretKeyName: []byte("SECRET_ACCESS_KEY"),
@@ -62,9 +77,9 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 		},
 		{
 			name: "error reconciling credentials",
-			client: moqClient.NewSigsClientMoqWithScheme(scheme, &v1.CredentialsRequest{
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), &v1.CredentialsRequest{
 				ObjectMeta: controllerruntime.ObjectMeta{
-					Name:      defaultProviderCredentialName,
+					Name:      postgresCredentialName,
 					Namespace: "testNamespace",
 				},
 				Status: v1.CredentialsRequestStatus{
@@ -75,7 +90,13 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 				},
 			}),
 			wantErr:        true,
-			expectedErrMsg: "failed to reconcile aws credentials from credential request cloud-resources-aws-credentials",
+			expectedErrMsg: "failed to reconcile aws credentials from credential request cloud-resources-aws-credentials-postgres",
+		},
+		{
+			name:           "error getting cluster id",
+			client:         moqClient.NewSigsClientMoqWithScheme(scheme),
+			wantErr:        true,
+			expectedErrMsg: "failed to get cluster id",
 		},
 	}
 	for _, tc := range cases {
@@ -84,7 +105,7 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 			if err != nil {
 				t.Fatal(err.Error())
 			}
-			awsCreds, err := cm.(*CredentialMinterCredentialManager).ReconcileProviderCredentials(context.TODO(), "testNamespace")
+			awsCreds, err := cm.(*CredentialMinterCredentialManager).ReconcileProviderCredentials(context.TODO(), "testNamespace", providers.PostgresResourceType)
 			if tc.wantErr {
 				if !errorContains(err, tc.expectedErrMsg) {
 					t.Fatalf("unexpected error from ReconcileProviderCredentials(): %v", err)
@@ -97,10 +118,182 @@ func TestCredentialMinterManager_ReconcileProviderCredentials(t *testing.T) {
 			if awsCreds.SecretAccessKey != tc.expectedSecretKey {
 				t.Fatalf("unexpected secret access key, expected %s but got %s", tc.expectedSecretKey, awsCreds.SecretAccessKey)
 			}
+			legacy := &v1.CredentialsRequest{}
+			if err := tc.client.Get(context.TODO(), types.NamespacedName{Name: defaultProviderCredentialName, Namespace: "testNamespace"}, legacy); !k8serr.IsNotFound(err) {
+				t.Fatalf("expected credential request %s to be deleted, got error %v", defaultProviderCredentialName, err)
+			}
+		})
+	}
+}
+
+func TestBuildResourceEntries(t *testing.T) {
+	testRoleArn := "arn:aws:iam::123456789012:role/cloud-resources"
+	requestTagKey := fmt.Sprintf("aws:RequestTag/%sclusterID", resources.GetOrganizationTag())
+	resourceTagKey := fmt.Sprintf("aws:ResourceTag/%sclusterID", resources.GetOrganizationTag())
+	unconditioned := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition == nil && entry.Resource == "*"
+	}
+	taggedRequest := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition["StringEquals"][requestTagKey] == defaultInfraName
+	}
+	taggedResource := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition["StringEquals"][resourceTagKey] == defaultInfraName && entry.PolicyCondition["StringEqualsIfExists"] == nil
+	}
+	bucketResource := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition == nil && entry.Resource == "arn:aws:s3:::strategy-bucket"
+	}
+	objectResource := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition == nil && entry.Resource == "arn:aws:s3:::strategy-bucket/*"
+	}
+	assumedRole := func(entry v1.StatementEntry) bool {
+		return entry.PolicyCondition == nil && entry.Resource == testRoleArn
+	}
+	cases := []struct {
+		name             string
+		rt               providers.ResourceType
+		roleArns         []string
+		buckets          []string
+		expectedActions  map[string]func(entry v1.StatementEntry) bool
+		forbiddenActions []string
+	}{
+		{
+			name: "postgres credentials are restricted to rds and network actions",
+			rt:   providers.PostgresResourceType,
+			expectedActions: map[string]func(entry v1.StatementEntry) bool{
+				"rds:DescribeDBInstances": unconditioned,
+				"rds:CreateDBInstance":    taggedRequest,
+				"ec2:CreateVpc":           taggedRequest,
+				"rds:AddTagsToResource":   taggedRequest,
+				"rds:DeleteDBInstance":    taggedResource,
+				"ec2:DeleteVpc":           taggedResource,
+			},
+			forbiddenActions: []string{"elasticache:CreateReplicationGroup", "s3:CreateBucket", "sts:AssumeRole"},
+		},
+		{
+			name:     "postgres credentials can only assume the roles of the strategies",
+			rt:       providers.PostgresResourceType,
+			roleArns: []string{testRoleArn},
+			expectedActions: map[string]func(entry v1.StatementEntry) bool{
+				"rds:CreateDBInstance": taggedRequest,
+				"sts:AssumeRole":       assumedRole,
+			},
+		},
+		{
+			name: "redis credentials are restricted to elasticache and network actions",
+			rt:   providers.RedisResourceType,
+			expectedActions: map[string]func(entry v1.StatementEntry) bool{
+				"elasticache:DescribeReplicationGroups": unconditioned,
+				"elasticache:CreateReplicationGroup":    taggedRequest,
+				"elasticache:DeleteReplicationGroup":    taggedResource,
+				"ec2:CreateVpc":                         taggedRequest,
+			},
+			forbiddenActions: []string{"rds:CreateDBInstance", "s3:CreateBucket"},
+		},
+		{
+			name: "network credentials are restricted to network actions",
+			rt:   providers.NetworkResourceType,
+			expectedActions: map[string]func(entry v1.StatementEntry) bool{
+				"ec2:CreateVpc":  taggedRequest,
+				"ec2:DeleteVpc":  taggedResource,
+				"ec2:CreateTags": taggedRequest,
+			},
+			forbiddenActions: []string{"rds:CreateDBInstance", "elasticache:CreateReplicationGroup", "s3:CreateBucket"},
+		},
+		{
+			name:    "blob storage credentials are restricted to the s3 buckets of the blob storage crs",
+			rt:      providers.BlobStorageResourceType,
+			buckets: []string{"strategy-bucket"},
+			expectedActions: map[string]func(entry v1.StatementEntry) bool{
+				"s3:ListAllMyBuckets": unconditioned,
+				"s3:CreateBucket":     bucketResource,
+				"s3:DeleteBucket":     bucketResource,
+				"s3:DeleteObject":     objectResource,
+			},
+			forbiddenActions: []string{"rds:CreateDBInstance", "ec2:CreateVpc", "sts:AssumeRole"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := buildResourceEntries(tc.rt, defaultInfraName, tc.roleArns, tc.buckets)
+			actions := map[string][]v1.StatementEntry{}
+			for _, entry := range entries {
+				for _, action := range entry.Action {
+					actions[action] = append(actions[action], entry)
+				}
+			}
+			for action, allowedBy := range tc.expectedActions {
+				allowed := false
+				for _, entry := range actions[action] {
+					if allowedBy(entry) {
+						allowed = true
+					}
+				}
+				if !allowed {
+					t.Errorf("expected action %s in statements %v", action, actions[action])
+				}
+			}
+			for _, action := range tc.forbiddenActions {
+				if len(actions[action]) > 0 {
+					t.Errorf("unexpected action %s", action)
+				}
+			}
+			for action, statements := range actions {
+				for _, entry := range statements {
+					if entry.PolicyCondition["StringEqualsIfExists"] != nil && entry.PolicyCondition["StringEquals"][requestTagKey] != defaultInfraName {
+						t.Errorf("action %s is allowed on untagged resources without tagging them", action)
+					}
+				}
+			}
 		})
 	}
 }
 
+func TestCredentialMinterManager_getBucketNames(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	buildBlobStorage := func(name, tier, externalResourceID string) *v1alpha1.BlobStorage {
+		bs := buildTestBlobStorageCR()
+		bs.Name = name
+		bs.Namespace = "testNamespace"
+		bs.Spec.Tier = tier
+		bs.Spec.ExternalResourceID = externalResourceID
+		return bs
+	}
+	strategies := map[string]*StrategyConfig{
+		"production":  {CreateStrategy: json.RawMessage(`{"bucket": "strategy-bucket"}`)},
+		"development": {CreateStrategy: json.RawMessage(`{}`)},
+	}
+	client := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(),
+		buildBlobStorage("strategy", "production", ""),
+		buildBlobStorage("adopted", "development", "adopted-bucket"),
+		buildBlobStorage("adopted-production", "production", "adopted-production-bucket"),
+		buildBlobStorage("built", "development", ""),
+		buildBlobStorage("unknown-tier", "unknown", ""),
+	)
+	builtBucket, err := resources.BuildInfraNameFromObject(context.TODO(), client, buildBlobStorage("built", "development", "").ObjectMeta, defaultAwsBucketNameLength)
+	if err != nil {
+		t.Fatal("failed to build bucket name", err)
+	}
+	m := &CredentialMinterCredentialManager{Client: client}
+	buckets, err := m.getBucketNames(context.TODO(), "testNamespace", strategies)
+	if err != nil {
+		t.Fatalf("getBucketNames() error = %v", err)
+	}
+	want := []string{"adopted-bucket", "adopted-production-bucket", builtBucket, "strategy-bucket"}
+	sort.Strings(want)
+	if !reflect.DeepEqual(buckets, want) {
+		t.Errorf("getBucketNames() got = %v, want %v", buckets, want)
+	}
+	// every bucket is allowed by its exact arn, buckets of other clusters sharing a name prefix are not
+	for _, entry := range buildResourceEntries(providers.BlobStorageResourceType, defaultInfraName, nil, buckets) {
+		if entry.Resource != "*" && strings.Contains(strings.TrimSuffix(entry.Resource, "/*"), "*") {
+			t.Errorf("unexpected wildcard in s3 statement resource %s", entry.Resource)
+		}
+	}
+}
+
 func TestCredentialMinterManager_ReconcileCredentials(t *testing.T) {
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -354,7 +547,7 @@ func TestCredentialManager_ReconcileBucketOwnerCredentials(t *testing.T) {
 			if err != nil {
 				t.Fatal(err.Error())
 			}
-			awsCreds, err := cm.ReconcileBucketOwnerCredentials(tc.args.ctx, tc.args.name, tc.args.ns, tc.args.bucket)
+			awsCreds, err := cm.ReconcileBucketOwnerCredentials(tc.args.ctx, tc.args.name, tc.args.ns, tc.args.bucket, nil)
 			if tc.wantErr {
 				if err == nil {
 					t.Fatalf("expected error from ReconcileBucketOwnerCredentials(), but got nil")
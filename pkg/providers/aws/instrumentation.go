@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+)
+
+const (
+	awsProviderName = "aws"
+
+	// errorCodeUnknown is used as the error code of failed calls which did not return an aws error
+	errorCodeUnknown = "Unknown"
+)
+
+type throttleCounterKey struct{}
+
+// instrumentSession adds handlers to the session which expose metrics for every call made by a client created from it
+// aws clients are always created from a session, so this covers every aws api the operator calls
+func instrumentSession(sess *session.Session) *session.Session {
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{Name: "cro.StartThrottleCounter", Fn: startThrottleCounter})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{Name: "cro.CountThrottledAttempt", Fn: countThrottledAttempt})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{Name: "cro.ObserveCloudAPICall", Fn: observeCloudAPICall})
+	return sess
+}
+
+// startThrottleCounter attaches a counter to the request, used to count throttled attempts across retries
+func startThrottleCounter(r *request.Request) {
+	throttles := 0
+	r.SetContext(context.WithValue(r.Context(), throttleCounterKey{}, &throttles))
+}
+
+func countThrottledAttempt(r *request.Request) {
+	if r.Error == nil || !request.IsErrorThrottle(r.Error) {
+		return
+	}
+	if throttles, ok := r.Context().Value(throttleCounterKey{}).(*int); ok {
+		*throttles++
+	}
+}
+
+func observeCloudAPICall(r *request.Request) {
+	call := &resources.CloudAPICall{
+		Provider:  awsProviderName,
+		Service:   strings.ToLower(r.ClientInfo.ServiceID),
+		Operation: r.Operation.Name,
+		Region:    aws.StringValue(r.Config.Region),
+		Duration:  time.Since(r.Time),
+		Retries:   r.RetryCount,
+	}
+	if call.Service == "" {
+		call.Service = r.ClientInfo.ServiceName
+	}
+	if throttles, ok := r.Context().Value(throttleCounterKey{}).(*int); ok {
+		call.Throttles = *throttles
+	}
+	if r.Error != nil {
+		call.ErrorCode = errorCodeUnknown
+		if awsErr, ok := r.Error.(awserr.Error); ok {
+			call.ErrorCode = awsErr.Code()
+		}
+	}
+	resources.ObserveCloudAPICall(call)
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	customMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const testThrottlingResponse = `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>test</RequestId></ErrorResponse>`
+
+// gatherCloudAPIMetric returns the value of a cloud api counter for an operation, or 0 if it has not been set
+func gatherCloudAPIMetric(t *testing.T, name, operation string) float64 {
+	families, err := customMetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		var value float64
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == resources.LabelOperationKey && label.GetValue() == operation {
+					value += metric.GetCounter().GetValue()
+				}
+			}
+		}
+		return value
+	}
+	return 0
+}
+
+func TestInstrumentSession(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// the first attempt is throttled and the retry fails with the same error
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(testThrottlingResponse))
+	}))
+	defer server.Close()
+
+	sess := instrumentSession(session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("eu-west-1"),
+		Endpoint:    aws.String(server.URL),
+		Credentials: awsCredentials.NewStaticCredentials("test", "test", ""),
+		MaxRetries:  aws.Int(1),
+	})))
+	_, err := sts.New(sess).GetCallerIdentityWithContext(context.TODO(), &sts.GetCallerIdentityInput{})
+	if err == nil {
+		t.Fatal("expected throttling error")
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+
+	operation := "GetCallerIdentity"
+	if got := gatherCloudAPIMetric(t, resources.DefaultCloudAPIRequestsMetricName, operation); got != 1 {
+		t.Errorf("requests = %v, want 1", got)
+	}
+	if got := gatherCloudAPIMetric(t, resources.DefaultCloudAPIErrorsMetricName, operation); got != 1 {
+		t.Errorf("errors = %v, want 1", got)
+	}
+	if got := gatherCloudAPIMetric(t, resources.DefaultCloudAPIThrottlesMetricName, operation); got != 2 {
+		t.Errorf("throttles = %v, want 2", got)
+	}
+	if got := gatherCloudAPIMetric(t, resources.DefaultCloudAPIRetriesMetricName, operation); got != 1 {
+		t.Errorf("retries = %v, want 1", got)
+	}
+}
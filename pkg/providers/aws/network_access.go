@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultNetworkAccessSecurityGroupPostfix  = "network-access"
+	defaultNetworkAccessSecurityGroupTagValue = "Cloud Resource Network Access Security Group"
+	errCodeDependencyViolation                = "DependencyViolation"
+)
+
+// buildNetworkAccessSecurityGroupName returns the name of the security group restricting network access to a single
+// cloud resource
+func buildNetworkAccessSecurityGroupName(resourceID string) string {
+	return fmt.Sprintf("%s-%s", resourceID, defaultNetworkAccessSecurityGroupPostfix)
+}
+
+// reconcileNetworkAccessSecurityGroup ensures the security group of a cloud resource only accepts traffic, on the port
+// of the cloud resource, from the networks allowed by the network access of the cr. the security group is created in
+// the vpc of the shared security group, the cloud resource uses it instead of the shared security group
+//
+// the shared security group accepts traffic from the cluster vpc and from the sources the network connection needs,
+// such as the load balancers of the private link connection method. only the cluster vpc is replaced by the allowed
+// networks, the other sources are kept
+func reconcileNetworkAccessSecurityGroup(ctx context.Context, c client.Client, ec2Svc ec2iface.EC2API, sharedSecGroup *ec2.SecurityGroup, resourceID string, port int64, access *croType.NetworkAccess, logger *logrus.Entry) (*ec2.SecurityGroup, error) {
+	clusterVpc, err := getClusterVpc(ctx, c, ec2Svc, logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	cidrs, err := resources.GetNetworkAccessCIDRs(ctx, c, access, aws.StringValue(clusterVpc.CidrBlock))
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cidr blocks allowed by network access")
+	}
+	for _, perm := range sharedSecGroup.IpPermissions {
+		for _, ipRange := range perm.IpRanges {
+			if aws.StringValue(ipRange.CidrIp) != aws.StringValue(clusterVpc.CidrBlock) {
+				cidrs = append(cidrs, aws.StringValue(ipRange.CidrIp))
+			}
+		}
+	}
+	desired := buildNetworkAccessIpPermissions(port, cidrs, access.AllowedSecurityGroupIDs)
+
+	secGroupName := buildNetworkAccessSecurityGroupName(resourceID)
+	secGroup, err := getSecurityGroup(ec2Svc, secGroupName)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get network access security group")
+	}
+	if secGroup == nil {
+		tagSpec, err := getDefaultTagSpec(ctx, c, &resources.Tag{Key: resources.TagDisplayName, Value: defaultNetworkAccessSecurityGroupTagValue}, ec2.ResourceTypeSecurityGroup)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get default tag spec")
+		}
+		logger.Infof("creating network access security group %s", secGroupName)
+		createOutput, err := ec2Svc.CreateSecurityGroup(&ec2.CreateSecurityGroupInput{
+			Description:       aws.String(fmt.Sprintf("network access security group for cloud resource %s", resourceID)),
+			GroupName:         aws.String(secGroupName),
+			VpcId:             sharedSecGroup.VpcId,
+			TagSpecifications: tagSpec,
+		})
+		if err != nil {
+			return nil, errorUtil.Wrapf(err, "failed to create network access security group %s", secGroupName)
+		}
+		describeOutput, err := ec2Svc.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+			GroupIds: []*string{createOutput.GroupId},
+		})
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get created network access security group")
+		}
+		if len(describeOutput.SecurityGroups) == 0 {
+			return nil, errorUtil.Errorf("expected to find created network access security group %s", secGroupName)
+		}
+		secGroup = describeOutput.SecurityGroups[0]
+	}
+
+	authorize, revoke := diffIpPermissions(secGroup.IpPermissions, desired)
+	if len(authorize) > 0 {
+		logger.Infof("authorizing %d ingress rules for network access security group %s", len(authorize), secGroupName)
+		if _, err = ec2Svc.AuthorizeSecurityGroupIngress(&ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       secGroup.GroupId,
+			IpPermissions: authorize,
+		}); err != nil {
+			return nil, errorUtil.Wrapf(err, "failed to authorize ingress for network access security group %s", secGroupName)
+		}
+	}
+	if len(revoke) > 0 {
+		logger.Infof("revoking %d ingress rules for network access security group %s", len(revoke), secGroupName)
+		if _, err = ec2Svc.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       secGroup.GroupId,
+			IpPermissions: revoke,
+		}); err != nil {
+			return nil, errorUtil.Wrapf(err, "failed to revoke ingress for network access security group %s", secGroupName)
+		}
+	}
+	return secGroup, nil
+}
+
+// deleteNetworkAccessSecurityGroup deletes the security group restricting network access to a cloud resource, false is
+// returned while the security group is still in use by the cloud resource
+func deleteNetworkAccessSecurityGroup(ec2Svc ec2iface.EC2API, resourceID string, logger *logrus.Entry) (bool, error) {
+	secGroupName := buildNetworkAccessSecurityGroupName(resourceID)
+	secGroup, err := getSecurityGroup(ec2Svc, secGroupName)
+	if err != nil {
+		return false, errorUtil.Wrap(err, "failed to get network access security group")
+	}
+	if secGroup == nil {
+		return true, nil
+	}
+	logger.Infof("deleting network access security group %s", secGroupName)
+	if _, err = ec2Svc.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: secGroup.GroupId}); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == errCodeDependencyViolation {
+			logger.Infof("network access security group %s is still in use", secGroupName)
+			return false, nil
+		}
+		return false, errorUtil.Wrapf(err, "failed to delete network access security group %s", secGroupName)
+	}
+	return true, nil
+}
+
+// buildNetworkAccessIpPermissions returns an ingress rule on the port of the cloud resource for each cidr block and
+// security group
+func buildNetworkAccessIpPermissions(port int64, cidrs []string, securityGroupIDs []string) []*ec2.IpPermission {
+	var perms []*ec2.IpPermission
+	for _, cidr := range cidrs {
+		perms = append(perms, &ec2.IpPermission{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(port),
+			ToPort:     aws.Int64(port),
+			IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidr)}},
+		})
+	}
+	for _, securityGroupID := range securityGroupIDs {
+		perms = append(perms, &ec2.IpPermission{
+			IpProtocol:       aws.String("tcp"),
+			FromPort:         aws.Int64(port),
+			ToPort:           aws.Int64(port),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String(securityGroupID)}},
+		})
+	}
+	return perms
+}
+
+// diffIpPermissions returns the ingress rules to authorize and to revoke for the security group to only have the
+// desired ingress rules, rules are compared per cidr block and security group
+func diffIpPermissions(current, desired []*ec2.IpPermission) ([]*ec2.IpPermission, []*ec2.IpPermission) {
+	currentRules := flattenIpPermissions(current)
+	desiredRules := flattenIpPermissions(desired)
+	var authorize, revoke []*ec2.IpPermission
+	for _, key := range sortedIpPermissionKeys(desiredRules) {
+		if _, ok := currentRules[key]; !ok {
+			authorize = append(authorize, desiredRules[key])
+		}
+	}
+	for _, key := range sortedIpPermissionKeys(currentRules) {
+		if _, ok := desiredRules[key]; !ok {
+			revoke = append(revoke, currentRules[key])
+		}
+	}
+	return authorize, revoke
+}
+
+// flattenIpPermissions splits ingress rules into a rule per cidr block and security group, keyed by protocol, ports and
+// source
+func flattenIpPermissions(perms []*ec2.IpPermission) map[string]*ec2.IpPermission {
+	rules := map[string]*ec2.IpPermission{}
+	for _, perm := range perms {
+		for _, ipRange := range perm.IpRanges {
+			rule := &ec2.IpPermission{IpProtocol: perm.IpProtocol, FromPort: perm.FromPort, ToPort: perm.ToPort, IpRanges: []*ec2.IpRange{{CidrIp: ipRange.CidrIp}}}
+			rules[ipPermissionKey(rule, aws.StringValue(ipRange.CidrIp))] = rule
+		}
+		for _, pair := range perm.UserIdGroupPairs {
+			rule := &ec2.IpPermission{IpProtocol: perm.IpProtocol, FromPort: perm.FromPort, ToPort: perm.ToPort, UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: pair.GroupId, UserId: pair.UserId}}}
+			rules[ipPermissionKey(rule, aws.StringValue(pair.GroupId))] = rule
+		}
+	}
+	return rules
+}
+
+func ipPermissionKey(perm *ec2.IpPermission, source string) string {
+	return fmt.Sprintf("%s/%d-%d/%s", aws.StringValue(perm.IpProtocol), aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), source)
+}
+
+func sortedIpPermissionKeys(rules map[string]*ec2.IpPermission) []string {
+	var keys []string
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// securityGroupIDsEqual returns true if both lists hold the same security group ids, in any order
+func securityGroupIDsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestDiffIpPermissions(t *testing.T) {
+	tests := []struct {
+		name          string
+		current       []*ec2.IpPermission
+		desired       []*ec2.IpPermission
+		wantAuthorize []*ec2.IpPermission
+		wantRevoke    []*ec2.IpPermission
+	}{
+		{
+			name:          "test all desired rules are authorized for a new security group",
+			desired:       buildNetworkAccessIpPermissions(5432, []string{"10.0.0.0/16"}, []string{"sg-1"}),
+			wantAuthorize: buildNetworkAccessIpPermissions(5432, []string{"10.0.0.0/16"}, []string{"sg-1"}),
+		},
+		{
+			name: "test grouped rules matching the desired rules are kept",
+			current: []*ec2.IpPermission{
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(5432),
+					ToPort:           aws.Int64(5432),
+					IpRanges:         []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}, {CidrIp: aws.String("192.168.0.0/24")}},
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-1"), UserId: aws.String("123")}},
+				},
+			},
+			desired: buildNetworkAccessIpPermissions(5432, []string{"192.168.0.0/24", "10.0.0.0/16"}, []string{"sg-1"}),
+		},
+		{
+			name: "test rules which are no longer allowed are revoked",
+			current: []*ec2.IpPermission{
+				{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+				},
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(5432),
+					ToPort:           aws.Int64(5432),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-1")}},
+				},
+			},
+			desired:       buildNetworkAccessIpPermissions(5432, []string{"10.0.0.0/16"}, nil),
+			wantAuthorize: buildNetworkAccessIpPermissions(5432, []string{"10.0.0.0/16"}, nil),
+			wantRevoke: []*ec2.IpPermission{
+				{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}},
+				},
+				{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(5432),
+					ToPort:           aws.Int64(5432),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authorize, revoke := diffIpPermissions(tt.current, tt.desired)
+			if !reflect.DeepEqual(authorize, tt.wantAuthorize) {
+				t.Errorf("diffIpPermissions() authorize = %v, want %v", authorize, tt.wantAuthorize)
+			}
+			if !reflect.DeepEqual(revoke, tt.wantRevoke) {
+				t.Errorf("diffIpPermissions() revoke = %v, want %v", revoke, tt.wantRevoke)
+			}
+		})
+	}
+}
+
+func TestDeleteNetworkAccessSecurityGroup(t *testing.T) {
+	secGroup := &ec2.SecurityGroup{
+		GroupId:   aws.String("sg-access"),
+		GroupName: aws.String(buildNetworkAccessSecurityGroupName("test-id")),
+	}
+	tests := []struct {
+		name        string
+		secGroups   []*ec2.SecurityGroup
+		deleteErr   error
+		wantDeleted bool
+		wantErr     bool
+	}{
+		{
+			name:        "test deleted when the security group does not exist",
+			wantDeleted: true,
+		},
+		{
+			name:        "test security group is deleted",
+			secGroups:   []*ec2.SecurityGroup{secGroup},
+			wantDeleted: true,
+		},
+		{
+			name:      "test not deleted while the security group is in use",
+			secGroups: []*ec2.SecurityGroup{secGroup},
+			deleteErr: awserr.New(errCodeDependencyViolation, "in use", nil),
+		},
+		{
+			name:      "test error when the security group fails to delete",
+			secGroups: []*ec2.SecurityGroup{secGroup},
+			deleteErr: awserr.New("InternalError", "failed", nil),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ec2Svc := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+				ec2Client.describeSecurityGroupsFn = func(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+					return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: tt.secGroups}, nil
+				}
+				ec2Client.deleteSecurityGroupFn = func(*ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error) {
+					return &ec2.DeleteSecurityGroupOutput{}, tt.deleteErr
+				}
+			})
+			deleted, err := deleteNetworkAccessSecurityGroup(ec2Svc, "test-id", testLogger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("deleteNetworkAccessSecurityGroup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("deleteNetworkAccessSecurityGroup() = %v, want %v", deleted, tt.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestSecurityGroupIDsEqual(t *testing.T) {
+	if !securityGroupIDsEqual([]string{"sg-1", "sg-2"}, []string{"sg-2", "sg-1"}) {
+		t.Error("securityGroupIDsEqual() = false, want true for the same ids in another order")
+	}
+	if securityGroupIDsEqual([]string{"sg-1"}, []string{"sg-2"}) {
+		t.Error("securityGroupIDsEqual() = true, want false for different ids")
+	}
+}
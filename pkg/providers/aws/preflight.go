@@ -0,0 +1,249 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+)
+
+const (
+	// rdsInstanceQuotaName is the rds account quota on the number of db instances in the region
+	rdsInstanceQuotaName = "DBInstances"
+)
+
+// preflightRDSInstance runs the checks of an rds instance before it is first created, so failures such as a missing
+// permission or an exhausted quota are reported on their own rather than as a failed create
+func preflightRDSInstance(iamSvc iamiface.IAMAPI, stsSvc stsiface.STSAPI, rdsSvc rdsiface.RDSAPI, ec2Svc ec2iface.EC2API, rdsCfg *rds.CreateDBInstanceInput) *resources.Preflight {
+	preflight := &resources.Preflight{}
+	checkPermissions(preflight, iamSvc, stsSvc, postgresCreateEntries, rdsTagsToMap(rdsCfg.Tags))
+	checkRDSInstanceQuota(preflight, rdsSvc)
+	checkRDSInstanceAvailability(preflight, rdsSvc, rdsCfg)
+
+	required := int64(1)
+	if aws.BoolValue(rdsCfg.MultiAZ) {
+		required = 2
+	}
+	groups, err := rdsSvc.DescribeDBSubnetGroups(&rds.DescribeDBSubnetGroupsInput{DBSubnetGroupName: rdsCfg.DBSubnetGroupName})
+	if err != nil || len(groups.DBSubnetGroups) == 0 {
+		preflight.Unknown(resources.PreflightNetworkCapacityConditionType, "failed to describe rds subnet group %s: %v", aws.StringValue(rdsCfg.DBSubnetGroupName), err)
+	} else {
+		var subnetIDs []*string
+		for _, s := range groups.DBSubnetGroups[0].Subnets {
+			subnetIDs = append(subnetIDs, s.SubnetIdentifier)
+		}
+		checkSubnetCapacity(preflight, ec2Svc, subnetIDs, required)
+	}
+	return preflight
+}
+
+// preflightElasticacheCluster runs the checks of an elasticache replication group before it is first created.
+// elasticache does not expose its quotas through its own api, so no quota check is run
+func preflightElasticacheCluster(iamSvc iamiface.IAMAPI, stsSvc stsiface.STSAPI, cacheSvc elasticacheiface.ElastiCacheAPI, ec2Svc ec2iface.EC2API, elasticacheConfig *elasticache.CreateReplicationGroupInput) *resources.Preflight {
+	preflight := &resources.Preflight{}
+	checkPermissions(preflight, iamSvc, stsSvc, redisCreateEntries, elasticacheTagsToMap(elasticacheConfig.Tags))
+	checkElasticacheEngineAvailability(preflight, cacheSvc, elasticacheConfig)
+
+	required := aws.Int64Value(elasticacheConfig.NumCacheClusters)
+	if required < 1 {
+		required = 1
+	}
+	groups, err := cacheSvc.DescribeCacheSubnetGroups(&elasticache.DescribeCacheSubnetGroupsInput{CacheSubnetGroupName: elasticacheConfig.CacheSubnetGroupName})
+	if err != nil || len(groups.CacheSubnetGroups) == 0 {
+		preflight.Unknown(resources.PreflightNetworkCapacityConditionType, "failed to describe elasticache subnet group %s: %v", aws.StringValue(elasticacheConfig.CacheSubnetGroupName), err)
+	} else {
+		var subnetIDs []*string
+		for _, s := range groups.CacheSubnetGroups[0].Subnets {
+			subnetIDs = append(subnetIDs, s.SubnetIdentifier)
+		}
+		checkSubnetCapacity(preflight, ec2Svc, subnetIDs, required)
+	}
+	return preflight
+}
+
+// checkPermissions simulates the actions with the policies of the identity the provider credentials belong to, with the
+// tags the create request adds to the resource. the simulation does not account for service control policies or
+// permission boundaries, so a passed check does not guarantee the create succeeds. an action which is only denied
+// because the simulation is missing a context key the policies are conditioned on can not be decided, so it is unknown
+func checkPermissions(preflight *resources.Preflight, iamSvc iamiface.IAMAPI, stsSvc stsiface.STSAPI, actions []string, requestTags map[string]string) {
+	identity, err := stsSvc.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+	if err != nil {
+		preflight.Unknown(resources.PreflightPermissionsConditionType, "failed to get account identity: %v", err)
+		return
+	}
+	principal, err := buildPolicySourceArn(aws.StringValue(identity.Arn))
+	if err != nil {
+		preflight.Unknown(resources.PreflightPermissionsConditionType, "failed to parse caller arn %s: %v", aws.StringValue(identity.Arn), err)
+		return
+	}
+	var denied, undecided, missingKeys []string
+	err = iamSvc.SimulatePrincipalPolicyPages(&iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principal),
+		ActionNames:     aws.StringSlice(actions),
+		ContextEntries:  buildRequestTagContextEntries(requestTags),
+	}, func(page *iam.SimulatePolicyResponse, _ bool) bool {
+		for _, result := range page.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed {
+				continue
+			}
+			if len(result.MissingContextValues) > 0 {
+				undecided = append(undecided, aws.StringValue(result.EvalActionName))
+				missingKeys = append(missingKeys, aws.StringValueSlice(result.MissingContextValues)...)
+				continue
+			}
+			denied = append(denied, aws.StringValue(result.EvalActionName))
+		}
+		return true
+	})
+	if err != nil {
+		preflight.Unknown(resources.PreflightPermissionsConditionType, "failed to simulate permissions of %s: %v", principal, err)
+		return
+	}
+	if len(denied) > 0 {
+		preflight.Fail(resources.PreflightPermissionsConditionType, "%s is not allowed to call %s", principal, strings.Join(denied, ", "))
+		return
+	}
+	if len(undecided) > 0 {
+		preflight.Unknown(resources.PreflightPermissionsConditionType, "permissions of %s to call %s depend on context keys %s which can not be simulated", principal, strings.Join(undecided, ", "), strings.Join(uniqueSorted(missingKeys), ", "))
+		return
+	}
+	preflight.Pass(resources.PreflightPermissionsConditionType, "%s is allowed to call %s", principal, strings.Join(actions, ", "))
+}
+
+// buildRequestTagContextEntries returns the context keys a create request tagging a resource is evaluated with, the
+// provider credentials only allow creating resources tagged with the cluster id
+func buildRequestTagContextEntries(tags map[string]string) []*iam.ContextEntry {
+	if len(tags) == 0 {
+		return nil
+	}
+	var keys []string
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var entries []*iam.ContextEntry
+	for _, key := range keys {
+		entries = append(entries, &iam.ContextEntry{
+			ContextKeyName:   aws.String(fmt.Sprintf("aws:RequestTag/%s", key)),
+			ContextKeyType:   aws.String(iam.ContextKeyTypeEnumString),
+			ContextKeyValues: aws.StringSlice([]string{tags[key]}),
+		})
+	}
+	return append(entries, &iam.ContextEntry{
+		ContextKeyName:   aws.String("aws:TagKeys"),
+		ContextKeyType:   aws.String(iam.ContextKeyTypeEnumStringList),
+		ContextKeyValues: aws.StringSlice(keys),
+	})
+}
+
+// buildPolicySourceArn returns the arn of the iam user or role a caller arn belongs to, the arn of an assumed role
+// session can not be simulated
+func buildPolicySourceArn(callerArn string) (string, error) {
+	parsed, err := arn.Parse(callerArn)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Service == "sts" && strings.HasPrefix(parsed.Resource, "assumed-role/") {
+		parts := strings.Split(parsed.Resource, "/")
+		return arn.ARN{Partition: parsed.Partition, Service: "iam", AccountID: parsed.AccountID, Resource: fmt.Sprintf("role/%s", parts[1])}.String(), nil
+	}
+	return callerArn, nil
+}
+
+// checkRDSInstanceQuota fails when every db instance the account quota allows in the region is used
+func checkRDSInstanceQuota(preflight *resources.Preflight, rdsSvc rdsiface.RDSAPI) {
+	attributes, err := rdsSvc.DescribeAccountAttributes(&rds.DescribeAccountAttributesInput{})
+	if err != nil {
+		preflight.Unknown(resources.PreflightQuotaConditionType, "failed to describe rds account quotas: %v", err)
+		return
+	}
+	for _, quota := range attributes.AccountQuotas {
+		if aws.StringValue(quota.AccountQuotaName) != rdsInstanceQuotaName {
+			continue
+		}
+		used, limit := aws.Int64Value(quota.Used), aws.Int64Value(quota.Max)
+		if used >= limit {
+			preflight.Fail(resources.PreflightQuotaConditionType, "%d of %d rds instances allowed in the region are used", used, limit)
+			return
+		}
+		preflight.Pass(resources.PreflightQuotaConditionType, "%d of %d rds instances allowed in the region are used", used, limit)
+		return
+	}
+	preflight.Unknown(resources.PreflightQuotaConditionType, "rds account quota %s was not found", rdsInstanceQuotaName)
+}
+
+// checkRDSInstanceAvailability fails when the instance class is not offered with the engine version in the region
+func checkRDSInstanceAvailability(preflight *resources.Preflight, rdsSvc rdsiface.RDSAPI, rdsCfg *rds.CreateDBInstanceInput) {
+	options, err := rdsSvc.DescribeOrderableDBInstanceOptions(&rds.DescribeOrderableDBInstanceOptionsInput{
+		Engine:          rdsCfg.Engine,
+		EngineVersion:   rdsCfg.EngineVersion,
+		DBInstanceClass: rdsCfg.DBInstanceClass,
+	})
+	if err != nil {
+		preflight.Unknown(resources.PreflightAvailabilityConditionType, "failed to describe orderable rds instance options: %v", err)
+		return
+	}
+	if len(options.OrderableDBInstanceOptions) == 0 {
+		preflight.Fail(resources.PreflightAvailabilityConditionType, "rds instance class %s is not offered with %s %s in the region", aws.StringValue(rdsCfg.DBInstanceClass), aws.StringValue(rdsCfg.Engine), aws.StringValue(rdsCfg.EngineVersion))
+		return
+	}
+	preflight.Pass(resources.PreflightAvailabilityConditionType, "rds instance class %s is offered with %s %s in the region", aws.StringValue(rdsCfg.DBInstanceClass), aws.StringValue(rdsCfg.Engine), aws.StringValue(rdsCfg.EngineVersion))
+}
+
+// checkElasticacheEngineAvailability fails when the engine version is not offered in the region, a major version such
+// as 7.0 is matched by its patch versions
+func checkElasticacheEngineAvailability(preflight *resources.Preflight, cacheSvc elasticacheiface.ElastiCacheAPI, elasticacheConfig *elasticache.CreateReplicationGroupInput) {
+	engine := aws.StringValue(elasticacheConfig.Engine)
+	if engine == "" {
+		engine = "redis"
+	}
+	version := aws.StringValue(elasticacheConfig.EngineVersion)
+	versions, err := cacheSvc.DescribeCacheEngineVersions(&elasticache.DescribeCacheEngineVersionsInput{Engine: aws.String(engine)})
+	if err != nil {
+		preflight.Unknown(resources.PreflightAvailabilityConditionType, "failed to describe elasticache engine versions: %v", err)
+		return
+	}
+	for _, v := range versions.CacheEngineVersions {
+		found := aws.StringValue(v.EngineVersion)
+		if version == "" || found == version || strings.HasPrefix(found, version+".") {
+			preflight.Pass(resources.PreflightAvailabilityConditionType, "elasticache %s %s is offered in the region", engine, version)
+			return
+		}
+	}
+	preflight.Fail(resources.PreflightAvailabilityConditionType, "elasticache %s %s is not offered in the region", engine, version)
+}
+
+// checkSubnetCapacity fails when the subnets have fewer free addresses than the cloud resource requires
+func checkSubnetCapacity(preflight *resources.Preflight, ec2Svc ec2iface.EC2API, subnetIDs []*string, required int64) {
+	if len(subnetIDs) == 0 {
+		preflight.Fail(resources.PreflightNetworkCapacityConditionType, "subnet group has no subnets")
+		return
+	}
+	subnets, err := ec2Svc.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: subnetIDs})
+	if err != nil {
+		preflight.Unknown(resources.PreflightNetworkCapacityConditionType, "failed to describe subnets: %v", err)
+		return
+	}
+	var available int64
+	for _, s := range subnets.Subnets {
+		available += aws.Int64Value(s.AvailableIpAddressCount)
+	}
+	if available < required {
+		preflight.Fail(resources.PreflightNetworkCapacityConditionType, "subnets %s have %d free addresses, %d are required", strings.Join(aws.StringValueSlice(subnetIDs), ", "), available, required)
+		return
+	}
+	preflight.Pass(resources.PreflightNetworkCapacityConditionType, "subnets have %d free addresses, %d are required", available, required)
+}
@@ -0,0 +1,301 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// simulateStatements evaluates an action on any resource with the allow statements of a credential request, like the
+// iam policy simulator it reports the context keys a condition requires which are not in the context entries
+func simulateStatements(statements []v1.StatementEntry, action string, contextEntries []*iam.ContextEntry) *iam.EvaluationResult {
+	values := map[string][]string{}
+	for _, entry := range contextEntries {
+		values[aws.StringValue(entry.ContextKeyName)] = aws.StringValueSlice(entry.ContextKeyValues)
+	}
+	var missing []string
+	for _, statement := range statements {
+		matched := false
+		for _, statementAction := range statement.Action {
+			matched = matched || statementAction == action
+		}
+		if statement.Resource != "*" || !matched {
+			continue
+		}
+		for operator, conditions := range statement.PolicyCondition {
+			for key, want := range conditions {
+				got, ok := values[key]
+				if !ok {
+					if operator != "StringEqualsIfExists" {
+						missing = append(missing, key)
+						matched = false
+					}
+					continue
+				}
+				if len(got) != 1 || got[0] != fmt.Sprint(want) {
+					matched = false
+				}
+			}
+		}
+		if matched {
+			return &iam.EvaluationResult{EvalActionName: aws.String(action), EvalDecision: aws.String(iam.PolicyEvaluationDecisionTypeAllowed)}
+		}
+	}
+	return &iam.EvaluationResult{
+		EvalActionName:       aws.String(action),
+		EvalDecision:         aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny),
+		MissingContextValues: aws.StringSlice(missing),
+	}
+}
+
+func findPreflightCheck(preflight *resources.Preflight, checkType string) *resources.PreflightCheck {
+	for i := range preflight.Checks {
+		if preflight.Checks[i].Type == checkType {
+			return &preflight.Checks[i]
+		}
+	}
+	return nil
+}
+
+func TestBuildPolicySourceArn(t *testing.T) {
+	tests := []struct {
+		name      string
+		callerArn string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "test assumed role session is mapped to its role",
+			callerArn: "arn:aws:sts::123456789012:assumed-role/cro-role/session",
+			want:      "arn:aws:iam::123456789012:role/cro-role",
+		},
+		{
+			name:      "test iam user is kept",
+			callerArn: "arn:aws:iam::123456789012:user/cro-user",
+			want:      "arn:aws:iam::123456789012:user/cro-user",
+		},
+		{
+			name:      "test invalid arn",
+			callerArn: "invalid",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPolicySourceArn(tt.callerArn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildPolicySourceArn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildPolicySourceArn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreflightRDSInstance(t *testing.T) {
+	clusterIDTagKey := fmt.Sprintf("%sclusterID", resources.GetOrganizationTag())
+	rdsCfg := &rds.CreateDBInstanceInput{
+		DBInstanceIdentifier: aws.String("test"),
+		DBInstanceClass:      aws.String("db.t3.small"),
+		Engine:               aws.String("postgres"),
+		EngineVersion:        aws.String("16.1"),
+		DBSubnetGroupName:    aws.String("test-subnet-group"),
+		MultiAZ:              aws.Bool(true),
+	}
+	buildRdsSvc := func(modifyFn func(*mockRdsClient)) *mockRdsClient {
+		return buildMockRdsClient(func(rdsClient *mockRdsClient) {
+			rdsClient.describeAccountAttributesFn = func(*rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
+				return &rds.DescribeAccountAttributesOutput{AccountQuotas: []*rds.AccountQuota{
+					{AccountQuotaName: aws.String(rdsInstanceQuotaName), Used: aws.Int64(1), Max: aws.Int64(40)},
+				}}, nil
+			}
+			rdsClient.describeDBSubnetGroupsFn = func(*rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
+				return &rds.DescribeDBSubnetGroupsOutput{DBSubnetGroups: []*rds.DBSubnetGroup{
+					{Subnets: []*rds.Subnet{{SubnetIdentifier: aws.String("subnet-1")}, {SubnetIdentifier: aws.String("subnet-2")}}},
+				}}, nil
+			}
+			if modifyFn != nil {
+				modifyFn(rdsClient)
+			}
+		})
+	}
+	buildEc2Svc := func(free int64) *mockEc2Client {
+		return buildMockEc2Client(func(ec2Client *mockEc2Client) {
+			ec2Client.describeSubnetsFn = func(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+				return &ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{{AvailableIpAddressCount: aws.Int64(free)}}}, nil
+			}
+		})
+	}
+	tests := []struct {
+		name       string
+		iamSvc     *mockIamClient
+		tags       map[string]string
+		rdsSvc     *mockRdsClient
+		ec2Svc     *mockEc2Client
+		wantStatus map[string]metav1.ConditionStatus
+	}{
+		{
+			name:   "test every check passes",
+			iamSvc: &mockIamClient{},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType:     metav1.ConditionTrue,
+				resources.PreflightQuotaConditionType:           metav1.ConditionTrue,
+				resources.PreflightAvailabilityConditionType:    metav1.ConditionTrue,
+				resources.PreflightNetworkCapacityConditionType: metav1.ConditionTrue,
+			},
+		},
+		{
+			name:   "test denied action fails the permissions check",
+			iamSvc: &mockIamClient{deniedActions: []string{"rds:CreateDBInstance"}},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionFalse,
+			},
+		},
+		{
+			name:   "test create tagged with the cluster id passes the permissions check of the postgres credential policy",
+			iamSvc: &mockIamClient{statements: buildResourceEntries(providers.PostgresResourceType, defaultInfraName, nil, nil)},
+			tags:   map[string]string{clusterIDTagKey: defaultInfraName},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionTrue,
+			},
+		},
+		{
+			name:   "test create tagged with another cluster id fails the permissions check of the postgres credential policy",
+			iamSvc: &mockIamClient{statements: buildResourceEntries(providers.PostgresResourceType, defaultInfraName, nil, nil)},
+			tags:   map[string]string{clusterIDTagKey: "other-cluster"},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionFalse,
+			},
+		},
+		{
+			name:   "test create without the cluster id tag leaves the permissions check of the postgres credential policy unknown",
+			iamSvc: &mockIamClient{statements: buildResourceEntries(providers.PostgresResourceType, defaultInfraName, nil, nil)},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionUnknown,
+			},
+		},
+		{
+			name:   "test exhausted instance quota fails the quota check",
+			iamSvc: &mockIamClient{},
+			rdsSvc: buildRdsSvc(func(rdsClient *mockRdsClient) {
+				rdsClient.describeAccountAttributesFn = func(*rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
+					return &rds.DescribeAccountAttributesOutput{AccountQuotas: []*rds.AccountQuota{
+						{AccountQuotaName: aws.String(rdsInstanceQuotaName), Used: aws.Int64(40), Max: aws.Int64(40)},
+					}}, nil
+				}
+			}),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightQuotaConditionType: metav1.ConditionFalse,
+			},
+		},
+		{
+			name:   "test quota which can not be read is unknown",
+			iamSvc: &mockIamClient{},
+			rdsSvc: buildRdsSvc(func(rdsClient *mockRdsClient) {
+				rdsClient.describeAccountAttributesFn = func(*rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
+					return nil, errors.New("access denied")
+				}
+			}),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightQuotaConditionType: metav1.ConditionUnknown,
+			},
+		},
+		{
+			name:   "test instance class which is not offered fails the availability check",
+			iamSvc: &mockIamClient{},
+			rdsSvc: buildRdsSvc(func(rdsClient *mockRdsClient) {
+				rdsClient.describeOrderableDBInstanceOptsFn = func(*rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+					return &rds.DescribeOrderableDBInstanceOptionsOutput{}, nil
+				}
+			}),
+			ec2Svc: buildEc2Svc(10),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightAvailabilityConditionType: metav1.ConditionFalse,
+			},
+		},
+		{
+			name:   "test subnets without free addresses for a multi az instance fail the network capacity check",
+			iamSvc: &mockIamClient{},
+			rdsSvc: buildRdsSvc(nil),
+			ec2Svc: buildEc2Svc(1),
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightNetworkCapacityConditionType: metav1.ConditionFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := *rdsCfg
+			for key, value := range tt.tags {
+				cfg.Tags = append(cfg.Tags, &rds.Tag{Key: aws.String(key), Value: aws.String(value)})
+			}
+			preflight := preflightRDSInstance(tt.iamSvc, &mockStsClient{}, tt.rdsSvc, tt.ec2Svc, &cfg)
+			for checkType, want := range tt.wantStatus {
+				check := findPreflightCheck(preflight, checkType)
+				if check == nil || check.Status != want {
+					t.Errorf("preflightRDSInstance() %s = %v, want status %s", checkType, check, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckElasticacheEngineAvailability(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    metav1.ConditionStatus
+	}{
+		{
+			name:    "test major version is matched by its patch versions",
+			version: "7.0",
+			want:    metav1.ConditionTrue,
+		},
+		{
+			name:    "test version which is not offered fails",
+			version: "5.0",
+			want:    metav1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cacheSvc := buildMockElasticacheClient(func(cacheClient *mockElasticacheClient) {
+				cacheClient.describeCacheEngineVersFn = func(*elasticache.DescribeCacheEngineVersionsInput) (*elasticache.DescribeCacheEngineVersionsOutput, error) {
+					return &elasticache.DescribeCacheEngineVersionsOutput{CacheEngineVersions: []*elasticache.CacheEngineVersion{
+						{EngineVersion: aws.String("6.2.6")},
+						{EngineVersion: aws.String("7.0.7")},
+					}}, nil
+				}
+			})
+			preflight := &resources.Preflight{}
+			checkElasticacheEngineAvailability(preflight, cacheSvc, &elasticache.CreateReplicationGroupInput{EngineVersion: aws.String(tt.version)})
+			check := findPreflightCheck(preflight, resources.PreflightAvailabilityConditionType)
+			if check == nil || check.Status != tt.want {
+				t.Errorf("checkElasticacheEngineAvailability() = %v, want status %s", check, tt.want)
+			}
+		})
+	}
+}
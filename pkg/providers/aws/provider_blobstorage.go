@@ -7,7 +7,6 @@ import (
 	"time"
 
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
-	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -20,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 
@@ -42,6 +42,8 @@ const (
 	DetailsBlobStorageBucketRegion        = "bucketRegion"
 	DetailsBlobStorageCredentialKeyID     = "credentialKeyID" // #nosec G101 -- false positive (ref: https://securego.io/docs/rules/g101.html)
 	DetailsBlobStorageCredentialSecretKey = "credentialSecretKey"
+	DetailsBlobStorageRoleArn             = "roleArn"
+	DetailsBlobStorageWebIdentityToken    = "webIdentityTokenFile"
 	defaultForceBucketDeletion            = false
 
 	// bucket accessibility defaults
@@ -60,15 +62,23 @@ type BlobStorageDeploymentDetails struct {
 	BucketRegion        string
 	CredentialKeyID     string
 	CredentialSecretKey string
+	// RoleArn and WebIdentityTokenFile replace the static credentials on sts clusters
+	RoleArn              string
+	WebIdentityTokenFile string
 }
 
 func (d *BlobStorageDeploymentDetails) Data() map[string][]byte {
-	return map[string][]byte{
+	data := map[string][]byte{
 		DetailsBlobStorageBucketName:          []byte(d.BucketName),
 		DetailsBlobStorageBucketRegion:        []byte(d.BucketRegion),
 		DetailsBlobStorageCredentialKeyID:     []byte(d.CredentialKeyID),
 		DetailsBlobStorageCredentialSecretKey: []byte(d.CredentialSecretKey),
 	}
+	if d.RoleArn != "" {
+		data[DetailsBlobStorageRoleArn] = []byte(d.RoleArn)
+		data[DetailsBlobStorageWebIdentityToken] = []byte(d.WebIdentityTokenFile)
+	}
+	return data
 }
 
 var _ providers.BlobStorageProvider = (*BlobStorageProvider)(nil)
@@ -79,9 +89,11 @@ type BlobStorageProvider struct {
 	Logger            *logrus.Entry
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
+	TCPPinger         resources.ConnectionTester
+	Recorder          record.EventRecorder
 }
 
-func NewAWSBlobStorageProvider(client client.Client, logger *logrus.Entry) (*BlobStorageProvider, error) {
+func NewAWSBlobStorageProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*BlobStorageProvider, error) {
 	cm, err := NewCredentialManager(client)
 	if err != nil {
 		return nil, err
@@ -91,6 +103,8 @@ func NewAWSBlobStorageProvider(client client.Client, logger *logrus.Entry) (*Blo
 		Logger:            logger.WithFields(logrus.Fields{"provider": blobstorageProviderName}),
 		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigMapConfigManager(client),
+		TCPPinger:         resources.NewConnectionTestManager(),
+		Recorder:          recorder,
 	}, nil
 }
 
@@ -109,6 +123,14 @@ func (p *BlobStorageProvider) GetReconcileTime(bs *v1alpha1.BlobStorage) time.Du
 	return resources.GetForcedReconcileTimeOrDefault(defaultReconcileTime)
 }
 
+// S3CreateStrat custom s3 create strat, read from the same create strategy as the s3 create bucket input
+type S3CreateStrat struct {
+	_ struct{} `type:"structure"`
+
+	// RequestMetrics adds a request metrics configuration to the bucket, cloudwatch request metrics are charged by aws
+	RequestMetrics *bool `json:"requestMetrics"`
+}
+
 // S3DeleteStrat custom s3 delete strat
 type S3DeleteStrat struct {
 	_ struct{} `type:"structure"`
@@ -133,9 +155,10 @@ func (p *BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.Bl
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
 	p.Logger.Infof("creating provider credentials for creating s3 buckets, in namespace %s", bs.Namespace)
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace, providers.BlobStorageResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile aws blob storage provider credentials for blob storage instance %s", bs.Name)
+		resources.RecordWarningEvent(p.Recorder, bs, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
@@ -148,9 +171,15 @@ func (p *BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.Bl
 	}
 	s3Client := s3.New(sess)
 
+	bucketCreateStrat := &S3CreateStrat{}
+	if err = json.Unmarshal(stratCfg.CreateStrategy, bucketCreateStrat); err != nil {
+		errMsg := "failed to unmarshal aws s3 create strat configuration"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
 	// create bucket if it doesn't already exist, if it does exist then use the existing bucket
 	p.Logger.Infof("reconciling aws s3 bucket %s", *bucketCreateCfg.Bucket)
-	msg, err := p.reconcileBucketCreate(ctx, bs, s3Client, bucketCreateCfg)
+	msg, err := p.reconcileBucketCreate(ctx, bs, s3Client, bucketCreateCfg, aws.BoolValue(bucketCreateStrat.RequestMetrics))
 	if err != nil {
 		return nil, msg, errorUtil.Wrapf(err, string(msg))
 	}
@@ -158,7 +187,7 @@ func (p *BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.Bl
 	// create the credentials to be used by the end-user, whoever created the blobstorage instance
 	endUserCredsName := buildEndUserCredentialsNameFromBucket(*bucketCreateCfg.Bucket)
 	p.Logger.Infof("creating end-user credentials with name %s for managing s3 bucket %s", endUserCredsName, *bucketCreateCfg.Bucket)
-	endUserCreds, err := p.CredentialManager.ReconcileBucketOwnerCredentials(ctx, endUserCredsName, bs.Namespace, *bucketCreateCfg.Bucket)
+	endUserCreds, err := p.CredentialManager.ReconcileBucketOwnerCredentials(ctx, endUserCredsName, bs.Namespace, *bucketCreateCfg.Bucket, bs.Spec.ServiceAccounts)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile s3 end-user credentials for blob storage instance %s", bs.Name)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
@@ -168,10 +197,13 @@ func (p *BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.Bl
 	var bsi *providers.BlobStorageInstance
 	switch p.CredentialManager.(type) {
 	case *STSCredentialManager:
+		// pods assume the role of the bucket with the web identity token of their service account
 		bsi = &providers.BlobStorageInstance{
 			DeploymentDetails: &BlobStorageDeploymentDetails{
-				BucketName:   *bucketCreateCfg.Bucket,
-				BucketRegion: stratCfg.Region,
+				BucketName:           *bucketCreateCfg.Bucket,
+				BucketRegion:         stratCfg.Region,
+				RoleArn:              endUserCreds.RoleArn,
+				WebIdentityTokenFile: endUserCreds.TokenFilePath,
 			},
 		}
 	default:
@@ -235,9 +267,10 @@ func (p *BlobStorageProvider) DeleteStorage(ctx context.Context, bs *v1alpha1.Bl
 
 	// get provider aws creds so the bucket can be deleted
 	p.Logger.Infof("creating provider credentials for creating s3 buckets, in namespace %s", bs.Namespace)
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace, providers.BlobStorageResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile aws provider credentials for blob storage instance %s", bs.Name)
+		resources.RecordWarningEvent(p.Recorder, bs, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
@@ -275,13 +308,29 @@ func (p *BlobStorageProvider) reconcileBucketDelete(ctx context.Context, bs *v1a
 		}
 	}
 
+	// buckets have no snapshots, the snapshot deletion policy keeps the bucket and its objects like the retain policy
+	if resources.IsRetained(bs.Spec.ResourceTypeSpec) || resources.IsFinalSnapshotRequired(bs.Spec.ResourceTypeSpec) {
+		if err := tagS3BucketOrphaned(s3svc, *bucketCfg.Bucket); err != nil {
+			errMsg := fmt.Sprintf("failed to tag s3 bucket %s as orphaned", *bucketCfg.Bucket)
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		resources.RecordEvent(p.Recorder, bs, resources.EventReasonRetained, "deletion policy is %s, s3 bucket %s is kept and tagged as orphaned", bs.Spec.DeletionPolicy, *bucketCfg.Bucket)
+		if err := p.removeCredsAndFinalizer(ctx, bs, s3svc, bucketCfg, bucketDeleteCfg); err != nil {
+			errMsg := fmt.Sprintf("unable to remove credential secrets and finalizer for %s", *bucketCfg.Bucket)
+			return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
+		}
+		return croType.StatusMessage(fmt.Sprintf("deletion policy is %s, s3 bucket %s kept", bs.Spec.DeletionPolicy, *bucketCfg.Bucket)), nil
+	}
+
 	bucketSize, err := getBucketSize(s3svc, bucketCfg)
 	if err != nil {
 		errMsg := fmt.Sprintf("unable to get bucket size : %s", *bucketCfg.Bucket)
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
-	if *bucketDeleteCfg.ForceBucketDeletion || bucketSize == 0 {
+	// the delete deletion policy removes the bucket with its objects regardless of the strategy
+	forceBucketDeletion := *bucketDeleteCfg.ForceBucketDeletion || bs.Spec.DeletionPolicy == croType.DeletionPolicyDelete
+	if forceBucketDeletion || bucketSize == 0 {
 		if err := emptyBucket(s3svc, bucketCfg); err != nil {
 			errMsg := fmt.Sprintf("unable to empty bucket : %q", *bucketCfg.Bucket)
 			return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
@@ -291,6 +340,8 @@ func (p *BlobStorageProvider) reconcileBucketDelete(ctx context.Context, bs *v1a
 			errMsg := fmt.Sprintf("unable to delete bucket : %s", *bucketCfg.Bucket)
 			return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 		}
+	} else {
+		resources.RecordWarningEvent(p.Recorder, bs, resources.EventReasonDeletionBlocked, "s3 bucket %s is not empty and force bucket deletion is disabled, the bucket will be retained", *bucketCfg.Bucket)
 	}
 
 	if err := p.removeCredsAndFinalizer(ctx, bs, s3svc, bucketCfg, bucketDeleteCfg); err != nil {
@@ -301,10 +352,46 @@ func (p *BlobStorageProvider) reconcileBucketDelete(ctx context.Context, bs *v1a
 	return croType.StatusEmpty, nil
 }
 
+// tagS3BucketOrphaned adds the orphaned tag to a bucket kept by the retain deletion policy, the existing tags of the
+// bucket are kept as tagging a bucket replaces all of its tags
+func tagS3BucketOrphaned(s3svc s3iface.S3API, bucket string) error {
+	orphanedTag := resources.BuildOrphanedTag()
+	tagging, err := s3svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+	if err != nil && !isAwsErrorCode(err, "NoSuchTagSet") {
+		return errorUtil.Wrapf(err, "failed to get tags of s3 bucket %s", bucket)
+	}
+	var tags []*s3.Tag
+	if tagging != nil {
+		tags = tagging.TagSet
+	}
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == orphanedTag.Key && aws.StringValue(tag.Value) == orphanedTag.Value {
+			return nil
+		}
+	}
+	tags = append(tags, &s3.Tag{
+		Key:   aws.String(orphanedTag.Key),
+		Value: aws.String(orphanedTag.Value),
+	})
+	_, err = s3svc.PutBucketTagging(&s3.PutBucketTaggingInput{
+		Bucket:  aws.String(bucket),
+		Tagging: &s3.Tagging{TagSet: tags},
+	})
+	return err
+}
+
 func (p *BlobStorageProvider) removeCredsAndFinalizer(ctx context.Context, bs *v1alpha1.BlobStorage, s3svc s3iface.S3API, bucketCfg *s3.CreateBucketInput, bucketDeleteCfg *S3DeleteStrat) error {
 	// build end user credential name
 	endUserCredsName := buildEndUserCredentialsNameFromBucket(*bucketCfg.Bucket)
 
+	// remove the role created for the bucket on sts clusters
+	if stsCredentialManager, ok := p.CredentialManager.(*STSCredentialManager); ok {
+		p.Logger.Infof("deleting end-user role %s", endUserCredsName)
+		if err := stsCredentialManager.DeleteBucketOwnerCredentials(ctx, endUserCredsName, bs.Namespace); err != nil {
+			return errorUtil.Wrapf(err, "failed to delete end-user role %s", endUserCredsName)
+		}
+	}
+
 	// remove the credentials request created by the provider
 	p.Logger.Infof("deleting end-user credential request %s in namespace %s", endUserCredsName, bs.Namespace)
 	endUserCredsReq := &v1.CredentialsRequest{
@@ -322,8 +409,7 @@ func (p *BlobStorageProvider) removeCredsAndFinalizer(ctx context.Context, bs *v
 	}
 
 	// remove the finalizer
-	resources.RemoveFinalizer(&bs.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, bs); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, bs, DefaultFinalizer); err != nil {
 		errMsg := "failed to update blob storage cr as part of finalizer reconcile"
 		return errorUtil.Wrapf(err, errMsg)
 	}
@@ -387,7 +473,7 @@ func getBucketSize(s3svc s3iface.S3API, bucketCfg *s3.CreateBucketInput) (int, e
 	return len(resp.Contents), nil
 }
 
-func (p *BlobStorageProvider) reconcileBucketCreate(ctx context.Context, bs *v1alpha1.BlobStorage, s3svc s3iface.S3API, bucketCfg *s3.CreateBucketInput) (croType.StatusMessage, error) {
+func (p *BlobStorageProvider) reconcileBucketCreate(ctx context.Context, bs *v1alpha1.BlobStorage, s3svc s3iface.S3API, bucketCfg *s3.CreateBucketInput, requestMetrics bool) (croType.StatusMessage, error) {
 	// the aws access key can sometimes still not be registered in aws on first try, so loop
 	p.Logger.Infof("listing existing aws s3 buckets")
 	buckets, err := getS3buckets(s3svc)
@@ -408,8 +494,42 @@ func (p *BlobStorageProvider) reconcileBucketCreate(ctx context.Context, bs *v1a
 
 	defer p.exposeBlobStorageMetrics(ctx, bs)
 
+	// create connection metric
+	defer p.createBucketConnectionMetric(ctx, bs, s3svc, aws.StringValue(bucketCfg.Bucket))
+
 	if foundBucket != nil {
-		if err = reconcileS3BucketSettings(aws.StringValue(foundBucket.Name), s3svc); err != nil {
+		// compare the bucket against the strategy before the bucket settings and tags are applied again
+		expectedTags, err := p.getDefaultS3Tags(ctx, bs)
+		if err != nil {
+			errMsg := "failed to build default tags"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		// take over an existing bucket the first time it is found, the operator tags are applied once the bucket is
+		// reconciled
+		if resources.IsAdopted(bs.Spec.ResourceTypeSpec) && !resources.HasResourceIdentifier(ctx, bs) {
+			currentTags, err := getS3BucketTags(s3svc, *foundBucket.Name)
+			if err != nil {
+				errMsg := fmt.Sprintf("failed to get tags of s3 bucket %s", *foundBucket.Name)
+				return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+			}
+			if err = resources.ValidateAdoption(s3TagsToMap(expectedTags), currentTags, resources.AdoptionOwnershipTagKeys()); err != nil {
+				errMsg := fmt.Sprintf("s3 bucket %s can not be adopted", *foundBucket.Name)
+				return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+			}
+			resources.RecordEvent(p.Recorder, bs, resources.EventReasonAdopted, "adopted existing s3 bucket %s", *foundBucket.Name)
+			if err := resources.SetResourceIdentifier(ctx, p.Client, bs, *foundBucket.Name); err != nil {
+				errMsg := "failed to add annotation"
+				return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
+			}
+		}
+		drift, err := buildS3BucketDrift(s3svc, aws.StringValue(foundBucket.Name), expectedTags)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to detect drift of s3 bucket %s", *foundBucket.Name)
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		resources.ReportDrift(ctx, p.Client, p.Recorder, bs, &bs.Status, drift, string(providers.BlobStorageResourceType), *foundBucket.Name, blobstorageProviderName)
+
+		if err = reconcileS3BucketSettings(aws.StringValue(foundBucket.Name), s3svc, requestMetrics); err != nil {
 			errMsg := fmt.Sprintf("failed to set s3 bucket settings %s", *foundBucket.Name)
 			return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 		}
@@ -417,12 +537,18 @@ func (p *BlobStorageProvider) reconcileBucketCreate(ctx context.Context, bs *v1a
 		return croType.StatusMessage(msg), nil
 	}
 
+	// a bucket which is adopted is never created
+	if resources.IsAdopted(bs.Spec.ResourceTypeSpec) {
+		errMsg := fmt.Sprintf("external s3 bucket %s of BlobStorage CR %s in %s namespace was not found", bs.Spec.ExternalResourceID, bs.Name, bs.Namespace)
+		return croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+
 	// foundBucket == nil at this point, so if the CR already has a resourceIdentifier
 	// annotation, then we expect it to be there. We shouldn't create it again, it will require
 	// manual intervention to restore from a backup.
-	if annotations.Has(bs, ResourceIdentifierAnnotation) {
+	if resources.HasResourceIdentifier(ctx, bs) {
 		errMsg := fmt.Sprintf("BlobStorage CR %s in %s namespace has %s annotation with value %s, but no corresponding S3 Bucket was found",
-			bs.Name, bs.Namespace, ResourceIdentifierAnnotation, bs.ObjectMeta.Annotations[ResourceIdentifierAnnotation])
+			bs.Name, bs.Namespace, ResourceIdentifierAnnotation, resources.GetResourceIdentifier(ctx, bs))
 		return croType.StatusMessage(errMsg), fmt.Errorf(errMsg)
 	}
 
@@ -433,14 +559,14 @@ func (p *BlobStorageProvider) reconcileBucketCreate(ctx context.Context, bs *v1a
 		errMsg := fmt.Sprintf("failed to create s3 bucket %s", *bucketCfg.Bucket)
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
+	resources.RecordEvent(p.Recorder, bs, resources.EventReasonCreateStarted, "created s3 bucket %s", *bucketCfg.Bucket)
 
-	annotations.Add(bs, ResourceIdentifierAnnotation, *bucketCfg.Bucket)
-	if err := p.Client.Update(ctx, bs); err != nil {
+	if err := resources.SetResourceIdentifier(ctx, p.Client, bs, *bucketCfg.Bucket); err != nil {
 		errMsg := "failed to add annotation"
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
-	if err = reconcileS3BucketSettings(aws.StringValue(bucketCfg.Bucket), s3svc); err != nil {
+	if err = reconcileS3BucketSettings(aws.StringValue(bucketCfg.Bucket), s3svc, requestMetrics); err != nil {
 		errMsg := fmt.Sprintf("failed to set s3 bucket settings on bucket creation %s", aws.StringValue(bucketCfg.Bucket))
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
@@ -465,7 +591,7 @@ func getS3buckets(s3svc s3iface.S3API) ([]*s3.Bucket, error) {
 	return existingBuckets, nil
 }
 
-func reconcileS3BucketSettings(bucket string, s3svc s3iface.S3API) error {
+func reconcileS3BucketSettings(bucket string, s3svc s3iface.S3API, requestMetrics bool) error {
 	_, err := s3svc.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{
 		Bucket: aws.String(bucket),
 		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
@@ -493,9 +619,105 @@ func reconcileS3BucketSettings(bucket string, s3svc s3iface.S3API) error {
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to set encryption settings on bucket %s", bucket)
 	}
+	if !requestMetrics {
+		return nil
+	}
+	return reconcileS3RequestMetrics(bucket, s3svc)
+}
+
+// reconcileS3RequestMetrics adds a request metrics configuration for the whole bucket, request metrics are only
+// reported to cloudwatch for buckets with a request metrics configuration
+func reconcileS3RequestMetrics(bucket string, s3svc s3iface.S3API) error {
+	metricsOutput, err := s3svc.GetBucketMetricsConfiguration(&s3.GetBucketMetricsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(s3RequestMetricsFilterID),
+	})
+	if err != nil && !isAwsErrorCode(err, "NoSuchConfiguration") {
+		return errorUtil.Wrapf(err, "failed to get request metrics settings of bucket %s", bucket)
+	}
+	if err == nil && metricsOutput.MetricsConfiguration != nil && metricsOutput.MetricsConfiguration.Filter == nil {
+		return nil
+	}
+	_, err = s3svc.PutBucketMetricsConfiguration(&s3.PutBucketMetricsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(s3RequestMetricsFilterID),
+		MetricsConfiguration: &s3.MetricsConfiguration{
+			Id: aws.String(s3RequestMetricsFilterID),
+		},
+	})
+	if err != nil {
+		return errorUtil.Wrapf(err, "failed to set request metrics settings on bucket %s", bucket)
+	}
 	return nil
 }
 
+// buildS3BucketDrift compares the client access, encryption and tags of the bucket against the settings applied by
+// reconcileS3BucketSettings and the default tags
+func buildS3BucketDrift(s3svc s3iface.S3API, bucket string, expectedTags []*s3.Tag) (*resources.Drift, error) {
+	drift := &resources.Drift{}
+
+	publicAccessBlock := &s3.PublicAccessBlockConfiguration{}
+	publicAccessBlockOutput, err := s3svc.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{Bucket: aws.String(bucket)})
+	if err != nil && !isAwsErrorCode(err, "NoSuchPublicAccessBlockConfiguration") {
+		return nil, errorUtil.Wrapf(err, "failed to get client access settings of bucket %s", bucket)
+	}
+	if err == nil && publicAccessBlockOutput.PublicAccessBlockConfiguration != nil {
+		publicAccessBlock = publicAccessBlockOutput.PublicAccessBlockConfiguration
+	}
+	drift.Compare("BlockPublicAcls", aws.BoolValue(publicAccessBlock.BlockPublicAcls), defaultBlockPublicAcls)
+	drift.Compare("BlockPublicPolicy", aws.BoolValue(publicAccessBlock.BlockPublicPolicy), defaultBlockPublicPolicy)
+	drift.Compare("IgnorePublicAcls", aws.BoolValue(publicAccessBlock.IgnorePublicAcls), defaultIgnorePublicAcls)
+	drift.Compare("RestrictPublicBuckets", aws.BoolValue(publicAccessBlock.RestrictPublicBuckets), defaultRestrictPublicBuckets)
+
+	var sseAlgorithm string
+	encryptionOutput, err := s3svc.GetBucketEncryption(&s3.GetBucketEncryptionInput{Bucket: aws.String(bucket)})
+	if err != nil && !isAwsErrorCode(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return nil, errorUtil.Wrapf(err, "failed to get encryption settings of bucket %s", bucket)
+	}
+	if err == nil && encryptionOutput.ServerSideEncryptionConfiguration != nil {
+		for _, rule := range encryptionOutput.ServerSideEncryptionConfiguration.Rules {
+			if rule.ApplyServerSideEncryptionByDefault != nil {
+				sseAlgorithm = aws.StringValue(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm)
+			}
+		}
+	}
+	drift.Compare("SSEAlgorithm", sseAlgorithm, defaultEncryptionSSEAlgorithm)
+
+	currentTags, err := getS3BucketTags(s3svc, bucket)
+	if err != nil {
+		return nil, err
+	}
+	drift.CompareTags("Tags", currentTags, s3TagsToMap(expectedTags))
+	return drift, nil
+}
+
+// getS3BucketTags returns the tags of a bucket as a map of key to value, a bucket without tags has an empty map
+func getS3BucketTags(s3svc s3iface.S3API, bucket string) (map[string]string, error) {
+	taggingOutput, err := s3svc.GetBucketTagging(&s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+	if err != nil && !isAwsErrorCode(err, "NoSuchTagSet") {
+		return nil, errorUtil.Wrapf(err, "failed to get tags of bucket %s", bucket)
+	}
+	if err != nil {
+		return map[string]string{}, nil
+	}
+	return s3TagsToMap(taggingOutput.TagSet), nil
+}
+
+// s3TagsToMap returns s3 tags as a map of key to value
+func s3TagsToMap(tags []*s3.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+// isAwsErrorCode returns true if err is an aws error with the code
+func isAwsErrorCode(err error, code string) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == code
+}
+
 func (p *BlobStorageProvider) buildS3BucketConfig(ctx context.Context, bs *v1alpha1.BlobStorage) (*s3.CreateBucketInput, *S3DeleteStrat, *StrategyConfig, error) {
 	// info about the bucket to be created
 	p.Logger.Infof("getting aws s3 bucket config for blob storage instance %s", bs.Name)
@@ -506,13 +728,11 @@ func (p *BlobStorageProvider) buildS3BucketConfig(ctx context.Context, bs *v1alp
 
 	// cluster infra info
 	p.Logger.Info("getting cluster id from infrastructure for bucket naming")
-	bucketName, err := resources.BuildInfraNameFromObject(ctx, p.Client, bs.ObjectMeta, defaultAwsBucketNameLength)
+	bucketName, err := buildS3BucketName(ctx, p.Client, bs, bucketCreateCfg)
 	if err != nil {
 		return nil, nil, nil, errorUtil.Wrapf(err, fmt.Sprintf("failed to retrieve aws s3 bucket config for blob storage instance %s", bs.Name))
 	}
-	if bucketCreateCfg.Bucket == nil {
-		bucketCreateCfg.Bucket = aws.String(bucketName)
-	}
+	bucketCreateCfg.Bucket = aws.String(bucketName)
 
 	if bucketDeleteCfg.ForceBucketDeletion == nil {
 		bucketDeleteCfg.ForceBucketDeletion = aws.Bool(defaultForceBucketDeletion)
@@ -521,6 +741,15 @@ func (p *BlobStorageProvider) buildS3BucketConfig(ctx context.Context, bs *v1alp
 	return bucketCreateCfg, bucketDeleteCfg, stratCfg, nil
 }
 
+// buildS3BucketName returns the name of the bucket of a blob storage cr, the bucket name set in the strategy is used
+// unless the cr adopts an existing bucket, which is always identified by the external resource id of the cr
+func buildS3BucketName(ctx context.Context, c client.Client, bs *v1alpha1.BlobStorage, bucketCreateCfg *s3.CreateBucketInput) (string, error) {
+	if bucketCreateCfg.Bucket != nil && !resources.IsAdopted(bs.Spec.ResourceTypeSpec) {
+		return aws.StringValue(bucketCreateCfg.Bucket), nil
+	}
+	return resources.BuildResourceIdentifier(ctx, c, bs.ObjectMeta, bs.Spec.ResourceTypeSpec, defaultAwsBucketNameLength)
+}
+
 func (p *BlobStorageProvider) getS3BucketConfig(ctx context.Context, bs *v1alpha1.BlobStorage) (*s3.CreateBucketInput, *S3DeleteStrat, *StrategyConfig, error) {
 	stratCfg, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.BlobStorageResourceType, bs.Spec.Tier)
 	if err != nil {
@@ -569,7 +798,7 @@ func buildBlobStorageStatusMetricLabels(cr *v1alpha1.BlobStorage, clusterID, buc
 
 func (p *BlobStorageProvider) exposeBlobStorageMetrics(ctx context.Context, cr *v1alpha1.BlobStorage) {
 	// build instance name
-	bucketName, err := resources.BuildInfraNameFromObject(ctx, p.Client, cr.ObjectMeta, defaultAwsBucketNameLength)
+	bucketName, err := resources.BuildResourceIdentifier(ctx, p.Client, cr.ObjectMeta, cr.Spec.ResourceTypeSpec, defaultAwsBucketNameLength)
 	if err != nil {
 		logrus.Errorf("error occurred while building instance name during blob storage metrics: %v", err)
 	}
@@ -592,3 +821,44 @@ func (p *BlobStorageProvider) exposeBlobStorageMetrics(ctx context.Context, cr *
 		resources.SetMetric(resources.DefaultBlobStorageStatusMetricName, labelsFailed, resources.Btof64(cr.Status.Phase == phase))
 	}
 }
+
+// tests to see if a HEAD request can be made to the s3 bucket and creates a metric based on this
+func (p *BlobStorageProvider) createBucketConnectionMetric(ctx context.Context, cr *v1alpha1.BlobStorage, s3svc s3iface.S3API, bucketName string) {
+	logrus.Infof("testing and exposing blob storage connection metric for: %s", bucketName)
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		logrus.Errorf("failed to get cluster id while exposing connection metric for %v", bucketName)
+	}
+
+	genericLabels := resources.BuildGenericMetricLabels(cr.ObjectMeta, clusterID, bucketName, blobstorageProviderName)
+
+	result := p.TCPPinger.BlobStorageConnection(ctx, bucketName, func(ctx context.Context) error {
+		_, err := s3svc.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucketName)})
+		return classifyHeadBucketError(err)
+	})
+	resources.SetConnectionProbeMetrics(resources.ConnectionProbeResourceTypeBlobStorage, genericLabels, result)
+	if !result.Success {
+		// create failed connection metric
+		resources.SetMetric(resources.DefaultBlobStorageConnectionMetricName, genericLabels, 0)
+		return
+	}
+	// create successful connection metric
+	resources.SetMetric(resources.DefaultBlobStorageConnectionMetricName, genericLabels, 1)
+}
+
+// classifyHeadBucketError sets the connection probe failure reason for s3 errors
+// HEAD responses have no body, so only the status code is available from the error
+func classifyHeadBucketError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if reqErr, ok := err.(awserr.RequestFailure); ok {
+		switch reqErr.StatusCode() {
+		case 404:
+			return resources.NewConnectionProbeError(resources.ConnectionFailureReasonNotFound, err)
+		case 401, 403:
+			return resources.NewConnectionProbeError(resources.ConnectionFailureReasonAuth, err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,204 @@
+// blob storage metric provider scrapes metrics for a single blob storage (s3) bucket
+//
+// we are required to gather data from s3 buckets which can be used in alerts, to detect runaway
+// storage growth and failing requests
+//
+// this providers does
+//   - scrape metric data from cloudwatch
+//   - build a generic cloud metric data type from cloudwatch data
+//   - return generic cloud metric data to metric controller to be exposed
+//
+// this provider does not
+//   - expose the metrics, this is controller at a higher level (controller)
+package aws
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	blobStorageMetricProviderName = "aws s3 metrics provider"
+	cloudWatchS3BucketDimension   = "BucketName"
+	cloudWatchS3StorageDimension  = "StorageType"
+	cloudWatchS3FilterDimension   = "FilterId"
+
+	// s3RequestMetricsFilterID the id of the request metrics configuration which is added to buckets with the
+	// requestMetrics create strategy, request metrics are only reported by cloudwatch for buckets with a request metrics
+	// configuration
+	s3RequestMetricsFilterID = "EntireBucket"
+)
+
+// s3StorageMetricTypes maps the daily s3 storage metrics to the storage type they are reported for
+// any other s3 metric is a request metric, which is reported for the request metrics configuration
+var s3StorageMetricTypes = map[string]string{
+	"BucketSizeBytes": "StandardStorage",
+	"NumberOfObjects": "AllStorageTypes",
+}
+
+var _ providers.BlobStorageMetricsProvider = (*BlobStorageMetricsProvider)(nil)
+
+type BlobStorageMetricsProvider struct {
+	Client            client.Client
+	Logger            *logrus.Entry
+	CredentialManager CredentialManager
+	ConfigManager     ConfigManager
+}
+
+func NewAWSBlobStorageMetricsProvider(client client.Client, logger *logrus.Entry) (*BlobStorageMetricsProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobStorageMetricsProvider{
+		Client:            client,
+		Logger:            logger.WithFields(logrus.Fields{"providers": blobStorageMetricProviderName}),
+		CredentialManager: cm,
+		ConfigManager:     NewDefaultConfigMapConfigManager(client),
+	}, nil
+}
+
+func (p *BlobStorageMetricsProvider) SupportsStrategy(strategy string) bool {
+	return strategy == providers.AWSDeploymentStrategy
+}
+
+// ScrapeBlobStorageMetrics returns scraped metrics to metric controller
+func (p *BlobStorageMetricsProvider) ScrapeBlobStorageMetrics(ctx context.Context, blobStorage *v1alpha1.BlobStorage, metricTypes []providers.CloudProviderMetricType) (*providers.ScrapeMetricsData, error) {
+	logger := resources.NewActionLoggerWithFields(p.Logger, map[string]interface{}{
+		resources.LoggingKeyAction: "ScrapeMetrics",
+		"Resource":                 blobStorage.Name,
+	})
+	logger.Infof("reconciling blob storage metrics %s", blobStorage.Name)
+
+	// read storage strategy for blob storage instance
+	// this is required to create the correct credentials for aws
+	blobStorageStrategyConfig, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.BlobStorageResourceType, blobStorage.Spec.Tier)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to read blob storage aws strategy config")
+	}
+
+	// reconcile aws credentials (keys)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, blobStorage.Namespace, providers.BlobStorageResourceType)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to reconcile s3 credentials")
+	}
+
+	// create a session from blob storage strategy (region) and reconciled aws keys
+	// s3 metrics are reported to cloudwatch in the region of the bucket
+	sess, err := CreateSessionFromStrategy(ctx, p.Client, providerCreds, blobStorageStrategyConfig)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to create aws session to scrape s3 cloud watch metrics")
+	}
+
+	// scrape metric data from cloud watch
+	cloudMetrics, err := p.scrapeS3CloudWatchMetricData(ctx, cloudwatch.New(sess), blobStorage, metricTypes)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to scrape s3 cloud watch metrics")
+	}
+
+	return &providers.ScrapeMetricsData{
+		Metrics: cloudMetrics,
+	}, nil
+}
+
+// scrapeS3CloudWatchMetricData fetches cloud watch metrics for s3
+// and parses it to a GenericCloudMetric in order to return to the controller
+func (p *BlobStorageMetricsProvider) scrapeS3CloudWatchMetricData(ctx context.Context, cloudWatchApi cloudwatchiface.CloudWatchAPI, blobStorage *v1alpha1.BlobStorage, metricTypes []providers.CloudProviderMetricType) ([]*providers.GenericCloudMetric, error) {
+	bucketName, err := resources.BuildResourceIdentifier(ctx, p.Client, blobStorage.ObjectMeta, blobStorage.Spec.ResourceTypeSpec, defaultAwsBucketNameLength)
+	if err != nil {
+		return nil, errorUtil.Errorf("error occurred building bucket name: %v", err)
+	}
+
+	logger := resources.NewActionLogger(p.Logger, "scrapeS3CloudWatchMetricData")
+	logger.Infof("scraping s3 bucket %s cloud watch metrics", bucketName)
+	metricOutput, err := cloudWatchApi.GetMetricData(&cloudwatch.GetMetricDataInput{
+		// build metric data query array from `metricTypes`
+		MetricDataQueries: buildS3MetricDataQuery(metricTypes, bucketName),
+		// s3 storage metrics are reported once a day, some time after the day they are reported for
+		// two full periods are requested to ensure the most recent data point is included
+		StartTime: aws.Time(time.Now().Add(-2 * getMetricDataWindow(metricTypes))),
+		EndTime:   aws.Time(time.Now()),
+		ScanBy:    aws.String(cloudwatch.ScanByTimestampDescending),
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "error getting metric for s3")
+	}
+
+	// get cluster if for use in metric labels
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "error getting clusterID")
+	}
+
+	// ensure metric data results are not nil
+	if len(metricOutput.MetricDataResults) == 0 {
+		return nil, errorUtil.New("no metric data returned from s3 cloudwatch")
+	}
+
+	logger.Infof("parsing s3 cloud watch metrics for blob storage %s", bucketName)
+	// parse the returned data from the cloudwatch to a GenericCloudMetric
+	var metrics []*providers.GenericCloudMetric
+	for _, metricData := range metricOutput.MetricDataResults {
+		// status code complete ensures all metrics have been successful
+		if aws.StringValue(metricData.StatusCode) != cloudwatch.StatusCodeComplete {
+			continue
+		}
+		// values are ordered by timestamp descending, only the most recent value is exposed
+		if len(metricData.Values) == 0 {
+			logger.Infof("no data points returned for s3 metric %s", aws.StringValue(metricData.Id))
+			continue
+		}
+		metrics = append(metrics, &providers.GenericCloudMetric{
+			Name:   aws.StringValue(metricData.Id),
+			Labels: resources.BuildGenericMetricLabels(blobStorage.ObjectMeta, clusterID, bucketName, blobstorageProviderName),
+			Value:  aws.Float64Value(metricData.Values[0]),
+		})
+	}
+	return metrics, nil
+}
+
+// buildS3MetricDataQuery builds an aws query from wanted s3 metric types
+func buildS3MetricDataQuery(metricTypes []providers.CloudProviderMetricType, bucketName string) []*cloudwatch.MetricDataQuery {
+	var metricDataQueries []*cloudwatch.MetricDataQuery
+	for _, metricType := range metricTypes {
+		dimension := &cloudwatch.Dimension{
+			Name:  aws.String(cloudWatchS3FilterDimension),
+			Value: aws.String(s3RequestMetricsFilterID),
+		}
+		if storageType, ok := s3StorageMetricTypes[metricType.ProviderMetricName]; ok {
+			dimension = &cloudwatch.Dimension{
+				Name:  aws.String(cloudWatchS3StorageDimension),
+				Value: aws.String(storageType),
+			}
+		}
+		metricDataQueries = append(metricDataQueries, &cloudwatch.MetricDataQuery{
+			Id: aws.String(metricType.PrometheusMetricName),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					MetricName: aws.String(metricType.ProviderMetricName),
+					Namespace:  aws.String("AWS/S3"),
+					Dimensions: []*cloudwatch.Dimension{
+						{
+							Name:  aws.String(cloudWatchS3BucketDimension),
+							Value: aws.String(bucketName),
+						},
+						dimension,
+					},
+				},
+				Stat:   aws.String(metricType.Statistic),
+				Period: aws.Int64(int64(metricType.GetPeriodOrDefault(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration)).Seconds())),
+			},
+		})
+	}
+	return metricDataQueries
+}
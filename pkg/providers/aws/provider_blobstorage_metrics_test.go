@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/moq/moq_aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestBlobStorageMetricsProvider_scrapeS3CloudWatchMetricData(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name          string
+		client        client.Client
+		cloudWatchApi cloudwatchiface.CloudWatchAPI
+		want          map[string]float64
+		wantErr       bool
+	}{
+		{
+			name:   "test most recent value is returned for each s3 metric",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			cloudWatchApi: moq_aws.BuildMockCloudWatchClient(func(watchClient *moq_aws.MockCloudWatchClient) {
+				watchClient.GetMetricDataFn = func(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					return &cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							moq_aws.BuildMockMetricDataResult(func(result *cloudwatch.MetricDataResult) {
+								result.Id = aws.String(resources.BlobStorageSizeBytesAverageMetricName)
+								result.Values = []*float64{aws.Float64(2048), aws.Float64(1024)}
+							}),
+							moq_aws.BuildMockMetricDataResult(func(result *cloudwatch.MetricDataResult) {
+								result.Id = aws.String(resources.BlobStorageRequestsSumMetricName)
+								result.Values = []*float64{aws.Float64(42)}
+							}),
+						},
+					}, nil
+				}
+			}),
+			want: map[string]float64{
+				resources.BlobStorageSizeBytesAverageMetricName: 2048,
+				resources.BlobStorageRequestsSumMetricName:      42,
+			},
+		},
+		{
+			name:   "test metrics without data points or incomplete are skipped",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			cloudWatchApi: moq_aws.BuildMockCloudWatchClient(func(watchClient *moq_aws.MockCloudWatchClient) {
+				watchClient.GetMetricDataFn = func(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					return &cloudwatch.GetMetricDataOutput{
+						MetricDataResults: []*cloudwatch.MetricDataResult{
+							moq_aws.BuildMockMetricDataResult(func(result *cloudwatch.MetricDataResult) {
+								result.Id = aws.String(resources.BlobStorageSizeBytesAverageMetricName)
+							}),
+							moq_aws.BuildMockMetricDataResult(func(result *cloudwatch.MetricDataResult) {
+								result.Id = aws.String(resources.BlobStorageRequestsSumMetricName)
+								result.StatusCode = aws.String(cloudwatch.StatusCodeInternalError)
+								result.Values = []*float64{aws.Float64(42)}
+							}),
+						},
+					}, nil
+				}
+			}),
+			want: map[string]float64{},
+		},
+		{
+			name:   "test error when cloudwatch returns no metric data",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			cloudWatchApi: moq_aws.BuildMockCloudWatchClient(func(watchClient *moq_aws.MockCloudWatchClient) {
+				watchClient.GetMetricDataFn = func(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					return &cloudwatch.GetMetricDataOutput{}, nil
+				}
+			}),
+			wantErr: true,
+		},
+		{
+			name:   "test error when cloudwatch request fails",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+			cloudWatchApi: moq_aws.BuildMockCloudWatchClient(func(watchClient *moq_aws.MockCloudWatchClient) {
+				watchClient.GetMetricDataFn = func(input *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+					return nil, errors.New("cloudwatch error")
+				}
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &BlobStorageMetricsProvider{
+				Client: tt.client,
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			}
+			got, err := p.scrapeS3CloudWatchMetricData(context.TODO(), tt.cloudWatchApi, buildTestBlobStorageCR(), []providers.CloudProviderMetricType{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scrapeS3CloudWatchMetricData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("scrapeS3CloudWatchMetricData() got %d metrics, want %d", len(got), len(tt.want))
+			}
+			for _, metric := range got {
+				if metric.Value != tt.want[metric.Name] {
+					t.Errorf("scrapeS3CloudWatchMetricData() metric %s = %v, want %v", metric.Name, metric.Value, tt.want[metric.Name])
+				}
+				if metric.Labels[resources.LabelStrategyKey] != blobstorageProviderName {
+					t.Errorf("scrapeS3CloudWatchMetricData() metric %s has strategy label %s, want %s", metric.Name, metric.Labels[resources.LabelStrategyKey], blobstorageProviderName)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildS3MetricDataQuery(t *testing.T) {
+	queries := buildS3MetricDataQuery([]providers.CloudProviderMetricType{
+		{PrometheusMetricName: resources.BlobStorageSizeBytesAverageMetricName, ProviderMetricName: "BucketSizeBytes", Statistic: cloudwatch.StatisticAverage},
+		{PrometheusMetricName: resources.BlobStorageRequestsSumMetricName, ProviderMetricName: "AllRequests", Statistic: cloudwatch.StatisticSum},
+	}, "test-bucket")
+	if len(queries) != 2 {
+		t.Fatalf("buildS3MetricDataQuery() got %d queries, want 2", len(queries))
+	}
+	want := map[string]string{
+		resources.BlobStorageSizeBytesAverageMetricName: cloudWatchS3StorageDimension + "=StandardStorage",
+		resources.BlobStorageRequestsSumMetricName:      cloudWatchS3FilterDimension + "=" + s3RequestMetricsFilterID,
+	}
+	for _, query := range queries {
+		dimensions := query.MetricStat.Metric.Dimensions
+		if len(dimensions) != 2 || aws.StringValue(dimensions[0].Value) != "test-bucket" {
+			t.Fatalf("buildS3MetricDataQuery() query %s has unexpected dimensions %v", aws.StringValue(query.Id), dimensions)
+		}
+		got := aws.StringValue(dimensions[1].Name) + "=" + aws.StringValue(dimensions[1].Value)
+		if got != want[aws.StringValue(query.Id)] {
+			t.Errorf("buildS3MetricDataQuery() query %s dimension = %s, want %s", aws.StringValue(query.Id), got, want[aws.StringValue(query.Id)])
+		}
+	}
+}
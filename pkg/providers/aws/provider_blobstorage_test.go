@@ -9,10 +9,12 @@ import (
 
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	k8sTypes "k8s.io/apimachinery/pkg/types"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	croapis "github.com/integr8ly/cloud-resource-operator/apis"
@@ -21,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +40,9 @@ type mockS3Svc struct {
 	wantErrCreate bool
 	wantErrDelete bool
 	bucketNames   []string
+	// metricsConfiguration is returned for the request metrics configuration of a bucket, nil when there is none
+	metricsConfiguration *s3.MetricsConfiguration
+	putMetricsCalls      int
 }
 
 func buildTestScheme() (*runtime.Scheme, error) {
@@ -127,6 +133,37 @@ func (s *mockS3Svc) PutBucketEncryption(*s3.PutBucketEncryptionInput) (*s3.PutBu
 	return &s3.PutBucketEncryptionOutput{}, nil
 }
 
+func (s *mockS3Svc) PutBucketMetricsConfiguration(*s3.PutBucketMetricsConfigurationInput) (*s3.PutBucketMetricsConfigurationOutput, error) {
+	s.putMetricsCalls++
+	return &s3.PutBucketMetricsConfigurationOutput{}, nil
+}
+
+func (s *mockS3Svc) GetBucketMetricsConfiguration(*s3.GetBucketMetricsConfigurationInput) (*s3.GetBucketMetricsConfigurationOutput, error) {
+	if s.metricsConfiguration == nil {
+		return nil, awserr.New("NoSuchConfiguration", "mock metrics configuration not found", nil)
+	}
+	return &s3.GetBucketMetricsConfigurationOutput{MetricsConfiguration: s.metricsConfiguration}, nil
+}
+
+func (s *mockS3Svc) GetPublicAccessBlock(*s3.GetPublicAccessBlockInput) (*s3.GetPublicAccessBlockOutput, error) {
+	return &s3.GetPublicAccessBlockOutput{
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(defaultBlockPublicAcls),
+			BlockPublicPolicy:     aws.Bool(defaultBlockPublicPolicy),
+			IgnorePublicAcls:      aws.Bool(defaultIgnorePublicAcls),
+			RestrictPublicBuckets: aws.Bool(defaultRestrictPublicBuckets),
+		},
+	}, nil
+}
+
+func (s *mockS3Svc) GetBucketEncryption(*s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error) {
+	return nil, awserr.New("ServerSideEncryptionConfigurationNotFoundError", "mock encryption not found", nil)
+}
+
+func (s *mockS3Svc) GetBucketTagging(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error) {
+	return &s3.GetBucketTaggingOutput{}, nil
+}
+
 func buildTestBlobStorageCR() *v1alpha1.BlobStorage {
 	return &v1alpha1.BlobStorage{
 		ObjectMeta: metav1.ObjectMeta{
@@ -162,7 +199,7 @@ func TestBlobStorageProvider_reconcileBucket(t *testing.T) {
 		{
 			name: "test aws s3 bucket already exists",
 			fields: fields{
-				Client:            moqClient.NewSigsClientMoqWithScheme(scheme, buildTestBlobStorageCR(), buildTestCredentialsRequest()),
+				Client:            moqClient.NewSigsClientMoqWithScheme(scheme, buildTestBlobStorageCR(), buildTestCredentialsRequest(), buildTestInfra()),
 				Logger:            logrus.WithFields(logrus.Fields{}),
 				CredentialManager: &CredentialManagerMock{},
 				ConfigManager:     &ConfigManagerMock{},
@@ -205,15 +242,61 @@ func TestBlobStorageProvider_reconcileBucket(t *testing.T) {
 				Logger:            tt.fields.Logger,
 				CredentialManager: tt.fields.CredentialManager,
 				ConfigManager:     tt.fields.ConfigManager,
+				TCPPinger:         resources.BuildMockConnectionTester(),
 			}
 			dummyBlobStorage := &v1alpha1.BlobStorage{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", ResourceVersion: fakeResourceVersion}}
-			if _, err := p.reconcileBucketCreate(tt.args.ctx, dummyBlobStorage, tt.args.s3svc, tt.args.bucketCfg); (err != nil) != tt.wantErr {
+			if _, err := p.reconcileBucketCreate(tt.args.ctx, dummyBlobStorage, tt.args.s3svc, tt.args.bucketCfg, false); (err != nil) != tt.wantErr {
 				t.Errorf("reconcileBucket() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func Test_reconcileS3BucketSettings(t *testing.T) {
+	tests := []struct {
+		name                 string
+		requestMetrics       bool
+		metricsConfiguration *s3.MetricsConfiguration
+		wantPutMetricsCalls  int
+	}{
+		{
+			name:                "test request metrics are not configured by default",
+			wantPutMetricsCalls: 0,
+		},
+		{
+			name:                "test request metrics are configured when enabled",
+			requestMetrics:      true,
+			wantPutMetricsCalls: 1,
+		},
+		{
+			name:                 "test request metrics are not configured again when already configured",
+			requestMetrics:       true,
+			metricsConfiguration: &s3.MetricsConfiguration{Id: aws.String(s3RequestMetricsFilterID)},
+			wantPutMetricsCalls:  0,
+		},
+		{
+			name:           "test request metrics are configured when filtered",
+			requestMetrics: true,
+			metricsConfiguration: &s3.MetricsConfiguration{
+				Id:     aws.String(s3RequestMetricsFilterID),
+				Filter: &s3.MetricsFilter{Prefix: aws.String("test")},
+			},
+			wantPutMetricsCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s3svc := &mockS3Svc{metricsConfiguration: tt.metricsConfiguration}
+			if err := reconcileS3BucketSettings("test", s3svc, tt.requestMetrics); err != nil {
+				t.Fatalf("reconcileS3BucketSettings() unexpected error = %v", err)
+			}
+			if s3svc.putMetricsCalls != tt.wantPutMetricsCalls {
+				t.Errorf("reconcileS3BucketSettings() put metrics calls = %d, want %d", s3svc.putMetricsCalls, tt.wantPutMetricsCalls)
+			}
+		})
+	}
+}
+
 func TestBlobStorageProvider_reconcileBucketDelete(t *testing.T) {
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -454,7 +537,7 @@ func TestNewAWSBlobStorageProvider(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAWSBlobStorageProvider(tt.args.client(), tt.args.logger)
+			got, err := NewAWSBlobStorageProvider(tt.args.client(), tt.args.logger, record.NewFakeRecorder(10))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("NewAWSBlobStorageProvider(), got = %v, want non-nil error", err)
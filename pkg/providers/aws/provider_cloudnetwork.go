@@ -0,0 +1,273 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	cloudNetworkProviderName = "aws-vpc"
+)
+
+var _ providers.CloudNetworkProvider = (*CloudNetworkProvider)(nil)
+
+// CloudNetworkProvider reconciles the network postgres and redis cloud resources are created in, the standalone vpc
+// connected to the cluster vpc or the bundled subnets of the cluster vpc on clusters created before the standalone vpc
+type CloudNetworkProvider struct {
+	Client            client.Client
+	Logger            *logrus.Entry
+	CredentialManager CredentialManager
+	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
+}
+
+func NewAWSCloudNetworkProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*CloudNetworkProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
+	return &CloudNetworkProvider{
+		Client:            client,
+		Logger:            logger.WithFields(logrus.Fields{"provider": cloudNetworkProviderName}),
+		CredentialManager: cm,
+		ConfigManager:     NewDefaultConfigMapConfigManager(client),
+		Recorder:          recorder,
+	}, nil
+}
+
+func (p *CloudNetworkProvider) GetName() string {
+	return cloudNetworkProviderName
+}
+
+func (p *CloudNetworkProvider) SupportsStrategy(d string) bool {
+	return d == providers.AWSDeploymentStrategy
+}
+
+func (p *CloudNetworkProvider) GetReconcileTime(cn *v1alpha1.CloudNetwork) time.Duration {
+	if cn.Status.Phase != croType.PhaseComplete {
+		return time.Second * 60
+	}
+	return resources.GetForcedReconcileTimeOrDefault(defaultReconcileTime)
+}
+
+// ReconcileCloudNetwork creates the standalone vpc from the `_network` strategy of the cloud network tier and connects
+// it to the cluster vpc, the ids, cidr blocks and connection state of the network are reported in the status
+func (p *CloudNetworkProvider) ReconcileCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (*providers.CloudNetworkInstance, croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "ReconcileCloudNetwork")
+	logger.Infof("reconciling cloud network %s", cn.Name)
+
+	// the network is only deleted once no cloud resources are created in it
+	if err := resources.CreateFinalizer(ctx, p.Client, cn, DefaultFinalizer); err != nil {
+		return nil, "failed to set finalizer", err
+	}
+
+	networkManager, msg, err := p.getNetworkManager(ctx, cn, logger)
+	if err != nil {
+		return nil, msg, err
+	}
+	isEnabled, err := networkManager.IsEnabled(ctx)
+	if err != nil {
+		errMsg := "failed to check cluster vpc subnets"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	// cloud resources are created in the bundled subnets of the cluster vpc on clusters created before the standalone
+	// vpc, there are no standalone subnets or connection to report
+	if !isEnabled {
+		clusterVpc, err := getClusterVpc(ctx, p.Client, networkManager.clusterEc2Api(), logger)
+		if err != nil {
+			errMsg := "failed to get cluster vpc"
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		setCloudNetworkStatus(cn, &Network{Vpc: clusterVpc})
+		cn.Status.ConnectionMethod = ""
+		cn.Status.ConnectionID = ""
+		cn.Status.ConnectionState = ""
+		if err = p.setSecurityGroupStatus(ctx, cn, networkManager); err != nil {
+			return nil, "failed to get security group", err
+		}
+		return &providers.CloudNetworkInstance{NetworkID: cn.Status.NetworkID}, croType.StatusMessage(fmt.Sprintf("cloud resources are created in bundled subnets of cluster vpc %s", cn.Status.NetworkID)), nil
+	}
+
+	standaloneNetwork, networkConnector, msg, err := reconcileStandaloneNetwork(ctx, networkManager, p.ConfigManager, cn.Spec.Tier, logger)
+	if err != nil {
+		return nil, msg, err
+	}
+	setCloudNetworkStatus(cn, standaloneNetwork)
+	if networkConnector == nil {
+		return nil, msg, nil
+	}
+
+	connection, err := networkConnector.Describe(ctx, standaloneNetwork)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to describe standalone network connection with method %s", networkConnector.Method())
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	cn.Status.ConnectionMethod = string(networkConnector.Method())
+	if connection != nil {
+		cn.Status.ConnectionID = connection.ID
+		cn.Status.ConnectionState = connection.State
+	}
+	if err = p.setSecurityGroupStatus(ctx, cn, networkManager); err != nil {
+		return nil, "failed to get security group", err
+	}
+	return &providers.CloudNetworkInstance{NetworkID: cn.Status.NetworkID}, croType.StatusMessage(fmt.Sprintf("standalone vpc %s connected with method %s", cn.Status.NetworkID, networkConnector.Method())), nil
+}
+
+// DeleteCloudNetwork removes the connection to the cluster vpc and the standalone vpc, deletion is blocked while
+// postgres or redis cloud resources are created in the network
+func (p *CloudNetworkProvider) DeleteCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "DeleteCloudNetwork")
+	logger.Infof("reconciling cloud network %s", cn.Name)
+
+	inUse, err := resources.CloudNetworkInUse(ctx, p.Client, providers.AWSDeploymentStrategy)
+	if err != nil {
+		msg := "failed to check cloud resources created in the cloud network"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if inUse > 0 {
+		msg := fmt.Sprintf("deletion blocked, %d postgres and redis resources are created in the cloud network", inUse)
+		resources.RecordWarningEvent(p.Recorder, cn, resources.EventReasonDeletionBlocked, "%s", msg)
+		return croType.StatusMessage(msg), nil
+	}
+
+	networkManager, msg, err := p.getNetworkManager(ctx, cn, logger)
+	if err != nil {
+		return msg, err
+	}
+	isEnabled, err := networkManager.IsEnabled(ctx)
+	if err != nil {
+		errMsg := "failed to check cluster vpc subnets"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	if isEnabled {
+		saVPC, err := getStandaloneVpc(ctx, p.Client, networkManager.Ec2Api, logger)
+		if err != nil {
+			msg := "failed to get standalone VPC"
+			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+		if saVPC != nil {
+			networkConnector, err := networkManager.GetNetworkConnector(ctx, p.ConfigManager, cn.Spec.Tier)
+			if err != nil {
+				msg := "failed to get standalone network connector"
+				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+			}
+			deleted, err := networkConnector.Delete(ctx)
+			if err != nil {
+				msg := fmt.Sprintf("failed to delete standalone network connection with method %s", networkConnector.Method())
+				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+			}
+			if !deleted {
+				return croType.StatusMessage(fmt.Sprintf("waiting for standalone network connection with method %s to be deleted", networkConnector.Method())), nil
+			}
+			if err = networkManager.DeleteNetwork(ctx); err != nil {
+				msg := "failed to delete aws networking"
+				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+			}
+		}
+	} else {
+		if err = networkManager.DeleteBundledCloudResources(ctx); err != nil {
+			msg := "failed to delete bundled networking resources"
+			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+	}
+
+	if err = resources.DeleteFinalizer(ctx, p.Client, cn, DefaultFinalizer); err != nil {
+		msg := "failed to update instance as part of finalizer reconcile"
+		return croType.StatusMessage(msg), errorUtil.Wrapf(err, msg)
+	}
+	return croType.StatusEmpty, nil
+}
+
+// getNetworkManager returns a network manager using the provider credentials of the operator namespace and the region
+// of the `_network` strategy of the cloud network tier
+func (p *CloudNetworkProvider) getNetworkManager(ctx context.Context, cn *v1alpha1.CloudNetwork, logger *logrus.Entry) (*NetworkProvider, croType.StatusMessage, error) {
+	stratCfg, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.NetworkResourceType, cn.Spec.Tier)
+	if err != nil {
+		msg := "failed to read aws _network strategy config"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if stratCfg.Region == "" {
+		stratCfg.Region, err = GetRegionFromStrategyOrDefault(ctx, p.Client, stratCfg)
+		if err != nil {
+			msg := "failed to get default region"
+			return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+	}
+
+	// the cloud network is cluster scoped, the provider credentials of the operator namespace are used
+	ns, err := k8sutil.GetOperatorNamespace()
+	if err != nil {
+		msg := "failed to get operator namespace"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, ns, providers.NetworkResourceType)
+	if err != nil {
+		msg := "failed to reconcile aws provider credentials"
+		resources.RecordWarningEvent(p.Recorder, cn, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	sess, err := CreateSessionFromStrategy(ctx, p.Client, providerCreds, stratCfg)
+	if err != nil {
+		msg := "failed to create aws session to reconcile cloud network"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	networkManager, msg, err := newNetworkManagerFromStrategy(ctx, p.Client, p.ConfigManager, cn.Spec.Tier, providerCreds, stratCfg, sess, logger)
+	if err != nil {
+		return nil, msg, errorUtil.Wrap(err, "failed to initialise network manager")
+	}
+	return networkManager, croType.StatusEmpty, nil
+}
+
+// setSecurityGroupStatus reports the security group the cloud resources are created with
+func (p *CloudNetworkProvider) setSecurityGroupStatus(ctx context.Context, cn *v1alpha1.CloudNetwork, networkManager *NetworkProvider) error {
+	securityGroupName, err := resources.BuildInfraName(ctx, p.Client, defaultSecurityGroupPostfix, defaultAwsIdentifierLength)
+	if err != nil {
+		return errorUtil.Wrap(err, "error building security group name")
+	}
+	securityGroup, err := getSecurityGroup(networkManager.Ec2Api, securityGroupName)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to find security group")
+	}
+	cn.Status.SecurityGroupID = ""
+	if securityGroup != nil {
+		cn.Status.SecurityGroupID = aws.StringValue(securityGroup.GroupId)
+	}
+	return nil
+}
+
+// setCloudNetworkStatus reports the vpc and subnets of the network in the cloud network status
+func setCloudNetworkStatus(cn *v1alpha1.CloudNetwork, network *Network) {
+	if network == nil || network.Vpc == nil {
+		return
+	}
+	cn.Status.NetworkID = aws.StringValue(network.Vpc.VpcId)
+	cn.Status.CidrBlock = aws.StringValue(network.Vpc.CidrBlock)
+	subnets := make([]v1alpha1.CloudNetworkSubnet, 0, len(network.Subnets))
+	for _, subnet := range network.Subnets {
+		subnets = append(subnets, buildCloudNetworkSubnet(subnet))
+	}
+	cn.Status.Subnets = subnets
+}
+
+func buildCloudNetworkSubnet(subnet *ec2.Subnet) v1alpha1.CloudNetworkSubnet {
+	return v1alpha1.CloudNetworkSubnet{
+		ID:        aws.StringValue(subnet.SubnetId),
+		CidrBlock: aws.StringValue(subnet.CidrBlock),
+		Zone:      aws.StringValue(subnet.AvailabilityZone),
+	}
+}
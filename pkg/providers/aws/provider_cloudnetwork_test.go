@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCloudNetworkStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		network *Network
+		want    v1alpha1.CloudNetworkStatus
+	}{
+		{
+			name: "test vpc and subnets reported",
+			network: &Network{
+				Vpc: buildMockVpc(func(vpc *ec2.Vpc) {
+					vpc.VpcId = aws.String("vpc-test")
+					vpc.CidrBlock = aws.String("10.1.0.0/26")
+				}),
+				Subnets: []*ec2.Subnet{
+					{
+						SubnetId:         aws.String("subnet-a"),
+						CidrBlock:        aws.String("10.1.0.0/27"),
+						AvailabilityZone: aws.String("test-zone-1a"),
+					},
+				},
+			},
+			want: v1alpha1.CloudNetworkStatus{
+				NetworkID: "vpc-test",
+				CidrBlock: "10.1.0.0/26",
+				Subnets:   []v1alpha1.CloudNetworkSubnet{{ID: "subnet-a", CidrBlock: "10.1.0.0/27", Zone: "test-zone-1a"}},
+			},
+		},
+		{
+			name: "test status unchanged without a network",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cn := &v1alpha1.CloudNetwork{}
+			setCloudNetworkStatus(cn, tt.network)
+			if !reflect.DeepEqual(cn.Status, tt.want) {
+				t.Errorf("setCloudNetworkStatus() status = %v, want %v", cn.Status, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandaloneNetworkTier(t *testing.T) {
+	if got := standaloneNetworkTier(nil, "production"); got != "production" {
+		t.Errorf("standaloneNetworkTier() = %s, want the tier of the cloud resource without a cloud network", got)
+	}
+	cn := &v1alpha1.CloudNetwork{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.CloudNetworkName}, Spec: v1alpha1.CloudNetworkSpec{Tier: "development"}}
+	if got := standaloneNetworkTier(cn, "production"); got != "development" {
+		t.Errorf("standaloneNetworkTier() = %s, want the tier of the cloud network", got)
+	}
+}
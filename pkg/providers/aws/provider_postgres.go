@@ -11,9 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
-	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
 
 	"k8s.io/apimachinery/pkg/types"
 
@@ -21,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
@@ -94,9 +98,10 @@ type PostgresProvider struct {
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
 	TCPPinger         resources.ConnectionTester
+	Recorder          record.EventRecorder
 }
 
-func NewAWSPostgresProvider(client client.Client, logger *logrus.Entry) (*PostgresProvider, error) {
+func NewAWSPostgresProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*PostgresProvider, error) {
 	cm, err := NewCredentialManager(client)
 	if err != nil {
 		return nil, err
@@ -107,6 +112,7 @@ func NewAWSPostgresProvider(client client.Client, logger *logrus.Entry) (*Postgr
 		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigMapConfigManager(client),
 		TCPPinger:         resources.NewConnectionTestManager(),
+		Recorder:          recorder,
 	}, nil
 }
 
@@ -143,9 +149,10 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 	}
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		msg := "failed to reconcile rds credentials"
+		resources.RecordWarningEvent(p.Recorder, pg, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
@@ -165,6 +172,12 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
+	// the network access security group is built from the cluster vpc, which is not reachable with the role of the strategy
+	if strategyConfig.IsCrossAccount() && pg.Spec.NetworkAccess != nil {
+		errMsg := "networkAccess is not supported with a strategy roleArn"
+		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+
 	// setup aws RDS instance sdk session
 	sess, err := CreateSessionFromStrategy(ctx, p.Client, providerCreds, strategyConfig)
 	if err != nil {
@@ -173,57 +186,44 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 	}
 
 	// check is a standalone network is required
-	networkManager := NewNetworkManager(sess, p.Client, logger, isSTSCluster(ctx, p.Client))
+	networkManager, msg, err := newNetworkManagerFromStrategy(ctx, p.Client, p.ConfigManager, pg.Spec.Tier, providerCreds, strategyConfig, sess, logger)
+	if err != nil {
+		return nil, msg, errorUtil.Wrap(err, "failed to initialise network manager")
+	}
 	isEnabled, err := networkManager.IsEnabled(ctx)
 	if err != nil {
 		errMsg := "failed to check cluster vpc subnets"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
+	// cloud resources are created once the cloud network, when one exists, is ready
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		errMsg := "failed to get cloud network"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if cloudNetwork != nil && !resources.IsCloudNetworkReady(cloudNetwork) {
+		return nil, croType.StatusMessage(fmt.Sprintf("waiting for cloud network %s to become ready", cloudNetwork.Name)), nil
+	}
+
 	//networkManager isEnabled checks for the presence of bundled subnets in the cluster vpc
 	//when bundled subnets are present in a cluster vpc it indicates that the vpc configuration
 	//was created in a cluster with a cluster version <= 4.4.5
 	//
 	//when bundled subnets are absent in a cluster vpc it indicates that the vpc configuration has not been created
-	//and a new vpc is created for all resources to be deployed in and peered with the cluster vpc
+	//and a new vpc is created for all resources to be deployed in and connected to the cluster vpc
+	var networkConnector NetworkConnector
 	if isEnabled {
-		// get cidr block from _network strat map, based on tier from postgres cr
-		vpcCidrBlock, err := networkManager.ReconcileNetworkProviderConfig(ctx, p.ConfigManager, pg.Spec.Tier, logger)
-		if err != nil {
-			errMsg := "failed to reconcile network provider config"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Debug("standalone network provider enabled, reconciling standalone vpc")
-
-		// create the standalone vpc, subnets and subnet groups
-		standaloneNetwork, err := networkManager.CreateNetwork(ctx, vpcCidrBlock)
-		if err != nil {
-			errMsg := "failed to create resource network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Infof("created standalone network %s", aws.StringValue(standaloneNetwork.Vpc.VpcId))
-
-		// we've created the standalone vpc, now we peer it to the cluster vpc
-		logger.Infof("creating network peering")
-		networkPeering, err := networkManager.CreateNetworkPeering(ctx, standaloneNetwork)
-		if err != nil {
-			errMsg := "failed to peer standalone network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Infof("created network peering %s", aws.StringValue(networkPeering.PeeringConnection.VpcPeeringConnectionId))
-
-		// we have created the peering connection we must now create the security groups and update the route tables
-		securityGroup, err := networkManager.CreateNetworkConnection(ctx, standaloneNetwork)
-		if err != nil {
-			errMsg := "failed to create standalone network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		var msg croType.StatusMessage
+		networkConnector, msg, err = getStandaloneNetworkConnector(ctx, networkManager, p.ConfigManager, cloudNetwork, pg.Spec.Tier, logger)
+		if err != nil || networkConnector == nil {
+			return nil, msg, err
 		}
-		logger.Infof("created security group %s", aws.StringValue(securityGroup.StandaloneSecurityGroup.GroupName))
 	}
 
 	session := rds.New(sess)
 	// create the aws RDS instance
-	postgres, reconcileStatus, err := p.reconcileRDSInstance(ctx, pg, session, ec2.New(sess), rdsCfg, isEnabled, maintenanceWindow)
+	postgres, reconcileStatus, err := p.reconcileRDSInstance(ctx, pg, session, ec2.New(sess), iam.New(sess), sts.New(sess), rdsCfg, isEnabled, maintenanceWindow)
 	if err != nil {
 		errMsg := "failed to reconcile rds instance"
 		return nil, reconcileStatus, errorUtil.Wrap(err, errMsg)
@@ -232,7 +232,27 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 		return nil, reconcileStatus, nil
 	}
 
-	if maintenanceWindow {
+	// the rds instance may not be reachable on its own endpoint from the cluster vpc, depending on the connection method
+	if networkConnector != nil {
+		pdd, ok := postgres.DeploymentDetails.(*providers.PostgresDeploymentDetails)
+		if !ok {
+			errMsg := "unexpected postgres deployment details type"
+			return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+		}
+		endpoint, err := networkConnector.ExposeEndpoint(ctx, aws.StringValue(rdsCfg.DBInstanceIdentifier), &NetworkEndpoint{Host: pdd.Host, Port: int64(pdd.Port)})
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to expose rds instance endpoint with method %s", networkConnector.Method())
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		if endpoint == nil {
+			return nil, croType.StatusMessage(fmt.Sprintf("waiting for rds instance endpoint to be exposed with method %s", networkConnector.Method())), nil
+		}
+		pdd.Host = endpoint.Host
+		pdd.Port = int(endpoint.Port)
+	}
+
+	// modifications are not applied in dry-run mode, keep the maintenance window open until dry-run mode is disabled
+	if maintenanceWindow && !resources.IsDryRun(pg) {
 		if serviceUpdates != nil && len(serviceUpdates.updates) > 0 {
 			pi, err := getRDSInstances(session)
 			if err != nil {
@@ -243,7 +263,7 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 			// check if the cluster has already been created
 			foundInstance := getFoundInstance(pi, rdsCfg)
 
-			updating, message, err := p.rdsApplyServiceUpdates(session, serviceUpdates, foundInstance)
+			updating, message, err := p.rdsApplyServiceUpdates(pg, session, serviceUpdates, foundInstance)
 			if err != nil {
 				errMsg := "failed to service update rds instance"
 				return nil, croType.StatusMessage(errMsg), err
@@ -254,8 +274,7 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 		}
 
 		// set updates allowed to false on the CR after successful reconcile
-		pg.Spec.MaintenanceWindow = false
-		if err := p.Client.Update(ctx, pg); err != nil {
+		if err := resources.PatchObject(ctx, p.Client, pg, func() { pg.Spec.MaintenanceWindow = false }); err != nil {
 			return nil, "failed to set postgres maintenanceWindow to false", err
 		}
 	}
@@ -264,7 +283,7 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 
 }
 
-func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, ec2Svc ec2iface.EC2API, rdsCfg *rds.CreateDBInstanceInput, standaloneNetworkExists bool, maintenanceWindow bool) (*providers.PostgresInstance, croType.StatusMessage, error) {
+func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, ec2Svc ec2iface.EC2API, iamSvc iamiface.IAMAPI, stsSvc stsiface.STSAPI, rdsCfg *rds.CreateDBInstanceInput, standaloneNetworkExists bool, maintenanceWindow bool) (*providers.PostgresInstance, croType.StatusMessage, error) {
 	logger := p.Logger.WithField("action", "reconcileRDSInstance")
 	// the aws access key can sometimes still not be registered in aws on first try, so loop
 	pi, err := getRDSInstances(rdsSvc)
@@ -316,13 +335,16 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 	foundInstance := getFoundInstance(pi, rdsCfg)
 
 	// expose pending maintenance metric
-	defer p.setPostgresServiceMaintenanceMetric(ctx, rdsSvc, foundInstance)
+	defer p.setPostgresServiceMaintenanceMetric(ctx, cr, rdsSvc, foundInstance)
+
+	// set latest snapshot metrics
+	defer p.setPostgresSnapshotMetrics(ctx, cr, rdsSvc, foundInstance)
 
 	// set status metric
 	defer p.exposePostgresMetrics(ctx, cr, foundInstance, ec2Svc)
 
 	// create connection metric
-	defer p.createRDSConnectionMetric(ctx, cr, foundInstance)
+	defer p.createRDSConnectionMetric(ctx, cr, foundInstance, postgresPass)
 
 	// check if we are running in STS mode
 	_, isSTS := p.CredentialManager.(*STSCredentialManager)
@@ -344,7 +366,44 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 			return nil, croType.StatusMessage(fmt.Sprintf("reconcileRDSInstance() in progress, current aws rds resource status is %s", *foundInstance.DBInstanceStatus)), nil
 		}
 
-		if maintenanceWindow {
+		// take over an existing instance the first time it is found, in dry-run mode the instance is only verified and
+		// the changes of the adoption are reported with the other pending changes
+		dryRun := resources.IsDryRun(cr)
+		adopting := resources.IsAdopted(cr.Spec.ResourceTypeSpec) && !resources.HasResourceIdentifier(ctx, cr)
+		var adoptionChanges []croType.PendingChange
+		if adopting {
+			var statusMsg croType.StatusMessage
+			adoptionChanges, statusMsg, err = p.adoptRDSInstance(ctx, cr, rdsSvc, foundInstance, postgresPass, dryRun)
+			if err != nil {
+				return nil, statusMsg, err
+			}
+		}
+
+		// network access is applied straight away rather than in the maintenance window, in dry-run mode it is reported
+		// with the other pending changes
+		networkAccessChanges, statusMsg, err := p.reconcileRDSNetworkAccess(cr, rdsSvc, ec2Svc, rdsCfg, foundInstance)
+		if err != nil || statusMsg != croType.StatusEmpty {
+			return nil, statusMsg, err
+		}
+
+		// compare the rds instance against the strategy, drift is corrected in the maintenance window unless the cr asks
+		// for it to be remediated straight away
+		expectedTags, err := p.getDefaultRdsTags(ctx, cr)
+		if err != nil {
+			errMsg := "failed to build default tags"
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		drift, err := buildRDSDrift(rdsCfg, foundInstance, expectedTags)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to detect drift of rds instance %s", *foundInstance.DBInstanceIdentifier)
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		resources.ReportDrift(ctx, p.Client, p.Recorder, cr, &cr.Status, drift, string(providers.PostgresResourceType), *foundInstance.DBInstanceIdentifier, postgresProviderName)
+
+		if !dryRun {
+			resources.SetPendingChanges(p.Recorder, cr, &cr.Status, nil)
+		}
+		if maintenanceWindow || dryRun || resources.IsDriftRemediated(cr.Spec.ResourceTypeSpec, drift) {
 			// check if found instance and user strategy differs, and modify instance
 			logger.Infof("found existing rds instance: %s", *foundInstance.DBInstanceIdentifier)
 			mi, err := buildRDSUpdateStrategy(rdsCfg, foundInstance, cr)
@@ -352,7 +411,13 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 				errMsg := fmt.Sprintf("error building update config for rds instance: %s", *foundInstance.DBInstanceIdentifier)
 				return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 			}
-			if mi != nil {
+			if dryRun {
+				logger.Infof("dry-run mode enabled, not applying modifications to rds instance %s", *foundInstance.DBInstanceIdentifier)
+				changes := append(networkAccessChanges, adoptionChanges...)
+				changes = append(changes, buildRDSPendingChanges(mi, foundInstance)...)
+				changes = append(changes, resources.NewTagPendingChanges(rdsTagsToMap(foundInstance.TagList), rdsTagsToMap(expectedTags))...)
+				resources.SetPendingChanges(p.Recorder, cr, &cr.Status, changes)
+			} else if mi != nil {
 				_, err := rdsSvc.ModifyDBInstance(mi)
 				if err != nil {
 					errMsg := fmt.Sprintf("error experienced trying to modify db instance: %s", *foundInstance.DBInstanceIdentifier)
@@ -360,11 +425,12 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 				}
 				statusMsg := fmt.Sprintf("set pending modifications for rds instance: %s", *foundInstance.DBInstanceIdentifier)
 				logger.Info(statusMsg)
+				resources.RecordEvent(p.Recorder, cr, resources.EventReasonModifyApplied, "%s", statusMsg)
 				return nil, croType.StatusMessage(statusMsg), nil
 			}
 		}
 
-		if !isSTS {
+		if !isSTS && !dryRun {
 			croStatus, err := p.TagRDSPostgres(ctx, cr, rdsSvc, foundInstance)
 			if err != nil {
 				errMsg := fmt.Sprintf("failed to add tags to rds: %s", croStatus)
@@ -374,15 +440,21 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 
 		msg = fmt.Sprintf("rds instance %s is as expected", *foundInstance.DBInstanceIdentifier)
 		logger.Infof(msg)
+		// adopted instances may have been created without an initial database, connect to the default one instead
+		database := aws.StringValue(foundInstance.DBName)
+		if database == "" {
+			database = defaultAwsPostgresDatabase
+		}
 		pdd := &providers.PostgresDeploymentDetails{
 			Username: *foundInstance.MasterUsername,
 			Password: postgresPass,
 			Host:     *foundInstance.Endpoint.Address,
-			Database: *foundInstance.DBName,
+			Database: database,
 			Port:     int(*foundInstance.Endpoint.Port),
 		}
 
-		if !annotations.Has(cr, ResourceIdentifierAnnotation) {
+		// an instance is only adopted once dry-run mode is disabled and the adoption is applied
+		if !resources.HasResourceIdentifier(ctx, cr) && !(adopting && dryRun) {
 			statusMsg, err := addAnnotation(ctx, p.Client, cr, *rdsCfg.DBInstanceIdentifier)
 			if err != nil {
 				return nil, statusMsg, err
@@ -393,26 +465,38 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 		return &providers.PostgresInstance{DeploymentDetails: pdd}, croType.StatusMessage(fmt.Sprintf("%s, aws rds status is %s", msg, *foundInstance.DBInstanceStatus)), nil
 	}
 
+	// an instance which is adopted is never created
+	if resources.IsAdopted(cr.Spec.ResourceTypeSpec) {
+		errMsg := fmt.Sprintf("external rds instance %s of Postgres CR %s in %s namespace was not found", cr.Spec.ExternalResourceID, cr.Name, cr.Namespace)
+		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+
 	// create the rds if it doesn't exist
-	if annotations.Has(cr, ResourceIdentifierAnnotation) {
+	if resources.HasResourceIdentifier(ctx, cr) {
 		errMsg := fmt.Sprintf("Postgres CR %s in %s namespace has %s annotation with value %s, but no corresponding RDS instance was found",
-			cr.Name, cr.Namespace, ResourceIdentifierAnnotation, cr.ObjectMeta.Annotations[ResourceIdentifierAnnotation])
+			cr.Name, cr.Namespace, ResourceIdentifierAnnotation, resources.GetResourceIdentifier(ctx, cr))
 		return nil, croType.StatusMessage(errMsg), fmt.Errorf(errMsg)
 	}
-	// the tag should be added to the create strategy in cases where sts is enabled
-	// and in the same api request of the first creation of the postgres to allow
-	if isSTS {
-		msg, err := p.buildRDSTagCreateStrategy(ctx, cr, rdsCfg)
-		if err != nil {
-			errMsg := fmt.Sprintf("failed to add tags to rds: %s", msg)
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
+	// the tags are added in the same api request as the creation of the postgres, the provider credentials only allow
+	// creating resources tagged with the cluster id
+	msg, err := p.buildRDSTagCreateStrategy(ctx, cr, rdsCfg)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to add tags to rds: %s", msg)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	// report missing permissions, exhausted quotas and unavailable instance classes before the create fails on them
+	preflight := preflightRDSInstance(iamSvc, stsSvc, rdsSvc, ec2Svc, rdsCfg)
+	if !resources.ReportPreflight(p.Recorder, cr, &cr.Status, preflight) {
+		errMsg := fmt.Sprintf("preflight checks failed for rds instance %s: %s", *rdsCfg.DBInstanceIdentifier, preflight.String())
+		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
 	}
 
 	logger.Info("creating rds instance")
 	if _, err := rdsSvc.CreateDBInstance(rdsCfg); err != nil {
 		return nil, croType.StatusMessage(fmt.Sprintf("error creating rds instance %s", err)), err
 	}
+	resources.RecordEvent(p.Recorder, cr, resources.EventReasonCreateStarted, "started provisioning rds instance %s", *rdsCfg.DBInstanceIdentifier)
 
 	statusMsg, err := addAnnotation(ctx, p.Client, cr, *rdsCfg.DBInstanceIdentifier)
 	if err != nil {
@@ -421,6 +505,54 @@ func (p *PostgresProvider) reconcileRDSInstance(ctx context.Context, cr *v1alpha
 	return nil, "started rds provision", nil
 }
 
+// reconcileRDSNetworkAccess moves the rds instance to the security groups of the create strategy when its network access
+// is restricted, or was restricted, and deletes the network access security group once it is no longer
+// used. in dry-run mode nothing is changed and the changes are returned as pending changes instead
+func (p *PostgresProvider) reconcileRDSNetworkAccess(cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, ec2Svc ec2iface.EC2API, rdsCfg *rds.CreateDBInstanceInput, foundInstance *rds.DBInstance) ([]croType.PendingChange, croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "reconcileRDSNetworkAccess")
+	accessSecGroup, err := getSecurityGroup(ec2Svc, buildNetworkAccessSecurityGroupName(*foundInstance.DBInstanceIdentifier))
+	if err != nil {
+		errMsg := "failed to get rds instance network access security group"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if accessSecGroup == nil {
+		return nil, croType.StatusEmpty, nil
+	}
+	dryRun := resources.IsDryRun(cr)
+	var securityGroupIDs []string
+	for _, sg := range foundInstance.VpcSecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(sg.VpcSecurityGroupId))
+	}
+	inUse := resources.Contains(securityGroupIDs, aws.StringValue(accessSecGroup.GroupId))
+	if (cr.Spec.NetworkAccess != nil || inUse) && !securityGroupIDsEqual(securityGroupIDs, aws.StringValueSlice(rdsCfg.VpcSecurityGroupIds)) {
+		if dryRun {
+			logger.Infof("dry-run mode enabled, not applying network access to rds instance %s", *foundInstance.DBInstanceIdentifier)
+			return []croType.PendingChange{resources.NewPendingChange("VpcSecurityGroupIds", securityGroupIDs, aws.StringValueSlice(rdsCfg.VpcSecurityGroupIds))}, croType.StatusEmpty, nil
+		}
+		if _, err = rdsSvc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+			DBInstanceIdentifier: foundInstance.DBInstanceIdentifier,
+			VpcSecurityGroupIds:  rdsCfg.VpcSecurityGroupIds,
+			ApplyImmediately:     aws.Bool(true),
+		}); err != nil {
+			errMsg := fmt.Sprintf("failed to apply network access to rds instance %s", *foundInstance.DBInstanceIdentifier)
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		statusMsg := fmt.Sprintf("applied network access to rds instance %s", *foundInstance.DBInstanceIdentifier)
+		resources.RecordEvent(p.Recorder, cr, resources.EventReasonModifyApplied, "%s", statusMsg)
+		return nil, croType.StatusMessage(statusMsg), nil
+	}
+	if cr.Spec.NetworkAccess == nil && !inUse {
+		if dryRun {
+			return []croType.PendingChange{resources.NewPendingChange("NetworkAccessSecurityGroup", accessSecGroup.GroupName, nil)}, croType.StatusEmpty, nil
+		}
+		if _, err = deleteNetworkAccessSecurityGroup(ec2Svc, *foundInstance.DBInstanceIdentifier, logger); err != nil {
+			errMsg := "failed to delete rds instance network access security group"
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+	}
+	return nil, croType.StatusEmpty, nil
+}
+
 // buildRDSTagCreateStrategy Tags RDS resources
 func (p *PostgresProvider) buildRDSTagCreateStrategy(ctx context.Context, cr *v1alpha1.Postgres, rdsCreateConfig *rds.CreateDBInstanceInput) (croType.StatusMessage, error) {
 	rdsTags, err := p.getDefaultRdsTags(ctx, cr)
@@ -445,6 +577,51 @@ func (p *PostgresProvider) buildRDSTagCreateStrategy(ctx context.Context, cr *v1
 	return "", nil
 }
 
+// adoptRDSInstance takes over an existing rds instance, it verifies the instance is a postgres instance which is not
+// managed by another cr, resets the master password if requested and applies the operator tags. in dry-run mode the
+// instance is only verified and the reset of the master password is returned as a pending change
+func (p *PostgresProvider) adoptRDSInstance(ctx context.Context, cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, foundInstance *rds.DBInstance, postgresPass string, dryRun bool) ([]croType.PendingChange, croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "adoptRDSInstance")
+	if aws.StringValue(foundInstance.Engine) != defaultAwsEngine {
+		errMsg := fmt.Sprintf("rds instance %s has engine %s, only %s instances can be adopted", *foundInstance.DBInstanceIdentifier, aws.StringValue(foundInstance.Engine), defaultAwsEngine)
+		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+	expectedTags, err := p.getDefaultRdsTags(ctx, cr)
+	if err != nil {
+		errMsg := "failed to build default tags"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if err = resources.ValidateAdoption(rdsTagsToMap(expectedTags), rdsTagsToMap(foundInstance.TagList), resources.AdoptionOwnershipTagKeys()); err != nil {
+		errMsg := fmt.Sprintf("rds instance %s can not be adopted", *foundInstance.DBInstanceIdentifier)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if dryRun {
+		logger.Infof("dry-run mode enabled, not adopting rds instance %s", *foundInstance.DBInstanceIdentifier)
+		if cr.Spec.ResetCredentials {
+			return []croType.PendingChange{resources.NewPendingChange("MasterUserPassword", nil, "reset to the password of the credential secret")}, croType.StatusEmpty, nil
+		}
+		return nil, croType.StatusEmpty, nil
+	}
+	if cr.Spec.ResetCredentials {
+		logger.Infof("resetting master password of rds instance %s", *foundInstance.DBInstanceIdentifier)
+		if _, err = rdsSvc.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+			DBInstanceIdentifier: foundInstance.DBInstanceIdentifier,
+			MasterUserPassword:   aws.String(postgresPass),
+			ApplyImmediately:     aws.Bool(true),
+		}); err != nil {
+			errMsg := fmt.Sprintf("failed to reset master password of rds instance %s", *foundInstance.DBInstanceIdentifier)
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+	}
+	if statusMsg, err := p.TagRDSPostgres(ctx, cr, rdsSvc, foundInstance); err != nil {
+		errMsg := fmt.Sprintf("failed to add tags to rds: %s", statusMsg)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	resources.RecordEvent(p.Recorder, cr, resources.EventReasonAdopted, "adopted existing rds instance %s", *foundInstance.DBInstanceIdentifier)
+	statusMsg, err := addAnnotation(ctx, p.Client, cr, *foundInstance.DBInstanceIdentifier)
+	return nil, statusMsg, err
+}
+
 func (p *PostgresProvider) TagRDSPostgres(ctx context.Context, cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, foundInstance *rds.DBInstance) (croType.StatusMessage, error) {
 
 	logger := p.Logger.WithField("action", "TagRDSPostgres")
@@ -509,9 +686,10 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, r *v1alpha1.Postg
 	}
 
 	// get provider aws creds so the postgres instance can be deleted
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		msg := "failed to reconcile aws provider credentials"
+		resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
@@ -523,7 +701,10 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, r *v1alpha1.Postg
 	}
 
 	// network manager required for cleaning up network vpc, subnet and subnet groups.
-	networkManager := NewNetworkManager(sess, p.Client, logger, isSTSCluster(ctx, p.Client))
+	networkManager, msg, err := newNetworkManagerFromStrategy(ctx, p.Client, p.ConfigManager, r.Spec.Tier, providerCreds, stratCfg, sess, logger)
+	if err != nil {
+		return msg, errorUtil.Wrap(err, "failed to initialise network manager")
+	}
 
 	isEnabled, err := networkManager.IsEnabled(ctx)
 	if err != nil {
@@ -574,9 +755,21 @@ func (p *PostgresProvider) deleteRDSInstance(ctx context.Context, pg *v1alpha1.P
 		if *foundInstance.DBInstanceStatus != "available" {
 			statusMessage := fmt.Sprintf("delete detected, deleteDBInstance() in progress, current aws rds status is %s", *foundInstance.DBInstanceStatus)
 			logger.Info(statusMessage)
+			if *foundInstance.DBInstanceStatus != "deleting" {
+				resources.RecordWarningEvent(p.Recorder, pg, resources.EventReasonDeletionBlocked, "rds instance %s cannot be deleted while its status is %s", *foundInstance.DBInstanceIdentifier, *foundInstance.DBInstanceStatus)
+			}
 			return croType.StatusMessage(statusMessage), nil
 		}
 
+		// keep the rds instance with the retain deletion policy, it is tagged so it can be found and cleaned up later
+		if resources.IsRetained(pg.Spec.ResourceTypeSpec) {
+			if err = tagRDSInstanceOrphaned(instanceSvc, foundInstance); err != nil {
+				msg := fmt.Sprintf("failed to tag rds instance %s as orphaned", *foundInstance.DBInstanceIdentifier)
+				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+			}
+			return resources.RetainResource(ctx, p.Client, p.Recorder, pg, DefaultFinalizer, *foundInstance.DBInstanceIdentifier)
+		}
+
 		// delete rds instance if deletion protection is false
 		if !*foundInstance.DeletionProtection {
 			_, err = instanceSvc.DeleteDBInstance(rdsDeleteConfig)
@@ -597,39 +790,69 @@ func (p *PostgresProvider) deleteRDSInstance(ctx context.Context, pg *v1alpha1.P
 			msg := "failed to remove deletion protection"
 			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
+		resources.RecordEvent(p.Recorder, pg, resources.EventReasonDeletionBlocked, "deletion protection is enabled on rds instance %s, removing it before deletion", *foundInstance.DBInstanceIdentifier)
 
 		return croType.StatusMessage(fmt.Sprintf("deletion protection detected, modifyDBInstance() in progress, current aws rds status is %s", *foundInstance.DBInstanceStatus)), nil
 	}
 
+	// the network access security group of the instance must be deleted before the network it is in
+	deleted, err := deleteNetworkAccessSecurityGroup(ec2Svc, *rdsDeleteConfig.DBInstanceIdentifier, logger)
+	if err != nil {
+		msg := "failed to delete rds instance network access security group"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if !deleted {
+		return "waiting for rds instance network access security group to be deleted", nil
+	}
+
+	// the network is deleted with the cloud network when one exists
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		msg := "failed to get cloud network"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if cloudNetwork != nil {
+		isLastResource = false
+	}
+
 	// isEnabled is true if no bundled resources are found in the cluster vpc
-	if isEnabled && isLastResource {
-		saVPC, err := getStandaloneVpc(ctx, p.Client, ec2Svc, logger)
+	if isEnabled {
+		networkConnector, err := networkManager.GetNetworkConnector(ctx, p.ConfigManager, standaloneNetworkTier(cloudNetwork, pg.Spec.Tier))
 		if err != nil {
-			msg := "failed to get standalone VPC"
+			msg := "failed to get standalone network connector"
 			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
-		// Remove all networking resources if standalone vpc exists
-		if saVPC != nil {
-			logger.Info("found the last instance of types postgres and redis so deleting the standalone network")
-			networkPeering, err := networkManager.GetClusterNetworkPeering(ctx)
-			if err != nil {
-				msg := "failed to get cluster network peering"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
-			}
-
-			if err = networkManager.DeleteNetworkConnection(ctx, networkPeering); err != nil {
-				msg := "failed to delete network connection"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
-			}
+		removed, err := networkConnector.RemoveEndpoint(ctx, aws.StringValue(rdsCreateConfig.DBInstanceIdentifier))
+		if err != nil {
+			msg := fmt.Sprintf("failed to remove rds instance endpoint with method %s", networkConnector.Method())
+			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+		if !removed {
+			return croType.StatusMessage(fmt.Sprintf("waiting for rds instance endpoint to be removed with method %s", networkConnector.Method())), nil
+		}
 
-			if err = networkManager.DeleteNetworkPeering(networkPeering); err != nil {
-				msg := "failed to delete cluster network peering"
+		if isLastResource {
+			saVPC, err := getStandaloneVpc(ctx, p.Client, ec2Svc, logger)
+			if err != nil {
+				msg := "failed to get standalone VPC"
 				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 			}
+			// Remove all networking resources if standalone vpc exists
+			if saVPC != nil {
+				logger.Info("found the last instance of types postgres and redis so deleting the standalone network")
+				deleted, err := networkConnector.Delete(ctx)
+				if err != nil {
+					msg := fmt.Sprintf("failed to delete standalone network connection with method %s", networkConnector.Method())
+					return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				}
+				if !deleted {
+					return croType.StatusMessage(fmt.Sprintf("waiting for standalone network connection with method %s to be deleted", networkConnector.Method())), nil
+				}
 
-			if err = networkManager.DeleteNetwork(ctx); err != nil {
-				msg := "failed to delete aws networking"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				if err = networkManager.DeleteNetwork(ctx); err != nil {
+					msg := "failed to delete aws networking"
+					return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				}
 			}
 		}
 	}
@@ -658,14 +881,33 @@ func (p *PostgresProvider) deleteRDSInstance(ctx context.Context, pg *v1alpha1.P
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
-	resources.RemoveFinalizer(&pg.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, pg); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, pg, DefaultFinalizer); err != nil {
 		msg := "failed to update instance as part of finalizer reconcile"
 		return croType.StatusMessage(msg), errorUtil.Wrapf(err, msg)
 	}
 	return croType.StatusEmpty, nil
 }
 
+// tagRDSInstanceOrphaned adds the orphaned tag to an rds instance kept by the retain deletion policy
+func tagRDSInstanceOrphaned(rdsSvc rdsiface.RDSAPI, foundInstance *rds.DBInstance) error {
+	orphanedTag := resources.BuildOrphanedTag()
+	for _, tag := range foundInstance.TagList {
+		if aws.StringValue(tag.Key) == orphanedTag.Key && aws.StringValue(tag.Value) == orphanedTag.Value {
+			return nil
+		}
+	}
+	_, err := rdsSvc.AddTagsToResource(&rds.AddTagsToResourceInput{
+		ResourceName: foundInstance.DBInstanceArn,
+		Tags: []*rds.Tag{
+			{
+				Key:   aws.String(orphanedTag.Key),
+				Value: aws.String(orphanedTag.Value),
+			},
+		},
+	})
+	return err
+}
+
 // function to get rds instances, used to check/wait on AWS credentials
 func getRDSInstances(cacheSvc rdsiface.RDSAPI) ([]*rds.DBInstance, error) {
 	var pi []*rds.DBInstance
@@ -814,6 +1056,101 @@ func buildRDSUpdateStrategy(rdsConfig *rds.CreateDBInstanceInput, foundConfig *r
 	return mi, nil
 }
 
+// buildRDSDrift compares the attributes of the found rds instance against the create strategy
+func buildRDSDrift(rdsConfig *rds.CreateDBInstanceInput, foundConfig *rds.DBInstance, expectedTags []*rds.Tag) (*resources.Drift, error) {
+	drift := &resources.Drift{}
+	drift.Compare("DBInstanceClass", foundConfig.DBInstanceClass, rdsConfig.DBInstanceClass)
+	drift.Compare("Engine", foundConfig.Engine, rdsConfig.Engine)
+	drift.Compare("MultiAZ", foundConfig.MultiAZ, rdsConfig.MultiAZ)
+	drift.Compare("PubliclyAccessible", foundConfig.PubliclyAccessible, rdsConfig.PubliclyAccessible)
+	drift.Compare("DeletionProtection", foundConfig.DeletionProtection, rdsConfig.DeletionProtection)
+	drift.Compare("BackupRetentionPeriod", foundConfig.BackupRetentionPeriod, rdsConfig.BackupRetentionPeriod)
+	drift.Compare("PreferredBackupWindow", foundConfig.PreferredBackupWindow, rdsConfig.PreferredBackupWindow)
+	drift.Compare("PreferredMaintenanceWindow", foundConfig.PreferredMaintenanceWindow, rdsConfig.PreferredMaintenanceWindow)
+	drift.Compare("MaxAllocatedStorage", foundConfig.MaxAllocatedStorage, rdsConfig.MaxAllocatedStorage)
+	drift.Compare("AutoMinorVersionUpgrade", foundConfig.AutoMinorVersionUpgrade, rdsConfig.AutoMinorVersionUpgrade)
+	drift.Compare("StorageEncrypted", foundConfig.StorageEncrypted, rdsConfig.StorageEncrypted)
+	drift.Compare("StorageType", foundConfig.StorageType, rdsConfig.StorageType)
+	drift.Compare("CopyTagsToSnapshot", foundConfig.CopyTagsToSnapshot, rdsConfig.CopyTagsToSnapshot)
+	if foundConfig.Endpoint != nil {
+		drift.Compare("Port", foundConfig.Endpoint.Port, rdsConfig.Port)
+	}
+	if foundConfig.DBSubnetGroup != nil {
+		drift.Compare("DBSubnetGroupName", foundConfig.DBSubnetGroup.DBSubnetGroupName, rdsConfig.DBSubnetGroupName)
+	}
+	var securityGroupIDs []string
+	for _, sg := range foundConfig.VpcSecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(sg.VpcSecurityGroupId))
+	}
+	drift.CompareSet("VpcSecurityGroupIds", securityGroupIDs, aws.StringValueSlice(rdsConfig.VpcSecurityGroupIds))
+	// minor versions are upgraded by aws, only an older version than the strategy is drift
+	if rdsConfig.EngineVersion != nil && foundConfig.EngineVersion != nil {
+		upgradeNeeded, err := resources.VerifyVersionUpgradeNeeded(*foundConfig.EngineVersion, *rdsConfig.EngineVersion)
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "invalid postgres version")
+		}
+		if upgradeNeeded {
+			drift.Compare("EngineVersion", foundConfig.EngineVersion, rdsConfig.EngineVersion)
+		}
+	}
+	drift.CompareTags("Tags", rdsTagsToMap(foundConfig.TagList), rdsTagsToMap(expectedTags))
+	return drift, nil
+}
+
+// rdsTagsToMap returns rds tags as a map of key to value
+func rdsTagsToMap(tags []*rds.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+// buildRDSPendingChanges returns the modifications of the modify input as pending changes against the found instance
+func buildRDSPendingChanges(mi *rds.ModifyDBInstanceInput, foundConfig *rds.DBInstance) []croType.PendingChange {
+	if mi == nil {
+		return nil
+	}
+	var changes []croType.PendingChange
+	if mi.CACertificateIdentifier != nil {
+		changes = append(changes, resources.NewPendingChange("CACertificateIdentifier", foundConfig.CACertificateIdentifier, mi.CACertificateIdentifier))
+	}
+	if mi.DeletionProtection != nil {
+		changes = append(changes, resources.NewPendingChange("DeletionProtection", foundConfig.DeletionProtection, mi.DeletionProtection))
+	}
+	if mi.DBPortNumber != nil && foundConfig.Endpoint != nil {
+		changes = append(changes, resources.NewPendingChange("DBPortNumber", foundConfig.Endpoint.Port, mi.DBPortNumber))
+	}
+	if mi.BackupRetentionPeriod != nil {
+		changes = append(changes, resources.NewPendingChange("BackupRetentionPeriod", foundConfig.BackupRetentionPeriod, mi.BackupRetentionPeriod))
+	}
+	if mi.DBInstanceClass != nil {
+		changes = append(changes, resources.NewPendingChange("DBInstanceClass", foundConfig.DBInstanceClass, mi.DBInstanceClass))
+	}
+	if mi.PubliclyAccessible != nil {
+		changes = append(changes, resources.NewPendingChange("PubliclyAccessible", foundConfig.PubliclyAccessible, mi.PubliclyAccessible))
+	}
+	if mi.MaxAllocatedStorage != nil {
+		changes = append(changes, resources.NewPendingChange("MaxAllocatedStorage", foundConfig.MaxAllocatedStorage, mi.MaxAllocatedStorage))
+	}
+	if mi.MultiAZ != nil {
+		changes = append(changes, resources.NewPendingChange("MultiAZ", foundConfig.MultiAZ, mi.MultiAZ))
+	}
+	if mi.AutoMinorVersionUpgrade != nil {
+		changes = append(changes, resources.NewPendingChange("AutoMinorVersionUpgrade", foundConfig.AutoMinorVersionUpgrade, mi.AutoMinorVersionUpgrade))
+	}
+	if mi.PreferredBackupWindow != nil {
+		changes = append(changes, resources.NewPendingChange("PreferredBackupWindow", foundConfig.PreferredBackupWindow, mi.PreferredBackupWindow))
+	}
+	if mi.PreferredMaintenanceWindow != nil {
+		changes = append(changes, resources.NewPendingChange("PreferredMaintenanceWindow", foundConfig.PreferredMaintenanceWindow, mi.PreferredMaintenanceWindow))
+	}
+	if mi.EngineVersion != nil {
+		changes = append(changes, resources.NewPendingChange("EngineVersion", foundConfig.EngineVersion, mi.EngineVersion))
+	}
+	return changes
+}
+
 // returns true if modify input is not pending
 func verifyPendingModification(mi *rds.ModifyDBInstanceInput, pm *rds.PendingModifiedValues) bool {
 	pendingModifications := true
@@ -898,7 +1235,8 @@ func (p *PostgresProvider) buildRDSCreateStrategy(ctx context.Context, pg *v1alp
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to retrieve rds config")
 	}
-	if rdsCreateConfig.DBInstanceIdentifier == nil {
+	// an adopted instance is always identified by the external resource id of the cr
+	if rdsCreateConfig.DBInstanceIdentifier == nil || resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
 		rdsCreateConfig.DBInstanceIdentifier = aws.String(instanceName)
 	}
 	if rdsCreateConfig.MultiAZ == nil {
@@ -931,6 +1269,14 @@ func (p *PostgresProvider) buildRDSCreateStrategy(ctx context.Context, pg *v1alp
 		rdsCreateConfig.VpcSecurityGroupIds = []*string{
 			aws.String(*foundSecGroup.GroupId),
 		}
+		// the instance uses a security group of its own when network access to it is restricted
+		if pg.Spec.NetworkAccess != nil {
+			accessSecGroup, err := reconcileNetworkAccessSecurityGroup(ctx, p.Client, ec2Svc, foundSecGroup, *rdsCreateConfig.DBInstanceIdentifier, *rdsCreateConfig.Port, pg.Spec.NetworkAccess, p.Logger)
+			if err != nil {
+				return errorUtil.Wrap(err, "failed to reconcile network access security group")
+			}
+			rdsCreateConfig.VpcSecurityGroupIds = []*string{accessSecGroup.GroupId}
+		}
 	}
 	if rdsCreateConfig.CopyTagsToSnapshot == nil {
 		rdsCreateConfig.CopyTagsToSnapshot = aws.Bool(defaultAwsCopyTagsToSnapshot)
@@ -940,12 +1286,12 @@ func (p *PostgresProvider) buildRDSCreateStrategy(ctx context.Context, pg *v1alp
 
 // verify postgres delete config
 func (p *PostgresProvider) buildRDSDeleteConfig(ctx context.Context, pg *v1alpha1.Postgres, rdsCreateConfig *rds.CreateDBInstanceInput, rdsDeleteConfig *rds.DeleteDBInstanceInput) error {
-	instanceIdentifier, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultAwsIdentifierLength)
+	instanceIdentifier, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to retrieve rds config")
 	}
-	if rdsDeleteConfig.DBInstanceIdentifier == nil {
-		if rdsCreateConfig.DBInstanceIdentifier == nil {
+	if rdsDeleteConfig.DBInstanceIdentifier == nil || resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
+		if rdsCreateConfig.DBInstanceIdentifier == nil || resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
 			rdsCreateConfig.DBInstanceIdentifier = aws.String(instanceIdentifier)
 		}
 		rdsDeleteConfig.DBInstanceIdentifier = rdsCreateConfig.DBInstanceIdentifier
@@ -953,6 +1299,14 @@ func (p *PostgresProvider) buildRDSDeleteConfig(ctx context.Context, pg *v1alpha
 	if rdsDeleteConfig.DeleteAutomatedBackups == nil {
 		rdsDeleteConfig.DeleteAutomatedBackups = aws.Bool(defaultAwsDeleteAutomatedBackups)
 	}
+	// the deletion policy of the cr takes precedence over the final snapshot settings of the strategy
+	switch pg.Spec.DeletionPolicy {
+	case croType.DeletionPolicySnapshot:
+		rdsDeleteConfig.SkipFinalSnapshot = aws.Bool(false)
+	case croType.DeletionPolicyDelete:
+		rdsDeleteConfig.SkipFinalSnapshot = aws.Bool(true)
+		rdsDeleteConfig.FinalDBSnapshotIdentifier = nil
+	}
 	if rdsDeleteConfig.SkipFinalSnapshot == nil {
 		rdsDeleteConfig.SkipFinalSnapshot = aws.Bool(defaultAwsSkipFinalSnapshot)
 	}
@@ -1151,7 +1505,7 @@ func (p *PostgresProvider) setPostgresDeletionTimestampMetric(ctx context.Contex
 	}
 }
 
-func (p *PostgresProvider) setPostgresServiceMaintenanceMetric(ctx context.Context, rdsSession rdsiface.RDSAPI, instance *rds.DBInstance) {
+func (p *PostgresProvider) setPostgresServiceMaintenanceMetric(ctx context.Context, cr *v1alpha1.Postgres, rdsSession rdsiface.RDSAPI, instance *rds.DBInstance) {
 	// if the instance is nil skip this metric
 	if instance == nil {
 		logrus.Error("foundInstance is nil, skipping setPostgresServiceMaintenanceMetric")
@@ -1173,6 +1527,7 @@ func (p *PostgresProvider) setPostgresServiceMaintenanceMetric(ctx context.Conte
 	}
 
 	logrus.Infof("rds serviceupdates: %d available", len(output.PendingMaintenanceActions))
+	var pendingMaintenance []string
 	for _, su := range output.PendingMaintenanceActions {
 		metricLabels := map[string]string{}
 
@@ -1201,13 +1556,66 @@ func (p *PostgresProvider) setPostgresServiceMaintenanceMetric(ctx context.Conte
 			metricLabels["Description"] = *pma.Description
 
 			resources.SetMetric(resources.DefaultPostgresMaintenanceMetricName, metricLabels, float64(metricEpochTimestamp))
+
+			if instance.DBInstanceArn != nil && *su.ResourceIdentifier == *instance.DBInstanceArn {
+				pendingMaintenance = append(pendingMaintenance, fmt.Sprintf("pending maintenance for rds instance %s: %s", *instance.DBInstanceIdentifier, *pma.Description))
+			}
+		}
+	}
+	resources.ReportMaintenance(p.Recorder, cr, &cr.Status, pendingMaintenance)
+}
+
+// setPostgresSnapshotMetrics exposes the creation time and size of the latest available rds snapshot
+// this covers both automated backups and manual snapshots created by the operator
+func (p *PostgresProvider) setPostgresSnapshotMetrics(ctx context.Context, cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, instance *rds.DBInstance) {
+	// if the instance is nil skip this metric
+	if instance == nil {
+		logrus.Error("foundInstance is nil, skipping setPostgresSnapshotMetrics")
+		return
+	}
+
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		logrus.Errorf("failed to get cluster id while exposing snapshot metrics for %s : %v", *instance.DBInstanceIdentifier, err)
+		return
+	}
+
+	// automated and manual snapshots are both returned when no snapshot type is specified, only the snapshots of the
+	// instance are listed and the largest page size is used to keep the number of calls down
+	var snapshots []*resources.SnapshotMetricInfo
+	input := &rds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: instance.DBInstanceIdentifier,
+		MaxRecords:           aws.Int64(100),
+	}
+	for {
+		output, err := rdsSvc.DescribeDBSnapshots(input)
+		if err != nil {
+			logrus.Errorf("failed to describe snapshots while exposing snapshot metrics for %s : %v", *instance.DBInstanceIdentifier, err)
+			return
 		}
+		for _, snapshot := range output.DBSnapshots {
+			if aws.StringValue(snapshot.Status) != "available" || snapshot.SnapshotCreateTime == nil {
+				continue
+			}
+			// rds does not report the size of the snapshot, the allocated storage of the snapshotted instance is exposed
+			// instead, as documented for cro_postgres_latest_snapshot_size_bytes
+			snapshots = append(snapshots, &resources.SnapshotMetricInfo{
+				CreateTime: *snapshot.SnapshotCreateTime,
+				SizeBytes:  float64(aws.Int64Value(snapshot.AllocatedStorage) * resources.BytesInGibiBytes),
+			})
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
 	}
 
+	genericLabels := resources.BuildGenericMetricLabels(cr.ObjectMeta, clusterID, *instance.DBInstanceIdentifier, postgresProviderName)
+	resources.SetLatestSnapshotMetrics(resources.DefaultPostgresLatestSnapshotTimestampMetricName, resources.DefaultPostgresLatestSnapshotSizeBytesMetricName, genericLabels, snapshots)
 }
 
-// tests to see if a simple tcp connection can be made to rds and creates a metric based on this
-func (p *PostgresProvider) createRDSConnectionMetric(ctx context.Context, cr *v1alpha1.Postgres, instance *rds.DBInstance) {
+// tests to see if the generated credentials can be used to query rds and creates a metric based on this
+func (p *PostgresProvider) createRDSConnectionMetric(ctx context.Context, cr *v1alpha1.Postgres, instance *rds.DBInstance, password string) {
 	// build instance name
 	instanceName, err := p.buildInstanceName(ctx, cr)
 	if err != nil {
@@ -1239,8 +1647,15 @@ func (p *PostgresProvider) createRDSConnectionMetric(ctx context.Context, cr *v1
 	}
 
 	// test the connection
-	conn := p.TCPPinger.TCPConnection(*instance.Endpoint.Address, int(*instance.Endpoint.Port))
-	if !conn {
+	result := p.TCPPinger.PostgresConnection(ctx, &resources.PostgresConnectionDetails{
+		Host:     aws.StringValue(instance.Endpoint.Address),
+		Port:     int(aws.Int64Value(instance.Endpoint.Port)),
+		Username: aws.StringValue(instance.MasterUsername),
+		Password: password,
+		Database: aws.StringValue(instance.DBName),
+	})
+	resources.SetConnectionProbeMetrics(resources.ConnectionProbeResourceTypePostgres, genericLabels, result)
+	if !result.Success {
 		// create failed connection metric
 		resources.SetMetric(resources.DefaultPostgresConnectionMetricName, genericLabels, 0)
 		return
@@ -1251,7 +1666,7 @@ func (p *PostgresProvider) createRDSConnectionMetric(ctx context.Context, cr *v1
 
 // returns the name of the instance from build infra
 func (p *PostgresProvider) buildInstanceName(ctx context.Context, pg *v1alpha1.Postgres) (string, error) {
-	instanceName, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultAwsIdentifierLength)
+	instanceName, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return "", errorUtil.Errorf("error occurred building instance name: %v", err)
 	}
@@ -1262,7 +1677,7 @@ func rdsInstanceStatusIsHealthy(instance *rds.DBInstance) bool {
 	return resources.Contains(healthyAWSDBInstanceStatuses, *instance.DBInstanceStatus)
 }
 
-func (p *PostgresProvider) rdsApplyServiceUpdates(rdsSvc rdsiface.RDSAPI, serviceUpdates *ServiceUpdate, foundInstance *rds.DBInstance) (bool, croType.StatusMessage, error) {
+func (p *PostgresProvider) rdsApplyServiceUpdates(cr *v1alpha1.Postgres, rdsSvc rdsiface.RDSAPI, serviceUpdates *ServiceUpdate, foundInstance *rds.DBInstance) (bool, croType.StatusMessage, error) {
 	// Retrieve service maintenance updates, create and export Prometheus metrics
 	output, err := rdsSvc.DescribePendingMaintenanceActions(&rds.DescribePendingMaintenanceActionsInput{ResourceIdentifier: foundInstance.DBInstanceArn})
 	if err != nil {
@@ -1297,6 +1712,7 @@ func (p *PostgresProvider) rdsApplyServiceUpdates(rdsSvc rdsiface.RDSAPI, servic
 				errMsg := "failed to apply service update"
 				return false, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 			}
+			resources.RecordEvent(p.Recorder, cr, resources.EventReasonServiceUpdateApplied, "applied %s service update to rds instance %s", *pmac.Action, *foundInstance.DBInstanceIdentifier)
 			upgrading = true
 		}
 	}
@@ -1304,8 +1720,7 @@ func (p *PostgresProvider) rdsApplyServiceUpdates(rdsSvc rdsiface.RDSAPI, servic
 }
 
 func addAnnotation(ctx context.Context, client client.Client, cr *v1alpha1.Postgres, rdsDBInstanceIdentifier string) (croType.StatusMessage, error) {
-	annotations.Add(cr, ResourceIdentifierAnnotation, rdsDBInstanceIdentifier)
-	if err := client.Update(ctx, cr); err != nil {
+	if err := resources.SetResourceIdentifier(ctx, client, cr, rdsDBInstanceIdentifier); err != nil {
 		errMsg := "failed to add annotation"
 		return croType.StatusMessage(errMsg), err
 	}
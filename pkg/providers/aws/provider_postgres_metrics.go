@@ -74,7 +74,7 @@ func (p PostgresMetricsProvider) ScrapePostgresMetrics(ctx context.Context, post
 	}
 
 	// reconcile aws credentials (keys)
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, postgres.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, postgres.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to reconcile rds credentials")
 	}
@@ -99,7 +99,7 @@ func (p PostgresMetricsProvider) ScrapePostgresMetrics(ctx context.Context, post
 // scrapeRDSCloudWatchMetricData fetches cloud watch metrics for rds
 // and parses it to a GenericCloudMetric in order to return to the controller
 func (p *PostgresMetricsProvider) scrapeRDSCloudWatchMetricData(ctx context.Context, cloudWatchApi cloudwatchiface.CloudWatchAPI, postgres *v1alpha1.Postgres, metricTypes []providers.CloudProviderMetricType) ([]*providers.GenericCloudMetric, error) {
-	resourceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, postgres.ObjectMeta, defaultAwsIdentifierLength)
+	resourceID, err := resources.BuildResourceIdentifier(ctx, p.Client, postgres.ObjectMeta, postgres.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return nil, errorUtil.Errorf("error occurred building instance name: %v", err)
 	}
@@ -112,7 +112,7 @@ func (p *PostgresMetricsProvider) scrapeRDSCloudWatchMetricData(ctx context.Cont
 		// build metric data query array from `metricTypes`
 		MetricDataQueries: buildRDSMetricDataQuery(metricTypes, resourceID),
 		// metrics gathered from start time to end time
-		StartTime: aws.Time(time.Now().Add(-resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration))),
+		StartTime: aws.Time(time.Now().Add(-getMetricDataWindow(metricTypes))),
 		EndTime:   aws.Time(time.Now()),
 	})
 	if err != nil {
@@ -178,9 +178,21 @@ func buildRDSMetricDataQuery(metricTypes []providers.CloudProviderMetricType, re
 					},
 				},
 				Stat:   aws.String(metricType.Statistic),
-				Period: aws.Int64(int64(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration).Seconds())),
+				Period: aws.Int64(int64(metricType.GetPeriodOrDefault(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration)).Seconds())),
 			},
 		})
 	}
 	return metricDataQueries
 }
+
+// getMetricDataWindow returns how far back metric data is requested, this is the metric reconcile time
+// unless a metric type declares a longer period, in which case at least one full period is requested
+func getMetricDataWindow(metricTypes []providers.CloudProviderMetricType) time.Duration {
+	window := resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration)
+	for _, metricType := range metricTypes {
+		if metricType.Period > window {
+			window = metricType.Period
+		}
+	}
+	return window
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -73,6 +74,8 @@ type mockRdsClient struct {
 	describePendingMaintenanceActionsFn func(*rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error)
 	applyPendingMaintenanceActionFn     func(*rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error)
 	modifyDBInstanceFn                  func(*rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error)
+	describeAccountAttributesFn         func(*rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error)
+	describeOrderableDBInstanceOptsFn   func(*rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error)
 }
 
 type mockEc2Client struct {
@@ -107,6 +110,8 @@ type mockEc2Client struct {
 	describeSubnetsFn               func(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
 	describeAvailabilityZonesFn     func(*ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	createSecurityGroupFn           func(*ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error)
+	describeTgwVpcAttachmentsFn     func(*ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error)
+	describeVpcEndpointServicesFn   func(*ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error)
 	calls                           struct {
 		DescribeRouteTables []struct {
 			Tables *ec2.DescribeRouteTablesInput
@@ -155,6 +160,15 @@ func buildMockEc2Client(modifyFn func(*mockEc2Client)) *mockEc2Client {
 	mock.describeSubnetsFn = func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
 		return &ec2.DescribeSubnetsOutput{}, nil
 	}
+	mock.describeVpcPeeringConnectionFn = func(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+		return &ec2.DescribeVpcPeeringConnectionsOutput{}, nil
+	}
+	mock.describeRouteTablesFn = func(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error) {
+		return &ec2.DescribeRouteTablesOutput{}, nil
+	}
+	mock.describeSecurityGroupsFn = func(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+		return &ec2.DescribeSecurityGroupsOutput{}, nil
+	}
 	if modifyFn != nil {
 		modifyFn(mock)
 	}
@@ -221,6 +235,9 @@ func (m *mockRdsClient) AddTagsToResource(input *rds.AddTagsToResourceInput) (*r
 }
 
 func (m *mockRdsClient) DescribeDBSnapshots(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+	if m.describeDBSnapshotsFn == nil {
+		return &rds.DescribeDBSnapshotsOutput{}, nil
+	}
 	return m.describeDBSnapshotsFn(input)
 }
 
@@ -265,6 +282,20 @@ func (m *mockRdsClient) RemoveTagsFromResource(input *rds.RemoveTagsFromResource
 	return m.removeTagsFromResourceFn(input)
 }
 
+func (m *mockRdsClient) DescribeAccountAttributes(input *rds.DescribeAccountAttributesInput) (*rds.DescribeAccountAttributesOutput, error) {
+	if m.describeAccountAttributesFn == nil {
+		return &rds.DescribeAccountAttributesOutput{}, nil
+	}
+	return m.describeAccountAttributesFn(input)
+}
+
+func (m *mockRdsClient) DescribeOrderableDBInstanceOptions(input *rds.DescribeOrderableDBInstanceOptionsInput) (*rds.DescribeOrderableDBInstanceOptionsOutput, error) {
+	if m.describeOrderableDBInstanceOptsFn == nil {
+		return &rds.DescribeOrderableDBInstanceOptionsOutput{OrderableDBInstanceOptions: []*rds.OrderableDBInstanceOption{{}}}, nil
+	}
+	return m.describeOrderableDBInstanceOptsFn(input)
+}
+
 func (m *mockEc2Client) DescribeSubnets(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
 	if m.describeSubnetsFn == nil {
 		panic("mockEc2Client.DescribeSubnets: method is nil")
@@ -442,6 +473,14 @@ func (m *mockEc2Client) DeleteSecurityGroup(input *ec2.DeleteSecurityGroupInput)
 	return m.deleteSecurityGroupFn(input)
 }
 
+func (m *mockEc2Client) DescribeTransitGatewayVpcAttachments(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return m.describeTgwVpcAttachmentsFn(input)
+}
+
+func (m *mockEc2Client) DescribeVpcEndpointServiceConfigurations(input *ec2.DescribeVpcEndpointServiceConfigurationsInput) (*ec2.DescribeVpcEndpointServiceConfigurationsOutput, error) {
+	return m.describeVpcEndpointServicesFn(input)
+}
+
 func (m *mockEc2Client) AuthorizeSecurityGroupIngress(*ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
 	return &ec2.AuthorizeSecurityGroupIngressOutput{}, nil
 }
@@ -509,6 +548,23 @@ func buildMockNetworkManager() *NetworkManagerMock {
 		DeleteBundledCloudResourcesFunc: func(ctx context.Context) error {
 			return nil
 		},
+		GetNetworkConnectorFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnector, error) {
+			return buildMockNetworkConnector(), nil
+		},
+	}
+}
+
+func buildMockNetworkConnector() *NetworkConnectorMock {
+	return &NetworkConnectorMock{
+		MethodFunc: func() NetworkConnectionMethod {
+			return NetworkConnectionMethodPeering
+		},
+		DeleteFunc: func(ctx context.Context) (bool, error) {
+			return true, nil
+		},
+		RemoveEndpointFunc: func(ctx context.Context, name string) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -543,8 +599,10 @@ func buildTestPostgresApplyImmediatelyCR() *v1alpha1.Postgres {
 				"productName": "test_product",
 			},
 		},
-		Spec: croType.ResourceTypeSpec{
-			ApplyImmediately: true,
+		Spec: v1alpha1.PostgresSpec{
+			ResourceTypeSpec: croType.ResourceTypeSpec{
+				ApplyImmediately: true,
+			},
 		},
 	}
 }
@@ -1008,6 +1066,86 @@ func TestAWSPostgresProvider_createPostgresInstance(t *testing.T) {
 			}},
 			wantErr: false,
 		},
+		{
+			name: "test rds without an initial database connects to the default database",
+			args: args{
+				rdsSvc: buildMockRdsClient(func(rdsClient *mockRdsClient) {
+					rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
+						return &rds.DescribeDBSubnetGroupsOutput{}, nil
+					}
+					rdsClient.describeDBInstancesFn = func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+						return &rds.DescribeDBInstancesOutput{
+							DBInstances: func() []*rds.DBInstance {
+								instances := buildAvailableDBInstance(testIdentifier)
+								instances[0].DBName = nil
+								return instances
+							}(),
+						}, nil
+					}
+					rdsClient.addTagsToResourceFn = func(input *rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error) {
+						return &rds.AddTagsToResourceOutput{}, nil
+					}
+					rdsClient.describeDBSnapshotsFn = func(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+						return &rds.DescribeDBSnapshotsOutput{
+							DBSnapshots: []*rds.DBSnapshot{
+								{
+									DBSnapshotArn:        &snapshotARN,
+									DBSnapshotIdentifier: &snapshotIdentifier,
+								},
+							},
+						}, nil
+					}
+					rdsClient.describePendingMaintenanceActionsFn = func(input *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+						return buildPendingMaintenanceActions()
+					}
+				}),
+				ec2Svc: &mockEc2Client{
+					describeVpcsFn: func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+						return &ec2.DescribeVpcsOutput{
+							Vpcs: buildVpcs(),
+						}, nil
+					},
+					subnets: buildValidBundleSubnets(),
+					describeSecurityGroupsFn: func(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+						return &ec2.DescribeSecurityGroupsOutput{
+							SecurityGroups: buildSecurityGroups(secName),
+						}, nil
+					},
+					describeSubnetsFn: func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+						return &ec2.DescribeSubnetsOutput{
+							Subnets: buildValidBundleSubnets(),
+						}, nil
+					},
+					describeAvailabilityZonesFn: func(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+						return &ec2.DescribeAvailabilityZonesOutput{
+							AvailabilityZones: buildAZ(),
+						}, nil
+					},
+				},
+				ctx: context.TODO(),
+				cr:  buildTestPostgresCR(),
+				postgresCfg: &rds.CreateDBInstanceInput{
+					DBInstanceIdentifier: aws.String(testIdentifier),
+				},
+				standaloneNetworkExists: false,
+				maintenanceWindow:       false,
+			},
+			fields: fields{
+				Client:            moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresCR(), builtTestCredSecret(), buildTestInfra()),
+				Logger:            testLogger,
+				CredentialManager: nil,
+				ConfigManager:     nil,
+				TCPPinger:         resources.BuildMockConnectionTester(),
+			},
+			want: &providers.PostgresInstance{DeploymentDetails: &providers.PostgresDeploymentDetails{
+				Username: defaultAwsPostgresUser,
+				Password: "test",
+				Host:     "blob",
+				Database: defaultAwsPostgresDatabase,
+				Port:     defaultAwsPostgresPort,
+			}},
+			wantErr: false,
+		},
 		{
 			name: "test rds exists and is not available (valid cluster bundle subnets)",
 			args: args{
@@ -1709,7 +1847,7 @@ func TestAWSPostgresProvider_createPostgresInstance(t *testing.T) {
 				ConfigManager:     tt.fields.ConfigManager,
 				TCPPinger:         tt.fields.TCPPinger,
 			}
-			got, _, err := p.reconcileRDSInstance(tt.args.ctx, tt.args.cr, tt.args.rdsSvc, tt.args.ec2Svc, tt.args.postgresCfg, tt.args.standaloneNetworkExists, tt.args.maintenanceWindow)
+			got, _, err := p.reconcileRDSInstance(tt.args.ctx, tt.args.cr, tt.args.rdsSvc, tt.args.ec2Svc, &mockIamClient{}, &mockStsClient{}, tt.args.postgresCfg, tt.args.standaloneNetworkExists, tt.args.maintenanceWindow)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("reconcileRDSInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1721,6 +1859,128 @@ func TestAWSPostgresProvider_createPostgresInstance(t *testing.T) {
 	}
 }
 
+// dryRunRdsClient records the rds api calls which change the cloud resource
+type dryRunRdsClient struct {
+	*mockRdsClient
+	calls []string
+}
+
+func (m *dryRunRdsClient) ModifyDBInstance(*rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+	m.calls = append(m.calls, "ModifyDBInstance")
+	return &rds.ModifyDBInstanceOutput{}, nil
+}
+
+func (m *dryRunRdsClient) AddTagsToResource(*rds.AddTagsToResourceInput) (*rds.AddTagsToResourceOutput, error) {
+	m.calls = append(m.calls, "AddTagsToResource")
+	return &rds.AddTagsToResourceOutput{}, nil
+}
+
+func (m *dryRunRdsClient) ApplyPendingMaintenanceAction(*rds.ApplyPendingMaintenanceActionInput) (*rds.ApplyPendingMaintenanceActionOutput, error) {
+	m.calls = append(m.calls, "ApplyPendingMaintenanceAction")
+	return &rds.ApplyPendingMaintenanceActionOutput{}, nil
+}
+
+func TestPostgresProvider_reconcileRDSInstance_dryRun(t *testing.T) {
+	scheme, err := buildTestSchemePostgresql()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	secName, err := resources.BuildInfraName(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()), defaultSecurityGroupPostfix, defaultAwsIdentifierLength)
+	if err != nil {
+		t.Fatal("failed to build security name", err)
+	}
+	testIdentifier := "test-identifier"
+	tests := []struct {
+		name           string
+		cr             *v1alpha1.Postgres
+		wantChanges    []string
+		wantAnnotation bool
+	}{
+		{
+			name: "test modifications and missing tags of an existing instance are reported as pending changes",
+			cr: func() *v1alpha1.Postgres {
+				cr := buildTestPostgresCR()
+				cr.Annotations = map[string]string{resources.DryRunAnnotation: "true", ResourceIdentifierAnnotation: testIdentifier}
+				cr.Spec.MaintenanceWindow = true
+				return cr
+			}(),
+			wantChanges:    []string{"DBInstanceClass", "Tags." + resources.DefaultTagKeyPrefix + "clusterID"},
+			wantAnnotation: true,
+		},
+		{
+			name: "test adoption of an existing instance is reported as pending changes",
+			cr: func() *v1alpha1.Postgres {
+				cr := buildTestPostgresCR()
+				cr.Annotations = map[string]string{resources.DryRunAnnotation: "true"}
+				cr.Spec.ExternalResourceID = testIdentifier
+				cr.Spec.ResetCredentials = true
+				return cr
+			}(),
+			wantChanges: []string{"MasterUserPassword", "DBInstanceClass", "Tags." + resources.DefaultTagKeyPrefix + "clusterID"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rdsSvc := &dryRunRdsClient{mockRdsClient: buildMockRdsClient(func(rdsClient *mockRdsClient) {
+				rdsClient.describeDBSubnetGroupsFn = func(input *rds.DescribeDBSubnetGroupsInput) (*rds.DescribeDBSubnetGroupsOutput, error) {
+					return &rds.DescribeDBSubnetGroupsOutput{}, nil
+				}
+				rdsClient.describeDBInstancesFn = func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+					instances := buildAvailableDBInstance(testIdentifier)
+					instances[0].DBInstanceClass = aws.String("db.t3.micro")
+					return &rds.DescribeDBInstancesOutput{DBInstances: instances}, nil
+				}
+				rdsClient.describeDBSnapshotsFn = func(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+					return &rds.DescribeDBSnapshotsOutput{}, nil
+				}
+				rdsClient.describePendingMaintenanceActionsFn = func(input *rds.DescribePendingMaintenanceActionsInput) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+					return buildPendingMaintenanceActions()
+				}
+			})}
+			ec2Svc := &mockEc2Client{
+				describeVpcsFn: func(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+					return &ec2.DescribeVpcsOutput{Vpcs: buildVpcs()}, nil
+				},
+				subnets: buildValidBundleSubnets(),
+				describeSecurityGroupsFn: func(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+					return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: buildSecurityGroups(secName)}, nil
+				},
+				describeSubnetsFn: func(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+					return &ec2.DescribeSubnetsOutput{Subnets: buildValidBundleSubnets()}, nil
+				},
+				describeAvailabilityZonesFn: func(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+					return &ec2.DescribeAvailabilityZonesOutput{AvailabilityZones: buildAZ()}, nil
+				},
+			}
+			p := &PostgresProvider{
+				Client:    moqClient.NewSigsClientMoqWithScheme(scheme, tt.cr, builtTestCredSecret(), buildTestInfra()),
+				Logger:    testLogger,
+				Recorder:  record.NewFakeRecorder(10),
+				TCPPinger: resources.BuildMockConnectionTester(),
+			}
+			rdsCfg := &rds.CreateDBInstanceInput{DBInstanceIdentifier: aws.String(testIdentifier), DBInstanceClass: aws.String(defaultAwsDBInstanceClass)}
+			if _, _, err := p.reconcileRDSInstance(context.TODO(), tt.cr, rdsSvc, ec2Svc, &mockIamClient{}, &mockStsClient{}, rdsCfg, false, tt.cr.Spec.MaintenanceWindow); err != nil {
+				t.Fatalf("reconcileRDSInstance() unexpected error = %v", err)
+			}
+			if len(rdsSvc.calls) != 0 {
+				t.Errorf("reconcileRDSInstance() changed the rds instance in dry-run mode with %v", rdsSvc.calls)
+			}
+			var fields []string
+			for _, change := range tt.cr.Status.PendingChanges {
+				fields = append(fields, change.Field)
+			}
+			for _, want := range tt.wantChanges {
+				if !resources.Contains(fields, want) {
+					t.Errorf("reconcileRDSInstance() pending changes %v do not contain %s", fields, want)
+				}
+			}
+			if got := resources.HasResourceIdentifier(context.TODO(), tt.cr); got != tt.wantAnnotation {
+				t.Errorf("reconcileRDSInstance() resource identifier annotation set = %v, want %v", got, tt.wantAnnotation)
+			}
+		})
+	}
+}
+
 func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 	scheme, err := buildTestSchemePostgresql()
 	testIdentifier := "test-id"
@@ -1735,7 +1995,6 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 		ConfigManager     ConfigManager
 	}
 	type args struct {
-		ctx                     context.Context
 		pg                      *v1alpha1.Postgres
 		networkManager          NetworkManager
 		instanceSvc             rdsiface.RDSAPI
@@ -1764,7 +2023,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 						return &rds.DescribeDBInstancesOutput{}, nil
 					},
 				},
-				ec2Svc:                  &mockEc2Client{},
+				ec2Svc:                  buildMockEc2Client(nil),
 				standaloneNetworkExists: false,
 				isLastResource:          false,
 			},
@@ -1790,7 +2049,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 						}, nil
 					},
 				},
-				ec2Svc:                  &mockEc2Client{},
+				ec2Svc:                  buildMockEc2Client(nil),
 				standaloneNetworkExists: false,
 				isLastResource:          false,
 			},
@@ -1816,7 +2075,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 						}, nil
 					},
 				},
-				ec2Svc:                  &mockEc2Client{},
+				ec2Svc:                  buildMockEc2Client(nil),
 				standaloneNetworkExists: false,
 				isLastResource:          false,
 			},
@@ -1842,7 +2101,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 						}, nil
 					},
 				},
-				ec2Svc:                  &mockEc2Client{},
+				ec2Svc:                  buildMockEc2Client(nil),
 				standaloneNetworkExists: false,
 				isLastResource:          false,
 			},
@@ -1855,6 +2114,37 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 			want:    croType.StatusMessage("deletion protection detected, modifyDBInstance() in progress, current aws rds status is available"),
 			wantErr: false,
 		},
+		{
+			name: "test successful retain with existing available postgres and the retain deletion policy",
+			args: args{
+				postgresDeleteConfig: &rds.DeleteDBInstanceInput{DBInstanceIdentifier: aws.String(testIdentifier)},
+				postgresCreateConfig: &rds.CreateDBInstanceInput{DBInstanceIdentifier: aws.String(testIdentifier)},
+				pg: func() *v1alpha1.Postgres {
+					pg := buildTestPostgresCR()
+					pg.Spec.DeletionPolicy = croType.DeletionPolicyRetain
+					return pg
+				}(),
+				networkManager: buildMockNetworkManager(),
+				instanceSvc: &mockRdsClient{
+					describeDBInstancesFn: func(input *rds.DescribeDBInstancesInput) (*rds.DescribeDBInstancesOutput, error) {
+						return &rds.DescribeDBInstancesOutput{
+							DBInstances: buildDbInstanceDeletionProtection(),
+						}, nil
+					},
+				},
+				ec2Svc:                  buildMockEc2Client(nil),
+				standaloneNetworkExists: false,
+				isLastResource:          true,
+			},
+			fields: fields{
+				Client:            moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresCR(), buildTestInfra(), buildTestPostgresqlPrometheusRule()),
+				Logger:            testLogger,
+				CredentialManager: &CredentialManagerMock{},
+				ConfigManager:     &ConfigManagerMock{},
+			},
+			want:    croType.StatusMessage("deletion policy is Retain, test-id kept"),
+			wantErr: false,
+		},
 		{
 			name: "test successful delete with no postgres and deletion of standalone network",
 			args: args{
@@ -1900,7 +2190,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 						return &rds.DescribeDBInstancesOutput{}, nil
 					},
 				},
-				ec2Svc:                  &mockEc2Client{},
+				ec2Svc:                  buildMockEc2Client(nil),
 				standaloneNetworkExists: false,
 				isLastResource:          true,
 			},
@@ -1922,7 +2212,7 @@ func TestAWSPostgresProvider_deletePostgresInstance(t *testing.T) {
 				CredentialManager: tt.fields.CredentialManager,
 				ConfigManager:     tt.fields.ConfigManager,
 			}
-			got, err := p.deleteRDSInstance(tt.args.ctx, tt.args.pg, tt.args.networkManager, tt.args.instanceSvc, tt.args.ec2Svc, tt.args.postgresCreateConfig, tt.args.postgresDeleteConfig, tt.args.standaloneNetworkExists, tt.args.isLastResource)
+			got, err := p.deleteRDSInstance(context.TODO(), tt.args.pg, tt.args.networkManager, tt.args.instanceSvc, tt.args.ec2Svc, tt.args.postgresCreateConfig, tt.args.postgresDeleteConfig, tt.args.standaloneNetworkExists, tt.args.isLastResource)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("deleteRDSInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2422,6 +2712,223 @@ func Test_buildRDSUpdateStrategy(t *testing.T) {
 	}
 }
 
+func TestPostgresProvider_reconcileRDSNetworkAccess(t *testing.T) {
+	accessSecGroup := buildSecurityGroup(func(sg *ec2.SecurityGroup) {
+		sg.GroupName = aws.String(buildNetworkAccessSecurityGroupName("test"))
+		sg.GroupId = aws.String("sg-access")
+	})
+	tests := []struct {
+		name          string
+		cr            *v1alpha1.Postgres
+		currentGroup  string
+		wantChanges   []croType.PendingChange
+		wantStatusMsg croType.StatusMessage
+		wantModify    bool
+	}{
+		{
+			name: "test network access is applied to the rds instance",
+			cr: func() *v1alpha1.Postgres {
+				cr := buildTestPostgresCR()
+				cr.Spec.NetworkAccess = &croType.NetworkAccess{ClusterPodsOnly: true}
+				return cr
+			}(),
+			currentGroup:  "sg-shared",
+			wantStatusMsg: "applied network access to rds instance test",
+			wantModify:    true,
+		},
+		{
+			name: "test network access is reported as a pending change in dry-run mode",
+			cr: func() *v1alpha1.Postgres {
+				cr := buildTestPostgresCR()
+				cr.Annotations = map[string]string{resources.DryRunAnnotation: "true"}
+				cr.Spec.NetworkAccess = &croType.NetworkAccess{ClusterPodsOnly: true}
+				return cr
+			}(),
+			currentGroup: "sg-shared",
+			wantChanges: []croType.PendingChange{
+				{Field: "VpcSecurityGroupIds", Current: `["sg-shared"]`, Desired: `["sg-access"]`},
+			},
+		},
+		{
+			name: "test unused network access security group is reported as a pending change in dry-run mode",
+			cr: func() *v1alpha1.Postgres {
+				cr := buildTestPostgresCR()
+				cr.Annotations = map[string]string{resources.DryRunAnnotation: "true"}
+				return cr
+			}(),
+			currentGroup: "sg-shared",
+			wantChanges: []croType.PendingChange{
+				{Field: "NetworkAccessSecurityGroup", Current: buildNetworkAccessSecurityGroupName("test")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modified := false
+			rdsSvc := buildMockRdsClient(func(rdsClient *mockRdsClient) {
+				rdsClient.modifyDBInstanceFn = func(input *rds.ModifyDBInstanceInput) (*rds.ModifyDBInstanceOutput, error) {
+					modified = true
+					return &rds.ModifyDBInstanceOutput{}, nil
+				}
+			})
+			ec2Svc := buildMockEc2Client(func(ec2Client *mockEc2Client) {
+				ec2Client.describeSecurityGroupsFn = func(input *ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error) {
+					return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{accessSecGroup}}, nil
+				}
+				ec2Client.deleteSecurityGroupFn = func(input *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error) {
+					t.Fatal("unexpected delete of the network access security group")
+					return nil, nil
+				}
+			})
+			foundInstance := &rds.DBInstance{
+				DBInstanceIdentifier: aws.String("test"),
+				VpcSecurityGroups:    []*rds.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String(tt.currentGroup)}},
+			}
+			rdsCfg := &rds.CreateDBInstanceInput{VpcSecurityGroupIds: aws.StringSlice([]string{"sg-access"})}
+			p := &PostgresProvider{Logger: testLogger, Recorder: record.NewFakeRecorder(10)}
+			changes, statusMsg, err := p.reconcileRDSNetworkAccess(tt.cr, rdsSvc, ec2Svc, rdsCfg, foundInstance)
+			if err != nil {
+				t.Fatalf("reconcileRDSNetworkAccess() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(changes, tt.wantChanges) {
+				t.Errorf("reconcileRDSNetworkAccess() changes = %v, want %v", changes, tt.wantChanges)
+			}
+			if statusMsg != tt.wantStatusMsg {
+				t.Errorf("reconcileRDSNetworkAccess() statusMsg = %v, want %v", statusMsg, tt.wantStatusMsg)
+			}
+			if modified != tt.wantModify {
+				t.Errorf("reconcileRDSNetworkAccess() modified = %t, want %t", modified, tt.wantModify)
+			}
+		})
+	}
+}
+
+func Test_buildRDSPendingChanges(t *testing.T) {
+	type args struct {
+		mi          *rds.ModifyDBInstanceInput
+		foundConfig *rds.DBInstance
+	}
+	tests := []struct {
+		name string
+		args args
+		want []croType.PendingChange
+	}{
+		{
+			name: "test pending changes are built from the modify input",
+			args: args{
+				mi: &rds.ModifyDBInstanceInput{
+					DBInstanceIdentifier: aws.String("test"),
+					DBInstanceClass:      aws.String("db.t3.large"),
+					DBPortNumber:         aws.Int64(5433),
+					MultiAZ:              aws.Bool(true),
+					EngineVersion:        aws.String("16.1"),
+				},
+				foundConfig: &rds.DBInstance{
+					DBInstanceIdentifier: aws.String("test"),
+					DBInstanceClass:      aws.String("db.t3.small"),
+					Endpoint: &rds.Endpoint{
+						Port: aws.Int64(5432),
+					},
+					MultiAZ:       aws.Bool(false),
+					EngineVersion: aws.String("15.5"),
+				},
+			},
+			want: []croType.PendingChange{
+				{Field: "DBPortNumber", Current: "5432", Desired: "5433"},
+				{Field: "DBInstanceClass", Current: "db.t3.small", Desired: "db.t3.large"},
+				{Field: "MultiAZ", Current: "false", Desired: "true"},
+				{Field: "EngineVersion", Current: "15.5", Desired: "16.1"},
+			},
+		},
+		{
+			name: "test no pending changes without modify input",
+			args: args{
+				foundConfig: &rds.DBInstance{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildRDSPendingChanges(tt.args.mi, tt.args.foundConfig); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildRDSPendingChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_buildRDSDrift(t *testing.T) {
+	type args struct {
+		rdsConfig    *rds.CreateDBInstanceInput
+		foundConfig  *rds.DBInstance
+		expectedTags []*rds.Tag
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []resources.DriftedAttribute
+		wantErr bool
+	}{
+		{
+			name: "test drift is built from the strategy",
+			args: args{
+				rdsConfig: &rds.CreateDBInstanceInput{
+					DBInstanceClass:     aws.String("db.t3.large"),
+					MultiAZ:             aws.Bool(true),
+					EngineVersion:       aws.String("16.1"),
+					VpcSecurityGroupIds: aws.StringSlice([]string{"sg-a", "sg-b"}),
+				},
+				foundConfig: &rds.DBInstance{
+					DBInstanceClass: aws.String("db.t3.small"),
+					MultiAZ:         aws.Bool(true),
+					EngineVersion:   aws.String("15.5"),
+					VpcSecurityGroups: []*rds.VpcSecurityGroupMembership{
+						{VpcSecurityGroupId: aws.String("sg-b")},
+						{VpcSecurityGroupId: aws.String("sg-a")},
+					},
+					TagList: []*rds.Tag{{Key: aws.String("integreatly.org/clusterID"), Value: aws.String("old")}},
+				},
+				expectedTags: []*rds.Tag{{Key: aws.String("integreatly.org/clusterID"), Value: aws.String("test")}},
+			},
+			want: []resources.DriftedAttribute{
+				{Name: "DBInstanceClass", Current: "db.t3.small", Desired: "db.t3.large"},
+				{Name: "EngineVersion", Current: "15.5", Desired: "16.1"},
+				{Name: "Tags[integreatly.org/clusterID]", Current: "old", Desired: "test"},
+			},
+		},
+		{
+			name: "test newer engine version is not drift",
+			args: args{
+				rdsConfig:   &rds.CreateDBInstanceInput{EngineVersion: aws.String("15.2")},
+				foundConfig: &rds.DBInstance{EngineVersion: aws.String("15.5")},
+			},
+		},
+		{
+			name: "test invalid engine version returns error",
+			args: args{
+				rdsConfig:   &rds.CreateDBInstanceInput{EngineVersion: aws.String("invalid")},
+				foundConfig: &rds.DBInstance{EngineVersion: aws.String("15.5")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRDSDrift(tt.args.rdsConfig, tt.args.foundConfig, tt.args.expectedTags)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("buildRDSDrift() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Attributes, tt.want) {
+				t.Errorf("buildRDSDrift() = %v, want %v", got.Attributes, tt.want)
+			}
+		})
+	}
+}
+
 func Test_rdsApplyServiceUpdates(t *testing.T) {
 	testIdentifier := "test-identifier"
 	scheme, err := buildTestSchemePostgresql()
@@ -2695,7 +3202,7 @@ func Test_rdsApplyServiceUpdates(t *testing.T) {
 				CredentialManager: tt.fields.CredentialManager,
 				ConfigManager:     tt.fields.ConfigManager,
 			}
-			update, got, err := p.rdsApplyServiceUpdates(tt.args.session, tt.args.serviceUpdates, tt.args.foundInstance)
+			update, got, err := p.rdsApplyServiceUpdates(&v1alpha1.Postgres{}, tt.args.session, tt.args.serviceUpdates, tt.args.foundInstance)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("rdsApplyStatusUpdate() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2806,7 +3313,7 @@ func TestReconcilePostgres(t *testing.T) {
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestPostgresCR()),
 				Logger: testLogger,
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return nil, genericAWSError
 					},
 				},
@@ -2834,7 +3341,7 @@ func TestReconcilePostgres(t *testing.T) {
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra(), buildTestPostgresCR()),
 				Logger: testLogger,
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -2927,7 +3434,7 @@ func TestNewAWSPostgresProvider(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAWSPostgresProvider(tt.args.client(), tt.args.logger)
+			got, err := NewAWSPostgresProvider(tt.args.client(), tt.args.logger, record.NewFakeRecorder(10))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("NewAWSPostgresProvider(), got = %v, want non-nil error", err)
@@ -2965,7 +3472,7 @@ func TestAddAnnotation_ClientUpdate(t *testing.T) {
 			args: args{
 				client: func() client.Client {
 					mockClient := moqClient.NewSigsClientMoqWithScheme(scheme)
-					mockClient.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mockClient.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return nil
 					}
 					return mockClient
@@ -2981,7 +3488,7 @@ func TestAddAnnotation_ClientUpdate(t *testing.T) {
 			args: args{
 				client: func() client.Client {
 					mockClient := moqClient.NewSigsClientMoqWithScheme(scheme)
-					mockClient.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mockClient.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return errors.New("failed to add annotation")
 					}
 					return mockClient
@@ -3146,3 +3653,37 @@ func TestPostgresProvider_setPostgresMaxMemoryMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgresProvider_setPostgresSnapshotMetrics(t *testing.T) {
+	scheme, err := buildTestSchemePostgresql()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	instanceID := "test-identifier"
+	var describeCalls int
+	rdsClient := buildMockRdsClient(func(rdsClient *mockRdsClient) {
+		rdsClient.describeDBSnapshotsFn = func(input *rds.DescribeDBSnapshotsInput) (*rds.DescribeDBSnapshotsOutput, error) {
+			describeCalls++
+			if aws.StringValue(input.DBInstanceIdentifier) != instanceID {
+				t.Errorf("setPostgresSnapshotMetrics() described snapshots of %v, want %s", input.DBInstanceIdentifier, instanceID)
+			}
+			if input.Marker == nil {
+				return &rds.DescribeDBSnapshotsOutput{
+					DBSnapshots: []*rds.DBSnapshot{{Status: aws.String("available"), SnapshotCreateTime: aws.Time(time.Now().Add(-time.Hour)), AllocatedStorage: aws.Int64(20)}},
+					Marker:      aws.String("next"),
+				}, nil
+			}
+			return &rds.DescribeDBSnapshotsOutput{
+				DBSnapshots: []*rds.DBSnapshot{{Status: aws.String("creating"), AllocatedStorage: aws.Int64(20)}},
+			}, nil
+		}
+	})
+	p := &PostgresProvider{
+		Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInfra()),
+		Logger: logrus.NewEntry(logrus.StandardLogger()),
+	}
+	p.setPostgresSnapshotMetrics(context.TODO(), buildTestPostgresCR(), rdsClient, &rds.DBInstance{DBInstanceIdentifier: aws.String(instanceID)})
+	if describeCalls != 2 {
+		t.Errorf("setPostgresSnapshotMetrics() described snapshots %d times, want 2", describeCalls)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,9 +34,10 @@ type PostgresSnapshotProvider struct {
 	logger            *logrus.Entry
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
 }
 
-func NewAWSPostgresSnapshotProvider(client client.Client, logger *logrus.Entry) (*PostgresSnapshotProvider, error) {
+func NewAWSPostgresSnapshotProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*PostgresSnapshotProvider, error) {
 	cm, err := NewCredentialManager(client)
 	if err != nil {
 		return nil, err
@@ -45,6 +47,7 @@ func NewAWSPostgresSnapshotProvider(client client.Client, logger *logrus.Entry)
 		logger:            logger.WithFields(logrus.Fields{"provider": postgresSnapshotProviderName}),
 		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigMapConfigManager(client),
+		Recorder:          recorder,
 	}, nil
 }
 
@@ -70,7 +73,7 @@ func (p *PostgresSnapshotProvider) CreatePostgresSnapshot(ctx context.Context, s
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	session, err := p.createSessionForResource(ctx, postgres.Namespace, providers.PostgresResourceType, postgres.Spec.Tier)
+	session, err := p.createSessionForResource(ctx, snapshot, providers.PostgresResourceType, postgres.Spec.Tier)
 
 	if err != nil {
 		errMsg := "failed to create AWS session"
@@ -85,7 +88,7 @@ func (p *PostgresSnapshotProvider) CreatePostgresSnapshot(ctx context.Context, s
 func (p *PostgresSnapshotProvider) DeletePostgresSnapshot(ctx context.Context, snapshot *v1alpha1.PostgresSnapshot, postgres *v1alpha1.Postgres) (croType.StatusMessage, error) {
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
-	session, err := p.createSessionForResource(ctx, postgres.Namespace, providers.PostgresResourceType, postgres.Spec.Tier)
+	session, err := p.createSessionForResource(ctx, snapshot, providers.PostgresResourceType, postgres.Spec.Tier)
 
 	if err != nil {
 		errMsg := "failed to create AWS session"
@@ -108,15 +111,13 @@ func (p *PostgresSnapshotProvider) createPostgresSnapshot(ctx context.Context, s
 	}
 
 	// update cr with snapshot name
-	snapshot.Status.SnapshotID = snapshotName
-
-	if err = p.client.Status().Update(ctx, snapshot); err != nil {
+	if err = resources.PatchStatus(ctx, p.client, snapshot, func() { snapshot.Status.SnapshotID = snapshotName }); err != nil {
 		errMsg := fmt.Sprintf("failed to update instance %s in namespace %s", snapshot.Name, snapshot.Namespace)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
 	// get instance name
-	instanceName, err := resources.BuildInfraNameFromObject(ctx, p.client, postgres.ObjectMeta, defaultAwsIdentifierLength)
+	instanceName, err := resources.BuildResourceIdentifier(ctx, p.client, postgres.ObjectMeta, postgres.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		errMsg := "failed to get cluster name"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
@@ -157,6 +158,7 @@ func (p *PostgresSnapshotProvider) createPostgresSnapshot(ctx context.Context, s
 			errMsg := "error creating rds snapshot"
 			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 		}
+		resources.RecordEvent(p.Recorder, snapshot, resources.EventReasonCreateStarted, "started rds snapshot %s", snapshotName)
 		return nil, "snapshot started", nil
 	}
 
@@ -184,9 +186,7 @@ func (p *PostgresSnapshotProvider) deletePostgresSnapshot(ctx context.Context, s
 
 	// snapshot is deleted
 	if foundSnapshot == nil {
-		resources.RemoveFinalizer(&snapshot.ObjectMeta, DefaultFinalizer)
-
-		if err := p.client.Update(ctx, snapshot); err != nil {
+		if err := resources.DeleteFinalizer(ctx, p.client, snapshot, DefaultFinalizer); err != nil {
 			msg := "failed to update instance as part of finalizer reconcile"
 			return croType.StatusMessage(msg), errorUtil.Wrapf(err, msg)
 		}
@@ -229,11 +229,12 @@ func (p *PostgresSnapshotProvider) findSnapshotInstance(rdsSvc rdsiface.RDSAPI,
 	return foundSnapshot, nil
 }
 
-func (p *PostgresSnapshotProvider) createSessionForResource(ctx context.Context, namespace string, resourceType providers.ResourceType, tier string) (*session.Session, error) {
+func (p *PostgresSnapshotProvider) createSessionForResource(ctx context.Context, snapshot client.Object, resourceType providers.ResourceType, tier string) (*session.Session, error) {
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, snapshot.GetNamespace(), providers.PostgresResourceType)
 	if err != nil {
+		resources.RecordWarningEvent(p.Recorder, snapshot, resources.EventReasonCredentialsFailed, "failed to reconcile aws credentials: %v", err)
 		return nil, errorUtil.Wrap(err, "failed to reconcile aws credentials")
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"k8s.io/client-go/tools/record"
 	"os"
 	"reflect"
 	"testing"
@@ -796,7 +797,7 @@ func TestNewAWSPostgresSnapshotProvider(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAWSPostgresSnapshotProvider(tt.args.client(), tt.args.logger)
+			got, err := NewAWSPostgresSnapshotProvider(tt.args.client(), tt.args.logger, record.NewFakeRecorder(10))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("NewAWSPostgresSnapshotProvider(), got = %v, want non-nil error", err)
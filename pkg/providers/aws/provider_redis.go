@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -13,10 +14,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
-	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
 
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 
@@ -43,6 +46,7 @@ const (
 	// 3scale does not support in transit encryption (redis with tls)
 	defaultInTransitEncryption = false
 	defaultNumCacheClusters    = 2
+	defaultRedisPort           = 6379
 	defaultSnapshotRetention   = 31
 	redisProviderName          = "aws-elasticache"
 )
@@ -69,9 +73,10 @@ type RedisProvider struct {
 	ConfigManager     ConfigManager
 	CacheSvc          elasticacheiface.ElastiCacheAPI
 	TCPPinger         resources.ConnectionTester
+	Recorder          record.EventRecorder
 }
 
-func NewAWSRedisProvider(client client.Client, logger *logrus.Entry) (*RedisProvider, error) {
+func NewAWSRedisProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*RedisProvider, error) {
 	cm, err := NewCredentialManager(client)
 	if err != nil {
 		return nil, err
@@ -82,6 +87,7 @@ func NewAWSRedisProvider(client client.Client, logger *logrus.Entry) (*RedisProv
 		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigMapConfigManager(client),
 		TCPPinger:         resources.NewConnectionTestManager(),
+		Recorder:          recorder,
 	}, nil
 }
 
@@ -117,12 +123,19 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 	}
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.RedisResourceType)
 	if err != nil {
 		msg := "failed to reconcile elasticache credentials"
+		resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
+	// the network access security group is built from the cluster vpc, which is not reachable with the role of the strategy
+	if stratCfg.IsCrossAccount() && r.Spec.NetworkAccess != nil {
+		errMsg := "networkAccess is not supported with a strategy roleArn"
+		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+
 	// setup aws elasticache cluster sdk session
 	sess, err := CreateSessionFromStrategy(ctx, p.Client, providerCreds, stratCfg)
 	if err != nil {
@@ -137,54 +150,43 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 	}
 
 	// check if a standalone network is required
-	networkManager := NewNetworkManager(sess, p.Client, logger, isSTSCluster(ctx, p.Client))
+	networkManager, msg, err := newNetworkManagerFromStrategy(ctx, p.Client, p.ConfigManager, r.Spec.Tier, providerCreds, stratCfg, sess, logger)
+	if err != nil {
+		return nil, msg, errorUtil.Wrap(err, "failed to initialise network manager")
+	}
 	isEnabled, err := networkManager.IsEnabled(ctx)
 	if err != nil {
 		errMsg := "failed to check cluster vpc subnets"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
+	// cloud resources are created once the cloud network, when one exists, is ready
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		errMsg := "failed to get cloud network"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if cloudNetwork != nil && !resources.IsCloudNetworkReady(cloudNetwork) {
+		return nil, croType.StatusMessage(fmt.Sprintf("waiting for cloud network %s to become ready", cloudNetwork.Name)), nil
+	}
+
 	//networkManager isEnabled checks for the presence of valid CRO subnets in the cluster vpc
 	//when CRO subnets are present in a cluster vpc it indicates that the vpc configuration
 	//was created in a cluster with a cluster version <= 4.4.5
 	//
 	//when CRO subnets are absent in a cluster vpc it indicates that the vpc configuration has not been created
-	//and a new vpc is created for all resources to be deployed in and peered with the cluster vpc
+	//and a new vpc is created for all resources to be deployed in and connected to the cluster vpc
+	var networkConnector NetworkConnector
 	if isEnabled {
-		// get cidr block from _network strat map, based on tier from redis cr
-		vpcCidrBlock, err := networkManager.ReconcileNetworkProviderConfig(ctx, p.ConfigManager, r.Spec.Tier, logger)
-		if err != nil {
-			errMsg := "failed to get _network strategy config"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Debug("standalone network provider enabled, reconciling standalone vpc")
-
-		// create the standalone vpc, subnets and subnet groups
-		standaloneNetwork, err := networkManager.CreateNetwork(ctx, vpcCidrBlock)
-		if err != nil {
-			errMsg := "failed to create resource network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		var msg croType.StatusMessage
+		networkConnector, msg, err = getStandaloneNetworkConnector(ctx, networkManager, p.ConfigManager, cloudNetwork, r.Spec.Tier, logger)
+		if err != nil || networkConnector == nil {
+			return nil, msg, err
 		}
-		// we've created the standalone vpc, now we peer it to the cluster vpc
-		logger.Infof("creating network peering")
-		networkPeering, err := networkManager.CreateNetworkPeering(ctx, standaloneNetwork)
-		if err != nil {
-			errMsg := "failed to peer standalone network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Infof("created network peering %s", aws.StringValue(networkPeering.PeeringConnection.VpcPeeringConnectionId))
-
-		// we have created the peering connection we must now create the security groups and update the route tables
-		securityGroup, err := networkManager.CreateNetworkConnection(ctx, standaloneNetwork)
-		if err != nil {
-			errMsg := "failed to create standalone network"
-			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-		}
-		logger.Infof("created security group %s", aws.StringValue(securityGroup.StandaloneSecurityGroup.GroupName))
 	}
 
 	// create the aws elasticache cluster
-	redis, reconcileStatus, err := p.createElasticacheCluster(ctx, r, elasticache.New(sess), sts.New(sess), ec2.New(sess), elasticacheCreateConfig, stratCfg, serviceUpdates, isEnabled, maintenanceWindow)
+	redis, reconcileStatus, err := p.createElasticacheCluster(ctx, r, elasticache.New(sess), sts.New(sess), ec2.New(sess), iam.New(sess), elasticacheCreateConfig, stratCfg, serviceUpdates, isEnabled, maintenanceWindow)
 	if err != nil {
 		errMsg := "failed to reconcile redis instance"
 		return nil, reconcileStatus, errorUtil.Wrap(err, errMsg)
@@ -193,10 +195,29 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 		return nil, reconcileStatus, nil
 	}
 
-	// set updates allowed to false on the CR after successful reconcile
-	if maintenanceWindow {
-		r.Spec.MaintenanceWindow = false
-		if err := p.Client.Update(ctx, r); err != nil {
+	// the replication group may not be reachable on its own endpoint from the cluster vpc, depending on the connection method
+	if networkConnector != nil {
+		rdd, ok := redis.DeploymentDetails.(*providers.RedisDeploymentDetails)
+		if !ok {
+			errMsg := "unexpected redis deployment details type"
+			return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+		}
+		endpoint, err := networkConnector.ExposeEndpoint(ctx, aws.StringValue(elasticacheCreateConfig.ReplicationGroupId), &NetworkEndpoint{Host: rdd.URI, Port: rdd.Port})
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to expose elasticache replication group endpoint with method %s", networkConnector.Method())
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		if endpoint == nil {
+			return nil, croType.StatusMessage(fmt.Sprintf("waiting for elasticache replication group endpoint to be exposed with method %s", networkConnector.Method())), nil
+		}
+		rdd.URI = endpoint.Host
+		rdd.Port = endpoint.Port
+	}
+
+	// set updates allowed to false on the CR after successful reconcile, modifications are not applied in dry-run mode so
+	// the maintenance window is kept open until dry-run mode is disabled
+	if maintenanceWindow && !resources.IsDryRun(r) {
+		if err := resources.PatchObject(ctx, p.Client, r, func() { r.Spec.MaintenanceWindow = false }); err != nil {
 			return nil, "failed to set redis allowUpdates to false", err
 		}
 	}
@@ -204,7 +225,7 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 	return redis, reconcileStatus, nil
 }
 
-func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, stsSvc stsiface.STSAPI, ec2Svc ec2iface.EC2API, elasticacheConfig *elasticache.CreateReplicationGroupInput, _ *StrategyConfig, serviceUpdates *ServiceUpdate, standaloneNetworkExists bool, maintenanceWindow bool) (*providers.RedisCluster, croType.StatusMessage, error) {
+func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, stsSvc stsiface.STSAPI, ec2Svc ec2iface.EC2API, iamSvc iamiface.IAMAPI, elasticacheConfig *elasticache.CreateReplicationGroupInput, _ *StrategyConfig, serviceUpdates *ServiceUpdate, standaloneNetworkExists bool, maintenanceWindow bool) (*providers.RedisCluster, croType.StatusMessage, error) {
 	logger := p.Logger.WithField("action", "createElasticacheCluster")
 	// the aws access key can sometimes still not be registered in aws on first try, so loop
 	rgs, err := getReplicationGroups(cacheSvc)
@@ -250,7 +271,10 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 	}
 
 	// expose elasticache maintenance metric
-	defer p.setRedisServiceMaintenanceMetric(ctx, cacheSvc, foundCache)
+	defer p.setRedisServiceMaintenanceMetric(ctx, r, cacheSvc, foundCache)
+
+	// set latest snapshot metrics
+	defer p.setRedisSnapshotMetrics(ctx, r, cacheSvc, foundCache)
 
 	// expose status metrics
 	defer p.exposeRedisMetrics(ctx, r, foundCache)
@@ -263,19 +287,29 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 
 	// create elasticache cluster if it doesn't exist
 	if foundCache == nil {
-		if annotations.Has(r, ResourceIdentifierAnnotation) {
+		// a replication group which is adopted is never created
+		if resources.IsAdopted(r.Spec.ResourceTypeSpec) {
+			errMsg := fmt.Sprintf("external elasticache replication group %s of Redis CR %s in %s namespace was not found", r.Spec.ExternalResourceID, r.Name, r.Namespace)
+			return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+		}
+		if resources.HasResourceIdentifier(ctx, r) {
 			errMsg := fmt.Sprintf("Redis CR %s in %s namespace has %s annotation with value %s, but no corresponding Elasticache cluster was found",
-				r.Name, r.Namespace, ResourceIdentifierAnnotation, r.ObjectMeta.Annotations[ResourceIdentifierAnnotation])
+				r.Name, r.Namespace, ResourceIdentifierAnnotation, resources.GetResourceIdentifier(ctx, r))
 			return nil, croType.StatusMessage(errMsg), fmt.Errorf(errMsg)
 		}
-		if isSTS {
-			// the tag should be added to the create strategy in cases where sts is enabled
-			// and in the same api request of the first creation of the postgres to allow
-			msg, err := p.buildRedisTagCreateStrategy(ctx, r, elasticacheConfig)
-			if err != nil {
-				errMsg := fmt.Sprintf("failed to add tags to rds: %s", msg)
-				return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
-			}
+		// the tags are added in the same api request as the creation of the redis, the provider credentials only allow
+		// creating resources tagged with the cluster id
+		msg, err := p.buildRedisTagCreateStrategy(ctx, r, elasticacheConfig)
+		if err != nil {
+			errMsg := fmt.Sprintf("failed to add tags to rds: %s", msg)
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+
+		// report missing permissions, unavailable engine versions and full subnets before the create fails on them
+		preflight := preflightElasticacheCluster(iamSvc, stsSvc, cacheSvc, ec2Svc, elasticacheConfig)
+		if !resources.ReportPreflight(p.Recorder, r, &r.Status, preflight) {
+			errMsg := fmt.Sprintf("preflight checks failed for elasticache replication group %s: %s", *elasticacheConfig.ReplicationGroupId, preflight.String())
+			return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
 		}
 
 		logrus.Info("creating elasticache cluster")
@@ -283,9 +317,9 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 			errMsg := fmt.Sprintf("error creating elasticache cluster %s", err)
 			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 		}
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonCreateStarted, "started provisioning elasticache replication group %s", *elasticacheConfig.ReplicationGroupId)
 
-		annotations.Add(r, ResourceIdentifierAnnotation, *elasticacheConfig.ReplicationGroupId)
-		if err := p.Client.Update(ctx, r); err != nil {
+		if err := resources.SetResourceIdentifier(ctx, p.Client, r, *elasticacheConfig.ReplicationGroupId); err != nil {
 			return nil, croType.StatusMessage("failed to add annotation"), err
 		}
 		return nil, "started elasticache provision", nil
@@ -316,7 +350,45 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 	}
 	logger.Infof("found existing elasticache cluster %s", *foundCache.ReplicationGroupId)
 
-	if maintenanceWindow {
+	// network access is applied straight away rather than in the maintenance window, in dry-run mode it is reported with
+	// the other pending changes
+	networkAccessChanges, statusMsg, err := p.reconcileElasticacheNetworkAccess(r, cacheSvc, ec2Svc, elasticacheConfig, foundCache, replicationGroupClusters)
+	if err != nil || statusMsg != croType.StatusEmpty {
+		return nil, statusMsg, err
+	}
+
+	// compare the elasticache replication group against the strategy, drift is corrected in the maintenance window
+	// unless the cr asks for it to be remediated straight away
+	expectedTags, _, err := p.getDefaultElasticacheTags(ctx, r)
+	if err != nil {
+		errMsg := "failed to build default tags"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	currentTags, err := getElasticacheClusterTags(cacheSvc, replicationGroupClusters)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to get tags of elasticache replication group %s", *foundCache.ReplicationGroupId)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	// take over an existing replication group the first time it is found, in dry-run mode the replication group is only
+	// verified and its tags are reported with the other pending changes
+	dryRun := resources.IsDryRun(r)
+	if resources.IsAdopted(r.Spec.ResourceTypeSpec) && !resources.HasResourceIdentifier(ctx, r) {
+		statusMsg, err := p.adoptElasticacheReplicationGroup(ctx, r, cacheSvc, stsSvc, foundCache, replicationGroupClusters, currentTags, expectedTags, dryRun)
+		if err != nil {
+			return nil, statusMsg, err
+		}
+	}
+	drift, err := buildElasticacheDrift(elasticacheConfig, foundCache, replicationGroupClusters, currentTags, expectedTags)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to detect drift of elasticache replication group %s", *foundCache.ReplicationGroupId)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	resources.ReportDrift(ctx, p.Client, p.Recorder, r, &r.Status, drift, string(providers.RedisResourceType), *foundCache.ReplicationGroupId, redisProviderName)
+
+	if !dryRun {
+		resources.SetPendingChanges(p.Recorder, r, &r.Status, nil)
+	}
+	if maintenanceWindow || dryRun || resources.IsDriftRemediated(r.Spec.ResourceTypeSpec, drift) {
 		// check if any modifications are required to bring the elasticache instance up to date with the strategy map.
 		modifyInput, err := buildElasticacheUpdateStrategy(ec2Svc, elasticacheConfig, foundCache, replicationGroupClusters, logger, r)
 		if err != nil {
@@ -327,26 +399,34 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 			logger.Infof("elasticache replication group %s is as expected", *foundCache.ReplicationGroupId)
 		}
 
+		if dryRun {
+			logger.Infof("dry-run mode enabled, not applying modifications to elasticache replication group %s", *foundCache.ReplicationGroupId)
+			changes := append(networkAccessChanges, buildElasticachePendingChanges(modifyInput, foundCache, replicationGroupClusters)...)
+			changes = append(changes, resources.NewTagPendingChanges(currentTags, elasticacheTagsToMap(expectedTags))...)
+			resources.SetPendingChanges(p.Recorder, r, &r.Status, changes)
+		}
+
 		// modifications are required to bring the elasticache instance up to date with the strategy map, perform updates.
-		if modifyInput != nil {
+		if modifyInput != nil && !dryRun {
 			logger.Infof("%s differs from expected strategy, applying pending modifications :\n%s", *foundCache.ReplicationGroupId, modifyInput)
 			if _, err := cacheSvc.ModifyReplicationGroup(modifyInput); err != nil {
 				errMsg := "failed to modify elasticache cluster"
 				return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 			}
 			logger.Infof("set pending modifications to elasticache replication group %s", *foundCache.ReplicationGroupId)
+			resources.RecordEvent(p.Recorder, r, resources.EventReasonModifyApplied, "set pending modifications to elasticache replication group %s", *foundCache.ReplicationGroupId)
 		}
 	}
 
-	if serviceUpdates != nil && len(serviceUpdates.updates) > 0 {
-		err = p.applySpecifiedSecurityUpdates(cacheSvc, foundCache, serviceUpdates)
+	if serviceUpdates != nil && len(serviceUpdates.updates) > 0 && !dryRun {
+		err = p.applySpecifiedSecurityUpdates(r, cacheSvc, foundCache, serviceUpdates)
 		if err != nil {
 			errMsg := "there was an error applying critical security updates"
 			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 		}
 	}
 
-	if !isSTS {
+	if !isSTS && !dryRun {
 		// add tags to cache nodes
 		cacheInstance := *foundCache.NodeGroups[0]
 		if *cacheInstance.Status != "available" {
@@ -373,6 +453,93 @@ func (p *RedisProvider) createElasticacheCluster(ctx context.Context, r *v1alpha
 	return &providers.RedisCluster{DeploymentDetails: rdd}, croType.StatusMessage(fmt.Sprintf("successfully created and tagged, aws elasticache status is %s", *foundCache.Status)), nil
 }
 
+// adoptElasticacheReplicationGroup takes over an existing replication group, it verifies the replication group is a
+// redis replication group which is not managed by another cr and applies the operator tags to its nodes. in dry-run mode
+// the replication group is only verified
+func (p *RedisProvider) adoptElasticacheReplicationGroup(ctx context.Context, r *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, stsSvc stsiface.STSAPI, foundCache *elasticache.ReplicationGroup, replicationGroupClusters []elasticache.CacheCluster, currentTags map[string]string, expectedTags []*elasticache.Tag, dryRun bool) (croType.StatusMessage, error) {
+	// cluster mode enabled replication groups have no primary endpoint to hand out to consumers
+	if aws.BoolValue(foundCache.ClusterEnabled) {
+		errMsg := fmt.Sprintf("elasticache replication group %s has cluster mode enabled, only replication groups with cluster mode disabled can be adopted", *foundCache.ReplicationGroupId)
+		return croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+	}
+	for _, cluster := range replicationGroupClusters {
+		if aws.StringValue(cluster.Engine) != "redis" {
+			errMsg := fmt.Sprintf("elasticache replication group %s has engine %s, only redis replication groups can be adopted", *foundCache.ReplicationGroupId, aws.StringValue(cluster.Engine))
+			return croType.StatusMessage(errMsg), errorUtil.New(errMsg)
+		}
+	}
+	if err := resources.ValidateAdoption(elasticacheTagsToMap(expectedTags), currentTags, resources.AdoptionOwnershipTagKeys()); err != nil {
+		errMsg := fmt.Sprintf("elasticache replication group %s can not be adopted", *foundCache.ReplicationGroupId)
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if dryRun {
+		p.Logger.Infof("dry-run mode enabled, not adopting elasticache replication group %s", *foundCache.ReplicationGroupId)
+		return croType.StatusEmpty, nil
+	}
+	for _, nodeGroup := range foundCache.NodeGroups {
+		for _, cache := range nodeGroup.NodeGroupMembers {
+			if msg, err := p.TagElasticacheNode(ctx, cacheSvc, stsSvc, r, cache); err != nil {
+				errMsg := fmt.Sprintf("failed to add tags to elasticache: %s", msg)
+				return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+			}
+		}
+	}
+	resources.RecordEvent(p.Recorder, r, resources.EventReasonAdopted, "adopted existing elasticache replication group %s", *foundCache.ReplicationGroupId)
+	if err := resources.SetResourceIdentifier(ctx, p.Client, r, *foundCache.ReplicationGroupId); err != nil {
+		return croType.StatusMessage("failed to add annotation"), err
+	}
+	return "", nil
+}
+
+// reconcileElasticacheNetworkAccess moves the replication group to the security groups of the create strategy when its
+// network access is restricted, or was restricted, and deletes the network access security group once it is no longer
+// used. in dry-run mode nothing is changed and the changes are returned as pending changes instead
+func (p *RedisProvider) reconcileElasticacheNetworkAccess(r *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, ec2Svc ec2iface.EC2API, elasticacheConfig *elasticache.CreateReplicationGroupInput, foundCache *elasticache.ReplicationGroup, replicationGroupClusters []elasticache.CacheCluster) ([]croType.PendingChange, croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "reconcileElasticacheNetworkAccess")
+	accessSecGroup, err := getSecurityGroup(ec2Svc, buildNetworkAccessSecurityGroupName(*foundCache.ReplicationGroupId))
+	if err != nil {
+		errMsg := "failed to get elasticache network access security group"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if accessSecGroup == nil || len(replicationGroupClusters) == 0 {
+		return nil, croType.StatusEmpty, nil
+	}
+	dryRun := resources.IsDryRun(r)
+	// the cache clusters share their security groups, checking the first is enough
+	var securityGroupIDs []string
+	for _, sg := range replicationGroupClusters[0].SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(sg.SecurityGroupId))
+	}
+	inUse := resources.Contains(securityGroupIDs, aws.StringValue(accessSecGroup.GroupId))
+	if (r.Spec.NetworkAccess != nil || inUse) && !securityGroupIDsEqual(securityGroupIDs, aws.StringValueSlice(elasticacheConfig.SecurityGroupIds)) {
+		if dryRun {
+			logger.Infof("dry-run mode enabled, not applying network access to elasticache replication group %s", *foundCache.ReplicationGroupId)
+			return []croType.PendingChange{resources.NewPendingChange("SecurityGroupIds", securityGroupIDs, aws.StringValueSlice(elasticacheConfig.SecurityGroupIds))}, croType.StatusEmpty, nil
+		}
+		if _, err = cacheSvc.ModifyReplicationGroup(&elasticache.ModifyReplicationGroupInput{
+			ReplicationGroupId: foundCache.ReplicationGroupId,
+			SecurityGroupIds:   elasticacheConfig.SecurityGroupIds,
+			ApplyImmediately:   aws.Bool(true),
+		}); err != nil {
+			errMsg := fmt.Sprintf("failed to apply network access to elasticache replication group %s", *foundCache.ReplicationGroupId)
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		statusMsg := fmt.Sprintf("applied network access to elasticache replication group %s", *foundCache.ReplicationGroupId)
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonModifyApplied, "%s", statusMsg)
+		return nil, croType.StatusMessage(statusMsg), nil
+	}
+	if r.Spec.NetworkAccess == nil && !inUse {
+		if dryRun {
+			return []croType.PendingChange{resources.NewPendingChange("NetworkAccessSecurityGroup", accessSecGroup.GroupName, nil)}, croType.StatusEmpty, nil
+		}
+		if _, err = deleteNetworkAccessSecurityGroup(ec2Svc, *foundCache.ReplicationGroupId, logger); err != nil {
+			errMsg := "failed to delete elasticache network access security group"
+			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+	}
+	return nil, croType.StatusEmpty, nil
+}
+
 // buildRedisTagCreateStrategy Tags RDS resources
 func (p *RedisProvider) buildRedisTagCreateStrategy(ctx context.Context, cr *v1alpha1.Redis, elasticacheCreateConfig *elasticache.CreateReplicationGroupInput) (croType.StatusMessage, error) {
 	redisTags, _, err := p.getDefaultElasticacheTags(ctx, cr)
@@ -516,9 +683,10 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 	}
 
 	// get provider aws creds so the elasticache cluster can be deleted
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.RedisResourceType)
 	if err != nil {
 		errMsg := "failed to reconcile aws provider credentials"
+		resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
@@ -530,7 +698,10 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 	}
 
 	// network manager required for cleaning up network.
-	networkManager := NewNetworkManager(sess, p.Client, logger, isSTSCluster(ctx, p.Client))
+	networkManager, msg, err := newNetworkManagerFromStrategy(ctx, p.Client, p.ConfigManager, r.Spec.Tier, providerCreds, stratCfg, sess, logger)
+	if err != nil {
+		return msg, errorUtil.Wrap(err, "failed to initialise network manager")
+	}
 
 	isEnabled, err := networkManager.IsEnabled(ctx)
 	if err != nil {
@@ -579,9 +750,21 @@ func (p *RedisProvider) deleteElasticacheCluster(ctx context.Context, networkMan
 
 		// if status is not available return
 		if *foundCache.Status != "available" {
+			if *foundCache.Status != "deleting" {
+				resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonDeletionBlocked, "elasticache replication group %s cannot be deleted while its status is %s", *foundCache.ReplicationGroupId, *foundCache.Status)
+			}
 			return croType.StatusMessage(fmt.Sprintf("delete detected, deleteReplicationGroup() in progress, current aws elasticache status is %s", *foundCache.Status)), nil
 		}
 
+		// keep the replication group with the retain deletion policy, it is tagged so it can be found and cleaned up later
+		if resources.IsRetained(r.Spec.ResourceTypeSpec) {
+			if err = tagElasticacheOrphaned(cacheSvc, foundCache); err != nil {
+				errMsg := fmt.Sprintf("failed to tag elasticache replication group %s as orphaned", *foundCache.ReplicationGroupId)
+				return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+			}
+			return resources.RetainResource(ctx, p.Client, p.Recorder, r, DefaultFinalizer, *foundCache.ReplicationGroupId)
+		}
+
 		// delete elasticache cluster
 		_, err = cacheSvc.DeleteReplicationGroup(elasticacheDeleteConfig)
 		elasticacheErr, isAwsErr := err.(awserr.Error)
@@ -592,35 +775,64 @@ func (p *RedisProvider) deleteElasticacheCluster(ctx context.Context, networkMan
 
 		return "delete detected, deleteReplicationGroup started", nil
 	}
+	// the network access security group of the replication group must be deleted before the network it is in
+	deleted, err := deleteNetworkAccessSecurityGroup(ec2Svc, *elasticacheCreateConfig.ReplicationGroupId, logger)
+	if err != nil {
+		errMsg := "failed to delete elasticache network access security group"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if !deleted {
+		return "waiting for elasticache network access security group to be deleted", nil
+	}
+
+	// the network is deleted with the cloud network when one exists
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		msg := "failed to get cloud network"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if cloudNetwork != nil {
+		isLastResource = false
+	}
+
 	// isEnabled is true if no bundled resources are found in the cluster vpc
-	if isEnabled && isLastResource {
-		saVPC, err := getStandaloneVpc(ctx, p.Client, ec2Svc, logger)
+	if isEnabled {
+		networkConnector, err := networkManager.GetNetworkConnector(ctx, p.ConfigManager, standaloneNetworkTier(cloudNetwork, r.Spec.Tier))
 		if err != nil {
-			msg := "failed to get standalone VPC"
+			msg := "failed to get standalone network connector"
 			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
-		// Remove all networking resources if standalone vpc exists
-		if saVPC != nil {
-			logger.Info("found the last instance of types postgres and redis so deleting the standalone network")
-			networkPeering, err := networkManager.GetClusterNetworkPeering(ctx)
-			if err != nil {
-				msg := "failed to get cluster network peering"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
-			}
-
-			if err = networkManager.DeleteNetworkConnection(ctx, networkPeering); err != nil {
-				msg := "failed to delete network connection"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
-			}
+		removed, err := networkConnector.RemoveEndpoint(ctx, aws.StringValue(elasticacheCreateConfig.ReplicationGroupId))
+		if err != nil {
+			msg := fmt.Sprintf("failed to remove elasticache replication group endpoint with method %s", networkConnector.Method())
+			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+		if !removed {
+			return croType.StatusMessage(fmt.Sprintf("waiting for elasticache replication group endpoint to be removed with method %s", networkConnector.Method())), nil
+		}
 
-			if err = networkManager.DeleteNetworkPeering(networkPeering); err != nil {
-				msg := "failed to delete cluster network peering"
+		if isLastResource {
+			saVPC, err := getStandaloneVpc(ctx, p.Client, ec2Svc, logger)
+			if err != nil {
+				msg := "failed to get standalone VPC"
 				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 			}
+			// Remove all networking resources if standalone vpc exists
+			if saVPC != nil {
+				logger.Info("found the last instance of types postgres and redis so deleting the standalone network")
+				deleted, err := networkConnector.Delete(ctx)
+				if err != nil {
+					msg := fmt.Sprintf("failed to delete standalone network connection with method %s", networkConnector.Method())
+					return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				}
+				if !deleted {
+					return croType.StatusMessage(fmt.Sprintf("waiting for standalone network connection with method %s to be deleted", networkConnector.Method())), nil
+				}
 
-			if err = networkManager.DeleteNetwork(ctx); err != nil {
-				msg := "failed to delete aws networking"
-				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				if err = networkManager.DeleteNetwork(ctx); err != nil {
+					msg := "failed to delete aws networking"
+					return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				}
 			}
 		}
 	}
@@ -636,8 +848,7 @@ func (p *RedisProvider) deleteElasticacheCluster(ctx context.Context, networkMan
 		}
 	}
 	// remove the finalizer added by the provider
-	resources.RemoveFinalizer(&r.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, r); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, r, DefaultFinalizer); err != nil {
 		errMsg := "failed to update instance as part of finalizer reconcile"
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
@@ -829,6 +1040,109 @@ func buildElasticacheUpdateStrategy(ec2Client ec2iface.EC2API, elasticacheConfig
 	return nil, nil
 }
 
+// getElasticacheClusterTags returns the tags of the first cache cluster of the replication group, the tags are added
+// to the cache clusters rather than the replication group. nil is returned when the cache cluster arn is unknown
+func getElasticacheClusterTags(cacheSvc elasticacheiface.ElastiCacheAPI, replicationGroupClusters []elasticache.CacheCluster) (map[string]string, error) {
+	if len(replicationGroupClusters) == 0 || replicationGroupClusters[0].ARN == nil {
+		return nil, nil
+	}
+	output, err := cacheSvc.ListTagsForResource(&elasticache.ListTagsForResourceInput{
+		ResourceName: replicationGroupClusters[0].ARN,
+	})
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to list tags of cache cluster %s", aws.StringValue(replicationGroupClusters[0].CacheClusterId))
+	}
+	tags := map[string]string{}
+	for _, tag := range output.TagList {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// buildElasticacheDrift compares the attributes of the found replication group and its cache clusters against the
+// create strategy, tags are only compared when the current tags are known
+func buildElasticacheDrift(elasticacheConfig *elasticache.CreateReplicationGroupInput, foundConfig *elasticache.ReplicationGroup, replicationGroupClusters []elasticache.CacheCluster, currentTags map[string]string, expectedTags []*elasticache.Tag) (*resources.Drift, error) {
+	drift := &resources.Drift{}
+	drift.Compare("CacheNodeType", foundConfig.CacheNodeType, elasticacheConfig.CacheNodeType)
+	drift.Compare("AtRestEncryptionEnabled", foundConfig.AtRestEncryptionEnabled, elasticacheConfig.AtRestEncryptionEnabled)
+	drift.Compare("TransitEncryptionEnabled", foundConfig.TransitEncryptionEnabled, elasticacheConfig.TransitEncryptionEnabled)
+	drift.Compare("SnapshotRetentionLimit", foundConfig.SnapshotRetentionLimit, elasticacheConfig.SnapshotRetentionLimit)
+	drift.Compare("SnapshotWindow", foundConfig.SnapshotWindow, elasticacheConfig.SnapshotWindow)
+	if elasticacheConfig.AutomaticFailoverEnabled != nil {
+		desiredFailover := elasticache.AutomaticFailoverStatusDisabled
+		if *elasticacheConfig.AutomaticFailoverEnabled {
+			desiredFailover = elasticache.AutomaticFailoverStatusEnabled
+		}
+		drift.Compare("AutomaticFailover", foundConfig.AutomaticFailover, desiredFailover)
+	}
+	if elasticacheConfig.NumCacheClusters != nil {
+		drift.Compare("NumCacheClusters", len(foundConfig.MemberClusters), *elasticacheConfig.NumCacheClusters)
+	}
+	for _, foundCacheCluster := range replicationGroupClusters {
+		// minor versions are upgraded by aws, only an older version than the strategy is drift
+		if elasticacheConfig.EngineVersion != nil && foundCacheCluster.EngineVersion != nil {
+			upgradeNeeded, err := resources.VerifyVersionUpgradeNeeded(*foundCacheCluster.EngineVersion, *elasticacheConfig.EngineVersion)
+			if err != nil {
+				return nil, errorUtil.Wrap(err, "invalid redis version")
+			}
+			if upgradeNeeded {
+				drift.Compare("EngineVersion", foundCacheCluster.EngineVersion, elasticacheConfig.EngineVersion)
+			}
+		}
+		drift.Compare("PreferredMaintenanceWindow", foundCacheCluster.PreferredMaintenanceWindow, elasticacheConfig.PreferredMaintenanceWindow)
+		drift.Compare("CacheSubnetGroupName", foundCacheCluster.CacheSubnetGroupName, elasticacheConfig.CacheSubnetGroupName)
+		var securityGroupIDs []string
+		for _, sg := range foundCacheCluster.SecurityGroups {
+			securityGroupIDs = append(securityGroupIDs, aws.StringValue(sg.SecurityGroupId))
+		}
+		drift.CompareSet("SecurityGroupIds", securityGroupIDs, aws.StringValueSlice(elasticacheConfig.SecurityGroupIds))
+		// the cache clusters share their configuration, comparing the first is enough
+		break
+	}
+	if currentTags != nil {
+		drift.CompareTags("Tags", currentTags, elasticacheTagsToMap(expectedTags))
+	}
+	return drift, nil
+}
+
+// elasticacheTagsToMap returns elasticache tags as a map of key to value
+func elasticacheTagsToMap(tags []*elasticache.Tag) map[string]string {
+	tagMap := map[string]string{}
+	for _, tag := range tags {
+		tagMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tagMap
+}
+
+// buildElasticachePendingChanges returns the modifications of the modify input as pending changes against the found
+// replication group, values only available from the cache clusters are taken from the first cache cluster
+func buildElasticachePendingChanges(modifyInput *elasticache.ModifyReplicationGroupInput, foundConfig *elasticache.ReplicationGroup, replicationGroupClusters []elasticache.CacheCluster) []croType.PendingChange {
+	if modifyInput == nil {
+		return nil
+	}
+	foundCluster := elasticache.CacheCluster{}
+	if len(replicationGroupClusters) > 0 {
+		foundCluster = replicationGroupClusters[0]
+	}
+	var changes []croType.PendingChange
+	if modifyInput.CacheNodeType != nil {
+		changes = append(changes, resources.NewPendingChange("CacheNodeType", foundConfig.CacheNodeType, modifyInput.CacheNodeType))
+	}
+	if modifyInput.SnapshotRetentionLimit != nil {
+		changes = append(changes, resources.NewPendingChange("SnapshotRetentionLimit", foundConfig.SnapshotRetentionLimit, modifyInput.SnapshotRetentionLimit))
+	}
+	if modifyInput.EngineVersion != nil {
+		changes = append(changes, resources.NewPendingChange("EngineVersion", foundCluster.EngineVersion, modifyInput.EngineVersion))
+	}
+	if modifyInput.PreferredMaintenanceWindow != nil {
+		changes = append(changes, resources.NewPendingChange("PreferredMaintenanceWindow", foundCluster.PreferredMaintenanceWindow, modifyInput.PreferredMaintenanceWindow))
+	}
+	if modifyInput.SnapshotWindow != nil {
+		changes = append(changes, resources.NewPendingChange("SnapshotWindow", foundCluster.SnapshotWindow, modifyInput.SnapshotWindow))
+	}
+	return changes
+}
+
 // verifyRedisConfig checks elasticache config, if none exist sets values to default
 func (p *RedisProvider) buildElasticacheCreateStrategy(ctx context.Context, r *v1alpha1.Redis, ec2Svc ec2iface.EC2API, elasticacheConfig *elasticache.CreateReplicationGroupInput) error {
 
@@ -856,11 +1170,12 @@ func (p *RedisProvider) buildElasticacheCreateStrategy(ctx context.Context, r *v
 	if elasticacheConfig.TransitEncryptionEnabled == nil {
 		elasticacheConfig.TransitEncryptionEnabled = aws.Bool(defaultInTransitEncryption)
 	}
-	cacheName, err := resources.BuildInfraNameFromObject(ctx, p.Client, r.ObjectMeta, defaultAwsIdentifierLength)
+	cacheName, err := resources.BuildResourceIdentifier(ctx, p.Client, r.ObjectMeta, r.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to retrieve elasticache config")
 	}
-	if elasticacheConfig.ReplicationGroupId == nil {
+	// an adopted replication group is always identified by the external resource id of the cr
+	if elasticacheConfig.ReplicationGroupId == nil || resources.IsAdopted(r.Spec.ResourceTypeSpec) {
 		elasticacheConfig.ReplicationGroupId = aws.String(cacheName)
 	}
 
@@ -885,6 +1200,18 @@ func (p *RedisProvider) buildElasticacheCreateStrategy(ctx context.Context, r *v
 		elasticacheConfig.SecurityGroupIds = []*string{
 			aws.String(*foundSecGroup.GroupId),
 		}
+		// the replication group uses a security group of its own when network access to it is restricted
+		if r.Spec.NetworkAccess != nil {
+			port := int64(defaultRedisPort)
+			if elasticacheConfig.Port != nil {
+				port = *elasticacheConfig.Port
+			}
+			accessSecGroup, err := reconcileNetworkAccessSecurityGroup(ctx, p.Client, ec2Svc, foundSecGroup, *elasticacheConfig.ReplicationGroupId, port, r.Spec.NetworkAccess, p.Logger)
+			if err != nil {
+				return errorUtil.Wrap(err, "failed to reconcile network access security group")
+			}
+			elasticacheConfig.SecurityGroupIds = []*string{accessSecGroup.GroupId}
+		}
 	}
 
 	return nil
@@ -892,12 +1219,12 @@ func (p *RedisProvider) buildElasticacheCreateStrategy(ctx context.Context, r *v
 
 // buildElasticacheDeleteConfig checks redis config, if none exists sets values to defaults
 func (p *RedisProvider) buildElasticacheDeleteConfig(ctx context.Context, r v1alpha1.Redis, elasticacheCreateConfig *elasticache.CreateReplicationGroupInput, elasticacheDeleteConfig *elasticache.DeleteReplicationGroupInput) error {
-	cacheName, err := resources.BuildInfraNameFromObject(ctx, p.Client, r.ObjectMeta, defaultAwsIdentifierLength)
+	cacheName, err := resources.BuildResourceIdentifier(ctx, p.Client, r.ObjectMeta, r.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to retrieve elasticache config")
 	}
-	if elasticacheDeleteConfig.ReplicationGroupId == nil {
-		if elasticacheCreateConfig.ReplicationGroupId == nil {
+	if elasticacheDeleteConfig.ReplicationGroupId == nil || resources.IsAdopted(r.Spec.ResourceTypeSpec) {
+		if elasticacheCreateConfig.ReplicationGroupId == nil || resources.IsAdopted(r.Spec.ResourceTypeSpec) {
 			elasticacheCreateConfig.ReplicationGroupId = aws.String(cacheName)
 		}
 		elasticacheDeleteConfig.ReplicationGroupId = elasticacheCreateConfig.ReplicationGroupId
@@ -909,12 +1236,32 @@ func (p *RedisProvider) buildElasticacheDeleteConfig(ctx context.Context, r v1al
 	if err != nil {
 		return errorUtil.Wrapf(err, "failed to retrieve rds config")
 	}
+	// the delete deletion policy removes the final snapshot, any other policy takes one before deletion
+	if r.Spec.DeletionPolicy == croType.DeletionPolicyDelete {
+		elasticacheDeleteConfig.FinalSnapshotIdentifier = nil
+		return nil
+	}
 	if elasticacheDeleteConfig.FinalSnapshotIdentifier == nil {
 		elasticacheDeleteConfig.FinalSnapshotIdentifier = aws.String(snapshotIdentifier)
 	}
 	return nil
 }
 
+// tagElasticacheOrphaned adds the orphaned tag to a replication group kept by the retain deletion policy
+func tagElasticacheOrphaned(cacheSvc elasticacheiface.ElastiCacheAPI, foundCache *elasticache.ReplicationGroup) error {
+	orphanedTag := resources.BuildOrphanedTag()
+	_, err := cacheSvc.AddTagsToResource(&elasticache.AddTagsToResourceInput{
+		ResourceName: foundCache.ARN,
+		Tags: []*elasticache.Tag{
+			{
+				Key:   aws.String(orphanedTag.Key),
+				Value: aws.String(orphanedTag.Value),
+			},
+		},
+	})
+	return err
+}
+
 // ensures a subnet group is in place to configure the resource, so that it is in the same vpc as the cluster
 func (p *RedisProvider) configureElasticacheVpc(ctx context.Context, cacheSvc elasticacheiface.ElastiCacheAPI, ec2Svc ec2iface.EC2API) error {
 	logrus.Info("configuring cluster vpc for redis resource")
@@ -941,6 +1288,12 @@ func (p *RedisProvider) configureElasticacheVpc(ctx context.Context, cacheSvc el
 		return nil
 	}
 
+	// get cluster id
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		return errorUtil.Wrap(err, "error getting cluster id")
+	}
+
 	// get cluster vpc subnets
 	subIDs, err := GetPrivateSubnetIDS(ctx, p.Client, ec2Svc, p.Logger)
 	if err != nil {
@@ -952,6 +1305,12 @@ func (p *RedisProvider) configureElasticacheVpc(ctx context.Context, cacheSvc el
 		CacheSubnetGroupDescription: aws.String("Subnet group created by the cloud resource operator"),
 		CacheSubnetGroupName:        aws.String(sgName),
 		SubnetIds:                   subIDs,
+		Tags: []*elasticache.Tag{
+			{
+				Key:   aws.String(resources.GetOrganizationTag() + "clusterID"),
+				Value: aws.String(clusterID),
+			},
+		},
 	}
 
 	logrus.Info("creating resource subnet group")
@@ -1036,7 +1395,7 @@ func (p *RedisProvider) setRedisDeletionTimestampMetric(ctx context.Context, cr
 }
 
 // sets maintenance metric
-func (p *RedisProvider) setRedisServiceMaintenanceMetric(ctx context.Context, cacheSvc elasticacheiface.ElastiCacheAPI, instance *elasticache.ReplicationGroup) {
+func (p *RedisProvider) setRedisServiceMaintenanceMetric(ctx context.Context, cr *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, instance *elasticache.ReplicationGroup) {
 	// if the instance is nil skip this metric
 	if instance == nil {
 		logrus.Error("foundInstance is nil, skipping setRedisServiceMaintenanceMetric")
@@ -1065,6 +1424,7 @@ func (p *RedisProvider) setRedisServiceMaintenanceMetric(ctx context.Context, ca
 	}
 
 	logrus.Infof("there are elasticache service update actions %d available : %s", len(output.UpdateActions), output.UpdateActions)
+	var pendingMaintenance []string
 	for _, updateAction := range output.UpdateActions {
 		metricLabels := map[string]string{}
 		metricLabels[resources.LabelClusterIDKey] = clusterID
@@ -1088,7 +1448,94 @@ func (p *RedisProvider) setRedisServiceMaintenanceMetric(ctx context.Context, ca
 		metricEpochTimestamp := (resources.SafeTimeDereference(updateAction.ServiceUpdateRecommendedApplyByDate)).Unix()
 
 		resources.SetMetric(resources.DefaultRedisMaintenanceMetricName, metricLabels, float64(metricEpochTimestamp))
+
+		if validServiceUpdateStates(resources.SafeStringDereference(updateAction.UpdateActionStatus)) {
+			pendingMaintenance = append(pendingMaintenance, fmt.Sprintf("pending %s service update %s for elasticache replication group %s", resources.SafeStringDereference(updateAction.ServiceUpdateSeverity), resources.SafeStringDereference(updateAction.ServiceUpdateName), *instance.ReplicationGroupId))
+		}
+	}
+	resources.ReportMaintenance(p.Recorder, cr, &cr.Status, pendingMaintenance)
+}
+
+// setRedisSnapshotMetrics exposes the creation time and size of the latest available elasticache snapshot
+// this covers both automatic daily snapshots and manual snapshots created by the operator
+func (p *RedisProvider) setRedisSnapshotMetrics(ctx context.Context, cr *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, instance *elasticache.ReplicationGroup) {
+	// if the instance is nil skip this metric
+	if instance == nil {
+		logrus.Error("foundInstance is nil, skipping setRedisSnapshotMetrics")
+		return
+	}
+
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		logrus.Errorf("failed to get cluster id while exposing snapshot metrics for %s : %v", *instance.ReplicationGroupId, err)
+		return
+	}
+
+	// snapshots of any node in the replication group are returned, regardless of their source
+	var snapshots []*resources.SnapshotMetricInfo
+	input := &elasticache.DescribeSnapshotsInput{
+		ReplicationGroupId: instance.ReplicationGroupId,
 	}
+	for {
+		output, err := cacheSvc.DescribeSnapshots(input)
+		if err != nil {
+			logrus.Errorf("failed to describe snapshots while exposing snapshot metrics for %s : %v", *instance.ReplicationGroupId, err)
+			return
+		}
+		for _, snapshot := range output.Snapshots {
+			if aws.StringValue(snapshot.SnapshotStatus) != "available" {
+				continue
+			}
+			if info := buildElasticacheSnapshotMetricInfo(snapshot); info != nil {
+				snapshots = append(snapshots, info)
+			}
+		}
+		if output.Marker == nil {
+			break
+		}
+		input.Marker = output.Marker
+	}
+
+	genericLabels := resources.BuildGenericMetricLabels(cr.ObjectMeta, clusterID, *instance.ReplicationGroupId, redisProviderName)
+	resources.SetLatestSnapshotMetrics(resources.DefaultRedisLatestSnapshotTimestampMetricName, resources.DefaultRedisLatestSnapshotSizeBytesMetricName, genericLabels, snapshots)
+}
+
+// buildElasticacheSnapshotMetricInfo returns the creation time and size of a snapshot from its node snapshots
+// a snapshot contains a node snapshot for each shard, the snapshot size is the sum of the node snapshot sizes
+func buildElasticacheSnapshotMetricInfo(snapshot *elasticache.Snapshot) *resources.SnapshotMetricInfo {
+	var info *resources.SnapshotMetricInfo
+	for _, nodeSnapshot := range snapshot.NodeSnapshots {
+		if nodeSnapshot.SnapshotCreateTime == nil {
+			continue
+		}
+		if info == nil {
+			info = &resources.SnapshotMetricInfo{}
+		}
+		if nodeSnapshot.SnapshotCreateTime.After(info.CreateTime) {
+			info.CreateTime = *nodeSnapshot.SnapshotCreateTime
+		}
+		info.SizeBytes += parseElasticacheCacheSize(aws.StringValue(nodeSnapshot.CacheSize))
+	}
+	return info
+}
+
+// parseElasticacheCacheSize converts a node snapshot cache size, e.g. `6 MB`, to bytes
+// 0 is returned if the size can not be parsed
+func parseElasticacheCacheSize(cacheSize string) float64 {
+	fields := strings.Fields(cacheSize)
+	if len(fields) != 2 {
+		return 0
+	}
+	size, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	for i, unit := range []string{"B", "KB", "MB", "GB", "TB"} {
+		if strings.EqualFold(fields[1], unit) {
+			return size * math.Pow(1024, float64(i))
+		}
+	}
+	return 0
 }
 
 func (p *RedisProvider) createElasticacheConnectionMetric(ctx context.Context, cr *v1alpha1.Redis, cache *elasticache.ReplicationGroup) {
@@ -1115,9 +1562,14 @@ func (p *RedisProvider) createElasticacheConnectionMetric(ctx context.Context, c
 		return
 	}
 
-	// test the connection
-	conn := p.TCPPinger.TCPConnection(*cache.NodeGroups[0].PrimaryEndpoint.Address, int(*cache.NodeGroups[0].PrimaryEndpoint.Port))
-	if !conn {
+	// test the connection, elasticache is created without an auth token so only PING is sent
+	result := p.TCPPinger.RedisConnection(ctx, &resources.RedisConnectionDetails{
+		Host: aws.StringValue(cache.NodeGroups[0].PrimaryEndpoint.Address),
+		Port: int(aws.Int64Value(cache.NodeGroups[0].PrimaryEndpoint.Port)),
+		TLS:  aws.BoolValue(cache.TransitEncryptionEnabled),
+	})
+	resources.SetConnectionProbeMetrics(resources.ConnectionProbeResourceTypeRedis, genericLabels, result)
+	if !result.Success {
 		// create failed connection metric
 		resources.SetMetric(resources.DefaultRedisConnectionMetricName, genericLabels, 0)
 		return
@@ -1127,7 +1579,7 @@ func (p *RedisProvider) createElasticacheConnectionMetric(ctx context.Context, c
 }
 
 func (p *RedisProvider) buildCacheName(ctx context.Context, rd *v1alpha1.Redis) (string, error) {
-	cacheName, err := resources.BuildInfraNameFromObject(ctx, p.Client, rd.ObjectMeta, defaultAwsIdentifierLength)
+	cacheName, err := resources.BuildResourceIdentifier(ctx, p.Client, rd.ObjectMeta, rd.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return "", errorUtil.Errorf("error occurred building cache name: %v", err)
 	}
@@ -1143,7 +1595,7 @@ func replicationGroupStatusIsHealthy(cache *elasticache.ReplicationGroup) bool {
 // it will loop through them and check if they are specified
 // if they are it will apply service update
 // if the applied update is critical security update, it will apply it immediately
-func (p *RedisProvider) applySpecifiedSecurityUpdates(cacheSvc elasticacheiface.ElastiCacheAPI, replicationGroup *elasticache.ReplicationGroup, specifiedUpdates *ServiceUpdate) error {
+func (p *RedisProvider) applySpecifiedSecurityUpdates(cr *v1alpha1.Redis, cacheSvc elasticacheiface.ElastiCacheAPI, replicationGroup *elasticache.ReplicationGroup, specifiedUpdates *ServiceUpdate) error {
 	logger := p.Logger.WithField("action", "applySpecifiedSecurityUpdates")
 	ServiceUpdateStatusAvailable := elasticache.ServiceUpdateStatusAvailable
 
@@ -1177,6 +1629,7 @@ func (p *RedisProvider) applySpecifiedSecurityUpdates(cacheSvc elasticacheiface.
 						logger.Errorf("error returned when running batchApplyUpdate function for update %s with err %v", *update.ServiceUpdateName, err)
 						break
 					}
+					resources.RecordEvent(p.Recorder, cr, resources.EventReasonServiceUpdateApplied, "applied service update %s to elasticache replication group %s", *update.ServiceUpdateName, *replicationGroup.ReplicationGroupId)
 
 					if *update.ServiceUpdateSeverity == elasticache.ServiceUpdateSeverityCritical &&
 						*update.ServiceUpdateType == elasticache.ServiceUpdateTypeSecurityUpdate {
@@ -63,7 +63,7 @@ func (r *RedisMetricsProvider) ScrapeRedisMetrics(ctx context.Context, redis *v1
 	}
 
 	// reconcile aws credentials (keys)
-	providerCreds, err := r.CredentialManager.ReconcileProviderCredentials(ctx, redis.Namespace)
+	providerCreds, err := r.CredentialManager.ReconcileProviderCredentials(ctx, redis.Namespace, providers.RedisResourceType)
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "failed to reconcile elasticache credentials")
 	}
@@ -86,7 +86,7 @@ func (r *RedisMetricsProvider) ScrapeRedisMetrics(ctx context.Context, redis *v1
 }
 
 func (r *RedisMetricsProvider) scrapeRedisCloudWatchMetricData(ctx context.Context, cloudWatchApi cloudwatchiface.CloudWatchAPI, redis *v1alpha1.Redis, elastiCacheApi elasticacheiface.ElastiCacheAPI, metricTypes []providers.CloudProviderMetricType) ([]*providers.GenericCloudMetric, error) {
-	resourceID, err := resources.BuildInfraNameFromObject(ctx, r.Client, redis.ObjectMeta, defaultAwsIdentifierLength)
+	resourceID, err := resources.BuildResourceIdentifier(ctx, r.Client, redis.ObjectMeta, redis.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		return nil, errorUtil.Errorf("error occurred building instance name: %v", err)
 	}
@@ -126,7 +126,7 @@ func (r *RedisMetricsProvider) scrapeRedisCloudWatchMetricData(ctx context.Conte
 			// build metric data query array from `metricType`
 			MetricDataQueries: buildRedisMetricDataQuery(*cacheClusterId, metricTypes),
 			// metrics gathered from start time to end time
-			StartTime: aws.Time(time.Now().Add(-resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration))),
+			StartTime: aws.Time(time.Now().Add(-getMetricDataWindow(metricTypes))),
 			EndTime:   aws.Time(time.Now()),
 		})
 		if err != nil {
@@ -188,7 +188,7 @@ func buildRedisMetricDataQuery(cacheClusterId string, metricTypes []providers.Cl
 					},
 				},
 				Stat:   aws.String(metricType.Statistic),
-				Period: aws.Int64(int64(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration).Seconds())),
+				Period: aws.Int64(int64(metricType.GetPeriodOrDefault(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration)).Seconds())),
 			},
 		})
 	}
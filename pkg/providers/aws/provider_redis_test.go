@@ -28,6 +28,7 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -63,7 +64,9 @@ type mockElasticacheClient struct {
 	modifyReplicationGroupFn    func(*elasticache.ModifyReplicationGroupInput) (*elasticache.ModifyReplicationGroupOutput, error)
 	batchApplyUpdateActionFn    func(*elasticache.BatchApplyUpdateActionInput) (*elasticache.BatchApplyUpdateActionOutput, error)
 	addTagsToResourceFn         func(*elasticache.AddTagsToResourceInput) (*elasticache.TagListMessage, error)
+	listTagsForResourceFn       func(*elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error)
 	createReplicationGroupFn    func(*elasticache.CreateReplicationGroupInput) (*elasticache.CreateReplicationGroupOutput, error)
+	describeCacheEngineVersFn   func(*elasticache.DescribeCacheEngineVersionsInput) (*elasticache.DescribeCacheEngineVersionsOutput, error)
 	calls                       struct {
 		DescribeSnapshots []struct {
 			In1 *elasticache.DescribeSnapshotsInput
@@ -106,6 +109,9 @@ func buildMockElasticacheClient(modifyFn func(*mockElasticacheClient)) *mockElas
 		modifyReplicationGroupFn: func(input *elasticache.ModifyReplicationGroupInput) (*elasticache.ModifyReplicationGroupOutput, error) {
 			return &elasticache.ModifyReplicationGroupOutput{}, nil
 		},
+		describeSnapshotsFn: func(input *elasticache.DescribeSnapshotsInput) (*elasticache.DescribeSnapshotsOutput, error) {
+			return &elasticache.DescribeSnapshotsOutput{}, nil
+		},
 	}
 	if modifyFn != nil {
 		modifyFn(mock)
@@ -214,6 +220,13 @@ func (m *mockElasticacheClient) AddTagsToResource(input *elasticache.AddTagsToRe
 }
 
 // mock elasticache DescribeSnapshots
+func (m *mockElasticacheClient) ListTagsForResource(input *elasticache.ListTagsForResourceInput) (*elasticache.TagListMessage, error) {
+	if m.listTagsForResourceFn == nil {
+		return &elasticache.TagListMessage{}, nil
+	}
+	return m.listTagsForResourceFn(input)
+}
+
 func (m *mockElasticacheClient) DescribeSnapshots(input *elasticache.DescribeSnapshotsInput) (*elasticache.DescribeSnapshotsOutput, error) {
 	if m.describeSnapshotsFn == nil {
 		panic("describeSnapshotsFn: method is nil but elasticacheClient.DescribeSnapshots was just called")
@@ -289,10 +302,18 @@ func (m *mockElasticacheClient) ModifyCacheSubnetGroup(input *elasticache.Modify
 	return m.modifyCacheSubnetGroupFn(input)
 }
 
+func (m *mockElasticacheClient) DescribeCacheEngineVersions(input *elasticache.DescribeCacheEngineVersionsInput) (*elasticache.DescribeCacheEngineVersionsOutput, error) {
+	if m.describeCacheEngineVersFn == nil {
+		return &elasticache.DescribeCacheEngineVersionsOutput{CacheEngineVersions: []*elasticache.CacheEngineVersion{{EngineVersion: aws.String(defaultEngineVersion)}}}, nil
+	}
+	return m.describeCacheEngineVersFn(input)
+}
+
 // mock sts get caller identity
 func (m *mockStsClient) GetCallerIdentity(*sts.GetCallerIdentityInput) (*sts.GetCallerIdentityOutput, error) {
 	return &sts.GetCallerIdentityOutput{
 		Account: aws.String("test"),
+		Arn:     aws.String("arn:aws:sts::test:assumed-role/test/test"),
 	}, nil
 }
 
@@ -1788,7 +1809,7 @@ func Test_createRedisCluster(t *testing.T) {
 				ConfigManager:     tt.fields.ConfigManager,
 				TCPPinger:         tt.fields.TCPPinger,
 			}
-			got, _, err := p.createElasticacheCluster(tt.args.ctx, tt.args.r, tt.args.cacheSvc, tt.args.stsSvc, tt.args.ec2Svc, tt.args.redisConfig, tt.args.stratCfg, tt.args.ServiceUpdate, tt.args.standaloneNetworkExists, tt.args.maintenanceWindow)
+			got, _, err := p.createElasticacheCluster(tt.args.ctx, tt.args.r, tt.args.cacheSvc, tt.args.stsSvc, tt.args.ec2Svc, &mockIamClient{}, tt.args.redisConfig, tt.args.stratCfg, tt.args.ServiceUpdate, tt.args.standaloneNetworkExists, tt.args.maintenanceWindow)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("createElasticacheCluster() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1819,7 +1840,6 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 		networkManager          NetworkManager
 		redisCreateConfig       *elasticache.CreateReplicationGroupInput
 		redisDeleteConfig       *elasticache.DeleteReplicationGroupInput
-		ctx                     context.Context
 		redis                   *v1alpha1.Redis
 		standaloneNetworkExists bool
 		isLastResource          bool
@@ -1845,6 +1865,7 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 				Logger:            testLogger,
 				CredentialManager: &CredentialManagerMock{},
 				ConfigManager:     &ConfigManagerMock{},
+				Ec2Svc:            buildMockEc2Client(nil),
 				CacheSvc: buildMockElasticacheClient(func(elasticacheClient *mockElasticacheClient) {
 					elasticacheClient.describeReplicationGroupsFn = func(*elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
 						return &elasticache.DescribeReplicationGroupsOutput{}, nil
@@ -1868,6 +1889,7 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 				Logger:            testLogger,
 				CredentialManager: &CredentialManagerMock{},
 				ConfigManager:     &ConfigManagerMock{},
+				Ec2Svc:            buildMockEc2Client(nil),
 				CacheSvc: buildMockElasticacheClient(func(elasticacheClient *mockElasticacheClient) {
 					elasticacheClient.describeReplicationGroupsFn = func(*elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
 						return &elasticache.DescribeReplicationGroupsOutput{
@@ -1898,6 +1920,7 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 				Logger:            testLogger,
 				CredentialManager: &CredentialManagerMock{},
 				ConfigManager:     &ConfigManagerMock{},
+				Ec2Svc:            buildMockEc2Client(nil),
 				CacheSvc: buildMockElasticacheClient(func(elasticacheClient *mockElasticacheClient) {
 					elasticacheClient.describeReplicationGroupsFn = func(*elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
 						return &elasticache.DescribeReplicationGroupsOutput{
@@ -1972,6 +1995,7 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 				Logger:            testLogger,
 				CredentialManager: &CredentialManagerMock{},
 				ConfigManager:     &ConfigManagerMock{},
+				Ec2Svc:            buildMockEc2Client(nil),
 				CacheSvc: buildMockElasticacheClient(func(elasticacheClient *mockElasticacheClient) {
 					elasticacheClient.describeReplicationGroupsFn = func(*elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
 						return &elasticache.DescribeReplicationGroupsOutput{}, nil
@@ -1990,7 +2014,7 @@ func TestAWSRedisProvider_deleteRedisCluster(t *testing.T) {
 				ConfigManager:     tt.fields.ConfigManager,
 				CacheSvc:          tt.fields.CacheSvc,
 			}
-			if _, err := p.deleteElasticacheCluster(tt.args.ctx, tt.args.networkManager, tt.fields.CacheSvc, tt.fields.Ec2Svc, tt.args.redisCreateConfig, tt.args.redisDeleteConfig, tt.args.redis, tt.args.standaloneNetworkExists, tt.args.isLastResource); (err != nil) != tt.wantErr {
+			if _, err := p.deleteElasticacheCluster(context.TODO(), tt.args.networkManager, tt.fields.CacheSvc, tt.fields.Ec2Svc, tt.args.redisCreateConfig, tt.args.redisDeleteConfig, tt.args.redis, tt.args.standaloneNetworkExists, tt.args.isLastResource); (err != nil) != tt.wantErr {
 				t.Errorf("deleteElasticacheCluster() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -2249,6 +2273,46 @@ func TestAWSRedisProvider_TagElasticache(t *testing.T) {
 	}
 }
 
+func TestAWSRedisProvider_adoptElasticacheReplicationGroup(t *testing.T) {
+	tests := []struct {
+		name       string
+		foundCache *elasticache.ReplicationGroup
+		clusters   []elasticache.CacheCluster
+		want       croType.StatusMessage
+	}{
+		{
+			name: "test cluster mode enabled replication group is not adopted",
+			foundCache: buildReplicationGroup(func(group *elasticache.ReplicationGroup) {
+				group.ReplicationGroupId = aws.String("test-id")
+				group.ClusterEnabled = aws.Bool(true)
+			}),
+			want: "elasticache replication group test-id has cluster mode enabled, only replication groups with cluster mode disabled can be adopted",
+		},
+		{
+			name: "test replication group with another engine is not adopted",
+			foundCache: buildReplicationGroup(func(group *elasticache.ReplicationGroup) {
+				group.ReplicationGroupId = aws.String("test-id")
+			}),
+			clusters: []elasticache.CacheCluster{
+				{Engine: aws.String("memcached")},
+			},
+			want: "elasticache replication group test-id has engine memcached, only redis replication groups can be adopted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &RedisProvider{Logger: testLogger}
+			got, err := p.adoptElasticacheReplicationGroup(context.TODO(), buildTestRedisCR(), nil, nil, tt.foundCache, tt.clusters, nil, nil, false)
+			if err == nil {
+				t.Fatal("adoptElasticacheReplicationGroup() expected an error")
+			}
+			if got != tt.want {
+				t.Errorf("adoptElasticacheReplicationGroup() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_buildElasticacheUpdateStrategy(t *testing.T) {
 	type args struct {
 		ec2Client                ec2iface.EC2API
@@ -2929,7 +2993,7 @@ func TestRedisProvider_applySpecifiedSecurityUpdates(t *testing.T) {
 				CacheSvc:          tt.fields.CacheSvc,
 				TCPPinger:         tt.fields.TCPPinger,
 			}
-			err := p.applySpecifiedSecurityUpdates(tt.args.cacheSvc, tt.args.replicationGroup, tt.args.specifiedUpdates)
+			err := p.applySpecifiedSecurityUpdates(&v1alpha1.Redis{}, tt.args.cacheSvc, tt.args.replicationGroup, tt.args.specifiedUpdates)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("applylSpecifiedSecurityUpdates() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2985,7 +3049,7 @@ func TestNewAWSRedisProvider(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAWSRedisProvider(tt.args.client(), tt.args.logger)
+			got, err := NewAWSRedisProvider(tt.args.client(), tt.args.logger, record.NewFakeRecorder(10))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("NewAWSRedisProvider(), got = %v, want non-nil error", err)
@@ -3056,8 +3120,10 @@ func TestRedisProvider_getElasticacheConfig(t *testing.T) {
 				Logger: testLogger,
 			},
 			args: args{
-				r: &v1alpha1.Redis{Spec: croType.ResourceTypeSpec{
-					Tier: "development",
+				r: &v1alpha1.Redis{Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Tier: "development",
+					},
 				}},
 			},
 			want: &elasticache.CreateReplicationGroupInput{
@@ -3086,9 +3152,11 @@ func TestRedisProvider_getElasticacheConfig(t *testing.T) {
 				Logger: testLogger,
 			},
 			args: args{
-				r: &v1alpha1.Redis{Spec: croType.ResourceTypeSpec{
-					Tier: "development",
-					Size: "cache.m5.large",
+				r: &v1alpha1.Redis{Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Tier: "development",
+						Size: "cache.m5.large",
+					},
 				}},
 			},
 			want: &elasticache.CreateReplicationGroupInput{
@@ -3117,9 +3185,11 @@ func TestRedisProvider_getElasticacheConfig(t *testing.T) {
 				Logger: testLogger,
 			},
 			args: args{
-				r: &v1alpha1.Redis{Spec: croType.ResourceTypeSpec{
-					Tier: "development",
-					Size: "cache.m5.large",
+				r: &v1alpha1.Redis{Spec: v1alpha1.RedisSpec{
+					ResourceTypeSpec: croType.ResourceTypeSpec{
+						Tier: "development",
+						Size: "cache.m5.large",
+					},
 				}},
 			},
 			want: &elasticache.CreateReplicationGroupInput{
@@ -3155,3 +3225,49 @@ func TestRedisProvider_getElasticacheConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildElasticacheSnapshotMetricInfo(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		snapshot *elasticache.Snapshot
+		want     *resources.SnapshotMetricInfo
+	}{
+		{
+			name: "test node snapshot sizes are summed and latest create time is used",
+			snapshot: &elasticache.Snapshot{
+				NodeSnapshots: []*elasticache.NodeSnapshot{
+					{SnapshotCreateTime: aws.Time(now.Add(-time.Minute)), CacheSize: aws.String("6 MB")},
+					{SnapshotCreateTime: aws.Time(now), CacheSize: aws.String("1 GB")},
+				},
+			},
+			want: &resources.SnapshotMetricInfo{
+				CreateTime: now,
+				SizeBytes:  6*1024*1024 + 1024*1024*1024,
+			},
+		},
+		{
+			name: "test unparsable cache size is ignored",
+			snapshot: &elasticache.Snapshot{
+				NodeSnapshots: []*elasticache.NodeSnapshot{
+					{SnapshotCreateTime: aws.Time(now), CacheSize: aws.String("unknown")},
+				},
+			},
+			want: &resources.SnapshotMetricInfo{
+				CreateTime: now,
+			},
+		},
+		{
+			name:     "test nil is returned for a snapshot without node snapshots",
+			snapshot: &elasticache.Snapshot{},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildElasticacheSnapshotMetricInfo(tt.snapshot); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildElasticacheSnapshotMetricInfo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,9 +34,10 @@ type RedisSnapshotProvider struct {
 	logger            *logrus.Entry
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
 }
 
-func NewAWSRedisSnapshotProvider(client client.Client, logger *logrus.Entry) (*RedisSnapshotProvider, error) {
+func NewAWSRedisSnapshotProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*RedisSnapshotProvider, error) {
 	cm, err := NewCredentialManager(client)
 	if err != nil {
 		return nil, err
@@ -45,6 +47,7 @@ func NewAWSRedisSnapshotProvider(client client.Client, logger *logrus.Entry) (*R
 		logger:            logger.WithFields(logrus.Fields{"provider": redisProviderName}),
 		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigMapConfigManager(client),
+		Recorder:          recorder,
 	}, nil
 }
 
@@ -70,7 +73,7 @@ func (p *RedisSnapshotProvider) CreateRedisSnapshot(ctx context.Context, snapsho
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	session, err := p.createSessionForResource(ctx, redis.Namespace, providers.RedisResourceType, redis.Spec.Tier)
+	session, err := p.createSessionForResource(ctx, snapshot, providers.RedisResourceType, redis.Spec.Tier)
 
 	if err != nil {
 		errMsg := "failed to create AWS session"
@@ -92,15 +95,13 @@ func (p *RedisSnapshotProvider) createRedisSnapshot(ctx context.Context, snapsho
 	}
 
 	// update cr with snapshot name
-	snapshot.Status.SnapshotID = snapshotName
-
-	if err = p.client.Status().Update(ctx, snapshot); err != nil {
+	if err = resources.PatchStatus(ctx, p.client, snapshot, func() { snapshot.Status.SnapshotID = snapshotName }); err != nil {
 		errMsg := fmt.Sprintf("failed to update instance %s in namespace %s", snapshot.Name, snapshot.Namespace)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
 	// generate cache cluster name
-	clusterName, err := resources.BuildInfraNameFromObject(ctx, p.client, redis.ObjectMeta, defaultAwsIdentifierLength)
+	clusterName, err := resources.BuildResourceIdentifier(ctx, p.client, redis.ObjectMeta, redis.Spec.ResourceTypeSpec, defaultAwsIdentifierLength)
 	if err != nil {
 		errMsg := "failed to get cluster name"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
@@ -155,6 +156,7 @@ func (p *RedisSnapshotProvider) createRedisSnapshot(ctx context.Context, snapsho
 			errMsg := "error creating elasticache snapshot"
 			return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 		}
+		resources.RecordEvent(p.Recorder, snapshot, resources.EventReasonCreateStarted, "started elasticache snapshot %s", snapshotName)
 		return nil, "snapshot started", nil
 	}
 
@@ -173,7 +175,7 @@ func (p *RedisSnapshotProvider) createRedisSnapshot(ctx context.Context, snapsho
 
 func (p *RedisSnapshotProvider) DeleteRedisSnapshot(ctx context.Context, snapshot *v1alpha1.RedisSnapshot, redis *v1alpha1.Redis) (croType.StatusMessage, error) {
 
-	session, err := p.createSessionForResource(ctx, redis.Namespace, providers.RedisResourceType, redis.Spec.Tier)
+	session, err := p.createSessionForResource(ctx, snapshot, providers.RedisResourceType, redis.Spec.Tier)
 
 	if err != nil {
 		errMsg := "failed to create AWS session"
@@ -196,9 +198,7 @@ func (p *RedisSnapshotProvider) deleteRedisSnapshot(ctx context.Context, snapsho
 
 	// snapshot is deleted
 	if foundSnapshot == nil {
-		resources.RemoveFinalizer(&snapshot.ObjectMeta, DefaultFinalizer)
-
-		if err := p.client.Update(ctx, snapshot); err != nil {
+		if err := resources.DeleteFinalizer(ctx, p.client, snapshot, DefaultFinalizer); err != nil {
 			msg := "failed to update instance as part of finalizer reconcile"
 			return croType.StatusMessage(msg), errorUtil.Wrapf(err, msg)
 		}
@@ -241,11 +241,12 @@ func (p *RedisSnapshotProvider) findSnapshotInstance(cacheSvc elasticacheiface.E
 	return foundSnapshot, nil
 }
 
-func (p *RedisSnapshotProvider) createSessionForResource(ctx context.Context, namespace string, resourceType providers.ResourceType, tier string) (*session.Session, error) {
+func (p *RedisSnapshotProvider) createSessionForResource(ctx context.Context, snapshot client.Object, resourceType providers.ResourceType, tier string) (*session.Session, error) {
 
 	// create the credentials to be used by the aws resource providers, not to be used by end-user
-	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, namespace)
+	providerCreds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, snapshot.GetNamespace(), providers.RedisResourceType)
 	if err != nil {
+		resources.RecordWarningEvent(p.Recorder, snapshot, resources.EventReasonCredentialsFailed, "failed to reconcile aws credentials: %v", err)
 		return nil, errorUtil.Wrap(err, "failed to reconcile aws credentials")
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"k8s.io/client-go/tools/record"
 	"os"
 	"reflect"
 	"testing"
@@ -740,7 +741,7 @@ func TestNewAWSRedisSnapshotProvider(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewAWSRedisSnapshotProvider(tt.args.client(), tt.args.logger)
+			got, err := NewAWSRedisSnapshotProvider(tt.args.client(), tt.args.logger, record.NewFakeRecorder(10))
 			if tt.wantErr {
 				if err == nil {
 					t.Fatalf("NewAWSRedisSnapshotProvider(), got = %v, want non-nil error", err)
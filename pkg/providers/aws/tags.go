@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
@@ -37,6 +38,10 @@ func genericToElasticacheTag(tag *resources.Tag) *elasticache.Tag {
 	return &elasticache.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)}
 }
 
+func genericToElbv2Tag(tag *resources.Tag) *elbv2.Tag {
+	return &elbv2.Tag{Key: aws.String(tag.Key), Value: aws.String(tag.Value)}
+}
+
 func genericToRdsTags(tags []*resources.Tag) []*rds.Tag {
 	var rdsTags []*rds.Tag
 	for _, tag := range tags {
@@ -76,3 +81,11 @@ func genericListToEc2TagList(tags []*resources.Tag) []*ec2.Tag {
 	}
 	return ec2Tags
 }
+
+func genericListToElbv2TagList(tags []*resources.Tag) []*elbv2.Tag {
+	var elbv2Tags []*elbv2.Tag
+	for _, tag := range tags {
+		elbv2Tags = append(elbv2Tags, genericToElbv2Tag(tag))
+	}
+	return elbv2Tags
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
 	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/api/option"
@@ -43,6 +44,11 @@ type NetworkManager interface {
 	DeleteNetworkIpRange(context.Context) error
 	ComponentsExist(context.Context) (bool, error)
 	ReconcileNetworkProviderConfig(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error)
+	ReconcileNetworkAccess(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error
+	DeleteNetworkAccess(ctx context.Context, resourceID string) error
+	GetNetworkConnectionMethod(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error)
+	CreatePrivateServiceConnectEndpoint(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error)
+	DeletePrivateServiceConnectEndpoint(ctx context.Context, name string, region string) (bool, error)
 }
 
 var (
@@ -50,17 +56,21 @@ var (
 )
 
 type NetworkProvider struct {
-	Client      client.Client
-	NetworkApi  gcpiface.NetworksAPI
-	SubnetApi   gcpiface.SubnetsApi
-	ServicesApi gcpiface.ServicesAPI
-	AddressApi  gcpiface.AddressAPI
-	Logger      *logrus.Entry
-	ProjectID   string
+	Client            client.Client
+	NetworkApi        gcpiface.NetworksAPI
+	SubnetApi         gcpiface.SubnetsApi
+	ServicesApi       gcpiface.ServicesAPI
+	AddressApi        gcpiface.AddressAPI
+	FirewallApi       gcpiface.FirewallsAPI
+	ForwardingRuleApi gcpiface.ForwardingRulesAPI
+	Logger            *logrus.Entry
+	ProjectID         string
 }
 
 type CreateVpcInput struct {
-	CidrBlock string
+	CidrBlock        string
+	PrefixLength     int
+	ConnectionMethod NetworkConnectionMethod
 }
 
 // NewNetworkManager initialises all required clients
@@ -81,17 +91,27 @@ func NewNetworkManager(ctx context.Context, projectID string, opt option.ClientO
 	if err != nil {
 		return nil, errorUtil.Wrap(err, "Failed to initialise addresses client")
 	}
+	firewallApi, err := gcpiface.NewFirewallsAPI(ctx, opt)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "Failed to initialise firewalls client")
+	}
+	forwardingRuleApi, err := gcpiface.NewForwardingRulesAPI(ctx, opt)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "Failed to initialise forwarding rules client")
+	}
 	if logger == nil {
 		logger = logrus.NewEntry(logrus.StandardLogger())
 	}
 	return &NetworkProvider{
-		Client:      client,
-		NetworkApi:  networksApi,
-		SubnetApi:   subnetsApi,
-		ServicesApi: servicesApi,
-		AddressApi:  addressApi,
-		Logger:      logger.WithField("provider", "gcp_network_provider"),
-		ProjectID:   projectID,
+		Client:            client,
+		NetworkApi:        networksApi,
+		SubnetApi:         subnetsApi,
+		ServicesApi:       servicesApi,
+		AddressApi:        addressApi,
+		FirewallApi:       firewallApi,
+		ForwardingRuleApi: forwardingRuleApi,
+		Logger:            logger.WithField("provider", "gcp_network_provider"),
+		ProjectID:         projectID,
 	}, nil
 }
 
@@ -111,11 +131,12 @@ func (n *NetworkProvider) CreateNetworkIpRange(ctx context.Context, cidrRange *n
 		if err != nil {
 			return nil, croType.StatusNetworkCreateError, errorUtil.Wrap(err, "failed to get cluster vpc")
 		}
-		subnets, err := n.getClusterSubnets(ctx, clusterVpc)
+		// a configured ip range is validated against the same cidr blocks an allocated one avoids
+		reserved, err := n.getReservedCIDRs(ctx)
 		if err != nil {
-			return nil, croType.StatusNetworkCreateError, errorUtil.Wrap(err, "failed to get cluster subnetworks")
+			return nil, croType.StatusNetworkCreateError, errorUtil.Wrap(err, "failed to get cidr blocks in use")
 		}
-		err = validateCidrBlock(cidrRange, subnets)
+		err = validateCidrBlock(cidrRange, reserved)
 		if err != nil {
 			return nil, croType.StatusNetworkCreateError, errorUtil.Wrap(err, "ip range validation failure")
 		}
@@ -425,29 +446,140 @@ func (n *NetworkProvider) ReconcileNetworkProviderConfig(ctx context.Context, co
 		return vpcCidr, nil
 	}
 
-	// if vpcCreateConfig.CidrBlock is an empty string we can go ahead and set a default with a mask size of /22
-	defaultCidr := &net.IPNet{
-		Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
+	// if vpcCreateConfig.CidrBlock is an empty string we can go ahead and allocate a free cidr block
+	ipRangeCidr, err := n.allocateIpRangeCIDR(ctx, vpcCreateConfig)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to allocate cidr block")
 	}
 
-	// a default cidr is generated and updated to the config map, that is returned for use in the network creation
-	return defaultCidr, nil
+	// the allocated cidr block is updated to the config map, that is returned for use in the network creation
+	createStrategy, err := resources.SetJSONField(stratCfg.CreateStrategy, "CidrBlock", ipRangeCidr.String())
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to set cidr block in _network strategy")
+	}
+	if err = configManager.WriteCreateStrategy(ctx, providers.NetworkResourceType, tier, createStrategy); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to persist allocated cidr block to _network strategy")
+	}
+	n.Logger.Infof("allocated ip range cidr block %s for network strategy tier %s", ipRangeCidr.String(), tier)
+	return ipRangeCidr, nil
 }
 
-func validateCidrBlock(validateCIDR *net.IPNet, subnets []*computepb.Subnetwork) error {
-	// validate has a cidr range lower than or equal to /22
-	if !isValidCIDRRange(validateCIDR) {
-		return fmt.Errorf("%s is out of range, block sizes must be `/22` or lower, please update `_network` strategy", validateCIDR.String())
+// allocateIpRangeCIDR returns the cidr block of the ip address range if it already exists, otherwise the first free
+// block of the requested prefix length, /22 by default, within the private ranges
+func (n *NetworkProvider) allocateIpRangeCIDR(ctx context.Context, vpcCreateConfig *CreateVpcInput) (*net.IPNet, error) {
+	// an ip address range created before cidr blocks were persisted keeps its cidr block
+	ipRangeName, err := resources.BuildInfraName(ctx, n.Client, defaultIpRangePostfix, defaultGcpIdentifierLength)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to build ip address range infra name")
+	}
+	address, err := n.getAddressRange(ctx, ipRangeName)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to retrieve ip address range")
+	}
+	if address != nil {
+		_, ipRangeCidr, err := net.ParseCIDR(fmt.Sprintf("%s/%d", address.GetAddress(), address.GetPrefixLength()))
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to parse ip address range cidr block")
+		}
+		return ipRangeCidr, nil
+	}
+
+	mask := defaultIpRangeCIDRMask
+	if vpcCreateConfig.PrefixLength != 0 {
+		mask = vpcCreateConfig.PrefixLength
+	}
+	if !isValidCIDRRange(&net.IPNet{Mask: net.CIDRMask(mask, defaultIpv4Length)}) {
+		return nil, fmt.Errorf("/%d is out of range, block sizes must be `/22` or lower, please update `_network` strategy", mask)
+	}
+
+	reserved, err := n.getReservedCIDRs(ctx)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cidr blocks in use")
+	}
+	return resources.AllocateCIDR(resources.PrivateCIDRPools(), mask, reserved)
+}
+
+// getReservedCIDRs returns the cidr blocks an ip address range can not overlap with
+//   - the primary and secondary ranges of the cluster vpc subnets
+//   - the cluster pod and service cidr blocks
+//   - the ip address ranges allocated in the cluster vpc, such as the ranges of other clusters sharing it
+//   - the routes imported from networks peered with the cluster vpc
+func (n *NetworkProvider) getReservedCIDRs(ctx context.Context) ([]*net.IPNet, error) {
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.NetworkApi, n.ProjectID, n.Logger)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	subnets, err := n.getClusterSubnets(ctx, clusterVpc)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster subnetworks")
+	}
+	var cidrs []string
+	for _, subnet := range subnets {
+		cidrs = append(cidrs, subnet.GetIpCidrRange())
+		for _, secondary := range subnet.GetSecondaryIpRanges() {
+			cidrs = append(cidrs, secondary.GetIpCidrRange())
+		}
 	}
-	for i := range subnets {
-		_, clusterCIDR, err := net.ParseCIDR(resources.SafeStringDereference(subnets[i].IpCidrRange))
+	// peering routes are listed per region, the cluster is in the regions of its subnets
+	regions := map[string]bool{}
+	for _, subnetUrl := range clusterVpc.GetSubnetworks() {
+		_, region, err := parseSubnetUrl(subnetUrl)
 		if err != nil {
-			return fmt.Errorf("failed to parse cluster subnet into cidr")
+			return nil, err
+		}
+		regions[region] = true
+	}
+
+	networkConf := &configv1.Network{}
+	if err = n.Client.Get(ctx, client.ObjectKey{Name: "cluster"}, networkConf); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster network config")
+	}
+	for _, entry := range networkConf.Spec.ClusterNetwork {
+		cidrs = append(cidrs, entry.CIDR)
+	}
+	cidrs = append(cidrs, networkConf.Spec.ServiceNetwork...)
+
+	addresses, err := n.AddressApi.List(ctx, &computepb.ListGlobalAddressesRequest{
+		Project: n.ProjectID,
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to list ip address ranges")
+	}
+	for _, address := range addresses {
+		if address.GetNetwork() != clusterVpc.GetSelfLink() || address.GetPrefixLength() == 0 {
+			continue
 		}
-		if clusterCIDR.Contains(validateCIDR.IP) || validateCIDR.Contains(clusterCIDR.IP) {
-			return fmt.Errorf("ip range creation failed: cidr block %s overlaps with cluster vpc subnet block %s, update _network strategy to continue ip range creation", validateCIDR.String(), clusterCIDR.String())
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", address.GetAddress(), address.GetPrefixLength()))
+	}
+
+	for _, peering := range clusterVpc.GetPeerings() {
+		for region := range regions {
+			routes, err := n.NetworkApi.ListPeeringRoutes(ctx, &computepb.ListPeeringRoutesNetworksRequest{
+				Project:     n.ProjectID,
+				Network:     clusterVpc.GetName(),
+				PeeringName: utils.To(peering.GetName()),
+				Region:      utils.To(region),
+				Direction:   utils.To(computepb.ListPeeringRoutesNetworksRequest_INCOMING.String()),
+			})
+			if err != nil {
+				return nil, errorUtil.Wrapf(err, "failed to list routes of peering %s", peering.GetName())
+			}
+			for _, route := range routes {
+				cidrs = append(cidrs, route.GetDestRange())
+			}
 		}
 	}
+	return resources.ParseCIDRs(cidrs...)
+}
+
+func validateCidrBlock(validateCIDR *net.IPNet, reserved []*net.IPNet) error {
+	// validate has a cidr range lower than or equal to /22
+	if !isValidCIDRRange(validateCIDR) {
+		return fmt.Errorf("%s is out of range, block sizes must be `/22` or lower, please update `_network` strategy", validateCIDR.String())
+	}
+	if reservedCIDR := resources.FindOverlappingCIDR(validateCIDR, reserved); reservedCIDR != nil {
+		return fmt.Errorf("ip range creation failed: cidr block %s overlaps with cidr block %s in use by the cluster network, update _network strategy to continue ip range creation", validateCIDR.String(), reservedCIDR.String())
+	}
 	return nil
 }
 
@@ -31,6 +31,12 @@ var _ NetworkManager = &NetworkManagerMock{}
 //			CreateNetworkServiceFunc: func(contextMoqParam context.Context) (*servicenetworking.Connection, croType.StatusMessage, error) {
 //				panic("mock out the CreateNetworkService method")
 //			},
+//			CreatePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error) {
+//				panic("mock out the CreatePrivateServiceConnectEndpoint method")
+//			},
+//			DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+//				panic("mock out the DeleteNetworkAccess method")
+//			},
 //			DeleteNetworkIpRangeFunc: func(contextMoqParam context.Context) error {
 //				panic("mock out the DeleteNetworkIpRange method")
 //			},
@@ -40,6 +46,15 @@ var _ NetworkManager = &NetworkManagerMock{}
 //			DeleteNetworkServiceFunc: func(contextMoqParam context.Context) error {
 //				panic("mock out the DeleteNetworkService method")
 //			},
+//			DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+//				panic("mock out the DeletePrivateServiceConnectEndpoint method")
+//			},
+//			GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+//				panic("mock out the GetNetworkConnectionMethod method")
+//			},
+//			ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+//				panic("mock out the ReconcileNetworkAccess method")
+//			},
 //			ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 //				panic("mock out the ReconcileNetworkProviderConfig method")
 //			},
@@ -59,6 +74,12 @@ type NetworkManagerMock struct {
 	// CreateNetworkServiceFunc mocks the CreateNetworkService method.
 	CreateNetworkServiceFunc func(contextMoqParam context.Context) (*servicenetworking.Connection, croType.StatusMessage, error)
 
+	// CreatePrivateServiceConnectEndpointFunc mocks the CreatePrivateServiceConnectEndpoint method.
+	CreatePrivateServiceConnectEndpointFunc func(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error)
+
+	// DeleteNetworkAccessFunc mocks the DeleteNetworkAccess method.
+	DeleteNetworkAccessFunc func(ctx context.Context, resourceID string) error
+
 	// DeleteNetworkIpRangeFunc mocks the DeleteNetworkIpRange method.
 	DeleteNetworkIpRangeFunc func(contextMoqParam context.Context) error
 
@@ -68,6 +89,15 @@ type NetworkManagerMock struct {
 	// DeleteNetworkServiceFunc mocks the DeleteNetworkService method.
 	DeleteNetworkServiceFunc func(contextMoqParam context.Context) error
 
+	// DeletePrivateServiceConnectEndpointFunc mocks the DeletePrivateServiceConnectEndpoint method.
+	DeletePrivateServiceConnectEndpointFunc func(ctx context.Context, name string, region string) (bool, error)
+
+	// GetNetworkConnectionMethodFunc mocks the GetNetworkConnectionMethod method.
+	GetNetworkConnectionMethodFunc func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error)
+
+	// ReconcileNetworkAccessFunc mocks the ReconcileNetworkAccess method.
+	ReconcileNetworkAccessFunc func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error
+
 	// ReconcileNetworkProviderConfigFunc mocks the ReconcileNetworkProviderConfig method.
 	ReconcileNetworkProviderConfigFunc func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error)
 
@@ -90,6 +120,24 @@ type NetworkManagerMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// CreatePrivateServiceConnectEndpoint holds details about calls to the CreatePrivateServiceConnectEndpoint method.
+		CreatePrivateServiceConnectEndpoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Region is the region argument value.
+			Region string
+			// ServiceAttachment is the serviceAttachment argument value.
+			ServiceAttachment string
+		}
+		// DeleteNetworkAccess holds details about calls to the DeleteNetworkAccess method.
+		DeleteNetworkAccess []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+		}
 		// DeleteNetworkIpRange holds details about calls to the DeleteNetworkIpRange method.
 		DeleteNetworkIpRange []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -105,6 +153,37 @@ type NetworkManagerMock struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
+		// DeletePrivateServiceConnectEndpoint holds details about calls to the DeletePrivateServiceConnectEndpoint method.
+		DeletePrivateServiceConnectEndpoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Region is the region argument value.
+			Region string
+		}
+		// GetNetworkConnectionMethod holds details about calls to the GetNetworkConnectionMethod method.
+		GetNetworkConnectionMethod []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ConfigManager is the configManager argument value.
+			ConfigManager ConfigManager
+			// Tier is the tier argument value.
+			Tier string
+		}
+		// ReconcileNetworkAccess holds details about calls to the ReconcileNetworkAccess method.
+		ReconcileNetworkAccess []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ResourceID is the resourceID argument value.
+			ResourceID string
+			// IP is the ip argument value.
+			IP string
+			// Port is the port argument value.
+			Port int32
+			// Access is the access argument value.
+			Access *croType.NetworkAccess
+		}
 		// ReconcileNetworkProviderConfig holds details about calls to the ReconcileNetworkProviderConfig method.
 		ReconcileNetworkProviderConfig []struct {
 			// Ctx is the ctx argument value.
@@ -115,13 +194,18 @@ type NetworkManagerMock struct {
 			Tier string
 		}
 	}
-	lockComponentsExist                sync.RWMutex
-	lockCreateNetworkIpRange           sync.RWMutex
-	lockCreateNetworkService           sync.RWMutex
-	lockDeleteNetworkIpRange           sync.RWMutex
-	lockDeleteNetworkPeering           sync.RWMutex
-	lockDeleteNetworkService           sync.RWMutex
-	lockReconcileNetworkProviderConfig sync.RWMutex
+	lockComponentsExist                     sync.RWMutex
+	lockCreateNetworkIpRange                sync.RWMutex
+	lockCreateNetworkService                sync.RWMutex
+	lockCreatePrivateServiceConnectEndpoint sync.RWMutex
+	lockDeleteNetworkAccess                 sync.RWMutex
+	lockDeleteNetworkIpRange                sync.RWMutex
+	lockDeleteNetworkPeering                sync.RWMutex
+	lockDeleteNetworkService                sync.RWMutex
+	lockDeletePrivateServiceConnectEndpoint sync.RWMutex
+	lockGetNetworkConnectionMethod          sync.RWMutex
+	lockReconcileNetworkAccess              sync.RWMutex
+	lockReconcileNetworkProviderConfig      sync.RWMutex
 }
 
 // ComponentsExist calls ComponentsExistFunc.
@@ -224,6 +308,86 @@ func (mock *NetworkManagerMock) CreateNetworkServiceCalls() []struct {
 	return calls
 }
 
+// CreatePrivateServiceConnectEndpoint calls CreatePrivateServiceConnectEndpointFunc.
+func (mock *NetworkManagerMock) CreatePrivateServiceConnectEndpoint(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error) {
+	if mock.CreatePrivateServiceConnectEndpointFunc == nil {
+		panic("NetworkManagerMock.CreatePrivateServiceConnectEndpointFunc: method is nil but NetworkManager.CreatePrivateServiceConnectEndpoint was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		Name              string
+		Region            string
+		ServiceAttachment string
+	}{
+		Ctx:               ctx,
+		Name:              name,
+		Region:            region,
+		ServiceAttachment: serviceAttachment,
+	}
+	mock.lockCreatePrivateServiceConnectEndpoint.Lock()
+	mock.calls.CreatePrivateServiceConnectEndpoint = append(mock.calls.CreatePrivateServiceConnectEndpoint, callInfo)
+	mock.lockCreatePrivateServiceConnectEndpoint.Unlock()
+	return mock.CreatePrivateServiceConnectEndpointFunc(ctx, name, region, serviceAttachment)
+}
+
+// CreatePrivateServiceConnectEndpointCalls gets all the calls that were made to CreatePrivateServiceConnectEndpoint.
+// Check the length with:
+//
+//	len(mockedNetworkManager.CreatePrivateServiceConnectEndpointCalls())
+func (mock *NetworkManagerMock) CreatePrivateServiceConnectEndpointCalls() []struct {
+	Ctx               context.Context
+	Name              string
+	Region            string
+	ServiceAttachment string
+} {
+	var calls []struct {
+		Ctx               context.Context
+		Name              string
+		Region            string
+		ServiceAttachment string
+	}
+	mock.lockCreatePrivateServiceConnectEndpoint.RLock()
+	calls = mock.calls.CreatePrivateServiceConnectEndpoint
+	mock.lockCreatePrivateServiceConnectEndpoint.RUnlock()
+	return calls
+}
+
+// DeleteNetworkAccess calls DeleteNetworkAccessFunc.
+func (mock *NetworkManagerMock) DeleteNetworkAccess(ctx context.Context, resourceID string) error {
+	if mock.DeleteNetworkAccessFunc == nil {
+		panic("NetworkManagerMock.DeleteNetworkAccessFunc: method is nil but NetworkManager.DeleteNetworkAccess was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+	}
+	mock.lockDeleteNetworkAccess.Lock()
+	mock.calls.DeleteNetworkAccess = append(mock.calls.DeleteNetworkAccess, callInfo)
+	mock.lockDeleteNetworkAccess.Unlock()
+	return mock.DeleteNetworkAccessFunc(ctx, resourceID)
+}
+
+// DeleteNetworkAccessCalls gets all the calls that were made to DeleteNetworkAccess.
+// Check the length with:
+//
+//	len(mockedNetworkManager.DeleteNetworkAccessCalls())
+func (mock *NetworkManagerMock) DeleteNetworkAccessCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+	}
+	mock.lockDeleteNetworkAccess.RLock()
+	calls = mock.calls.DeleteNetworkAccess
+	mock.lockDeleteNetworkAccess.RUnlock()
+	return calls
+}
+
 // DeleteNetworkIpRange calls DeleteNetworkIpRangeFunc.
 func (mock *NetworkManagerMock) DeleteNetworkIpRange(contextMoqParam context.Context) error {
 	if mock.DeleteNetworkIpRangeFunc == nil {
@@ -320,6 +484,134 @@ func (mock *NetworkManagerMock) DeleteNetworkServiceCalls() []struct {
 	return calls
 }
 
+// DeletePrivateServiceConnectEndpoint calls DeletePrivateServiceConnectEndpointFunc.
+func (mock *NetworkManagerMock) DeletePrivateServiceConnectEndpoint(ctx context.Context, name string, region string) (bool, error) {
+	if mock.DeletePrivateServiceConnectEndpointFunc == nil {
+		panic("NetworkManagerMock.DeletePrivateServiceConnectEndpointFunc: method is nil but NetworkManager.DeletePrivateServiceConnectEndpoint was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Name   string
+		Region string
+	}{
+		Ctx:    ctx,
+		Name:   name,
+		Region: region,
+	}
+	mock.lockDeletePrivateServiceConnectEndpoint.Lock()
+	mock.calls.DeletePrivateServiceConnectEndpoint = append(mock.calls.DeletePrivateServiceConnectEndpoint, callInfo)
+	mock.lockDeletePrivateServiceConnectEndpoint.Unlock()
+	return mock.DeletePrivateServiceConnectEndpointFunc(ctx, name, region)
+}
+
+// DeletePrivateServiceConnectEndpointCalls gets all the calls that were made to DeletePrivateServiceConnectEndpoint.
+// Check the length with:
+//
+//	len(mockedNetworkManager.DeletePrivateServiceConnectEndpointCalls())
+func (mock *NetworkManagerMock) DeletePrivateServiceConnectEndpointCalls() []struct {
+	Ctx    context.Context
+	Name   string
+	Region string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Name   string
+		Region string
+	}
+	mock.lockDeletePrivateServiceConnectEndpoint.RLock()
+	calls = mock.calls.DeletePrivateServiceConnectEndpoint
+	mock.lockDeletePrivateServiceConnectEndpoint.RUnlock()
+	return calls
+}
+
+// GetNetworkConnectionMethod calls GetNetworkConnectionMethodFunc.
+func (mock *NetworkManagerMock) GetNetworkConnectionMethod(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+	if mock.GetNetworkConnectionMethodFunc == nil {
+		panic("NetworkManagerMock.GetNetworkConnectionMethodFunc: method is nil but NetworkManager.GetNetworkConnectionMethod was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ConfigManager ConfigManager
+		Tier          string
+	}{
+		Ctx:           ctx,
+		ConfigManager: configManager,
+		Tier:          tier,
+	}
+	mock.lockGetNetworkConnectionMethod.Lock()
+	mock.calls.GetNetworkConnectionMethod = append(mock.calls.GetNetworkConnectionMethod, callInfo)
+	mock.lockGetNetworkConnectionMethod.Unlock()
+	return mock.GetNetworkConnectionMethodFunc(ctx, configManager, tier)
+}
+
+// GetNetworkConnectionMethodCalls gets all the calls that were made to GetNetworkConnectionMethod.
+// Check the length with:
+//
+//	len(mockedNetworkManager.GetNetworkConnectionMethodCalls())
+func (mock *NetworkManagerMock) GetNetworkConnectionMethodCalls() []struct {
+	Ctx           context.Context
+	ConfigManager ConfigManager
+	Tier          string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ConfigManager ConfigManager
+		Tier          string
+	}
+	mock.lockGetNetworkConnectionMethod.RLock()
+	calls = mock.calls.GetNetworkConnectionMethod
+	mock.lockGetNetworkConnectionMethod.RUnlock()
+	return calls
+}
+
+// ReconcileNetworkAccess calls ReconcileNetworkAccessFunc.
+func (mock *NetworkManagerMock) ReconcileNetworkAccess(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+	if mock.ReconcileNetworkAccessFunc == nil {
+		panic("NetworkManagerMock.ReconcileNetworkAccessFunc: method is nil but NetworkManager.ReconcileNetworkAccess was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ResourceID string
+		IP         string
+		Port       int32
+		Access     *croType.NetworkAccess
+	}{
+		Ctx:        ctx,
+		ResourceID: resourceID,
+		IP:         ip,
+		Port:       port,
+		Access:     access,
+	}
+	mock.lockReconcileNetworkAccess.Lock()
+	mock.calls.ReconcileNetworkAccess = append(mock.calls.ReconcileNetworkAccess, callInfo)
+	mock.lockReconcileNetworkAccess.Unlock()
+	return mock.ReconcileNetworkAccessFunc(ctx, resourceID, ip, port, access)
+}
+
+// ReconcileNetworkAccessCalls gets all the calls that were made to ReconcileNetworkAccess.
+// Check the length with:
+//
+//	len(mockedNetworkManager.ReconcileNetworkAccessCalls())
+func (mock *NetworkManagerMock) ReconcileNetworkAccessCalls() []struct {
+	Ctx        context.Context
+	ResourceID string
+	IP         string
+	Port       int32
+	Access     *croType.NetworkAccess
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ResourceID string
+		IP         string
+		Port       int32
+		Access     *croType.NetworkAccess
+	}
+	mock.lockReconcileNetworkAccess.RLock()
+	calls = mock.calls.ReconcileNetworkAccess
+	mock.lockReconcileNetworkAccess.RUnlock()
+	return calls
+}
+
 // ReconcileNetworkProviderConfig calls ReconcileNetworkProviderConfigFunc.
 func (mock *NetworkManagerMock) ReconcileNetworkProviderConfig(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 	if mock.ReconcileNetworkProviderConfigFunc == nil {
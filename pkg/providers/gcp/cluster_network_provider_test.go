@@ -58,6 +58,21 @@ func buildMockNetworkManager() *NetworkManagerMock {
 		ComponentsExistFunc: func(contextMoqParam context.Context) (bool, error) {
 			return false, nil
 		},
+		ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+			return nil
+		},
+		DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+			return nil
+		},
+		GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+			return NetworkConnectionMethodServiceNetworking, nil
+		},
+		CreatePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error) {
+			return &computepb.Address{Name: utils.To(buildPrivateServiceConnectEndpointName(name))}, "", nil
+		},
+		DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+			return true, nil
+		},
 	}
 }
 
@@ -116,6 +131,25 @@ func buildTestGcpInfrastructure(argsMap map[string]*string) *configv1.Infrastruc
 	return &infra
 }
 
+func buildTestGcpNetworkConfig() *configv1.Network {
+	return &configv1.Network{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+		},
+		Spec: configv1.NetworkSpec{
+			ClusterNetwork: []configv1.ClusterNetworkEntry{
+				{
+					CIDR:       "10.128.0.0/14",
+					HostPrefix: 23,
+				},
+			},
+			ServiceNetwork: []string{
+				"172.30.0.0/16",
+			},
+		},
+	}
+}
+
 func buildValidGcpListNetworks(req *computepb.ListNetworksRequest) ([]*computepb.Network, error) {
 	return testBuildNetwork(req, buildValidGcpNetwork)
 }
@@ -254,7 +288,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "create ip range created",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -284,7 +318,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "create ip range exists",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -311,7 +345,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "create ip range created - mask only",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -349,7 +383,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "googleapi error retrieving ip address range",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				AddressApi: gcpiface.GetMockAddressClient(func(addressClient *gcpiface.MockAddressClient) {
 					addressClient.GetFn = func(*computepb.GetGlobalAddressRequest) (*computepb.Address, error) {
 						return nil, &googleapi.Error{
@@ -365,7 +399,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "unknown error retrieving ip address range",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				AddressApi: gcpiface.GetMockAddressClient(func(addressClient *gcpiface.MockAddressClient) {
 					addressClient.GetFn = func(*computepb.GetGlobalAddressRequest) (*computepb.Address, error) {
 						return nil, errors.New("failed to get address")
@@ -379,7 +413,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "error no cluster vpc present",
 			fields: fields{
-				Client:     moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client:     moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(nil),
 				AddressApi: gcpiface.GetMockAddressClient(nil),
 			},
@@ -393,7 +427,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "error retrieving cluster subnets",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -418,7 +452,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "error overlapping cidr range",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -439,10 +473,34 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 			wantErr: true,
 			wantMsg: croType.StatusNetworkCreateError,
 		},
+		{
+			name: "error cidr range overlapping cluster service network",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				SubnetsApi: gcpiface.GetMockSubnetsClient(func(subnetClient *gcpiface.MockSubnetsClient) {
+					subnetClient.GetFn = func(req *computepb.GetSubnetworkRequest) (*computepb.Subnetwork, error) {
+						return buildValidSubnet(req.Subnetwork, gcpTestMasterSubnetCidr), nil
+					}
+					subnetClient.GetFnTwo = func(req *computepb.GetSubnetworkRequest) (*computepb.Subnetwork, error) {
+						return buildValidSubnet(req.Subnetwork, gcpTestWorkerSubnetCidr), nil
+					}
+				}),
+				AddressApi: gcpiface.GetMockAddressClient(nil),
+			},
+			args: args{
+				ipRangeCidr: buildIpRangeCidr("172.30.4.0/22"),
+			},
+			want:    nil,
+			wantErr: true,
+			wantMsg: croType.StatusNetworkCreateError,
+		},
 		{
 			name: "error invalid cidr range /23",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -466,7 +524,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "error creating ip address range",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -494,7 +552,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "error waiting for ip address range creation",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -522,7 +580,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "googleapi error retrieving ip address range - post creation",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -552,7 +610,7 @@ func TestNetworkProvider_CreateNetworkIpRange(t *testing.T) {
 		{
 			name: "unknown error retrieving ip address range - post creation",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
 				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
 					networksClient.ListFn = buildValidGcpListNetworks
 				}),
@@ -1377,39 +1435,177 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 	if err != nil {
 		t.Fatal("failed to build scheme", err)
 	}
+	clusterVpcSelfLink := fmt.Sprintf(defaultNetworksFormat, gcpTestProjectId, gcpTestNetworkName)
+	listNetworksWithSelfLink := func(req *computepb.ListNetworksRequest) ([]*computepb.Network, error) {
+		networks, err := buildValidGcpListNetworksPeering(req)
+		for i := range networks {
+			networks[i].SelfLink = utils.To(clusterVpcSelfLink)
+		}
+		return networks, err
+	}
+	subnetsApi := func() gcpiface.SubnetsApi {
+		return gcpiface.GetMockSubnetsClient(func(subnetClient *gcpiface.MockSubnetsClient) {
+			subnetClient.GetFn = func(req *computepb.GetSubnetworkRequest) (*computepb.Subnetwork, error) {
+				return buildValidSubnet(req.Subnetwork, gcpTestMasterSubnetCidr), nil
+			}
+			subnetClient.GetFnTwo = func(req *computepb.GetSubnetworkRequest) (*computepb.Subnetwork, error) {
+				return buildValidSubnet(req.Subnetwork, gcpTestWorkerSubnetCidr), nil
+			}
+		})
+	}
+	readStrategy := func(createStrategy string) func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+		return func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+			return &StrategyConfig{
+				CreateStrategy: json.RawMessage(createStrategy),
+			}, nil
+		}
+	}
+	writeStrategy := func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+		return nil
+	}
 	type fields struct {
-		Client client.Client
+		Client     client.Client
+		NetworkApi gcpiface.NetworksAPI
+		SubnetsApi gcpiface.SubnetsApi
+		AddressApi gcpiface.AddressAPI
+		ProjectID  string
 	}
 	type args struct {
 		ctx           context.Context
-		configManager ConfigManager
+		configManager *ConfigManagerMock
 		tier          string
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    *net.IPNet
-		wantErr bool
+		name               string
+		fields             fields
+		args               args
+		want               *net.IPNet
+		wantCreateStrategy string
+		wantErr            bool
 	}{
 		{
-			name: "empty config returns /22 cidr",
+			name: "empty config allocates and persists free /22 cidr",
 			fields: fields{
-				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				SubnetsApi: subnetsApi(),
+				AddressApi: gcpiface.GetMockAddressClient(nil),
+				ProjectID:  gcpTestProjectId,
 			},
 			args: args{
 				configManager: &ConfigManagerMock{
-					ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
-						return &StrategyConfig{
-							CreateStrategy: json.RawMessage(`{}`),
+					ReadStorageStrategyFunc: readStrategy(`{}`),
+					WriteCreateStrategyFunc: writeStrategy,
+				},
+			},
+			want:               buildIpRangeCidr("10.0.0.0/22"),
+			wantCreateStrategy: `{"CidrBlock":"10.0.0.0/22"}`,
+			wantErr:            false,
+		},
+		{
+			name: "allocated cidr skips address ranges and peering routes of the cluster vpc",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = listNetworksWithSelfLink
+					networksClient.ListPeeringRoutesFn = func(req *computepb.ListPeeringRoutesNetworksRequest) ([]*computepb.ExchangedPeeringRoute, error) {
+						return []*computepb.ExchangedPeeringRoute{
+							{DestRange: utils.To("10.1.0.0/16")},
 						}, nil
-					},
+					}
+				}),
+				SubnetsApi: subnetsApi(),
+				AddressApi: gcpiface.GetMockAddressClient(func(addressClient *gcpiface.MockAddressClient) {
+					addressClient.ListFn = func(req *computepb.ListGlobalAddressesRequest) ([]*computepb.Address, error) {
+						return []*computepb.Address{
+							{
+								Address:      utils.To("10.0.0.0"),
+								PrefixLength: utils.To(int32(20)),
+								Network:      utils.To(clusterVpcSelfLink),
+							},
+							{
+								Address:      utils.To("10.2.0.0"),
+								PrefixLength: utils.To(int32(20)),
+								Network:      utils.To("another-network"),
+							},
+						}, nil
+					}
+				}),
+				ProjectID: gcpTestProjectId,
+			},
+			args: args{
+				configManager: &ConfigManagerMock{
+					ReadStorageStrategyFunc: readStrategy(`{"PrefixLength": 20}`),
+					WriteCreateStrategyFunc: writeStrategy,
 				},
 			},
-			want: &net.IPNet{
-				Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
+			want:               buildIpRangeCidr("10.2.0.0/20"),
+			wantCreateStrategy: `{"CidrBlock":"10.2.0.0/20","PrefixLength":20}`,
+			wantErr:            false,
+		},
+		{
+			name: "existing ip address range cidr is persisted",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				AddressApi: gcpiface.GetMockAddressClient(func(addressClient *gcpiface.MockAddressClient) {
+					addressClient.GetFn = func(req *computepb.GetGlobalAddressRequest) (*computepb.Address, error) {
+						address := buildValidGcpAddressRange(gcpTestIpRangeName)
+						address.Address = utils.To("10.11.132.0")
+						address.PrefixLength = utils.To(int32(22))
+						return address, nil
+					}
+				}),
+				ProjectID: gcpTestProjectId,
 			},
-			wantErr: false,
+			args: args{
+				configManager: &ConfigManagerMock{
+					ReadStorageStrategyFunc: readStrategy(`{}`),
+					WriteCreateStrategyFunc: writeStrategy,
+				},
+			},
+			want:               buildIpRangeCidr(gcpTestValidCidr),
+			wantCreateStrategy: `{"CidrBlock":"10.11.132.0/22"}`,
+			wantErr:            false,
+		},
+		{
+			name: "error allocating cidr with prefix length higher than /22",
+			fields: fields{
+				Client:     moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				AddressApi: gcpiface.GetMockAddressClient(nil),
+				ProjectID:  gcpTestProjectId,
+			},
+			args: args{
+				configManager: &ConfigManagerMock{
+					ReadStorageStrategyFunc: readStrategy(`{"PrefixLength": 24}`),
+					WriteCreateStrategyFunc: writeStrategy,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "error persisting allocated cidr",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil), buildTestGcpNetworkConfig()),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				SubnetsApi: subnetsApi(),
+				AddressApi: gcpiface.GetMockAddressClient(nil),
+				ProjectID:  gcpTestProjectId,
+			},
+			args: args{
+				configManager: &ConfigManagerMock{
+					ReadStorageStrategyFunc: readStrategy(`{}`),
+					WriteCreateStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+						return fmt.Errorf("failed to update config map")
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
 		},
 		{
 			name: "error reading strategy config",
@@ -1433,11 +1629,7 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 			},
 			args: args{
 				configManager: &ConfigManagerMock{
-					ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
-						return &StrategyConfig{
-							CreateStrategy: json.RawMessage(`{"CidrBlock": "10.0.0.0/22"}`),
-						}, nil
-					},
+					ReadStorageStrategyFunc: readStrategy(`{"CidrBlock": "10.0.0.0/22"}`),
 				},
 			},
 			want:    buildIpRangeCidr("10.0.0.0/22"),
@@ -1450,11 +1642,7 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 			},
 			args: args{
 				configManager: &ConfigManagerMock{
-					ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
-						return &StrategyConfig{
-							CreateStrategy: json.RawMessage(`{"CidrBlock": "1000.0.0.0/22"}`),
-						}, nil
-					},
+					ReadStorageStrategyFunc: readStrategy(`{"CidrBlock": "1000.0.0.0/22"}`),
 				},
 			},
 			want:    nil,
@@ -1467,11 +1655,7 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 			},
 			args: args{
 				configManager: &ConfigManagerMock{
-					ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
-						return &StrategyConfig{
-							CreateStrategy: json.RawMessage(`{ invalid json }`),
-						}, nil
-					},
+					ReadStorageStrategyFunc: readStrategy(`{ invalid json }`),
 				},
 			},
 			want:    nil,
@@ -1481,8 +1665,12 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			n := &NetworkProvider{
-				Logger: logrus.NewEntry(logrus.StandardLogger()),
-				Client: tt.fields.Client,
+				Logger:     logrus.NewEntry(logrus.StandardLogger()),
+				Client:     tt.fields.Client,
+				NetworkApi: tt.fields.NetworkApi,
+				SubnetApi:  tt.fields.SubnetsApi,
+				AddressApi: tt.fields.AddressApi,
+				ProjectID:  tt.fields.ProjectID,
 			}
 			got, err := n.ReconcileNetworkProviderConfig(tt.args.ctx, tt.args.configManager, tt.args.tier)
 			if (err != nil) != tt.wantErr {
@@ -1490,7 +1678,17 @@ func TestNetworkProvider_ReconcileNetworkProviderConfig(t *testing.T) {
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("ReconcileNetworkProviderConfig() got = %s/%s, want %s/%s", got.IP.String(), got.Mask.String(), tt.want.IP.String(), tt.want.Mask.String())
+				t.Errorf("ReconcileNetworkProviderConfig() got = %s, want %s", got, tt.want)
+			}
+			writes := tt.args.configManager.WriteCreateStrategyCalls()
+			if tt.wantCreateStrategy == "" {
+				if len(writes) != 0 && !tt.wantErr {
+					t.Errorf("ReconcileNetworkProviderConfig() unexpected create strategy write %s", writes[0].CreateStrategy)
+				}
+				return
+			}
+			if len(writes) != 1 || string(writes[0].CreateStrategy) != tt.wantCreateStrategy {
+				t.Errorf("ReconcileNetworkProviderConfig() create strategy writes = %v, want %s", writes, tt.wantCreateStrategy)
 			}
 		})
 	}
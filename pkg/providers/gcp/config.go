@@ -13,6 +13,8 @@ import (
 
 	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -21,6 +23,8 @@ const (
 	defaultReconcileTime       = time.Second * 30
 	DefaultFinalizer           = "cloud-resources-operator.integreatly.org/finalizers"
 	defaultGcpIdentifierLength = 40
+	// cloudPlatformScope is the oauth scope of the tokens of an impersonated service account
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
 )
 
 // DefaultConfigMapNamespace is the default namespace that Configmaps will be created in
@@ -31,11 +35,21 @@ type StrategyConfig struct {
 	ProjectID      string          `json:"projectID"`
 	CreateStrategy json.RawMessage `json:"createStrategy"`
 	DeleteStrategy json.RawMessage `json:"deleteStrategy"`
+	// ImpersonateServiceAccount is the email of a service account the provider credentials impersonate to create the
+	// resources of the strategy, allowing them to be created in a project other than the cluster project
+	ImpersonateServiceAccount string `json:"impersonateServiceAccount,omitempty"`
+}
+
+// IsCrossProject returns true when the resources of the strategy are created with an impersonated service account, in a
+// project which may not be the cluster project
+func (s *StrategyConfig) IsCrossProject() bool {
+	return s != nil && s.ImpersonateServiceAccount != ""
 }
 
 //go:generate moq -out config_moq.go . ConfigManager
 type ConfigManager interface {
 	ReadStorageStrategy(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error)
+	WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error
 }
 
 type ConfigMapConfigManager struct {
@@ -70,6 +84,16 @@ func (cmm *ConfigMapConfigManager) ReadStorageStrategy(ctx context.Context, rt p
 	return stratCfg, nil
 }
 
+// WriteCreateStrategy persists the create strategy for the tier of a resource type, so a value chosen by the operator
+// is used on every reconcile
+func (cmm *ConfigMapConfigManager) WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+	name := types.NamespacedName{Name: cmm.configMapName, Namespace: cmm.configMapNamespace}
+	if err := resources.UpdateTierStrategy(ctx, cmm.client, name, BuildDefaultConfigMap(name.Name, name.Namespace), string(rt), tier, "createStrategy", createStrategy); err != nil {
+		return errorUtil.Wrapf(err, "failed to write create strategy for resource type %s", string(rt))
+	}
+	return nil
+}
+
 func (cmm *ConfigMapConfigManager) getTierStrategyForProvider(ctx context.Context, rt string, tier string) (*StrategyConfig, error) {
 	cm, err := resources.GetConfigMapOrDefault(ctx, cmm.client, types.NamespacedName{Name: cmm.configMapName, Namespace: cmm.configMapNamespace}, BuildDefaultConfigMap(cmm.configMapName, cmm.configMapNamespace))
 	if err != nil {
@@ -165,4 +189,30 @@ func getDefaultProject(ctx context.Context, c client.Client) (string, error) {
 	return defaultProject, nil
 }
 
+// CreateClientOptionFromStrategy returns the client option the gcp clients of the resources of a strategy are created
+// with, the provider credentials impersonate the service account of the strategy when one is set
+func CreateClientOptionFromStrategy(ctx context.Context, creds *Credentials, strategy *StrategyConfig) (option.ClientOption, error) {
+	credentialsOption := option.WithCredentialsJSON(creds.ServiceAccountJson)
+	if !strategy.IsCrossProject() {
+		return credentialsOption, nil
+	}
+	tokenSource, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: strategy.ImpersonateServiceAccount,
+		Scopes:          []string{cloudPlatformScope},
+	}, credentialsOption)
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to impersonate service account %s", strategy.ImpersonateServiceAccount)
+	}
+	return option.WithTokenSource(tokenSource), nil
+}
+
+// GetNetworkProjectFromStrategy returns the project of the cluster network the resources of a strategy are connected to,
+// it is the cluster project when the strategy impersonates a service account of another project
+func GetNetworkProjectFromStrategy(ctx context.Context, c client.Client, strategy *StrategyConfig) (string, error) {
+	if !strategy.IsCrossProject() {
+		return strategy.ProjectID, nil
+	}
+	return getDefaultProject(ctx, c)
+}
+
 var _ ConfigManager = (*ConfigMapConfigManager)(nil)
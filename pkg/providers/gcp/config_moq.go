@@ -5,6 +5,7 @@ package gcp
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"sync"
 )
@@ -22,6 +23,9 @@ var _ ConfigManager = &ConfigManagerMock{}
 //			ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
 //				panic("mock out the ReadStorageStrategy method")
 //			},
+//			WriteCreateStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+//				panic("mock out the WriteCreateStrategy method")
+//			},
 //		}
 //
 //		// use mockedConfigManager in code that requires ConfigManager
@@ -32,6 +36,9 @@ type ConfigManagerMock struct {
 	// ReadStorageStrategyFunc mocks the ReadStorageStrategy method.
 	ReadStorageStrategyFunc func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error)
 
+	// WriteCreateStrategyFunc mocks the WriteCreateStrategy method.
+	WriteCreateStrategyFunc func(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ReadStorageStrategy holds details about calls to the ReadStorageStrategy method.
@@ -43,8 +50,20 @@ type ConfigManagerMock struct {
 			// Tier is the tier argument value.
 			Tier string
 		}
+		// WriteCreateStrategy holds details about calls to the WriteCreateStrategy method.
+		WriteCreateStrategy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rt is the rt argument value.
+			Rt providers.ResourceType
+			// Tier is the tier argument value.
+			Tier string
+			// CreateStrategy is the createStrategy argument value.
+			CreateStrategy json.RawMessage
+		}
 	}
 	lockReadStorageStrategy sync.RWMutex
+	lockWriteCreateStrategy sync.RWMutex
 }
 
 // ReadStorageStrategy calls ReadStorageStrategyFunc.
@@ -86,3 +105,47 @@ func (mock *ConfigManagerMock) ReadStorageStrategyCalls() []struct {
 	mock.lockReadStorageStrategy.RUnlock()
 	return calls
 }
+
+// WriteCreateStrategy calls WriteCreateStrategyFunc.
+func (mock *ConfigManagerMock) WriteCreateStrategy(ctx context.Context, rt providers.ResourceType, tier string, createStrategy json.RawMessage) error {
+	if mock.WriteCreateStrategyFunc == nil {
+		panic("ConfigManagerMock.WriteCreateStrategyFunc: method is nil but ConfigManager.WriteCreateStrategy was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		Rt             providers.ResourceType
+		Tier           string
+		CreateStrategy json.RawMessage
+	}{
+		Ctx:            ctx,
+		Rt:             rt,
+		Tier:           tier,
+		CreateStrategy: createStrategy,
+	}
+	mock.lockWriteCreateStrategy.Lock()
+	mock.calls.WriteCreateStrategy = append(mock.calls.WriteCreateStrategy, callInfo)
+	mock.lockWriteCreateStrategy.Unlock()
+	return mock.WriteCreateStrategyFunc(ctx, rt, tier, createStrategy)
+}
+
+// WriteCreateStrategyCalls gets all the calls that were made to WriteCreateStrategy.
+// Check the length with:
+//
+//	len(mockedConfigManager.WriteCreateStrategyCalls())
+func (mock *ConfigManagerMock) WriteCreateStrategyCalls() []struct {
+	Ctx            context.Context
+	Rt             providers.ResourceType
+	Tier           string
+	CreateStrategy json.RawMessage
+} {
+	var calls []struct {
+		Ctx            context.Context
+		Rt             providers.ResourceType
+		Tier           string
+		CreateStrategy json.RawMessage
+	}
+	mock.lockWriteCreateStrategy.RLock()
+	calls = mock.calls.WriteCreateStrategy
+	mock.lockWriteCreateStrategy.RUnlock()
+	return calls
+}
@@ -271,6 +271,49 @@ func TestGetProjectFromStrategyOrDefault(t *testing.T) {
 	}
 }
 
+func TestGetNetworkProjectFromStrategy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := cloudcredentialv1.Install(scheme)
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	_ = configv1.Install(scheme)
+	tests := []struct {
+		name     string
+		strategy *StrategyConfig
+		want     string
+	}{
+		{
+			name: "successfully retrieve project from strategy",
+			strategy: &StrategyConfig{
+				ProjectID: "projectID-strategy",
+			},
+			want: "projectID-strategy",
+		},
+		{
+			name: "successfully retrieve cluster project when impersonating a service account",
+			strategy: &StrategyConfig{
+				ProjectID:                 "projectID-strategy",
+				ImpersonateServiceAccount: "cro@projectID-strategy.iam.gserviceaccount.com",
+			},
+			want: gcpTestProjectId,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil))
+			got, err := GetNetworkProjectFromStrategy(context.TODO(), c, tt.strategy)
+			if err != nil {
+				t.Errorf("GetNetworkProjectFromStrategy() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetNetworkProjectFromStrategy() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_getDefaultRegion(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -3,8 +3,10 @@ package gcp
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
 	errorUtil "github.com/pkg/errors"
 	v12 "k8s.io/api/core/v1"
@@ -22,13 +24,22 @@ const (
 )
 
 var (
-	operatorRoles = []string{
+	// networkRoles reserve the ip address ranges, connections, endpoints and firewall rules postgres and redis instances
+	// are reached through in the cluster vpc
+	networkRoles = []string{
 		"roles/compute.networkAdmin",
-		"roles/storage.admin",
-		"roles/redis.admin",
-		"roles/cloudsql.admin",
+		"roles/compute.securityAdmin",
 		"roles/servicenetworking.networksAdmin",
-		"roles/monitoring.viewer",
+	}
+	// resourceRoles the predefined roles of each resource type, the cloud credential operator can not add conditions to
+	// the roles of a service account so access is restricted to the services of a resource type only. postgres
+	// snapshots, including final snapshots, are exported to a bucket the postgres service account creates and grants
+	// the cloud sql instance access to, so it needs storage access too
+	resourceRoles = map[providers.ResourceType][]string{
+		providers.BlobStorageResourceType: {"roles/storage.admin", "roles/monitoring.viewer"},
+		providers.PostgresResourceType:    append([]string{"roles/cloudsql.admin", "roles/storage.admin", "roles/monitoring.viewer"}, networkRoles...),
+		providers.RedisResourceType:       append([]string{"roles/redis.admin", "roles/monitoring.viewer"}, networkRoles...),
+		providers.NetworkResourceType:     networkRoles,
 	}
 	timeOut = time.Minute * 5
 )
@@ -40,10 +51,27 @@ type Credentials struct {
 
 //go:generate moq -out credentials_moq.go . CredentialManager
 type CredentialManager interface {
-	ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error)
+	ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error)
 	ReconcileCredentials(ctx context.Context, name string, ns string, roles []string) (*v1.CredentialsRequest, *Credentials, error)
 }
 
+// NewCredentialManager returns the workload identity federation credential manager when the cluster uses short-lived
+// credentials, otherwise service account keys are minted by the cloud credential operator
+func NewCredentialManager(client client.Client) (CredentialManager, error) {
+	shortLived, err := isShortLivedCredentialsMode(context.TODO(), client)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to check cluster credentials mode")
+	}
+	if !shortLived {
+		return NewCredentialMinterCredentialManager(client), nil
+	}
+	cm, err := NewWorkloadIdentityCredentialManager()
+	if err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
 type CredentialMinterCredentialManager struct {
 	ProviderCredentialName string
 	Client                 client.Client
@@ -56,14 +84,34 @@ func NewCredentialMinterCredentialManager(client client.Client) *CredentialMinte
 	}
 }
 
-func (m *CredentialMinterCredentialManager) ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error) {
-	_, creds, err := m.ReconcileCredentials(ctx, m.ProviderCredentialName, ns, operatorRoles)
+// ReconcileProviderCredentials ensures the service account of a resource type is available, the credential request
+// granting every resource type the same roles is removed
+func (m *CredentialMinterCredentialManager) ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
+	roles, ok := resourceRoles[rt]
+	if !ok {
+		return nil, errorUtil.New(fmt.Sprintf("no gcp roles defined for resource type %s", rt))
+	}
+	_, creds, err := m.ReconcileCredentials(ctx, buildResourceCredentialName(m.ProviderCredentialName, rt), ns, roles)
 	if err != nil {
 		return nil, err
 	}
+	legacy := &v1.CredentialsRequest{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      m.ProviderCredentialName,
+			Namespace: ns,
+		},
+	}
+	if err := m.Client.Delete(ctx, legacy); err != nil && !errors.IsNotFound(err) {
+		return nil, errorUtil.Wrapf(err, "failed to delete credential request %s in namespace %s", legacy.Name, ns)
+	}
 	return creds, nil
 }
 
+// buildResourceCredentialName returns the name of the credential request and secret of a resource type
+func buildResourceCredentialName(prefix string, rt providers.ResourceType) string {
+	return fmt.Sprintf("%s-%s", prefix, strings.TrimPrefix(string(rt), "_"))
+}
+
 func (m *CredentialMinterCredentialManager) ReconcileCredentials(ctx context.Context, name string, ns string, roles []string) (*v1.CredentialsRequest, *Credentials, error) {
 	cr, err := m.reconcileCredentialRequest(ctx, name, ns, roles)
 	if err != nil {
@@ -5,6 +5,7 @@ package gcp
 
 import (
 	"context"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
 	"sync"
 )
@@ -22,7 +23,7 @@ var _ CredentialManager = &CredentialManagerMock{}
 //			ReconcileCredentialsFunc: func(ctx context.Context, name string, ns string, roles []string) (*v1.CredentialsRequest, *Credentials, error) {
 //				panic("mock out the ReconcileCredentials method")
 //			},
-//			ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+//			ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 //				panic("mock out the ReconcileProviderCredentials method")
 //			},
 //		}
@@ -36,7 +37,7 @@ type CredentialManagerMock struct {
 	ReconcileCredentialsFunc func(ctx context.Context, name string, ns string, roles []string) (*v1.CredentialsRequest, *Credentials, error)
 
 	// ReconcileProviderCredentialsFunc mocks the ReconcileProviderCredentials method.
-	ReconcileProviderCredentialsFunc func(ctx context.Context, ns string) (*Credentials, error)
+	ReconcileProviderCredentialsFunc func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -57,6 +58,8 @@ type CredentialManagerMock struct {
 			Ctx context.Context
 			// Ns is the ns argument value.
 			Ns string
+			// Rt is the rt argument value.
+			Rt providers.ResourceType
 		}
 	}
 	lockReconcileCredentials         sync.RWMutex
@@ -108,21 +111,23 @@ func (mock *CredentialManagerMock) ReconcileCredentialsCalls() []struct {
 }
 
 // ReconcileProviderCredentials calls ReconcileProviderCredentialsFunc.
-func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Context, ns string) (*Credentials, error) {
+func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 	if mock.ReconcileProviderCredentialsFunc == nil {
 		panic("CredentialManagerMock.ReconcileProviderCredentialsFunc: method is nil but CredentialManager.ReconcileProviderCredentials was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
 		Ns  string
+		Rt  providers.ResourceType
 	}{
 		Ctx: ctx,
 		Ns:  ns,
+		Rt:  rt,
 	}
 	mock.lockReconcileProviderCredentials.Lock()
 	mock.calls.ReconcileProviderCredentials = append(mock.calls.ReconcileProviderCredentials, callInfo)
 	mock.lockReconcileProviderCredentials.Unlock()
-	return mock.ReconcileProviderCredentialsFunc(ctx, ns)
+	return mock.ReconcileProviderCredentialsFunc(ctx, ns, rt)
 }
 
 // ReconcileProviderCredentialsCalls gets all the calls that were made to ReconcileProviderCredentials.
@@ -132,10 +137,12 @@ func (mock *CredentialManagerMock) ReconcileProviderCredentials(ctx context.Cont
 func (mock *CredentialManagerMock) ReconcileProviderCredentialsCalls() []struct {
 	Ctx context.Context
 	Ns  string
+	Rt  providers.ResourceType
 } {
 	var calls []struct {
 		Ctx context.Context
 		Ns  string
+		Rt  providers.ResourceType
 	}
 	mock.lockReconcileProviderCredentials.RLock()
 	calls = mock.calls.ReconcileProviderCredentials
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	cloudcredentialv1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
@@ -58,6 +59,7 @@ func TestCredentialMinterCredentialManager_ReconcileProviderCredentials(t *testi
 	type args struct {
 		ctx context.Context
 		ns  string
+		rt  providers.ResourceType
 	}
 	scheme := runtime.NewScheme()
 	err := cloudcredentialv1.Install(scheme)
@@ -99,6 +101,7 @@ func TestCredentialMinterCredentialManager_ReconcileProviderCredentials(t *testi
 			args: args{
 				ctx: context.TODO(),
 				ns:  testNs,
+				rt:  providers.PostgresResourceType,
 			},
 			want: &Credentials{
 				ServiceAccountID:   "serviceAccountID",
@@ -115,6 +118,21 @@ func TestCredentialMinterCredentialManager_ReconcileProviderCredentials(t *testi
 			args: args{
 				ctx: context.TODO(),
 				ns:  testNs,
+				rt:  providers.PostgresResourceType,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "failure reconciling provider credentials of unsupported resource type",
+			fields: fields{
+				ProviderCredentialName: defaultProviderCredentialName,
+				Client:                 moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
+			args: args{
+				ctx: context.TODO(),
+				ns:  testNs,
+				rt:  providers.ResourceType("unsupported"),
 			},
 			want:    nil,
 			wantErr: true,
@@ -126,7 +144,7 @@ func TestCredentialMinterCredentialManager_ReconcileProviderCredentials(t *testi
 				ProviderCredentialName: tt.fields.ProviderCredentialName,
 				Client:                 tt.fields.Client,
 			}
-			got, err := m.ReconcileProviderCredentials(tt.args.ctx, tt.args.ns)
+			got, err := m.ReconcileProviderCredentials(tt.args.ctx, tt.args.ns, tt.args.rt)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ReconcileProviderCredentials() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -192,7 +210,7 @@ func TestCredentialMinterCredentialManager_ReconcileCredentials(t *testing.T) {
 				ctx:   context.TODO(),
 				name:  defaultProviderCredentialName,
 				ns:    testNs,
-				roles: operatorRoles,
+				roles: resourceRoles[providers.PostgresResourceType],
 			},
 			credentialsRequest: &cloudcredentialv1.CredentialsRequest{
 				ObjectMeta: metav1.ObjectMeta{
@@ -399,3 +417,42 @@ func TestCredentialMinterCredentialManager_ReconcileCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceRoles(t *testing.T) {
+	tests := []struct {
+		name      string
+		rt        providers.ResourceType
+		wantRoles []string
+	}{
+		{
+			name:      "test postgres roles allow snapshots to be exported to a bucket",
+			rt:        providers.PostgresResourceType,
+			wantRoles: []string{"roles/cloudsql.admin", "roles/storage.admin"},
+		},
+		{
+			name:      "test blob storage roles allow buckets to be managed",
+			rt:        providers.BlobStorageResourceType,
+			wantRoles: []string{"roles/storage.admin"},
+		},
+		{
+			name:      "test redis roles allow memorystore instances to be managed",
+			rt:        providers.RedisResourceType,
+			wantRoles: []string{"roles/redis.admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, role := range tt.wantRoles {
+				found := false
+				for _, r := range resourceRoles[tt.rt] {
+					if r == role {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("resourceRoles[%s] = %v, want %s", tt.rt, resourceRoles[tt.rt], role)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	v1 "github.com/openshift/cloud-credential-operator/pkg/apis/cloudcredential/v1"
+	errorUtil "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// environment variables set on the operator by olm when the cluster uses gcp workload identity federation
+	envProjectNumber       = "PROJECT_NUMBER"
+	envPoolID              = "POOL_ID"
+	envProviderID          = "PROVIDER_ID"
+	envServiceAccountEmail = "SERVICE_ACCOUNT_EMAIL"
+
+	defaultTokenPath              = "/var/run/secrets/openshift/serviceaccount/token" // #nosec G101 -- false positive (ref: https://securego.io/docs/rules/g101.html)
+	defaultClusterResourceName    = "cluster"
+	externalAccountSubjectToken   = "urn:ietf:params:oauth:token-type:jwt"
+	externalAccountTokenURL       = "https://sts.googleapis.com/v1/token"
+	externalAccountImpersonateURL = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+)
+
+var _ CredentialManager = (*WorkloadIdentityCredentialManager)(nil)
+
+// WorkloadIdentityCredentialManager Implementation of CredentialManager for OpenShift Clusters that use GCP Workload
+// Identity Federation, the projected service account token of the operator is exchanged for short-lived credentials of
+// a gcp service account so no service account keys are stored
+type WorkloadIdentityCredentialManager struct {
+	ProjectNumber       string
+	PoolID              string
+	ProviderID          string
+	ServiceAccountEmail string
+	TokenFilePath       string
+}
+
+// NewWorkloadIdentityCredentialManager returns a credential manager configured from the environment of the operator
+func NewWorkloadIdentityCredentialManager() (*WorkloadIdentityCredentialManager, error) {
+	m := &WorkloadIdentityCredentialManager{
+		ProjectNumber:       os.Getenv(envProjectNumber),
+		PoolID:              os.Getenv(envPoolID),
+		ProviderID:          os.Getenv(envProviderID),
+		ServiceAccountEmail: os.Getenv(envServiceAccountEmail),
+		TokenFilePath:       defaultTokenPath,
+	}
+	for _, env := range []string{envProjectNumber, envPoolID, envProviderID, envServiceAccountEmail} {
+		if os.Getenv(env) == "" {
+			return nil, errorUtil.New(fmt.Sprintf("%s is undefined, it is required for gcp workload identity federation", env))
+		}
+	}
+	return m, nil
+}
+
+// ReconcileProviderCredentials returns the external account credential config of the service account of a resource
+// type, e.g. from `SERVICE_ACCOUNT_EMAIL_POSTGRES`, when it is defined, otherwise of the service account shared by all
+// resource types
+func (m *WorkloadIdentityCredentialManager) ReconcileProviderCredentials(_ context.Context, _ string, rt providers.ResourceType) (*Credentials, error) {
+	serviceAccountEmail := os.Getenv(buildResourceServiceAccountEnvName(rt))
+	if serviceAccountEmail == "" {
+		serviceAccountEmail = m.ServiceAccountEmail
+	}
+	return m.buildCredentials(serviceAccountEmail)
+}
+
+// ReconcileCredentials returns the external account credential config of the shared service account, roles can not be
+// granted without the cloud credential operator and must be granted to the service account in advance
+func (m *WorkloadIdentityCredentialManager) ReconcileCredentials(_ context.Context, _ string, _ string, _ []string) (*v1.CredentialsRequest, *Credentials, error) {
+	creds, err := m.buildCredentials(m.ServiceAccountEmail)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, creds, nil
+}
+
+func (m *WorkloadIdentityCredentialManager) buildCredentials(serviceAccountEmail string) (*Credentials, error) {
+	config, err := json.Marshal(map[string]interface{}{
+		"type":                              "external_account",
+		"audience":                          fmt.Sprintf("//iam.googleapis.com/projects/%s/locations/global/workloadIdentityPools/%s/providers/%s", m.ProjectNumber, m.PoolID, m.ProviderID),
+		"subject_token_type":                externalAccountSubjectToken,
+		"token_url":                         externalAccountTokenURL,
+		"service_account_impersonation_url": fmt.Sprintf(externalAccountImpersonateURL, serviceAccountEmail),
+		"credential_source": map[string]interface{}{
+			"file": m.TokenFilePath,
+			"format": map[string]string{
+				"type": "text",
+			},
+		},
+	})
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to marshal gcp external account credential config")
+	}
+	return &Credentials{
+		ServiceAccountID:   serviceAccountEmail,
+		ServiceAccountJson: config,
+	}, nil
+}
+
+func buildResourceServiceAccountEnvName(rt providers.ResourceType) string {
+	return fmt.Sprintf("%s_%s", envServiceAccountEmail, strings.ToUpper(strings.TrimPrefix(string(rt), "_")))
+}
+
+// isShortLivedCredentialsMode returns true when a gcp cluster runs the cloud credential operator in manual mode and
+// issues service account tokens that an external oidc provider trusts
+func isShortLivedCredentialsMode(ctx context.Context, c client.Client) (bool, error) {
+	infra, err := resources.GetClusterInfrastructure(ctx, c)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.Type != configv1.GCPPlatformType {
+		return false, nil
+	}
+	cloudCredential := &operatorv1.CloudCredential{}
+	if err := c.Get(ctx, types.NamespacedName{Name: defaultClusterResourceName}, cloudCredential); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errorUtil.Wrap(err, "failed to get cloud credential config")
+	}
+	if cloudCredential.Spec.CredentialsMode != operatorv1.CloudCredentialsModeManual {
+		return false, nil
+	}
+	authentication := &configv1.Authentication{}
+	if err := c.Get(ctx, types.NamespacedName{Name: defaultClusterResourceName}, authentication); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errorUtil.Wrap(err, "failed to get cluster authentication")
+	}
+	return authentication.Spec.ServiceAccountIssuer != "", nil
+}
@@ -0,0 +1,215 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testProjectNumber       = "123456789"
+	testPoolID              = "test-pool"
+	testProviderID          = "test-provider"
+	testServiceAccountEmail = "cro@test-project.iam.gserviceaccount.com"
+)
+
+func setWorkloadIdentityTestEnv(t *testing.T) {
+	t.Setenv(envProjectNumber, testProjectNumber)
+	t.Setenv(envPoolID, testPoolID)
+	t.Setenv(envProviderID, testProviderID)
+	t.Setenv(envServiceAccountEmail, testServiceAccountEmail)
+}
+
+func buildTestGCPInfrastructure() *configv1.Infrastructure {
+	return &configv1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultClusterResourceName,
+		},
+		Status: configv1.InfrastructureStatus{
+			PlatformStatus: &configv1.PlatformStatus{
+				Type: configv1.GCPPlatformType,
+			},
+		},
+	}
+}
+
+func buildTestCloudCredential(mode operatorv1.CloudCredentialsMode) *operatorv1.CloudCredential {
+	return &operatorv1.CloudCredential{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultClusterResourceName,
+		},
+		Spec: operatorv1.CloudCredentialSpec{
+			CredentialsMode: mode,
+		},
+	}
+}
+
+func buildTestAuthentication(issuer string) *configv1.Authentication {
+	return &configv1.Authentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultClusterResourceName,
+		},
+		Spec: configv1.AuthenticationSpec{
+			ServiceAccountIssuer: issuer,
+		},
+	}
+}
+
+func TestNewWorkloadIdentityCredentialManager(t *testing.T) {
+	tests := []struct {
+		name    string
+		unset   string
+		want    *WorkloadIdentityCredentialManager
+		wantErr bool
+	}{
+		{
+			name: "success creating credential manager from environment",
+			want: &WorkloadIdentityCredentialManager{
+				ProjectNumber:       testProjectNumber,
+				PoolID:              testPoolID,
+				ProviderID:          testProviderID,
+				ServiceAccountEmail: testServiceAccountEmail,
+				TokenFilePath:       defaultTokenPath,
+			},
+		},
+		{
+			name:    "failure when project number is undefined",
+			unset:   envProjectNumber,
+			wantErr: true,
+		},
+		{
+			name:    "failure when service account email is undefined",
+			unset:   envServiceAccountEmail,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setWorkloadIdentityTestEnv(t)
+			if tt.unset != "" {
+				t.Setenv(tt.unset, "")
+			}
+			got, err := NewWorkloadIdentityCredentialManager()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewWorkloadIdentityCredentialManager() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewWorkloadIdentityCredentialManager() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityCredentialManager_ReconcileProviderCredentials(t *testing.T) {
+	tests := []struct {
+		name                    string
+		env                     map[string]string
+		rt                      providers.ResourceType
+		wantServiceAccountEmail string
+	}{
+		{
+			name:                    "success using the shared service account",
+			rt:                      providers.PostgresResourceType,
+			wantServiceAccountEmail: testServiceAccountEmail,
+		},
+		{
+			name: "success using the service account of the resource type",
+			env: map[string]string{
+				"SERVICE_ACCOUNT_EMAIL_REDIS": "redis@test-project.iam.gserviceaccount.com",
+			},
+			rt:                      providers.RedisResourceType,
+			wantServiceAccountEmail: "redis@test-project.iam.gserviceaccount.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setWorkloadIdentityTestEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			m, err := NewWorkloadIdentityCredentialManager()
+			if err != nil {
+				t.Fatalf("NewWorkloadIdentityCredentialManager() error = %v", err)
+			}
+			got, err := m.ReconcileProviderCredentials(context.TODO(), testNs, tt.rt)
+			if err != nil {
+				t.Fatalf("ReconcileProviderCredentials() error = %v", err)
+			}
+			if got.ServiceAccountID != tt.wantServiceAccountEmail {
+				t.Errorf("ReconcileProviderCredentials() service account = %v, want %v", got.ServiceAccountID, tt.wantServiceAccountEmail)
+			}
+			config := map[string]interface{}{}
+			if err := json.Unmarshal(got.ServiceAccountJson, &config); err != nil {
+				t.Fatalf("failed to unmarshal credential config: %v", err)
+			}
+			wantAudience := "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/test-pool/providers/test-provider"
+			if config["type"] != "external_account" || config["audience"] != wantAudience {
+				t.Errorf("ReconcileProviderCredentials() config = %v, want external account with audience %s", config, wantAudience)
+			}
+			wantImpersonateURL := "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/" + tt.wantServiceAccountEmail + ":generateAccessToken"
+			if config["service_account_impersonation_url"] != wantImpersonateURL {
+				t.Errorf("ReconcileProviderCredentials() impersonation url = %v, want %v", config["service_account_impersonation_url"], wantImpersonateURL)
+			}
+		})
+	}
+}
+
+func TestIsShortLivedCredentialsMode(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	awsInfrastructure := buildTestGCPInfrastructure()
+	awsInfrastructure.Status.PlatformStatus.Type = configv1.AWSPlatformType
+	tests := []struct {
+		name    string
+		client  client.Client
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "true on gcp clusters with manual credentials mode and a service account issuer",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGCPInfrastructure(), buildTestCloudCredential(operatorv1.CloudCredentialsModeManual), buildTestAuthentication("https://test-issuer")),
+			want:   true,
+		},
+		{
+			name:   "false on gcp clusters with mint credentials mode",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGCPInfrastructure(), buildTestCloudCredential(operatorv1.CloudCredentialsModeMint), buildTestAuthentication("https://test-issuer")),
+			want:   false,
+		},
+		{
+			name:   "false on gcp clusters without a service account issuer",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGCPInfrastructure(), buildTestCloudCredential(operatorv1.CloudCredentialsModeManual), buildTestAuthentication("")),
+			want:   false,
+		},
+		{
+			name:   "false on aws clusters",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, awsInfrastructure, buildTestCloudCredential(operatorv1.CloudCredentialsModeManual), buildTestAuthentication("https://test-issuer")),
+			want:   false,
+		},
+		{
+			name:   "false when cluster infrastructure is not found",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isShortLivedCredentialsMode(context.TODO(), tt.client)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("isShortLivedCredentialsMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isShortLivedCredentialsMode() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
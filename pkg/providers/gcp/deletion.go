@@ -0,0 +1,78 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/storage"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const finalSnapshotSuffix = "-final"
+
+// buildFinalSnapshotURI returns the location of the final snapshot of an instance, it is exported to the bucket named
+// after the instance which also holds the snapshots taken by the snapshot providers
+func buildFinalSnapshotURI(instanceName string) string {
+	return fmt.Sprintf("gs://%s/%s%s", instanceName, instanceName, finalSnapshotSuffix)
+}
+
+// exportFinalSnapshot exports an instance to its bucket before it is deleted, the identity is granted access to the
+// bucket so the instance can write to it. it returns true once the final snapshot exists in the bucket
+func exportFinalSnapshot(ctx context.Context, storageClient gcpiface.StorageAPI, recorder record.EventRecorder, obj runtime.Object, strategyConfig *StrategyConfig, instanceName, identity string, export func() error) (bool, error) {
+	objectMeta, err := storageClient.GetObjectMetadata(ctx, instanceName, instanceName+finalSnapshotSuffix)
+	if err != nil && err != storage.ErrObjectNotExist && err != storage.ErrBucketNotExist {
+		return false, errorUtil.Wrapf(err, "failed to retrieve final snapshot metadata from bucket %s", instanceName)
+	}
+	if objectMeta != nil {
+		return true, nil
+	}
+	bucketAttrs, err := storageClient.GetBucket(ctx, instanceName)
+	if err != nil && err != storage.ErrBucketNotExist {
+		return false, errorUtil.Wrapf(err, "failed to retrieve bucket metadata for bucket %s", instanceName)
+	}
+	if bucketAttrs == nil {
+		err = storageClient.CreateBucket(ctx, instanceName, strategyConfig.ProjectID, &storage.BucketAttrs{
+			Location: strategyConfig.Region,
+		})
+		if err != nil && !resources.IsConflictError(err) {
+			return false, errorUtil.Wrapf(err, "failed to create bucket with name %s", instanceName)
+		}
+	}
+	hasPolicy, err := storageClient.HasBucketPolicy(ctx, instanceName, identity, bucketPolicy)
+	if err != nil {
+		return false, errorUtil.Wrapf(err, "failed to check bucket policy for %s", instanceName)
+	}
+	if !hasPolicy {
+		if err = storageClient.SetBucketPolicy(ctx, instanceName, identity, bucketPolicy); err != nil {
+			return false, errorUtil.Wrapf(err, "failed to set policy on bucket %s", instanceName)
+		}
+	}
+	// a conflict is returned while the export is still running
+	err = export()
+	if err != nil && !resources.IsConflictError(err) {
+		return false, errorUtil.Wrapf(err, "failed to export final snapshot of instance %s", instanceName)
+	}
+	if err == nil {
+		resources.RecordEvent(recorder, obj, resources.EventReasonFinalSnapshot, "started export of final snapshot of instance %s to %s", instanceName, buildFinalSnapshotURI(instanceName))
+	}
+	return false, nil
+}
+
+// buildOrphanedLabels returns the labels of an instance kept by the retain deletion policy, the existing labels are
+// kept as updating the labels of an instance replaces all of them
+func buildOrphanedLabels(labels map[string]string) (map[string]string, bool) {
+	key, value := buildGcpLabel(resources.BuildOrphanedTag())
+	if labels[key] == value {
+		return labels, false
+	}
+	orphanedLabels := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		orphanedLabels[k] = v
+	}
+	orphanedLabels[key] = value
+	return orphanedLabels, true
+}
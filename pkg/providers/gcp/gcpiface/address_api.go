@@ -2,11 +2,13 @@ package gcpiface
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	compute "cloud.google.com/go/compute/apiv1"
 	"cloud.google.com/go/compute/apiv1/computepb"
 	"github.com/googleapis/gax-go/v2"
+	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 )
@@ -15,6 +17,7 @@ type AddressAPI interface {
 	Get(context.Context, *computepb.GetGlobalAddressRequest, ...gax.CallOption) (*computepb.Address, error)
 	Insert(context.Context, *computepb.InsertGlobalAddressRequest, ...gax.CallOption) error
 	Delete(context.Context, *computepb.DeleteGlobalAddressRequest, ...gax.CallOption) error
+	List(context.Context, *computepb.ListGlobalAddressesRequest, ...gax.CallOption) ([]*computepb.Address, error)
 }
 
 // GCP Client code below
@@ -24,7 +27,11 @@ type addressClient struct {
 }
 
 func NewAddressAPI(ctx context.Context, opt option.ClientOption) (AddressAPI, error) {
-	globalAddressesRestClient, err := compute.NewGlobalAddressesRESTClient(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	globalAddressesRestClient, err := compute.NewGlobalAddressesRESTClient(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -33,11 +40,15 @@ func NewAddressAPI(ctx context.Context, opt option.ClientOption) (AddressAPI, er
 	}, nil
 }
 
-func (c *addressClient) Get(ctx context.Context, req *computepb.GetGlobalAddressRequest, opts ...gax.CallOption) (*computepb.Address, error) {
+func (c *addressClient) Get(ctx context.Context, req *computepb.GetGlobalAddressRequest, opts ...gax.CallOption) (_ *computepb.Address, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "GlobalAddresses.Get", gcpRegionGlobal)
+	defer call.observe(&err)
 	return c.addressService.Get(ctx, req, opts...)
 }
 
-func (c *addressClient) Insert(ctx context.Context, req *computepb.InsertGlobalAddressRequest, opts ...gax.CallOption) error {
+func (c *addressClient) Insert(ctx context.Context, req *computepb.InsertGlobalAddressRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "GlobalAddresses.Insert", gcpRegionGlobal)
+	defer call.observe(&err)
 	op, err := c.addressService.Insert(ctx, req, opts...)
 	if err != nil {
 		return err
@@ -45,7 +56,9 @@ func (c *addressClient) Insert(ctx context.Context, req *computepb.InsertGlobalA
 	return op.Wait(ctx)
 }
 
-func (c *addressClient) Delete(ctx context.Context, req *computepb.DeleteGlobalAddressRequest, opts ...gax.CallOption) error {
+func (c *addressClient) Delete(ctx context.Context, req *computepb.DeleteGlobalAddressRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "GlobalAddresses.Delete", gcpRegionGlobal)
+	defer call.observe(&err)
 	op, err := c.addressService.Delete(ctx, req, opts...)
 	if err != nil {
 		return err
@@ -53,12 +66,32 @@ func (c *addressClient) Delete(ctx context.Context, req *computepb.DeleteGlobalA
 	return op.Wait(ctx)
 }
 
+func (c *addressClient) List(ctx context.Context, req *computepb.ListGlobalAddressesRequest, opts ...gax.CallOption) (_ []*computepb.Address, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "GlobalAddresses.List", gcpRegionGlobal)
+	defer call.observe(&err)
+	addressIterator := c.addressService.List(ctx, req, opts...)
+	var addresses []*computepb.Address
+	for {
+		a, err := addressIterator.Next()
+		if err != nil {
+			var ae *apierror.APIError
+			if errors.As(err, &ae) {
+				return nil, err
+			}
+			break
+		}
+		addresses = append(addresses, a)
+	}
+	return addresses, nil
+}
+
 type MockAddressClient struct {
 	AddressAPI
 	GetFn    func(*computepb.GetGlobalAddressRequest) (*computepb.Address, error)
 	GetFnTwo func(*computepb.GetGlobalAddressRequest) (*computepb.Address, error)
 	InsertFn func(*computepb.InsertGlobalAddressRequest) error
 	DeleteFn func(*computepb.DeleteGlobalAddressRequest) error
+	ListFn   func(*computepb.ListGlobalAddressesRequest) ([]*computepb.Address, error)
 	call     int
 }
 
@@ -70,6 +103,9 @@ func GetMockAddressClient(modifyFn func(addressClient *MockAddressClient)) *Mock
 		DeleteFn: func(req *computepb.DeleteGlobalAddressRequest) error {
 			return nil
 		},
+		ListFn: func(req *computepb.ListGlobalAddressesRequest) ([]*computepb.Address, error) {
+			return []*computepb.Address{}, nil
+		},
 	}
 	if modifyFn != nil {
 		modifyFn(mock)
@@ -97,3 +133,7 @@ func (m *MockAddressClient) Insert(ctx context.Context, req *computepb.InsertGlo
 func (m *MockAddressClient) Delete(ctx context.Context, req *computepb.DeleteGlobalAddressRequest, opts ...gax.CallOption) error {
 	return m.DeleteFn(req)
 }
+
+func (m *MockAddressClient) List(ctx context.Context, req *computepb.ListGlobalAddressesRequest, opts ...gax.CallOption) ([]*computepb.Address, error) {
+	return m.ListFn(req)
+}
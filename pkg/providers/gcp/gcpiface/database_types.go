@@ -399,6 +399,9 @@ type IpConfiguration struct {
 	// updated, but it cannot be removed after it is set.
 	PrivateNetwork string `json:"privateNetwork,omitempty"`
 
+	// PscConfig: PSC settings for this instance.
+	PscConfig *sqladmin.PscConfig `json:"pscConfig,omitempty"`
+
 	// RequireSsl: Whether SSL connections over IP are enforced or not.
 	RequireSsl *bool `json:"requireSsl,omitempty"`
 }
@@ -599,6 +602,9 @@ func (dbi *DatabaseInstance) MapToGcpDatabaseInstance() *sqladmin.DatabaseInstan
 			if dbi.Settings.IpConfiguration.PrivateNetwork != "" {
 				gcpInstanceConfig.Settings.IpConfiguration.PrivateNetwork = dbi.Settings.IpConfiguration.PrivateNetwork
 			}
+			if dbi.Settings.IpConfiguration.PscConfig != nil {
+				gcpInstanceConfig.Settings.IpConfiguration.PscConfig = dbi.Settings.IpConfiguration.PscConfig
+			}
 			if dbi.Settings.IpConfiguration.RequireSsl != nil {
 				gcpInstanceConfig.Settings.IpConfiguration.RequireSsl = *dbi.Settings.IpConfiguration.RequireSsl
 				if !*dbi.Settings.IpConfiguration.RequireSsl {
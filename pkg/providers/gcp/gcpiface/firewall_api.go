@@ -0,0 +1,123 @@
+package gcpiface
+
+import (
+	"context"
+	"net/http"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+type FirewallsAPI interface {
+	Get(context.Context, *computepb.GetFirewallRequest, ...gax.CallOption) (*computepb.Firewall, error)
+	Insert(context.Context, *computepb.InsertFirewallRequest, ...gax.CallOption) error
+	Patch(context.Context, *computepb.PatchFirewallRequest, ...gax.CallOption) error
+	Delete(context.Context, *computepb.DeleteFirewallRequest, ...gax.CallOption) error
+}
+
+// GCP Client code below
+type firewallsClient struct {
+	FirewallsAPI
+	firewallsService *compute.FirewallsClient
+}
+
+func NewFirewallsAPI(ctx context.Context, opt option.ClientOption) (FirewallsAPI, error) {
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	firewallsRestClient, err := compute.NewFirewallsRESTClient(ctx, httpOpt)
+	if err != nil {
+		return nil, err
+	}
+	return &firewallsClient{
+		firewallsService: firewallsRestClient,
+	}, nil
+}
+
+func (c *firewallsClient) Get(ctx context.Context, req *computepb.GetFirewallRequest, opts ...gax.CallOption) (_ *computepb.Firewall, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Firewalls.Get", gcpRegionGlobal)
+	defer call.observe(&err)
+	return c.firewallsService.Get(ctx, req, opts...)
+}
+
+func (c *firewallsClient) Insert(ctx context.Context, req *computepb.InsertFirewallRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Firewalls.Insert", gcpRegionGlobal)
+	defer call.observe(&err)
+	op, err := c.firewallsService.Insert(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func (c *firewallsClient) Patch(ctx context.Context, req *computepb.PatchFirewallRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Firewalls.Patch", gcpRegionGlobal)
+	defer call.observe(&err)
+	op, err := c.firewallsService.Patch(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func (c *firewallsClient) Delete(ctx context.Context, req *computepb.DeleteFirewallRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Firewalls.Delete", gcpRegionGlobal)
+	defer call.observe(&err)
+	op, err := c.firewallsService.Delete(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+// Mock Client code below
+type MockFirewallsClient struct {
+	FirewallsAPI
+	GetFn    func(*computepb.GetFirewallRequest) (*computepb.Firewall, error)
+	InsertFn func(*computepb.InsertFirewallRequest) error
+	PatchFn  func(*computepb.PatchFirewallRequest) error
+	DeleteFn func(*computepb.DeleteFirewallRequest) error
+}
+
+func GetMockFirewallsClient(modifyFn func(firewallsClient *MockFirewallsClient)) *MockFirewallsClient {
+	mock := &MockFirewallsClient{
+		GetFn: func(req *computepb.GetFirewallRequest) (*computepb.Firewall, error) {
+			return nil, &googleapi.Error{
+				Code: http.StatusNotFound,
+			}
+		},
+		InsertFn: func(req *computepb.InsertFirewallRequest) error {
+			return nil
+		},
+		PatchFn: func(req *computepb.PatchFirewallRequest) error {
+			return nil
+		},
+		DeleteFn: func(req *computepb.DeleteFirewallRequest) error {
+			return nil
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(mock)
+	}
+	return mock
+}
+
+func (m *MockFirewallsClient) Get(ctx context.Context, req *computepb.GetFirewallRequest, opts ...gax.CallOption) (*computepb.Firewall, error) {
+	return m.GetFn(req)
+}
+
+func (m *MockFirewallsClient) Insert(ctx context.Context, req *computepb.InsertFirewallRequest, opts ...gax.CallOption) error {
+	return m.InsertFn(req)
+}
+
+func (m *MockFirewallsClient) Patch(ctx context.Context, req *computepb.PatchFirewallRequest, opts ...gax.CallOption) error {
+	return m.PatchFn(req)
+}
+
+func (m *MockFirewallsClient) Delete(ctx context.Context, req *computepb.DeleteFirewallRequest, opts ...gax.CallOption) error {
+	return m.DeleteFn(req)
+}
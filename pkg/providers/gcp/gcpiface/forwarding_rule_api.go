@@ -0,0 +1,167 @@
+package gcpiface
+
+import (
+	"context"
+	"net/http"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// ForwardingRulesAPI manages the regional forwarding rules and the regional addresses they use, such as the private
+// service connect endpoints of cloud resources
+type ForwardingRulesAPI interface {
+	GetAddress(context.Context, *computepb.GetAddressRequest, ...gax.CallOption) (*computepb.Address, error)
+	InsertAddress(context.Context, *computepb.InsertAddressRequest, ...gax.CallOption) error
+	DeleteAddress(context.Context, *computepb.DeleteAddressRequest, ...gax.CallOption) error
+	Get(context.Context, *computepb.GetForwardingRuleRequest, ...gax.CallOption) (*computepb.ForwardingRule, error)
+	Insert(context.Context, *computepb.InsertForwardingRuleRequest, ...gax.CallOption) error
+	Delete(context.Context, *computepb.DeleteForwardingRuleRequest, ...gax.CallOption) error
+}
+
+// GCP Client code below
+type forwardingRulesClient struct {
+	ForwardingRulesAPI
+	addressesService       *compute.AddressesClient
+	forwardingRulesService *compute.ForwardingRulesClient
+}
+
+func NewForwardingRulesAPI(ctx context.Context, opt option.ClientOption) (ForwardingRulesAPI, error) {
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	addressesRestClient, err := compute.NewAddressesRESTClient(ctx, httpOpt)
+	if err != nil {
+		return nil, err
+	}
+	forwardingRulesRestClient, err := compute.NewForwardingRulesRESTClient(ctx, httpOpt)
+	if err != nil {
+		return nil, err
+	}
+	return &forwardingRulesClient{
+		addressesService:       addressesRestClient,
+		forwardingRulesService: forwardingRulesRestClient,
+	}, nil
+}
+
+func (c *forwardingRulesClient) GetAddress(ctx context.Context, req *computepb.GetAddressRequest, opts ...gax.CallOption) (_ *computepb.Address, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Addresses.Get", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	return c.addressesService.Get(ctx, req, opts...)
+}
+
+func (c *forwardingRulesClient) InsertAddress(ctx context.Context, req *computepb.InsertAddressRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Addresses.Insert", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	op, err := c.addressesService.Insert(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func (c *forwardingRulesClient) DeleteAddress(ctx context.Context, req *computepb.DeleteAddressRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Addresses.Delete", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	op, err := c.addressesService.Delete(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func (c *forwardingRulesClient) Get(ctx context.Context, req *computepb.GetForwardingRuleRequest, opts ...gax.CallOption) (_ *computepb.ForwardingRule, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "ForwardingRules.Get", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	return c.forwardingRulesService.Get(ctx, req, opts...)
+}
+
+func (c *forwardingRulesClient) Insert(ctx context.Context, req *computepb.InsertForwardingRuleRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "ForwardingRules.Insert", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	op, err := c.forwardingRulesService.Insert(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+func (c *forwardingRulesClient) Delete(ctx context.Context, req *computepb.DeleteForwardingRuleRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "ForwardingRules.Delete", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	op, err := c.forwardingRulesService.Delete(ctx, req, opts...)
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
+
+// Mock Client code below
+type MockForwardingRulesClient struct {
+	ForwardingRulesAPI
+	GetAddressFn    func(*computepb.GetAddressRequest) (*computepb.Address, error)
+	InsertAddressFn func(*computepb.InsertAddressRequest) error
+	DeleteAddressFn func(*computepb.DeleteAddressRequest) error
+	GetFn           func(*computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error)
+	InsertFn        func(*computepb.InsertForwardingRuleRequest) error
+	DeleteFn        func(*computepb.DeleteForwardingRuleRequest) error
+}
+
+func GetMockForwardingRulesClient(modifyFn func(forwardingRulesClient *MockForwardingRulesClient)) *MockForwardingRulesClient {
+	mock := &MockForwardingRulesClient{
+		GetAddressFn: func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+			return nil, &googleapi.Error{
+				Code: http.StatusNotFound,
+			}
+		},
+		InsertAddressFn: func(req *computepb.InsertAddressRequest) error {
+			return nil
+		},
+		DeleteAddressFn: func(req *computepb.DeleteAddressRequest) error {
+			return nil
+		},
+		GetFn: func(req *computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error) {
+			return nil, &googleapi.Error{
+				Code: http.StatusNotFound,
+			}
+		},
+		InsertFn: func(req *computepb.InsertForwardingRuleRequest) error {
+			return nil
+		},
+		DeleteFn: func(req *computepb.DeleteForwardingRuleRequest) error {
+			return nil
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(mock)
+	}
+	return mock
+}
+
+func (m *MockForwardingRulesClient) GetAddress(ctx context.Context, req *computepb.GetAddressRequest, opts ...gax.CallOption) (*computepb.Address, error) {
+	return m.GetAddressFn(req)
+}
+
+func (m *MockForwardingRulesClient) InsertAddress(ctx context.Context, req *computepb.InsertAddressRequest, opts ...gax.CallOption) error {
+	return m.InsertAddressFn(req)
+}
+
+func (m *MockForwardingRulesClient) DeleteAddress(ctx context.Context, req *computepb.DeleteAddressRequest, opts ...gax.CallOption) error {
+	return m.DeleteAddressFn(req)
+}
+
+func (m *MockForwardingRulesClient) Get(ctx context.Context, req *computepb.GetForwardingRuleRequest, opts ...gax.CallOption) (*computepb.ForwardingRule, error) {
+	return m.GetFn(req)
+}
+
+func (m *MockForwardingRulesClient) Insert(ctx context.Context, req *computepb.InsertForwardingRuleRequest, opts ...gax.CallOption) error {
+	return m.InsertFn(req)
+}
+
+func (m *MockForwardingRulesClient) Delete(ctx context.Context, req *computepb.DeleteForwardingRuleRequest, opts ...gax.CallOption) error {
+	return m.DeleteFn(req)
+}
@@ -0,0 +1,192 @@
+package gcpiface
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	gcpProviderName = "gcp"
+	// gcpRegionGlobal is the region of calls to global resources, or to resources whose region is not in the request
+	gcpRegionGlobal = "global"
+
+	cloudresourcemanagerServiceName = "cloudresourcemanager"
+	computeServiceName              = "compute"
+	monitoringServiceName           = "monitoring"
+	redisServiceName                = "redis"
+	servicenetworkingServiceName    = "servicenetworking"
+	sqladminServiceName             = "sqladmin"
+	storageServiceName              = "storage"
+
+	// errorCodeUnknown is used as the error code of failed calls which did not return a gcp api error
+	errorCodeUnknown = "Unknown"
+
+	// cloudPlatformScope is requested by the instrumented http clients, it covers every gcp api used by the operator
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// apiCall is a call made to a gcp api, the attempts made for it by the gcp client libraries are counted through the
+// context returned by startCall
+type apiCall struct {
+	service   string
+	operation string
+	region    string
+	start     time.Time
+
+	mu         sync.Mutex
+	attempts   int
+	retries    int
+	throttles  int
+	lastFailed bool
+}
+
+type apiCallKey struct{}
+
+// startCall starts observing a call made to a gcp api, the returned context must be passed to the client library so
+// retries and throttled attempts are counted
+func startCall(ctx context.Context, service, operation, region string) (context.Context, *apiCall) {
+	call := &apiCall{
+		service:   service,
+		operation: operation,
+		region:    region,
+		start:     time.Now(),
+	}
+	return context.WithValue(ctx, apiCallKey{}, call), call
+}
+
+// countAttempt records an attempt made by a gcp client library for the call in the context, an attempt following a
+// failed one is a retry. list calls make an attempt per page, so a page following a successful attempt is not a retry
+func countAttempt(ctx context.Context, failed, throttled bool) {
+	call, ok := ctx.Value(apiCallKey{}).(*apiCall)
+	if !ok {
+		return
+	}
+	call.mu.Lock()
+	defer call.mu.Unlock()
+	call.attempts++
+	if call.lastFailed {
+		call.retries++
+	}
+	if throttled {
+		call.throttles++
+	}
+	call.lastFailed = failed
+}
+
+// observe exposes metrics for the call, it is deferred at the start of each client method, err points to the error
+// result of the method
+func (c *apiCall) observe(err *error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	call := &resources.CloudAPICall{
+		Provider:  gcpProviderName,
+		Service:   c.service,
+		Operation: c.operation,
+		Region:    c.region,
+		Duration:  time.Since(c.start),
+		Retries:   c.retries,
+		Throttles: c.throttles,
+	}
+	if err != nil && *err != nil {
+		var throttled bool
+		call.ErrorCode, throttled = classifyError(*err)
+		// attempts are only counted by the instrumented clients, the result is the single attempt seen otherwise
+		if throttled && c.attempts == 0 {
+			call.Throttles = 1
+		}
+	}
+	resources.ObserveCloudAPICall(call)
+}
+
+// regionFromName returns the region of a gcp resource name, such as projects/<project>/locations/<region>/instances/<id>,
+// or global if the name does not contain one
+func regionFromName(name string) string {
+	parts := strings.Split(name, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "locations" || parts[i] == "regions" {
+			return regionOrGlobal(parts[i+1])
+		}
+	}
+	return gcpRegionGlobal
+}
+
+// regionOrGlobal returns the region set in a request, or global if it is not set
+func regionOrGlobal(region string) string {
+	if region == "" {
+		return gcpRegionGlobal
+	}
+	return strings.ToLower(region)
+}
+
+// newHTTPClientOption returns the client option of a gcp api called over http, every request sent by the client
+// library is counted as an attempt of the call in its context
+func newHTTPClientOption(ctx context.Context, opt option.ClientOption) (option.ClientOption, error) {
+	trans, err := htransport.NewTransport(ctx, &attemptTransport{base: http.DefaultTransport}, opt, option.WithScopes(cloudPlatformScope))
+	if err != nil {
+		return nil, err
+	}
+	return option.WithHTTPClient(&http.Client{Transport: trans}), nil
+}
+
+// newGRPCClientOption returns the client option of a gcp api called over grpc, every rpc sent by the client library is
+// counted as an attempt of the call in its context
+func newGRPCClientOption() option.ClientOption {
+	return option.WithGRPCDialOption(grpc.WithChainUnaryInterceptor(countGRPCAttempt))
+}
+
+// attemptTransport counts the requests sent by a gcp client library
+type attemptTransport struct {
+	base http.RoundTripper
+}
+
+func (t *attemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	failed := err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	countAttempt(req.Context(), failed, err == nil && resp.StatusCode == http.StatusTooManyRequests)
+	return resp, err
+}
+
+func countGRPCAttempt(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	countAttempt(ctx, err != nil, status.Code(err) == codes.ResourceExhausted)
+	return err
+}
+
+// classifyError returns the grpc or http status code of a gcp api error, and whether the call was rate limited
+func classifyError(err error) (string, bool) {
+	var apiErr *apierror.APIError
+	if errors.As(err, &apiErr) {
+		if grpcStatus := apiErr.GRPCStatus(); grpcStatus != nil {
+			return grpcStatus.Code().String(), grpcStatus.Code() == codes.ResourceExhausted
+		}
+		if httpCode := apiErr.HTTPCode(); httpCode > 0 {
+			return strconv.Itoa(httpCode), httpCode == http.StatusTooManyRequests
+		}
+	}
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		return strconv.Itoa(googleErr.Code), googleErr.Code == http.StatusTooManyRequests
+	}
+	if grpcStatus, ok := status.FromError(err); ok {
+		return grpcStatus.Code().String(), grpcStatus.Code() == codes.ResourceExhausted
+	}
+	if errors.Is(err, storage.ErrBucketNotExist) || errors.Is(err, storage.ErrObjectNotExist) {
+		return strconv.Itoa(http.StatusNotFound), false
+	}
+	return errorCodeUnknown, false
+}
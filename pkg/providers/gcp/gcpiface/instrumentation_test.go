@@ -0,0 +1,144 @@
+package gcpiface
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantCode      string
+		wantThrottled bool
+	}{
+		{
+			name:          "test grpc resource exhausted error is throttled",
+			err:           status.Error(codes.ResourceExhausted, "quota exceeded"),
+			wantCode:      "ResourceExhausted",
+			wantThrottled: true,
+		},
+		{
+			name:     "test grpc error code is returned",
+			err:      status.Error(codes.NotFound, "instance not found"),
+			wantCode: "NotFound",
+		},
+		{
+			name:          "test http too many requests error is throttled",
+			err:           fmt.Errorf("failed to get instance: %w", &googleapi.Error{Code: http.StatusTooManyRequests}),
+			wantCode:      "429",
+			wantThrottled: true,
+		},
+		{
+			name:     "test http error code is returned",
+			err:      &googleapi.Error{Code: http.StatusForbidden},
+			wantCode: "403",
+		},
+		{
+			name:     "test storage not found error is returned as http not found",
+			err:      storage.ErrBucketNotExist,
+			wantCode: "404",
+		},
+		{
+			name:     "test unknown error",
+			err:      errors.New("generic error"),
+			wantCode: errorCodeUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, throttled := classifyError(tt.err)
+			if code != tt.wantCode || throttled != tt.wantThrottled {
+				t.Errorf("classifyError() = %s, %v, want %s, %v", code, throttled, tt.wantCode, tt.wantThrottled)
+			}
+		})
+	}
+}
+
+func TestRegionFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "projects/test/locations/europe-west1/instances/test",
+			want: "europe-west1",
+		},
+		{
+			name: "projects/test/regions/us-east1/subnetworks/test",
+			want: "us-east1",
+		},
+		{
+			name: "projects/test/global/networks/test",
+			want: gcpRegionGlobal,
+		},
+		{
+			name: "",
+			want: gcpRegionGlobal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := regionFromName(tt.name); got != tt.want {
+				t.Errorf("regionFromName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttemptTransport(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &attemptTransport{base: http.DefaultTransport}}
+	ctx, call := startCall(context.TODO(), sqladminServiceName, "Instances.List", gcpRegionGlobal)
+	// a throttled attempt followed by its retry and the request of a second page
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = resp.Body.Close()
+	}
+	if call.attempts != 3 || call.retries != 1 || call.throttles != 1 {
+		t.Errorf("unexpected attempts %d, retries %d, throttles %d, want 3, 1, 1", call.attempts, call.retries, call.throttles)
+	}
+}
+
+func TestCountGRPCAttempt(t *testing.T) {
+	results := []error{status.Error(codes.ResourceExhausted, "quota exceeded"), status.Error(codes.Unavailable, "unavailable"), nil}
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		err := results[0]
+		results = results[1:]
+		return err
+	}
+	ctx, call := startCall(context.TODO(), redisServiceName, "Instances.Get", "europe-west1")
+	for len(results) > 0 {
+		_ = countGRPCAttempt(ctx, "GetInstance", nil, nil, nil, invoker)
+	}
+	if call.attempts != 3 || call.retries != 2 || call.throttles != 1 {
+		t.Errorf("unexpected attempts %d, retries %d, throttles %d, want 3, 2, 1", call.attempts, call.retries, call.throttles)
+	}
+}
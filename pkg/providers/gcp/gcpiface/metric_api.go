@@ -8,7 +8,6 @@ import (
 	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
 	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
 	"github.com/googleapis/gax-go/v2"
-	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
@@ -25,7 +24,7 @@ type metricClient struct {
 }
 
 func NewMetricAPI(ctx context.Context, opt option.ClientOption, logger *logrus.Entry) (MetricApi, error) {
-	cloudMetricClient, err := monitoring.NewMetricClient(ctx, opt)
+	cloudMetricClient, err := monitoring.NewMetricClient(ctx, opt, newGRPCClientOption())
 	if err != nil {
 		return nil, err
 	}
@@ -36,23 +35,28 @@ func NewMetricAPI(ctx context.Context, opt option.ClientOption, logger *logrus.E
 }
 
 func (c *metricClient) ListTimeSeries(ctx context.Context, req *monitoringpb.ListTimeSeriesRequest, opts ...gax.CallOption) ([]*monitoringpb.TimeSeries, error) {
+	ctx, call := startCall(ctx, monitoringServiceName, "TimeSeries.List", gcpRegionGlobal)
 	c.logger.Infof("listing time series with filter '%s'", req.Filter)
 	timeSeriesIterator := c.metricService.ListTimeSeries(ctx, req, opts...)
 	var timeSeries []*monitoringpb.TimeSeries
+	var err error
 	for {
-		ts, err := timeSeriesIterator.Next()
+		var ts *monitoringpb.TimeSeries
+		ts, err = timeSeriesIterator.Next()
 		if errors.Is(err, iterator.Done) {
+			err = nil
 			break
 		}
 		if err != nil {
-			var ae *apierror.APIError
-			if errors.As(err, &ae) {
-				return nil, err
-			}
-			return nil, err
+			break
 		}
 		timeSeries = append(timeSeries, ts)
 	}
+	// an empty result is not an api error, so the call is observed before it is checked
+	call.observe(&err)
+	if err != nil {
+		return nil, err
+	}
 	if len(timeSeries) == 0 {
 		return nil, fmt.Errorf("could not find any time series")
 	}
@@ -14,6 +14,7 @@ import (
 type NetworksAPI interface {
 	List(context.Context, *computepb.ListNetworksRequest, ...gax.CallOption) ([]*computepb.Network, error)
 	RemovePeering(context.Context, *computepb.RemovePeeringNetworkRequest, ...gax.CallOption) error
+	ListPeeringRoutes(context.Context, *computepb.ListPeeringRoutesNetworksRequest, ...gax.CallOption) ([]*computepb.ExchangedPeeringRoute, error)
 }
 
 // GCP Client code below
@@ -23,7 +24,11 @@ type networksClient struct {
 }
 
 func NewNetworksAPI(ctx context.Context, opt option.ClientOption) (NetworksAPI, error) {
-	networksRestClient, err := compute.NewNetworksRESTClient(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	networksRestClient, err := compute.NewNetworksRESTClient(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +37,9 @@ func NewNetworksAPI(ctx context.Context, opt option.ClientOption) (NetworksAPI,
 	}, nil
 }
 
-func (c *networksClient) List(ctx context.Context, req *computepb.ListNetworksRequest, opts ...gax.CallOption) ([]*computepb.Network, error) {
+func (c *networksClient) List(ctx context.Context, req *computepb.ListNetworksRequest, opts ...gax.CallOption) (_ []*computepb.Network, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Networks.List", gcpRegionGlobal)
+	defer call.observe(&err)
 	netIterator := c.networksService.List(ctx, req, opts...)
 	var networks []*computepb.Network
 	for {
@@ -49,7 +56,9 @@ func (c *networksClient) List(ctx context.Context, req *computepb.ListNetworksRe
 	return networks, nil
 }
 
-func (c *networksClient) RemovePeering(ctx context.Context, req *computepb.RemovePeeringNetworkRequest, opts ...gax.CallOption) error {
+func (c *networksClient) RemovePeering(ctx context.Context, req *computepb.RemovePeeringNetworkRequest, opts ...gax.CallOption) (err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Networks.RemovePeering", gcpRegionGlobal)
+	defer call.observe(&err)
 	op, err := c.networksService.RemovePeering(ctx, req, opts...)
 	if err != nil {
 		return err
@@ -57,10 +66,30 @@ func (c *networksClient) RemovePeering(ctx context.Context, req *computepb.Remov
 	return op.Wait(ctx)
 }
 
+func (c *networksClient) ListPeeringRoutes(ctx context.Context, req *computepb.ListPeeringRoutesNetworksRequest, opts ...gax.CallOption) (_ []*computepb.ExchangedPeeringRoute, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Networks.ListPeeringRoutes", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
+	routeIterator := c.networksService.ListPeeringRoutes(ctx, req, opts...)
+	var routes []*computepb.ExchangedPeeringRoute
+	for {
+		r, err := routeIterator.Next()
+		if err != nil {
+			var ae *apierror.APIError
+			if errors.As(err, &ae) {
+				return nil, err
+			}
+			break
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
+
 type MockNetworksClient struct {
 	NetworksAPI
-	ListFn          func(*computepb.ListNetworksRequest) ([]*computepb.Network, error)
-	RemovePeeringFn func(*computepb.RemovePeeringNetworkRequest) error
+	ListFn              func(*computepb.ListNetworksRequest) ([]*computepb.Network, error)
+	RemovePeeringFn     func(*computepb.RemovePeeringNetworkRequest) error
+	ListPeeringRoutesFn func(*computepb.ListPeeringRoutesNetworksRequest) ([]*computepb.ExchangedPeeringRoute, error)
 }
 
 func GetMockNetworksClient(modifyFn func(networksClient *MockNetworksClient)) *MockNetworksClient {
@@ -71,6 +100,9 @@ func GetMockNetworksClient(modifyFn func(networksClient *MockNetworksClient)) *M
 		RemovePeeringFn: func(req *computepb.RemovePeeringNetworkRequest) error {
 			return nil
 		},
+		ListPeeringRoutesFn: func(req *computepb.ListPeeringRoutesNetworksRequest) ([]*computepb.ExchangedPeeringRoute, error) {
+			return []*computepb.ExchangedPeeringRoute{}, nil
+		},
 	}
 	if modifyFn != nil {
 		modifyFn(mock)
@@ -85,3 +117,7 @@ func (m *MockNetworksClient) List(ctx context.Context, req *computepb.ListNetwor
 func (m *MockNetworksClient) RemovePeering(ctx context.Context, req *computepb.RemovePeeringNetworkRequest, opts ...gax.CallOption) error {
 	return m.RemovePeeringFn(req)
 }
+
+func (m *MockNetworksClient) ListPeeringRoutes(ctx context.Context, req *computepb.ListPeeringRoutesNetworksRequest, opts ...gax.CallOption) ([]*computepb.ExchangedPeeringRoute, error) {
+	return m.ListPeeringRoutesFn(req)
+}
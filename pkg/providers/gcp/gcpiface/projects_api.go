@@ -0,0 +1,66 @@
+package gcpiface
+
+import (
+	"context"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/option"
+)
+
+type ProjectsAPI interface {
+	TestIamPermissions(ctx context.Context, projectID string, permissions []string) ([]string, error)
+}
+
+// GCP Client code below
+type projectsClient struct {
+	ProjectsAPI
+	cloudresourcemanagerService *cloudresourcemanager.Service
+}
+
+func NewProjectsAPI(ctx context.Context, opt option.ClientOption) (ProjectsAPI, error) {
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	cloudresourcemanagerService, err := cloudresourcemanager.NewService(ctx, httpOpt)
+	if err != nil {
+		return nil, err
+	}
+	return &projectsClient{
+		cloudresourcemanagerService: cloudresourcemanagerService,
+	}, nil
+}
+
+// TestIamPermissions returns the subset of the permissions the caller is granted on the project
+func (c *projectsClient) TestIamPermissions(ctx context.Context, projectID string, permissions []string) (_ []string, err error) {
+	ctx, call := startCall(ctx, cloudresourcemanagerServiceName, "Projects.TestIamPermissions", gcpRegionGlobal)
+	defer call.observe(&err)
+	resp, err := c.cloudresourcemanagerService.Projects.TestIamPermissions(projectID, &cloudresourcemanager.TestIamPermissionsRequest{
+		Permissions: permissions,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Permissions, nil
+}
+
+type MockProjectsClient struct {
+	ProjectsAPI
+	TestIamPermissionsFn func(context.Context, string, []string) ([]string, error)
+}
+
+func GetMockProjectsClient(modifyFn func(projectsClient *MockProjectsClient)) *MockProjectsClient {
+	mock := &MockProjectsClient{
+		TestIamPermissionsFn: func(ctx context.Context, projectID string, permissions []string) ([]string, error) {
+			return permissions, nil
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(mock)
+	}
+	return mock
+}
+
+func (m *MockProjectsClient) TestIamPermissions(ctx context.Context, projectID string, permissions []string) ([]string, error) {
+	return m.TestIamPermissionsFn(ctx, projectID, permissions)
+}
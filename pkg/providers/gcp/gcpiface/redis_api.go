@@ -16,6 +16,7 @@ type RedisAPI interface {
 	GetInstance(context.Context, *redispb.GetInstanceRequest, ...gax.CallOption) (*redispb.Instance, error)
 	UpdateInstance(context.Context, *redispb.UpdateInstanceRequest, ...gax.CallOption) (*redis.UpdateInstanceOperation, error)
 	UpgradeInstance(context.Context, *redispb.UpgradeInstanceRequest, ...gax.CallOption) (*redis.UpgradeInstanceOperation, error)
+	ExportInstance(context.Context, *redispb.ExportInstanceRequest, ...gax.CallOption) (*redis.ExportInstanceOperation, error)
 }
 
 type redisClient struct {
@@ -25,7 +26,7 @@ type redisClient struct {
 }
 
 func NewRedisAPI(ctx context.Context, opt option.ClientOption, logger *logrus.Entry) (RedisAPI, error) {
-	cloudRedisClient, err := redis.NewCloudRedisClient(ctx, opt)
+	cloudRedisClient, err := redis.NewCloudRedisClient(ctx, opt, newGRPCClientOption())
 	if err != nil {
 		return nil, err
 	}
@@ -35,17 +36,23 @@ func NewRedisAPI(ctx context.Context, opt option.ClientOption, logger *logrus.En
 	}, nil
 }
 
-func (c *redisClient) DeleteInstance(ctx context.Context, req *redispb.DeleteInstanceRequest, opts ...gax.CallOption) (*redis.DeleteInstanceOperation, error) {
+func (c *redisClient) DeleteInstance(ctx context.Context, req *redispb.DeleteInstanceRequest, opts ...gax.CallOption) (_ *redis.DeleteInstanceOperation, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Delete", regionFromName(req.GetName()))
+	defer call.observe(&err)
 	c.logger.Infof("deleting gcp redis instance %s", req.Name)
 	return c.redisService.DeleteInstance(ctx, req, opts...)
 }
 
-func (c *redisClient) CreateInstance(ctx context.Context, req *redispb.CreateInstanceRequest, opts ...gax.CallOption) (*redis.CreateInstanceOperation, error) {
+func (c *redisClient) CreateInstance(ctx context.Context, req *redispb.CreateInstanceRequest, opts ...gax.CallOption) (_ *redis.CreateInstanceOperation, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Create", regionFromName(req.GetParent()))
+	defer call.observe(&err)
 	c.logger.Infof("creating gcp redis instance %s", req.Instance.Name)
 	return c.redisService.CreateInstance(ctx, req, opts...)
 }
 
-func (c *redisClient) GetInstance(ctx context.Context, req *redispb.GetInstanceRequest, opts ...gax.CallOption) (*redispb.Instance, error) {
+func (c *redisClient) GetInstance(ctx context.Context, req *redispb.GetInstanceRequest, opts ...gax.CallOption) (_ *redispb.Instance, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Get", regionFromName(req.GetName()))
+	defer call.observe(&err)
 	c.logger.Infof("fetching gcp redis instance %s", req.Name)
 	instance, err := c.redisService.GetInstance(ctx, req, opts...)
 	if instance != nil {
@@ -54,16 +61,27 @@ func (c *redisClient) GetInstance(ctx context.Context, req *redispb.GetInstanceR
 	return instance, err
 }
 
-func (c *redisClient) UpdateInstance(ctx context.Context, req *redispb.UpdateInstanceRequest, opts ...gax.CallOption) (*redis.UpdateInstanceOperation, error) {
+func (c *redisClient) UpdateInstance(ctx context.Context, req *redispb.UpdateInstanceRequest, opts ...gax.CallOption) (_ *redis.UpdateInstanceOperation, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Patch", regionFromName(req.GetInstance().GetName()))
+	defer call.observe(&err)
 	c.logger.Infof("updating gcp redis instance %s", req.Instance.Name)
 	return c.redisService.UpdateInstance(ctx, req, opts...)
 }
 
-func (c *redisClient) UpgradeInstance(ctx context.Context, req *redispb.UpgradeInstanceRequest, opts ...gax.CallOption) (*redis.UpgradeInstanceOperation, error) {
+func (c *redisClient) UpgradeInstance(ctx context.Context, req *redispb.UpgradeInstanceRequest, opts ...gax.CallOption) (_ *redis.UpgradeInstanceOperation, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Upgrade", regionFromName(req.GetName()))
+	defer call.observe(&err)
 	c.logger.Infof("upgrading gcp redis instance %s", req.Name)
 	return c.redisService.UpgradeInstance(ctx, req, opts...)
 }
 
+func (c *redisClient) ExportInstance(ctx context.Context, req *redispb.ExportInstanceRequest, opts ...gax.CallOption) (_ *redis.ExportInstanceOperation, err error) {
+	ctx, call := startCall(ctx, redisServiceName, "Instances.Export", regionFromName(req.GetName()))
+	defer call.observe(&err)
+	c.logger.Infof("exporting gcp redis instance %s", req.Name)
+	return c.redisService.ExportInstance(ctx, req, opts...)
+}
+
 type MockRedisClient struct {
 	RedisAPI
 	DeleteInstanceFn  func(context.Context, *redispb.DeleteInstanceRequest, ...gax.CallOption) (*redis.DeleteInstanceOperation, error)
@@ -71,6 +89,7 @@ type MockRedisClient struct {
 	GetInstanceFn     func(context.Context, *redispb.GetInstanceRequest, ...gax.CallOption) (*redispb.Instance, error)
 	UpdateInstanceFn  func(context.Context, *redispb.UpdateInstanceRequest, ...gax.CallOption) (*redis.UpdateInstanceOperation, error)
 	UpgradeInstanceFn func(context.Context, *redispb.UpgradeInstanceRequest, ...gax.CallOption) (*redis.UpgradeInstanceOperation, error)
+	ExportInstanceFn  func(context.Context, *redispb.ExportInstanceRequest, ...gax.CallOption) (*redis.ExportInstanceOperation, error)
 }
 
 func GetMockRedisClient(modifyFn func(redisClient *MockRedisClient)) *MockRedisClient {
@@ -90,6 +109,9 @@ func GetMockRedisClient(modifyFn func(redisClient *MockRedisClient)) *MockRedisC
 		UpgradeInstanceFn: func(ctx context.Context, request *redispb.UpgradeInstanceRequest, opts ...gax.CallOption) (*redis.UpgradeInstanceOperation, error) {
 			return &redis.UpgradeInstanceOperation{}, nil
 		},
+		ExportInstanceFn: func(ctx context.Context, request *redispb.ExportInstanceRequest, opts ...gax.CallOption) (*redis.ExportInstanceOperation, error) {
+			return &redis.ExportInstanceOperation{}, nil
+		},
 	}
 	if modifyFn != nil {
 		modifyFn(mock)
@@ -116,3 +138,7 @@ func (m *MockRedisClient) UpdateInstance(ctx context.Context, req *redispb.Updat
 func (m *MockRedisClient) UpgradeInstance(ctx context.Context, req *redispb.UpgradeInstanceRequest, opts ...gax.CallOption) (*redis.UpgradeInstanceOperation, error) {
 	return m.UpgradeInstanceFn(ctx, req, opts...)
 }
+
+func (m *MockRedisClient) ExportInstance(ctx context.Context, req *redispb.ExportInstanceRequest, opts ...gax.CallOption) (*redis.ExportInstanceOperation, error) {
+	return m.ExportInstanceFn(ctx, req, opts...)
+}
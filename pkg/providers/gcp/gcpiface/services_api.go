@@ -22,7 +22,11 @@ type servicesClient struct {
 }
 
 func NewServicesAPI(ctx context.Context, opt option.ClientOption) (ServicesAPI, error) {
-	servicenetworkingService, err := servicenetworking.NewService(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	servicenetworkingService, err := servicenetworking.NewService(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -31,24 +35,30 @@ func NewServicesAPI(ctx context.Context, opt option.ClientOption) (ServicesAPI,
 	}, nil
 }
 
-func (c *servicesClient) ConnectionsList(clusterVpc *computepb.Network, projectID, parent string) (*servicenetworking.ListConnectionsResponse, error) {
-	call := c.servicenetworkingService.Services.Connections.List(parent)
-	call.Network(fmt.Sprintf("projects/%s/global/networks/%s", projectID, clusterVpc.GetName()))
-	return call.Do()
+func (c *servicesClient) ConnectionsList(clusterVpc *computepb.Network, projectID, parent string) (_ *servicenetworking.ListConnectionsResponse, err error) {
+	ctx, call := startCall(context.Background(), servicenetworkingServiceName, "Connections.List", gcpRegionGlobal)
+	defer call.observe(&err)
+	listCall := c.servicenetworkingService.Services.Connections.List(parent)
+	listCall.Network(fmt.Sprintf("projects/%s/global/networks/%s", projectID, clusterVpc.GetName()))
+	return listCall.Context(ctx).Do()
 }
 
-func (c *servicesClient) ConnectionsCreate(parent string, connection *servicenetworking.Connection) (*servicenetworking.Operation, error) {
+func (c *servicesClient) ConnectionsCreate(parent string, connection *servicenetworking.Connection) (_ *servicenetworking.Operation, err error) {
+	ctx, call := startCall(context.Background(), servicenetworkingServiceName, "Connections.Create", gcpRegionGlobal)
+	defer call.observe(&err)
 	return c.servicenetworkingService.Services.Connections.Create(
 		parent,
 		connection,
-	).Do()
+	).Context(ctx).Do()
 }
 
-func (c *servicesClient) ConnectionsDelete(name string, deleteconnectionrequest *servicenetworking.DeleteConnectionRequest) (*servicenetworking.Operation, error) {
+func (c *servicesClient) ConnectionsDelete(name string, deleteconnectionrequest *servicenetworking.DeleteConnectionRequest) (_ *servicenetworking.Operation, err error) {
+	ctx, call := startCall(context.Background(), servicenetworkingServiceName, "Connections.DeleteConnection", gcpRegionGlobal)
+	defer call.observe(&err)
 	return c.servicenetworkingService.Services.Connections.DeleteConnection(
 		name,
 		deleteconnectionrequest,
-	).Do()
+	).Context(ctx).Do()
 }
 
 type MockServicesClient struct {
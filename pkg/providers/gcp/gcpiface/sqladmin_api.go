@@ -15,10 +15,17 @@ type SQLAdminService interface {
 	ModifyInstance(context.Context, string, string, *sqladmin.DatabaseInstance) (*sqladmin.Operation, error)
 	GetInstance(context.Context, string, string) (*sqladmin.DatabaseInstance, error)
 	ExportDatabase(ctx context.Context, project, instanceName string, req *sqladmin.InstancesExportRequest) (*sqladmin.Operation, error)
+	ListBackupRuns(ctx context.Context, project, instanceName string) ([]*sqladmin.BackupRun, error)
+	UpdateUser(ctx context.Context, project, instanceName string, user *sqladmin.User) (*sqladmin.Operation, error)
+	ListTiers(ctx context.Context, project string) ([]*sqladmin.Tier, error)
 }
 
 func NewSQLAdminService(ctx context.Context, opt option.ClientOption, logger *logrus.Entry) (SQLAdminService, error) {
-	sqladminService, err := sqladmin.NewService(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	sqladminService, err := sqladmin.NewService(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -36,36 +43,78 @@ type sqlClient struct {
 	logger          *logrus.Entry
 }
 
-func (r *sqlClient) InstancesList(project string) (*sqladmin.InstancesListResponse, error) {
+func (r *sqlClient) InstancesList(project string) (_ *sqladmin.InstancesListResponse, err error) {
+	ctx, call := startCall(context.Background(), sqladminServiceName, "Instances.List", gcpRegionGlobal)
+	defer call.observe(&err)
 	r.logger.Info("listing gcp postgres instances")
-	return r.sqlAdminService.Instances.List(project).Do()
+	return r.sqlAdminService.Instances.List(project).Context(ctx).Do()
 }
 
-func (r *sqlClient) DeleteInstance(ctx context.Context, projectID, instanceName string) (*sqladmin.Operation, error) {
+func (r *sqlClient) DeleteInstance(ctx context.Context, projectID, instanceName string) (_ *sqladmin.Operation, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Instances.Delete", gcpRegionGlobal)
+	defer call.observe(&err)
 	r.logger.Infof("deleting gcp postgres instance %s", instanceName)
 	return r.sqlAdminService.Instances.Delete(projectID, instanceName).Context(ctx).Do()
 }
 
-func (r *sqlClient) CreateInstance(ctx context.Context, projectID string, databaseInstance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+func (r *sqlClient) CreateInstance(ctx context.Context, projectID string, databaseInstance *sqladmin.DatabaseInstance) (_ *sqladmin.Operation, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Instances.Insert", regionOrGlobal(databaseInstance.Region))
+	defer call.observe(&err)
 	r.logger.Infof("creating gcp postgres instance %s", databaseInstance.Name)
 	return r.sqlAdminService.Instances.Insert(projectID, databaseInstance).Context(ctx).Do()
 }
 
-func (r *sqlClient) ModifyInstance(ctx context.Context, projectID, instanceName string, databaseInstance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+func (r *sqlClient) ModifyInstance(ctx context.Context, projectID, instanceName string, databaseInstance *sqladmin.DatabaseInstance) (_ *sqladmin.Operation, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Instances.Patch", regionOrGlobal(databaseInstance.Region))
+	defer call.observe(&err)
 	r.logger.Infof("patching gcp postgres instance %s", databaseInstance.Name)
 	return r.sqlAdminService.Instances.Patch(projectID, instanceName, databaseInstance).Context(ctx).Do()
 }
 
-func (r *sqlClient) GetInstance(ctx context.Context, projectID, instanceName string) (*sqladmin.DatabaseInstance, error) {
+func (r *sqlClient) GetInstance(ctx context.Context, projectID, instanceName string) (_ *sqladmin.DatabaseInstance, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Instances.Get", gcpRegionGlobal)
+	defer call.observe(&err)
 	r.logger.Infof("fetching gcp postgres instance %s", instanceName)
 	return r.sqlAdminService.Instances.Get(projectID, instanceName).Context(ctx).Do()
 }
 
-func (r *sqlClient) ExportDatabase(ctx context.Context, projectID, instanceName string, req *sqladmin.InstancesExportRequest) (*sqladmin.Operation, error) {
+func (r *sqlClient) ExportDatabase(ctx context.Context, projectID, instanceName string, req *sqladmin.InstancesExportRequest) (_ *sqladmin.Operation, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Instances.Export", gcpRegionGlobal)
+	defer call.observe(&err)
 	r.logger.Infof("exporting gcp postgres database from instance %s", instanceName)
 	return r.sqlAdminService.Instances.Export(projectID, instanceName, req).Context(ctx).Do()
 }
 
+func (r *sqlClient) ListBackupRuns(ctx context.Context, projectID, instanceName string) (_ []*sqladmin.BackupRun, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "BackupRuns.List", gcpRegionGlobal)
+	defer call.observe(&err)
+	r.logger.Infof("listing gcp postgres backup runs for instance %s", instanceName)
+	var backupRuns []*sqladmin.BackupRun
+	err = r.sqlAdminService.BackupRuns.List(projectID, instanceName).Pages(ctx, func(page *sqladmin.BackupRunsListResponse) error {
+		backupRuns = append(backupRuns, page.Items...)
+		return nil
+	})
+	return backupRuns, err
+}
+
+func (r *sqlClient) UpdateUser(ctx context.Context, projectID, instanceName string, user *sqladmin.User) (_ *sqladmin.Operation, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Users.Update", gcpRegionGlobal)
+	defer call.observe(&err)
+	r.logger.Infof("updating user %s of gcp postgres instance %s", user.Name, instanceName)
+	return r.sqlAdminService.Users.Update(projectID, instanceName, user).Name(user.Name).Context(ctx).Do()
+}
+
+func (r *sqlClient) ListTiers(ctx context.Context, projectID string) (_ []*sqladmin.Tier, err error) {
+	ctx, call := startCall(ctx, sqladminServiceName, "Tiers.List", gcpRegionGlobal)
+	defer call.observe(&err)
+	r.logger.Infof("listing gcp postgres tiers available to project %s", projectID)
+	resp, err := r.sqlAdminService.Tiers.List(projectID).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
+
 type MockSqlClient struct {
 	SQLAdminService
 	InstancesListFn  func(string) (*sqladmin.InstancesListResponse, error)
@@ -74,6 +123,9 @@ type MockSqlClient struct {
 	ModifyInstanceFn func(context.Context, string, string, *sqladmin.DatabaseInstance) (*sqladmin.Operation, error)
 	GetInstanceFn    func(context.Context, string, string) (*sqladmin.DatabaseInstance, error)
 	ExportDatabaseFn func(context.Context, string, string, *sqladmin.InstancesExportRequest) (*sqladmin.Operation, error)
+	ListBackupRunsFn func(context.Context, string, string) ([]*sqladmin.BackupRun, error)
+	UpdateUserFn     func(context.Context, string, string, *sqladmin.User) (*sqladmin.Operation, error)
+	ListTiersFn      func(context.Context, string) ([]*sqladmin.Tier, error)
 }
 
 func GetMockSQLClient(modifyFn func(sqlClient *MockSqlClient)) *MockSqlClient {
@@ -98,6 +150,15 @@ func GetMockSQLClient(modifyFn func(sqlClient *MockSqlClient)) *MockSqlClient {
 		ExportDatabaseFn: func(ctx context.Context, projectID, instanceName string, req *sqladmin.InstancesExportRequest) (*sqladmin.Operation, error) {
 			return &sqladmin.Operation{}, nil
 		},
+		ListBackupRunsFn: func(ctx context.Context, projectID, instanceName string) ([]*sqladmin.BackupRun, error) {
+			return []*sqladmin.BackupRun{}, nil
+		},
+		UpdateUserFn: func(ctx context.Context, projectID, instanceName string, user *sqladmin.User) (*sqladmin.Operation, error) {
+			return &sqladmin.Operation{}, nil
+		},
+		ListTiersFn: func(ctx context.Context, projectID string) ([]*sqladmin.Tier, error) {
+			return []*sqladmin.Tier{}, nil
+		},
 	}
 	if modifyFn != nil {
 		modifyFn(mock)
@@ -128,3 +189,15 @@ func (m *MockSqlClient) GetInstance(ctx context.Context, projectID, instanceName
 func (m *MockSqlClient) ExportDatabase(ctx context.Context, projectID, instanceName string, req *sqladmin.InstancesExportRequest) (*sqladmin.Operation, error) {
 	return m.ExportDatabaseFn(ctx, projectID, instanceName, req)
 }
+
+func (m *MockSqlClient) ListBackupRuns(ctx context.Context, projectID, instanceName string) ([]*sqladmin.BackupRun, error) {
+	return m.ListBackupRunsFn(ctx, projectID, instanceName)
+}
+
+func (m *MockSqlClient) UpdateUser(ctx context.Context, projectID, instanceName string, user *sqladmin.User) (*sqladmin.Operation, error) {
+	return m.UpdateUserFn(ctx, projectID, instanceName, user)
+}
+
+func (m *MockSqlClient) ListTiers(ctx context.Context, projectID string) ([]*sqladmin.Tier, error) {
+	return m.ListTiersFn(ctx, projectID)
+}
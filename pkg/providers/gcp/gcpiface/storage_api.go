@@ -31,7 +31,11 @@ type storageClient struct {
 }
 
 func NewStorageAPI(ctx context.Context, opt option.ClientOption, logger *logrus.Entry) (StorageAPI, error) {
-	cloudStorageClient, err := storage.NewClient(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	cloudStorageClient, err := storage.NewClient(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -41,25 +45,41 @@ func NewStorageAPI(ctx context.Context, opt option.ClientOption, logger *logrus.
 	}, nil
 }
 
-func (c *storageClient) CreateBucket(ctx context.Context, bucket, projectID string, attrs *storage.BucketAttrs) error {
+func (c *storageClient) CreateBucket(ctx context.Context, bucket, projectID string, attrs *storage.BucketAttrs) (err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.Insert", bucketRegion(attrs))
+	defer call.observe(&err)
 	c.logger.Infof("creating bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	return bucketHandle.Create(ctx, projectID, attrs)
 }
 
-func (c *storageClient) GetBucket(ctx context.Context, bucket string) (*storage.BucketAttrs, error) {
+// bucketRegion returns the location a bucket is created in, multi-region locations are returned as they are
+func bucketRegion(attrs *storage.BucketAttrs) string {
+	if attrs == nil {
+		return gcpRegionGlobal
+	}
+	return regionOrGlobal(attrs.Location)
+}
+
+func (c *storageClient) GetBucket(ctx context.Context, bucket string) (_ *storage.BucketAttrs, err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.Get", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("getting bucket %s", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	return bucketHandle.Attrs(ctx)
 }
 
-func (c *storageClient) DeleteBucket(ctx context.Context, bucket string) error {
+func (c *storageClient) DeleteBucket(ctx context.Context, bucket string) (err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.Delete", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("deleting bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	return bucketHandle.Delete(ctx)
 }
 
-func (c *storageClient) SetBucketPolicy(ctx context.Context, bucket, identity, role string) error {
+func (c *storageClient) SetBucketPolicy(ctx context.Context, bucket, identity, role string) (err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.SetIamPolicy", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("setting policy on bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	policy, err := bucketHandle.IAM().Policy(ctx)
@@ -70,7 +90,9 @@ func (c *storageClient) SetBucketPolicy(ctx context.Context, bucket, identity, r
 	return bucketHandle.IAM().SetPolicy(ctx, policy)
 }
 
-func (c *storageClient) HasBucketPolicy(ctx context.Context, bucket, identity, role string) (bool, error) {
+func (c *storageClient) HasBucketPolicy(ctx context.Context, bucket, identity, role string) (_ bool, err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.GetIamPolicy", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("checking policy on bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	policy, err := bucketHandle.IAM().Policy(ctx)
@@ -80,7 +102,9 @@ func (c *storageClient) HasBucketPolicy(ctx context.Context, bucket, identity, r
 	return policy.HasRole(identity, iam.RoleName(role)), nil
 }
 
-func (c *storageClient) SetBucketLifecycle(ctx context.Context, bucket string, days int64) error {
+func (c *storageClient) SetBucketLifecycle(ctx context.Context, bucket string, days int64) (err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.Patch", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("setting object lifecycle on bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	uattrs := storage.BucketAttrsToUpdate{
@@ -97,11 +121,13 @@ func (c *storageClient) SetBucketLifecycle(ctx context.Context, bucket string, d
 			},
 		},
 	}
-	_, err := bucketHandle.Update(ctx, uattrs)
+	_, err = bucketHandle.Update(ctx, uattrs)
 	return err
 }
 
-func (c *storageClient) HasBucketLifecycle(ctx context.Context, bucket string, days int64) (bool, error) {
+func (c *storageClient) HasBucketLifecycle(ctx context.Context, bucket string, days int64) (_ bool, err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Buckets.Get", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("checking object lifecycle on bucket %q", bucket)
 	bucketHandle := c.storageService.Bucket(bucket)
 	attrs, err := bucketHandle.Attrs(ctx)
@@ -117,7 +143,9 @@ func (c *storageClient) HasBucketLifecycle(ctx context.Context, bucket string, d
 	return false, nil
 }
 
-func (c *storageClient) ListObjects(ctx context.Context, bucket string, query *storage.Query) ([]*storage.ObjectAttrs, error) {
+func (c *storageClient) ListObjects(ctx context.Context, bucket string, query *storage.Query) (_ []*storage.ObjectAttrs, err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Objects.List", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("listing objects from bucket %q", bucket)
 	objectIterator := c.storageService.Bucket(bucket).Objects(ctx, query)
 	var objects []*storage.ObjectAttrs
@@ -134,13 +162,17 @@ func (c *storageClient) ListObjects(ctx context.Context, bucket string, query *s
 	return objects, nil
 }
 
-func (c *storageClient) GetObjectMetadata(ctx context.Context, bucket, object string) (*storage.ObjectAttrs, error) {
+func (c *storageClient) GetObjectMetadata(ctx context.Context, bucket, object string) (_ *storage.ObjectAttrs, err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Objects.Get", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("fetching object %q from bucket %q", object, bucket)
 	objectHandle := c.storageService.Bucket(bucket).Object(object)
 	return objectHandle.Attrs(ctx)
 }
 
-func (c *storageClient) DeleteObject(ctx context.Context, bucket, object string) error {
+func (c *storageClient) DeleteObject(ctx context.Context, bucket, object string) (err error) {
+	ctx, call := startCall(ctx, storageServiceName, "Objects.Delete", gcpRegionGlobal)
+	defer call.observe(&err)
 	c.logger.Infof("deleting object %q from bucket %q", object, bucket)
 	objectHandle := c.storageService.Bucket(bucket).Object(object)
 	return objectHandle.Delete(ctx)
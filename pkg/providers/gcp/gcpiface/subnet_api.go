@@ -22,7 +22,11 @@ type subnetsClient struct {
 }
 
 func NewSubnetsAPI(ctx context.Context, opt option.ClientOption) (SubnetsApi, error) {
-	subnetsRestClient, err := compute.NewSubnetworksRESTClient(ctx, opt)
+	httpOpt, err := newHTTPClientOption(ctx, opt)
+	if err != nil {
+		return nil, err
+	}
+	subnetsRestClient, err := compute.NewSubnetworksRESTClient(ctx, httpOpt)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +35,9 @@ func NewSubnetsAPI(ctx context.Context, opt option.ClientOption) (SubnetsApi, er
 	}, nil
 }
 
-func (c *subnetsClient) Get(ctx context.Context, req *computepb.GetSubnetworkRequest, opts ...gax.CallOption) (*computepb.Subnetwork, error) {
+func (c *subnetsClient) Get(ctx context.Context, req *computepb.GetSubnetworkRequest, opts ...gax.CallOption) (_ *computepb.Subnetwork, err error) {
+	ctx, call := startCall(ctx, computeServiceName, "Subnetworks.Get", regionOrGlobal(req.GetRegion()))
+	defer call.observe(&err)
 	return c.subnetsService.Get(ctx, req, opts...)
 }
 
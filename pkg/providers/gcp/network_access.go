@@ -0,0 +1,236 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"google.golang.org/api/sqladmin/v1beta4"
+	utils "k8s.io/utils/ptr"
+)
+
+const (
+	defaultNetworkAccessAllowPostfix     = "network-access-allow"
+	defaultNetworkAccessDenyPostfix      = "network-access-deny"
+	defaultNetworkAccessAllowPriority    = 900
+	defaultNetworkAccessDenyPriority     = 1000
+	defaultNetworkAccessAuthorizedPrefix = "network-access"
+	defaultNetworkAccessProtocol         = "tcp"
+)
+
+// ReconcileNetworkAccess restricts the connections from the cluster vpc to the cloud resource at ip to the cluster
+// nodes, and so to the cluster pods, when the network access of the cr is restricted to the cluster pods. the private
+// services of gcp can not be firewalled, an egress rule allowing the cluster nodes and an egress rule denying every
+// other instance of the cluster vpc are used instead. the rules are removed when the access is not restricted
+func (n *NetworkProvider) ReconcileNetworkAccess(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+	if access == nil || !access.ClusterPodsOnly {
+		return n.DeleteNetworkAccess(ctx, resourceID)
+	}
+	if ip == "" {
+		return errorUtil.Errorf("cloud resource %s has no ip address to restrict network access to", resourceID)
+	}
+	clusterID, err := resources.GetClusterID(ctx, n.Client)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get cluster id")
+	}
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.NetworkApi, n.ProjectID, n.Logger)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	for _, firewall := range buildNetworkAccessFirewalls(clusterID, clusterVpc.GetSelfLink(), resourceID, ip, port) {
+		if err := n.reconcileFirewall(ctx, firewall); err != nil {
+			return errorUtil.Wrapf(err, "failed to reconcile network access firewall %s", firewall.GetName())
+		}
+	}
+	return nil
+}
+
+// DeleteNetworkAccess removes the firewall rules restricting the connections to the cloud resource
+func (n *NetworkProvider) DeleteNetworkAccess(ctx context.Context, resourceID string) error {
+	for _, name := range []string{buildNetworkAccessFirewallName(resourceID, defaultNetworkAccessAllowPostfix), buildNetworkAccessFirewallName(resourceID, defaultNetworkAccessDenyPostfix)} {
+		firewall, err := n.getFirewall(ctx, name)
+		if err != nil {
+			return err
+		}
+		if firewall == nil {
+			continue
+		}
+		n.Logger.Infof("deleting network access firewall %s", name)
+		if err := n.FirewallApi.Delete(ctx, &computepb.DeleteFirewallRequest{
+			Firewall: name,
+			Project:  n.ProjectID,
+		}); err != nil && !resources.IsNotFoundError(err) {
+			return errorUtil.Wrapf(err, "failed to delete network access firewall %s", name)
+		}
+	}
+	return nil
+}
+
+func (n *NetworkProvider) reconcileFirewall(ctx context.Context, firewall *computepb.Firewall) error {
+	found, err := n.getFirewall(ctx, firewall.GetName())
+	if err != nil {
+		return err
+	}
+	if found == nil {
+		n.Logger.Infof("creating network access firewall %s", firewall.GetName())
+		return n.FirewallApi.Insert(ctx, &computepb.InsertFirewallRequest{
+			FirewallResource: firewall,
+			Project:          n.ProjectID,
+		})
+	}
+	if firewallsEqual(found, firewall) {
+		return nil
+	}
+	n.Logger.Infof("updating network access firewall %s", firewall.GetName())
+	return n.FirewallApi.Patch(ctx, &computepb.PatchFirewallRequest{
+		Firewall:         firewall.GetName(),
+		FirewallResource: firewall,
+		Project:          n.ProjectID,
+	})
+}
+
+func (n *NetworkProvider) getFirewall(ctx context.Context, name string) (*computepb.Firewall, error) {
+	firewall, err := n.FirewallApi.Get(ctx, &computepb.GetFirewallRequest{
+		Firewall: name,
+		Project:  n.ProjectID,
+	})
+	if err != nil {
+		if resources.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unexpected error getting firewall %s from gcp: %w", name, err)
+	}
+	return firewall, nil
+}
+
+func buildNetworkAccessFirewallName(resourceID string, postfix string) string {
+	return fmt.Sprintf("%s-%s", resourceID, postfix)
+}
+
+// buildNetworkAccessFirewalls returns the egress rules allowing the cluster nodes, and denying every other instance of
+// the cluster vpc, to connect to the port of the cloud resource at ip. the cluster nodes are identified by the network
+// tags openshift sets on the control plane and compute nodes
+func buildNetworkAccessFirewalls(clusterID string, network string, resourceID string, ip string, port int32) []*computepb.Firewall {
+	destination := []string{fmt.Sprintf("%s/32", ip)}
+	ports := []string{strconv.Itoa(int(port))}
+	return []*computepb.Firewall{
+		{
+			Name:              utils.To(buildNetworkAccessFirewallName(resourceID, defaultNetworkAccessAllowPostfix)),
+			Description:       utils.To(fmt.Sprintf("allow cluster nodes to connect to cloud resource %s", resourceID)),
+			Network:           utils.To(network),
+			Direction:         utils.To(computepb.Firewall_EGRESS.String()),
+			Priority:          utils.To(int32(defaultNetworkAccessAllowPriority)),
+			DestinationRanges: destination,
+			TargetTags:        []string{fmt.Sprintf("%s-master", clusterID), fmt.Sprintf("%s-worker", clusterID)},
+			Allowed:           []*computepb.Allowed{{IPProtocol: utils.To(defaultNetworkAccessProtocol), Ports: ports}},
+		},
+		{
+			Name:              utils.To(buildNetworkAccessFirewallName(resourceID, defaultNetworkAccessDenyPostfix)),
+			Description:       utils.To(fmt.Sprintf("deny other instances to connect to cloud resource %s", resourceID)),
+			Network:           utils.To(network),
+			Direction:         utils.To(computepb.Firewall_EGRESS.String()),
+			Priority:          utils.To(int32(defaultNetworkAccessDenyPriority)),
+			DestinationRanges: destination,
+			Denied:            []*computepb.Denied{{IPProtocol: utils.To(defaultNetworkAccessProtocol), Ports: ports}},
+		},
+	}
+}
+
+// firewallsEqual compares the attributes of a firewall set by the network access
+func firewallsEqual(found, desired *computepb.Firewall) bool {
+	if found.GetPriority() != desired.GetPriority() || found.GetDisabled() != desired.GetDisabled() {
+		return false
+	}
+	if !stringSetsEqual(found.GetDestinationRanges(), desired.GetDestinationRanges()) || !stringSetsEqual(found.GetTargetTags(), desired.GetTargetTags()) {
+		return false
+	}
+	if len(found.GetAllowed()) != len(desired.GetAllowed()) || len(found.GetDenied()) != len(desired.GetDenied()) {
+		return false
+	}
+	for i := range desired.GetAllowed() {
+		if found.GetAllowed()[i].GetIPProtocol() != desired.GetAllowed()[i].GetIPProtocol() || !stringSetsEqual(found.GetAllowed()[i].GetPorts(), desired.GetAllowed()[i].GetPorts()) {
+			return false
+		}
+	}
+	for i := range desired.GetDenied() {
+		if found.GetDenied()[i].GetIPProtocol() != desired.GetDenied()[i].GetIPProtocol() || !stringSetsEqual(found.GetDenied()[i].GetPorts(), desired.GetDenied()[i].GetPorts()) {
+			return false
+		}
+	}
+	return true
+}
+
+func stringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	return reflect.DeepEqual(a, b)
+}
+
+// buildAuthorizedNetworks returns the authorized networks of a cloudSQL instance, the allowed cidr blocks of the network
+// access are added to the authorized networks of the strategy. authorized networks apply to connections to the public
+// ip of the instance
+func buildAuthorizedNetworks(strategyNetworks []*sqladmin.AclEntry, access *croType.NetworkAccess) ([]*sqladmin.AclEntry, error) {
+	if access == nil || len(access.AllowedCIDRs) == 0 {
+		return strategyNetworks, nil
+	}
+	if _, err := resources.ParseCIDRs(access.AllowedCIDRs...); err != nil {
+		return nil, errorUtil.Wrap(err, "invalid allowed cidr block in network access")
+	}
+	found := map[string]bool{}
+	authorizedNetworks := append([]*sqladmin.AclEntry{}, strategyNetworks...)
+	for _, entry := range strategyNetworks {
+		found[entry.Value] = true
+	}
+	for i, cidr := range access.AllowedCIDRs {
+		if found[cidr] {
+			continue
+		}
+		found[cidr] = true
+		authorizedNetworks = append(authorizedNetworks, &sqladmin.AclEntry{
+			Name:  fmt.Sprintf("%s-%d", defaultNetworkAccessAuthorizedPrefix, i),
+			Value: cidr,
+		})
+	}
+	return authorizedNetworks, nil
+}
+
+// authorizedNetworksChanged returns true if the authorized networks of a cloudSQL instance differ from the strategy,
+// authorized networks set outside of the operator are only replaced when the strategy or the network access manage them
+func authorizedNetworksChanged(desired, found []*sqladmin.AclEntry) bool {
+	if authorizedNetworksEqual(desired, found) {
+		return false
+	}
+	if len(desired) > 0 {
+		return true
+	}
+	for _, entry := range found {
+		if strings.HasPrefix(entry.Name, defaultNetworkAccessAuthorizedPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizedNetworksEqual returns true if both lists authorize the same cidr blocks, in any order
+func authorizedNetworksEqual(a, b []*sqladmin.AclEntry) bool {
+	var aValues, bValues []string
+	for _, entry := range a {
+		aValues = append(aValues, entry.Value)
+	}
+	for _, entry := range b {
+		bValues = append(bValues, entry.Value)
+	}
+	return stringSetsEqual(aValues, bValues)
+}
@@ -0,0 +1,272 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/sqladmin/v1beta4"
+	utils "k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNetworkProvider_ReconcileNetworkAccess(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	type fields struct {
+		Client      client.Client
+		NetworkApi  gcpiface.NetworksAPI
+		FirewallApi *gcpiface.MockFirewallsClient
+	}
+	type args struct {
+		ip     string
+		access *croType.NetworkAccess
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		args        args
+		wantInserts int
+		wantPatches int
+		wantDeletes int
+		wantErr     bool
+	}{
+		{
+			name: "create firewalls when network access is restricted to cluster pods",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				FirewallApi: gcpiface.GetMockFirewallsClient(nil),
+			},
+			args: args{
+				ip:     "10.1.0.3",
+				access: &croType.NetworkAccess{ClusterPodsOnly: true},
+			},
+			wantInserts: 2,
+		},
+		{
+			name: "update firewalls of a changed cloud resource ip",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				FirewallApi: gcpiface.GetMockFirewallsClient(func(firewallsClient *gcpiface.MockFirewallsClient) {
+					firewallsClient.GetFn = func(req *computepb.GetFirewallRequest) (*computepb.Firewall, error) {
+						for _, firewall := range buildNetworkAccessFirewalls(gcpTestClusterName, "", testName, "10.1.0.4", defaultGCPPostgresPort) {
+							if firewall.GetName() == req.Firewall {
+								return firewall, nil
+							}
+						}
+						return nil, errors.New("unexpected firewall")
+					}
+				}),
+			},
+			args: args{
+				ip:     "10.1.0.3",
+				access: &croType.NetworkAccess{ClusterPodsOnly: true},
+			},
+			wantPatches: 2,
+		},
+		{
+			name: "firewalls are unchanged",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				FirewallApi: gcpiface.GetMockFirewallsClient(func(firewallsClient *gcpiface.MockFirewallsClient) {
+					firewallsClient.GetFn = func(req *computepb.GetFirewallRequest) (*computepb.Firewall, error) {
+						for _, firewall := range buildNetworkAccessFirewalls(gcpTestClusterName, "", testName, "10.1.0.3", defaultGCPPostgresPort) {
+							if firewall.GetName() == req.Firewall {
+								return firewall, nil
+							}
+						}
+						return nil, errors.New("unexpected firewall")
+					}
+				}),
+			},
+			args: args{
+				ip:     "10.1.0.3",
+				access: &croType.NetworkAccess{ClusterPodsOnly: true},
+			},
+		},
+		{
+			name: "delete firewalls when network access is not restricted to cluster pods",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				FirewallApi: gcpiface.GetMockFirewallsClient(func(firewallsClient *gcpiface.MockFirewallsClient) {
+					firewallsClient.GetFn = func(req *computepb.GetFirewallRequest) (*computepb.Firewall, error) {
+						return &computepb.Firewall{Name: utils.To(req.Firewall)}, nil
+					}
+				}),
+			},
+			args: args{
+				ip:     "10.1.0.3",
+				access: &croType.NetworkAccess{AllowedCIDRs: []string{"10.2.0.0/16"}},
+			},
+			wantDeletes: 2,
+		},
+		{
+			name: "nothing to delete when network access is unset",
+			fields: fields{
+				Client:      moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				FirewallApi: gcpiface.GetMockFirewallsClient(nil),
+			},
+			args: args{
+				ip: "10.1.0.3",
+			},
+		},
+		{
+			name: "error when the cloud resource has no ip",
+			fields: fields{
+				Client:      moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				FirewallApi: gcpiface.GetMockFirewallsClient(nil),
+			},
+			args: args{
+				access: &croType.NetworkAccess{ClusterPodsOnly: true},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error getting firewall",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				FirewallApi: gcpiface.GetMockFirewallsClient(func(firewallsClient *gcpiface.MockFirewallsClient) {
+					firewallsClient.GetFn = func(req *computepb.GetFirewallRequest) (*computepb.Firewall, error) {
+						return nil, &googleapi.Error{
+							Code: http.StatusBadGateway,
+						}
+					}
+				}),
+			},
+			args: args{
+				ip:     "10.1.0.3",
+				access: &croType.NetworkAccess{ClusterPodsOnly: true},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var inserts, patches, deletes int
+			tt.fields.FirewallApi.InsertFn = func(req *computepb.InsertFirewallRequest) error {
+				inserts++
+				return nil
+			}
+			tt.fields.FirewallApi.PatchFn = func(req *computepb.PatchFirewallRequest) error {
+				patches++
+				return nil
+			}
+			tt.fields.FirewallApi.DeleteFn = func(req *computepb.DeleteFirewallRequest) error {
+				deletes++
+				return nil
+			}
+			n := &NetworkProvider{
+				Client:      tt.fields.Client,
+				NetworkApi:  tt.fields.NetworkApi,
+				FirewallApi: tt.fields.FirewallApi,
+				Logger:      logrus.NewEntry(logrus.StandardLogger()),
+				ProjectID:   gcpTestProjectId,
+			}
+			if err := n.ReconcileNetworkAccess(context.TODO(), testName, tt.args.ip, defaultGCPPostgresPort, tt.args.access); (err != nil) != tt.wantErr {
+				t.Fatalf("ReconcileNetworkAccess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if inserts != tt.wantInserts || patches != tt.wantPatches || deletes != tt.wantDeletes {
+				t.Errorf("ReconcileNetworkAccess() inserts = %d, patches = %d, deletes = %d, want %d, %d, %d", inserts, patches, deletes, tt.wantInserts, tt.wantPatches, tt.wantDeletes)
+			}
+		})
+	}
+}
+
+func TestBuildAuthorizedNetworks(t *testing.T) {
+	strategyNetworks := []*sqladmin.AclEntry{{Name: "office", Value: "192.168.0.0/24"}}
+	tests := []struct {
+		name    string
+		access  *croType.NetworkAccess
+		want    []*sqladmin.AclEntry
+		wantErr bool
+	}{
+		{
+			name: "strategy networks are kept without network access",
+			want: strategyNetworks,
+		},
+		{
+			name:   "allowed cidr blocks are added to the strategy networks",
+			access: &croType.NetworkAccess{AllowedCIDRs: []string{"10.2.0.0/16", "192.168.0.0/24"}},
+			want: []*sqladmin.AclEntry{
+				{Name: "office", Value: "192.168.0.0/24"},
+				{Name: "network-access-0", Value: "10.2.0.0/16"},
+			},
+		},
+		{
+			name:    "invalid allowed cidr block",
+			access:  &croType.NetworkAccess{AllowedCIDRs: []string{"10.2.0.0"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAuthorizedNetworks(strategyNetworks, tt.access)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildAuthorizedNetworks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildAuthorizedNetworks() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizedNetworksChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired []*sqladmin.AclEntry
+		found   []*sqladmin.AclEntry
+		want    bool
+	}{
+		{
+			name:    "same networks in a different order",
+			desired: []*sqladmin.AclEntry{{Value: "10.2.0.0/16"}, {Value: "10.3.0.0/16"}},
+			found:   []*sqladmin.AclEntry{{Value: "10.3.0.0/16"}, {Value: "10.2.0.0/16"}},
+			want:    false,
+		},
+		{
+			name:    "allowed cidr block added",
+			desired: []*sqladmin.AclEntry{{Value: "10.2.0.0/16"}},
+			want:    true,
+		},
+		{
+			name:  "networks set outside of the operator are kept",
+			found: []*sqladmin.AclEntry{{Name: "manual", Value: "10.2.0.0/16"}},
+			want:  false,
+		},
+		{
+			name:  "networks of a removed network access are removed",
+			found: []*sqladmin.AclEntry{{Name: "network-access-0", Value: "10.2.0.0/16"}},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authorizedNetworksChanged(tt.desired, tt.found); got != tt.want {
+				t.Errorf("authorizedNetworksChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
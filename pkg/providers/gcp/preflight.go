@@ -0,0 +1,99 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+)
+
+const (
+	// customTierPrefix is the prefix of cloudSQL tiers built from a custom machine type, they are not listed by the
+	// tiers api
+	customTierPrefix = "db-custom-"
+)
+
+var (
+	// cloudSQLCreatePermissions are the permissions the postgres provider credentials require to create and then
+	// reconcile a cloudSQL instance
+	cloudSQLCreatePermissions = []string{
+		"cloudsql.instances.create",
+		"cloudsql.instances.get",
+		"cloudsql.instances.update",
+	}
+	// redisCreatePermissions are the permissions the redis provider credentials require to create and then reconcile a
+	// memorystore for redis instance
+	redisCreatePermissions = []string{
+		"redis.instances.create",
+		"redis.instances.get",
+		"redis.instances.update",
+	}
+)
+
+// preflightCloudSQLInstance runs the checks of a cloudSQL instance before it is first created. gcp quotas are enforced
+// per project through the service usage api and the reserved ip range is checked by the network manager, so no quota
+// or network capacity check is run
+func preflightCloudSQLInstance(ctx context.Context, projectsClient gcpiface.ProjectsAPI, sqladminService gcpiface.SQLAdminService, projectID string, instance *gcpiface.DatabaseInstance) *resources.Preflight {
+	preflight := &resources.Preflight{}
+	checkPermissions(ctx, preflight, projectsClient, projectID, cloudSQLCreatePermissions)
+
+	var tier string
+	if instance.Settings != nil {
+		tier = instance.Settings.Tier
+	}
+	if strings.HasPrefix(tier, customTierPrefix) {
+		preflight.Unknown(resources.PreflightAvailabilityConditionType, "cloudSQL tier %s is built from a custom machine type and can not be checked", tier)
+		return preflight
+	}
+	tiers, err := sqladminService.ListTiers(ctx, projectID)
+	if err != nil || len(tiers) == 0 {
+		preflight.Unknown(resources.PreflightAvailabilityConditionType, "failed to list cloudSQL tiers of project %s: %v", projectID, err)
+		return preflight
+	}
+	for _, t := range tiers {
+		if t.Tier != tier {
+			continue
+		}
+		for _, region := range t.Region {
+			if region == instance.Region {
+				preflight.Pass(resources.PreflightAvailabilityConditionType, "cloudSQL tier %s is offered in %s", tier, instance.Region)
+				return preflight
+			}
+		}
+	}
+	preflight.Fail(resources.PreflightAvailabilityConditionType, "cloudSQL tier %s is not offered in %s", tier, instance.Region)
+	return preflight
+}
+
+// preflightRedisInstance runs the checks of a memorystore for redis instance before it is first created. memorystore
+// does not expose the versions or capacity it offers in a region, so only permissions are checked
+func preflightRedisInstance(ctx context.Context, projectsClient gcpiface.ProjectsAPI, projectID string) *resources.Preflight {
+	preflight := &resources.Preflight{}
+	checkPermissions(ctx, preflight, projectsClient, projectID, redisCreatePermissions)
+	return preflight
+}
+
+// checkPermissions fails when the provider credentials are not granted each permission on the project
+func checkPermissions(ctx context.Context, preflight *resources.Preflight, projectsClient gcpiface.ProjectsAPI, projectID string, permissions []string) {
+	granted, err := projectsClient.TestIamPermissions(ctx, projectID, permissions)
+	if err != nil {
+		preflight.Unknown(resources.PreflightPermissionsConditionType, "failed to test permissions on project %s: %v", projectID, err)
+		return
+	}
+	grantedSet := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		grantedSet[g] = true
+	}
+	var missing []string
+	for _, p := range permissions {
+		if !grantedSet[p] {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		preflight.Fail(resources.PreflightPermissionsConditionType, "provider credentials are missing %s on project %s", strings.Join(missing, ", "), projectID)
+		return
+	}
+	preflight.Pass(resources.PreflightPermissionsConditionType, "provider credentials are granted %s on project %s", strings.Join(permissions, ", "), projectID)
+}
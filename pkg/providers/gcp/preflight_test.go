@@ -0,0 +1,113 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func findPreflightCheck(preflight *resources.Preflight, checkType string) *resources.PreflightCheck {
+	for i := range preflight.Checks {
+		if preflight.Checks[i].Type == checkType {
+			return &preflight.Checks[i]
+		}
+	}
+	return nil
+}
+
+func TestPreflightCloudSQLInstance(t *testing.T) {
+	tiers := []*sqladmin.Tier{
+		{Tier: "db-f1-micro", Region: []string{"europe-west1", "us-central1"}},
+	}
+	tests := []struct {
+		name           string
+		projectsClient gcpiface.ProjectsAPI
+		sqlClient      gcpiface.SQLAdminService
+		tier           string
+		wantStatus     map[string]metav1.ConditionStatus
+	}{
+		{
+			name:           "test every check passes",
+			projectsClient: gcpiface.GetMockProjectsClient(nil),
+			sqlClient: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+				sqlClient.ListTiersFn = func(context.Context, string) ([]*sqladmin.Tier, error) {
+					return tiers, nil
+				}
+			}),
+			tier: "db-f1-micro",
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType:  metav1.ConditionTrue,
+				resources.PreflightAvailabilityConditionType: metav1.ConditionTrue,
+			},
+		},
+		{
+			name: "test missing permission fails the permissions check",
+			projectsClient: gcpiface.GetMockProjectsClient(func(projectsClient *gcpiface.MockProjectsClient) {
+				projectsClient.TestIamPermissionsFn = func(context.Context, string, []string) ([]string, error) {
+					return []string{"cloudsql.instances.get"}, nil
+				}
+			}),
+			sqlClient: gcpiface.GetMockSQLClient(nil),
+			tier:      defaultTier,
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionFalse,
+			},
+		},
+		{
+			name: "test permissions which can not be tested are unknown",
+			projectsClient: gcpiface.GetMockProjectsClient(func(projectsClient *gcpiface.MockProjectsClient) {
+				projectsClient.TestIamPermissionsFn = func(context.Context, string, []string) ([]string, error) {
+					return nil, errors.New("api disabled")
+				}
+			}),
+			sqlClient: gcpiface.GetMockSQLClient(nil),
+			tier:      defaultTier,
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightPermissionsConditionType: metav1.ConditionUnknown,
+			},
+		},
+		{
+			name:           "test custom tier availability is unknown",
+			projectsClient: gcpiface.GetMockProjectsClient(nil),
+			sqlClient:      gcpiface.GetMockSQLClient(nil),
+			tier:           defaultTier,
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightAvailabilityConditionType: metav1.ConditionUnknown,
+			},
+		},
+		{
+			name:           "test tier which is not offered in the region fails the availability check",
+			projectsClient: gcpiface.GetMockProjectsClient(nil),
+			sqlClient: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+				sqlClient.ListTiersFn = func(context.Context, string) ([]*sqladmin.Tier, error) {
+					return tiers, nil
+				}
+			}),
+			tier: "db-g1-small",
+			wantStatus: map[string]metav1.ConditionStatus{
+				resources.PreflightAvailabilityConditionType: metav1.ConditionFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance := &gcpiface.DatabaseInstance{
+				Name:     "test",
+				Region:   "europe-west1",
+				Settings: &gcpiface.Settings{Tier: tt.tier},
+			}
+			preflight := preflightCloudSQLInstance(context.TODO(), tt.projectsClient, tt.sqlClient, gcpTestProjectId, instance)
+			for checkType, want := range tt.wantStatus {
+				check := findPreflightCheck(preflight, checkType)
+				if check == nil || check.Status != want {
+					t.Errorf("preflightCloudSQLInstance() %s = %v, want status %s", checkType, check, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	utils "k8s.io/utils/ptr"
+)
+
+// NetworkConnectionMethod the method used to connect cloud resources to the cluster vpc, it is selected with
+// `ConnectionMethod` in the create strategy of the `_network` strategy, e.g. {"ConnectionMethod": "privateServiceConnect"}
+type NetworkConnectionMethod string
+
+const (
+	// NetworkConnectionMethodServiceNetworking reserves an ip address range in the cluster vpc and peers it with the
+	// service producer network of the cloud resources
+	NetworkConnectionMethodServiceNetworking NetworkConnectionMethod = "serviceNetworking"
+	// NetworkConnectionMethodPrivateServiceConnect creates a private service connect endpoint in the cluster subnet for
+	// each cloud resource, no ip address range or peering is required
+	NetworkConnectionMethodPrivateServiceConnect NetworkConnectionMethod = "privateServiceConnect"
+
+	defaultPrivateServiceConnectPostfix         = "psc"
+	defaultPrivateServiceConnectWorkerSubnet    = "worker"
+	privateServiceConnectStatusAccepted         = "ACCEPTED"
+	privateServiceConnectStatusRejected         = "REJECTED"
+	privateServiceConnectStatusNeedsAttention   = "NEEDS_ATTENTION"
+	privateServiceConnectEndpointPendingMessage = "private service connect endpoint %s creation in progress"
+)
+
+// GetNetworkConnectionMethod returns the connection method of the `_network` strategy of the tier, service networking
+// is used by default
+func (n *NetworkProvider) GetNetworkConnectionMethod(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+	stratCfg, err := configManager.ReadStorageStrategy(ctx, providers.NetworkResourceType, tier)
+	if err != nil {
+		return "", errorUtil.Wrap(err, "failed to read _network strategy config")
+	}
+	vpcCreateConfig := &CreateVpcInput{}
+	if err := json.Unmarshal(stratCfg.CreateStrategy, vpcCreateConfig); err != nil {
+		return "", errorUtil.Wrap(err, "failed to unmarshal gcp vpc create config")
+	}
+	switch vpcCreateConfig.ConnectionMethod {
+	case "", NetworkConnectionMethodServiceNetworking:
+		return NetworkConnectionMethodServiceNetworking, nil
+	case NetworkConnectionMethodPrivateServiceConnect:
+		return NetworkConnectionMethodPrivateServiceConnect, nil
+	}
+	return "", errorUtil.New(fmt.Sprintf("unsupported connection method %s, expected one of %s or %s, please update `_network` strategy", vpcCreateConfig.ConnectionMethod, NetworkConnectionMethodServiceNetworking, NetworkConnectionMethodPrivateServiceConnect))
+}
+
+// CreatePrivateServiceConnectEndpoint reserves an internal address in the cluster subnet of the region and creates a
+// forwarding rule from it to the service attachment of a cloud resource. the address is returned once the service
+// attachment accepted the connection, a status message is returned while the endpoint is pending
+func (n *NetworkProvider) CreatePrivateServiceConnectEndpoint(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, croType.StatusMessage, error) {
+	endpointName := buildPrivateServiceConnectEndpointName(name)
+	address, err := n.getEndpointAddress(ctx, endpointName, region)
+	if err != nil {
+		return nil, croType.StatusNetworkCreateError, err
+	}
+	clusterVpc, err := getClusterVpc(ctx, n.Client, n.NetworkApi, n.ProjectID, n.Logger)
+	if err != nil {
+		return nil, croType.StatusNetworkCreateError, errorUtil.Wrap(err, "failed to get cluster vpc")
+	}
+	if address == nil {
+		subnet, err := n.getEndpointSubnet(ctx, clusterVpc, region)
+		if err != nil {
+			return nil, croType.StatusNetworkCreateError, err
+		}
+		n.Logger.Infof("creating private service connect endpoint address %s in subnet %s", endpointName, subnet.GetName())
+		if err := n.ForwardingRuleApi.InsertAddress(ctx, &computepb.InsertAddressRequest{
+			Project: n.ProjectID,
+			Region:  region,
+			AddressResource: &computepb.Address{
+				Name:        utils.To(endpointName),
+				AddressType: utils.To(computepb.Address_INTERNAL.String()),
+				Subnetwork:  subnet.SelfLink,
+			},
+		}); err != nil {
+			return nil, croType.StatusNetworkCreateError, errorUtil.Wrapf(err, "failed to create private service connect endpoint address %s", endpointName)
+		}
+		return nil, croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)), nil
+	}
+	if address.GetStatus() == computepb.Address_RESERVING.String() {
+		return nil, croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)), nil
+	}
+
+	rule, err := n.getEndpointForwardingRule(ctx, endpointName, region)
+	if err != nil {
+		return nil, croType.StatusNetworkCreateError, err
+	}
+	if rule == nil {
+		n.Logger.Infof("creating private service connect endpoint %s for service attachment %s", endpointName, serviceAttachment)
+		if err := n.ForwardingRuleApi.Insert(ctx, &computepb.InsertForwardingRuleRequest{
+			Project: n.ProjectID,
+			Region:  region,
+			ForwardingRuleResource: &computepb.ForwardingRule{
+				Name:      utils.To(endpointName),
+				Network:   clusterVpc.SelfLink,
+				IPAddress: address.SelfLink,
+				Target:    utils.To(serviceAttachment),
+			},
+		}); err != nil {
+			return nil, croType.StatusNetworkCreateError, errorUtil.Wrapf(err, "failed to create private service connect endpoint %s", endpointName)
+		}
+		return nil, croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)), nil
+	}
+	switch rule.GetPscConnectionStatus() {
+	case privateServiceConnectStatusAccepted:
+		return address, croType.StatusEmpty, nil
+	case privateServiceConnectStatusRejected, privateServiceConnectStatusNeedsAttention:
+		return nil, croType.StatusNetworkCreateError, errorUtil.Errorf("private service connect endpoint %s connection is %s, the project %s must be allowed to connect to service attachment %s", endpointName, rule.GetPscConnectionStatus(), n.ProjectID, serviceAttachment)
+	}
+	return nil, croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)), nil
+}
+
+// DeletePrivateServiceConnectEndpoint removes the forwarding rule and the address of the private service connect
+// endpoint of a cloud resource, true is returned once both are removed
+func (n *NetworkProvider) DeletePrivateServiceConnectEndpoint(ctx context.Context, name string, region string) (bool, error) {
+	endpointName := buildPrivateServiceConnectEndpointName(name)
+	rule, err := n.getEndpointForwardingRule(ctx, endpointName, region)
+	if err != nil {
+		return false, err
+	}
+	if rule != nil {
+		n.Logger.Infof("deleting private service connect endpoint %s", endpointName)
+		if err := n.ForwardingRuleApi.Delete(ctx, &computepb.DeleteForwardingRuleRequest{
+			Project:        n.ProjectID,
+			Region:         region,
+			ForwardingRule: endpointName,
+		}); err != nil && !resources.IsNotFoundError(err) {
+			return false, errorUtil.Wrapf(err, "failed to delete private service connect endpoint %s", endpointName)
+		}
+		return false, nil
+	}
+	address, err := n.getEndpointAddress(ctx, endpointName, region)
+	if err != nil {
+		return false, err
+	}
+	if address != nil {
+		n.Logger.Infof("deleting private service connect endpoint address %s", endpointName)
+		if err := n.ForwardingRuleApi.DeleteAddress(ctx, &computepb.DeleteAddressRequest{
+			Project: n.ProjectID,
+			Region:  region,
+			Address: endpointName,
+		}); err != nil && !resources.IsNotFoundError(err) {
+			return false, errorUtil.Wrapf(err, "failed to delete private service connect endpoint address %s", endpointName)
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (n *NetworkProvider) getEndpointAddress(ctx context.Context, name string, region string) (*computepb.Address, error) {
+	address, err := n.ForwardingRuleApi.GetAddress(ctx, &computepb.GetAddressRequest{
+		Project: n.ProjectID,
+		Region:  region,
+		Address: name,
+	})
+	if err != nil {
+		if resources.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unexpected error getting private service connect endpoint address %s from gcp: %w", name, err)
+	}
+	return address, nil
+}
+
+func (n *NetworkProvider) getEndpointForwardingRule(ctx context.Context, name string, region string) (*computepb.ForwardingRule, error) {
+	rule, err := n.ForwardingRuleApi.Get(ctx, &computepb.GetForwardingRuleRequest{
+		Project:        n.ProjectID,
+		Region:         region,
+		ForwardingRule: name,
+	})
+	if err != nil {
+		if resources.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unexpected error getting private service connect endpoint %s from gcp: %w", name, err)
+	}
+	return rule, nil
+}
+
+// getEndpointSubnet returns the cluster subnet of the region the endpoint address is reserved in, the subnet of the
+// compute nodes is preferred
+func (n *NetworkProvider) getEndpointSubnet(ctx context.Context, clusterVpc *computepb.Network, region string) (*computepb.Subnetwork, error) {
+	subnets, err := n.getClusterSubnets(ctx, clusterVpc)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster subnetworks")
+	}
+	var found *computepb.Subnetwork
+	for _, subnet := range subnets {
+		if parseRegionFromUrl(subnet.GetRegion()) != region {
+			continue
+		}
+		if strings.Contains(subnet.GetName(), defaultPrivateServiceConnectWorkerSubnet) {
+			return subnet, nil
+		}
+		if found == nil {
+			found = subnet
+		}
+	}
+	if found == nil {
+		return nil, errorUtil.Errorf("no cluster subnetwork found in region %s", region)
+	}
+	return found, nil
+}
+
+func buildPrivateServiceConnectEndpointName(name string) string {
+	return fmt.Sprintf("%s-%s", name, defaultPrivateServiceConnectPostfix)
+}
+
+// parseRegionFromUrl returns the region of a regional resource url or name, such as a subnetwork or a service
+// attachment, a region name is returned unchanged
+func parseRegionFromUrl(link string) string {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	path := strings.Split(parsed.Path, "/")
+	for i := range path {
+		if path[i] == "regions" && i+1 < len(path) {
+			return path[i+1]
+		}
+	}
+	return link
+}
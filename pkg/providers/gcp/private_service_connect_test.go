@@ -0,0 +1,268 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/googleapi"
+	utils "k8s.io/utils/ptr"
+)
+
+const (
+	gcpTestServiceAttachment = "projects/tenant-project/regions/" + gcpTestRegion + "/serviceAttachments/a-1"
+)
+
+func TestNetworkProvider_GetNetworkConnectionMethod(t *testing.T) {
+	tests := []struct {
+		name           string
+		createStrategy string
+		want           NetworkConnectionMethod
+		wantErr        bool
+	}{
+		{
+			name:           "service networking is used by default",
+			createStrategy: `{"CidrBlock":"10.1.0.0/22"}`,
+			want:           NetworkConnectionMethodServiceNetworking,
+		},
+		{
+			name:           "private service connect is read from the strategy",
+			createStrategy: `{"ConnectionMethod":"privateServiceConnect"}`,
+			want:           NetworkConnectionMethodPrivateServiceConnect,
+		},
+		{
+			name:           "unsupported connection method",
+			createStrategy: `{"ConnectionMethod":"vpn"}`,
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configManager := &ConfigManagerMock{
+				ReadStorageStrategyFunc: func(ctx context.Context, rt providers.ResourceType, tier string) (*StrategyConfig, error) {
+					return &StrategyConfig{CreateStrategy: json.RawMessage(tt.createStrategy)}, nil
+				},
+			}
+			n := &NetworkProvider{Logger: logrus.NewEntry(logrus.StandardLogger())}
+			got, err := n.GetNetworkConnectionMethod(context.TODO(), configManager, "development")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNetworkConnectionMethod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GetNetworkConnectionMethod() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkProvider_CreatePrivateServiceConnectEndpoint(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	endpointName := buildPrivateServiceConnectEndpointName(testName)
+	tests := []struct {
+		name              string
+		forwardingRuleApi *gcpiface.MockForwardingRulesClient
+		wantAddress       bool
+		wantMsg           croType.StatusMessage
+		wantSubnet        string
+		wantErr           bool
+	}{
+		{
+			name:              "address is reserved in the worker subnet",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(nil),
+			wantMsg:           croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)),
+			wantSubnet:        fmt.Sprintf("%s-worker-subnet", gcpTestClusterName),
+		},
+		{
+			name: "forwarding rule is created to the service attachment",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetAddressFn = func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+					return &computepb.Address{Name: utils.To(req.Address), Status: utils.To(computepb.Address_RESERVED.String())}, nil
+				}
+			}),
+			wantMsg: croType.StatusMessage(fmt.Sprintf(privateServiceConnectEndpointPendingMessage, endpointName)),
+		},
+		{
+			name: "endpoint address is returned once the connection is accepted",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetAddressFn = func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+					return &computepb.Address{Name: utils.To(req.Address), Address: utils.To("10.0.32.5"), Status: utils.To(computepb.Address_IN_USE.String())}, nil
+				}
+				forwardingRulesClient.GetFn = func(req *computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error) {
+					return &computepb.ForwardingRule{Name: utils.To(req.ForwardingRule), PscConnectionStatus: utils.To(privateServiceConnectStatusAccepted)}, nil
+				}
+			}),
+			wantAddress: true,
+		},
+		{
+			name: "error when the connection is rejected",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetAddressFn = func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+					return &computepb.Address{Name: utils.To(req.Address), Status: utils.To(computepb.Address_IN_USE.String())}, nil
+				}
+				forwardingRulesClient.GetFn = func(req *computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error) {
+					return &computepb.ForwardingRule{Name: utils.To(req.ForwardingRule), PscConnectionStatus: utils.To(privateServiceConnectStatusRejected)}, nil
+				}
+			}),
+			wantMsg: croType.StatusNetworkCreateError,
+			wantErr: true,
+		},
+		{
+			name: "error getting endpoint address",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetAddressFn = func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+					return nil, &googleapi.Error{Code: http.StatusBadGateway}
+				}
+			}),
+			wantMsg: croType.StatusNetworkCreateError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var insertedSubnet, insertedTarget string
+			tt.forwardingRuleApi.InsertAddressFn = func(req *computepb.InsertAddressRequest) error {
+				insertedSubnet = req.AddressResource.GetSubnetwork()
+				return nil
+			}
+			tt.forwardingRuleApi.InsertFn = func(req *computepb.InsertForwardingRuleRequest) error {
+				insertedTarget = req.ForwardingRuleResource.GetTarget()
+				return nil
+			}
+			n := &NetworkProvider{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
+				NetworkApi: gcpiface.GetMockNetworksClient(func(networksClient *gcpiface.MockNetworksClient) {
+					networksClient.ListFn = buildValidGcpListNetworks
+				}),
+				SubnetApi: gcpiface.GetMockSubnetsClient(func(subnetClient *gcpiface.MockSubnetsClient) {
+					subnetClient.GetFn = func(req *computepb.GetSubnetworkRequest) (*computepb.Subnetwork, error) {
+						return &computepb.Subnetwork{
+							Name:     utils.To(req.Subnetwork),
+							Region:   utils.To(req.Region),
+							SelfLink: utils.To(req.Subnetwork),
+						}, nil
+					}
+				}),
+				ForwardingRuleApi: tt.forwardingRuleApi,
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				ProjectID:         gcpTestProjectId,
+			}
+			address, msg, err := n.CreatePrivateServiceConnectEndpoint(context.TODO(), testName, gcpTestRegion, gcpTestServiceAttachment)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreatePrivateServiceConnectEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if msg != tt.wantMsg {
+				t.Errorf("CreatePrivateServiceConnectEndpoint() msg = %s, want %s", msg, tt.wantMsg)
+			}
+			if (address != nil) != tt.wantAddress {
+				t.Errorf("CreatePrivateServiceConnectEndpoint() address = %v, wantAddress %v", address, tt.wantAddress)
+			}
+			if insertedSubnet != tt.wantSubnet {
+				t.Errorf("CreatePrivateServiceConnectEndpoint() address reserved in subnet %s, want %s", insertedSubnet, tt.wantSubnet)
+			}
+			if insertedTarget != "" && insertedTarget != gcpTestServiceAttachment {
+				t.Errorf("CreatePrivateServiceConnectEndpoint() forwarding rule target = %s, want %s", insertedTarget, gcpTestServiceAttachment)
+			}
+		})
+	}
+}
+
+func TestNetworkProvider_DeletePrivateServiceConnectEndpoint(t *testing.T) {
+	tests := []struct {
+		name              string
+		forwardingRuleApi *gcpiface.MockForwardingRulesClient
+		want              bool
+		wantErr           bool
+	}{
+		{
+			name: "forwarding rule is deleted first",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetFn = func(req *computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error) {
+					return &computepb.ForwardingRule{Name: utils.To(req.ForwardingRule)}, nil
+				}
+			}),
+		},
+		{
+			name: "address is deleted once the forwarding rule is removed",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetAddressFn = func(req *computepb.GetAddressRequest) (*computepb.Address, error) {
+					return &computepb.Address{Name: utils.To(req.Address)}, nil
+				}
+			}),
+		},
+		{
+			name:              "endpoint is deleted",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(nil),
+			want:              true,
+		},
+		{
+			name: "error deleting forwarding rule",
+			forwardingRuleApi: gcpiface.GetMockForwardingRulesClient(func(forwardingRulesClient *gcpiface.MockForwardingRulesClient) {
+				forwardingRulesClient.GetFn = func(req *computepb.GetForwardingRuleRequest) (*computepb.ForwardingRule, error) {
+					return &computepb.ForwardingRule{Name: utils.To(req.ForwardingRule)}, nil
+				}
+				forwardingRulesClient.DeleteFn = func(req *computepb.DeleteForwardingRuleRequest) error {
+					return &googleapi.Error{Code: http.StatusBadGateway}
+				}
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &NetworkProvider{
+				ForwardingRuleApi: tt.forwardingRuleApi,
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				ProjectID:         gcpTestProjectId,
+			}
+			got, err := n.DeletePrivateServiceConnectEndpoint(context.TODO(), testName, gcpTestRegion)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DeletePrivateServiceConnectEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DeletePrivateServiceConnectEndpoint() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRegionFromUrl(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{
+			name: "region of a subnetwork url",
+			link: fmt.Sprintf(gcpTestSubnetURL, "subnet"),
+			want: gcpTestRegion,
+		},
+		{
+			name: "region of a service attachment",
+			link: gcpTestServiceAttachment,
+			want: gcpTestRegion,
+		},
+		{
+			name: "region name is unchanged",
+			link: gcpTestRegion,
+			want: gcpTestRegion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRegionFromUrl(tt.link); got != tt.want {
+				t.Errorf("parseRegionFromUrl() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
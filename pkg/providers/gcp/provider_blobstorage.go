@@ -7,6 +7,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"time"
 )
@@ -17,14 +18,20 @@ type BlobStorageProvider struct {
 	Client            client.Client
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
 }
 
-func NewGCPBlobStorageProvider(client client.Client) *BlobStorageProvider {
+func NewGCPBlobStorageProvider(client client.Client, recorder record.EventRecorder) (*BlobStorageProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &BlobStorageProvider{
 		Client:            client,
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
-	}
+		Recorder:          recorder,
+	}, nil
 }
 
 func (bsp BlobStorageProvider) GetName() string {
@@ -43,9 +50,10 @@ func (bsp BlobStorageProvider) GetReconcileTime(bs *v1alpha1.BlobStorage) time.D
 }
 
 func (bsp BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.BlobStorage) (*providers.BlobStorageInstance, types.StatusMessage, error) {
-	_, err := bsp.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace)
+	_, err := bsp.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace, providers.BlobStorageResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile gcp blob storage provider credentials for blob storage instance %s", bs.Name)
+		resources.RecordWarningEvent(bsp.Recorder, bs, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return nil, types.StatusMessage(errMsg), fmt.Errorf("%s: %w", errMsg, err)
 	}
 	// TODO implement me
@@ -53,9 +61,10 @@ func (bsp BlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.B
 }
 
 func (bsp BlobStorageProvider) DeleteStorage(ctx context.Context, bs *v1alpha1.BlobStorage) (types.StatusMessage, error) {
-	_, err := bsp.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace)
+	_, err := bsp.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace, providers.BlobStorageResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile gcp blob storage provider credentials for blob storage instance %s", bs.Name)
+		resources.RecordWarningEvent(bsp.Recorder, bs, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return types.StatusMessage(errMsg), fmt.Errorf("%s: %w", errMsg, err)
 	}
 	// TODO implement me
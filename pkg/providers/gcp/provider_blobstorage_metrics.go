@@ -0,0 +1,142 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	blobStorageMetricProviderName   = "gcp-storage-monitoring"
+	blobStorageMetricFilterTemplate = "resource.type=%q resource.labels.bucket_name=%q metric.type=%q"
+
+	// GCSServerErrorCountMetric is storage.googleapis.com/api/request_count restricted to requests which failed with a server error
+	GCSServerErrorCountMetric = "storage.googleapis.com/api/request_count/server_error"
+	gcsRequestCountMetric     = "storage.googleapis.com/api/request_count"
+	gcsServerErrorFilter      = `metric.labels.response_code=one_of("INTERNAL","UNAVAILABLE","DEADLINE_EXCEEDED","UNKNOWN")`
+)
+
+var _ providers.BlobStorageMetricsProvider = (*BlobStorageMetricsProvider)(nil)
+
+type BlobStorageMetricsProvider struct {
+	Client            client.Client
+	Logger            *logrus.Entry
+	CredentialManager CredentialManager
+	ConfigManager     ConfigManager
+}
+
+func NewGCPBlobStorageMetricsProvider(client client.Client, logger *logrus.Entry) (*BlobStorageMetricsProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobStorageMetricsProvider{
+		Client:            client,
+		Logger:            logger.WithFields(logrus.Fields{"providers": blobStorageMetricProviderName}),
+		CredentialManager: cm,
+		ConfigManager:     NewDefaultConfigManager(client),
+	}, nil
+}
+
+func (p *BlobStorageMetricsProvider) SupportsStrategy(strategy string) bool {
+	return strategy == providers.GCPDeploymentStrategy
+}
+
+func (p *BlobStorageMetricsProvider) ScrapeBlobStorageMetrics(ctx context.Context, bs *v1alpha1.BlobStorage, metricTypes []providers.CloudProviderMetricType) (*providers.ScrapeMetricsData, error) {
+	p.Logger.Infof("reconciling blob storage metrics for bucket %s", bs.Name)
+	strategyConfig, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.BlobStorageResourceType, bs.Spec.Tier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve blob storage strategy config: %w", err)
+	}
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, bs.Namespace, providers.BlobStorageResourceType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile blob storage provider credentials: %w", err)
+	}
+	metricClient, err := gcpiface.NewMetricAPI(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise metric client: %w", err)
+	}
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster id: %w", err)
+	}
+	bucketName := annotations.Get(bs, ResourceIdentifierAnnotation)
+	if bucketName == "" {
+		bucketName, err = resources.BuildResourceIdentifier(ctx, p.Client, bs.ObjectMeta, bs.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
+		if err != nil {
+			return nil, fmt.Errorf("error building bucket name: %w", err)
+		}
+	}
+	metrics := p.scrapeGCSMetrics(ctx, metricClient, fmt.Sprintf("projects/%s", strategyConfig.ProjectID), bucketName,
+		resources.BuildGenericMetricLabels(bs.ObjectMeta, clusterID, bucketName, blobstorageProviderName), metricTypes)
+	return &providers.ScrapeMetricsData{
+		Metrics: metrics,
+	}, nil
+}
+
+// scrapeGCSMetrics returns the most recent value of each metric
+// gcs storage metrics are sampled daily, so a metric which has no data yet is logged and skipped
+func (p *BlobStorageMetricsProvider) scrapeGCSMetrics(ctx context.Context, metricClient gcpiface.MetricApi, projectID, bucketName string, labels map[string]string, metricTypes []providers.CloudProviderMetricType) []*providers.GenericCloudMetric {
+	metrics := make([]*providers.GenericCloudMetric, 0)
+	for _, metricType := range metricTypes {
+		period := metricType.GetPeriodOrDefault(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration))
+		timeSeries, err := metricClient.ListTimeSeries(ctx, &monitoringpb.ListTimeSeriesRequest{
+			Name:   projectID,
+			Filter: buildGCSMetricFilter(bucketName, metricType.ProviderMetricName),
+			Aggregation: &monitoringpb.Aggregation{
+				PerSeriesAligner: monitoringpb.Aggregation_Aligner(monitoringpb.Aggregation_Aligner_value[metricType.Statistic]),
+				AlignmentPeriod:  durationpb.New(period),
+				// request metrics are reported in a time series per method and response code
+				CrossSeriesReducer: monitoringpb.Aggregation_REDUCE_SUM,
+			},
+			// two full periods are requested to ensure the most recent aligned point is included
+			Interval: &monitoringpb.TimeInterval{
+				StartTime: timestamppb.New(time.Now().Add(-2 * period)),
+				EndTime:   timestamppb.Now(),
+			},
+			View: monitoringpb.ListTimeSeriesRequest_FULL,
+		})
+		if err != nil {
+			p.Logger.Infof("no data available for blob storage metric %s: %v", metricType.PrometheusMetricName, err)
+			continue
+		}
+		if len(timeSeries) == 0 || len(timeSeries[0].Points) == 0 {
+			p.Logger.Infof("no data points available for blob storage metric %s", metricType.PrometheusMetricName)
+			continue
+		}
+		// points are returned in reverse time order
+		metrics = append(metrics, &providers.GenericCloudMetric{
+			Name:   metricType.PrometheusMetricName,
+			Labels: labels,
+			Value:  getPointValue(timeSeries[0].Points[0]),
+		})
+	}
+	return metrics
+}
+
+func buildGCSMetricFilter(bucketName, providerMetricName string) string {
+	if providerMetricName == GCSServerErrorCountMetric {
+		return fmt.Sprintf(blobStorageMetricFilterTemplate, resources.MonitoringResourceTypeGcsBucket, bucketName, gcsRequestCountMetric) + " " + gcsServerErrorFilter
+	}
+	return fmt.Sprintf(blobStorageMetricFilterTemplate, resources.MonitoringResourceTypeGcsBucket, bucketName, providerMetricName)
+}
+
+// getPointValue returns the value of a point, aligned values are doubles unless the aligner keeps the metric value type
+func getPointValue(point *monitoringpb.Point) float64 {
+	if value, ok := point.Value.GetValue().(*monitoringpb.TypedValue_Int64Value); ok {
+		return float64(value.Int64Value)
+	}
+	return point.Value.GetDoubleValue()
+}
@@ -0,0 +1,94 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"github.com/sirupsen/logrus"
+)
+
+func buildTestTimeSeries(values ...*monitoringpb.TypedValue) []*monitoringpb.TimeSeries {
+	var points []*monitoringpb.Point
+	for _, value := range values {
+		points = append(points, &monitoringpb.Point{Value: value})
+	}
+	return []*monitoringpb.TimeSeries{{Points: points}}
+}
+
+func TestBlobStorageMetricsProvider_scrapeGCSMetrics(t *testing.T) {
+	metricTypes := []providers.CloudProviderMetricType{
+		{PrometheusMetricName: resources.BlobStorageSizeBytesAverageMetricName, ProviderMetricName: "storage.googleapis.com/storage/total_bytes", Statistic: monitoringpb.Aggregation_ALIGN_MEAN.String()},
+		{PrometheusMetricName: resources.BlobStorageServerErrorsSumMetricName, ProviderMetricName: GCSServerErrorCountMetric, Statistic: monitoringpb.Aggregation_ALIGN_SUM.String()},
+	}
+	tests := []struct {
+		name         string
+		metricClient gcpiface.MetricApi
+		want         map[string]float64
+	}{
+		{
+			name: "test most recent value is returned for each gcs metric",
+			metricClient: gcpiface.GetMockMetricClient(func(metricClient *gcpiface.MockMetricClient) {
+				metricClient.ListTimeSeriesFn = func(ctx context.Context, req *monitoringpb.ListTimeSeriesRequest, opts ...gax.CallOption) ([]*monitoringpb.TimeSeries, error) {
+					if strings.Contains(req.Filter, "response_code") {
+						return buildTestTimeSeries(&monitoringpb.TypedValue{Value: &monitoringpb.TypedValue_Int64Value{Int64Value: 3}}), nil
+					}
+					return buildTestTimeSeries(
+						&monitoringpb.TypedValue{Value: &monitoringpb.TypedValue_DoubleValue{DoubleValue: 2048}},
+						&monitoringpb.TypedValue{Value: &monitoringpb.TypedValue_DoubleValue{DoubleValue: 1024}},
+					), nil
+				}
+			}),
+			want: map[string]float64{
+				resources.BlobStorageSizeBytesAverageMetricName: 2048,
+				resources.BlobStorageServerErrorsSumMetricName:  3,
+			},
+		},
+		{
+			name: "test metrics without data are skipped",
+			metricClient: gcpiface.GetMockMetricClient(func(metricClient *gcpiface.MockMetricClient) {
+				metricClient.ListTimeSeriesFn = func(ctx context.Context, req *monitoringpb.ListTimeSeriesRequest, opts ...gax.CallOption) ([]*monitoringpb.TimeSeries, error) {
+					if strings.Contains(req.Filter, "response_code") {
+						return nil, errors.New("could not find any time series")
+					}
+					return buildTestTimeSeries(), nil
+				}
+			}),
+			want: map[string]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &BlobStorageMetricsProvider{
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			}
+			got := p.scrapeGCSMetrics(context.TODO(), tt.metricClient, "projects/test", "test-bucket", map[string]string{}, metricTypes)
+			if len(got) != len(tt.want) {
+				t.Fatalf("scrapeGCSMetrics() got %d metrics, want %d", len(got), len(tt.want))
+			}
+			for _, metric := range got {
+				if metric.Value != tt.want[metric.Name] {
+					t.Errorf("scrapeGCSMetrics() metric %s = %v, want %v", metric.Name, metric.Value, tt.want[metric.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildGCSMetricFilter(t *testing.T) {
+	got := buildGCSMetricFilter("test-bucket", "storage.googleapis.com/storage/total_bytes")
+	want := `resource.type="gcs_bucket" resource.labels.bucket_name="test-bucket" metric.type="storage.googleapis.com/storage/total_bytes"`
+	if got != want {
+		t.Errorf("buildGCSMetricFilter() = %s, want %s", got, want)
+	}
+	got = buildGCSMetricFilter("test-bucket", GCSServerErrorCountMetric)
+	if !strings.Contains(got, `metric.type="storage.googleapis.com/api/request_count"`) || !strings.Contains(got, gcsServerErrorFilter) {
+		t.Errorf("buildGCSMetricFilter() = %s, want request count filtered by server error response codes", got)
+	}
+}
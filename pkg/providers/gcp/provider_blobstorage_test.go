@@ -2,7 +2,9 @@ package gcp
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,32 +17,59 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestNewGCPBlobStorageProvider(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
 	type args struct {
 		client client.Client
 	}
 	tests := []struct {
-		name string
-		args args
-		want *BlobStorageProvider
+		name    string
+		args    args
+		wantErr bool
 	}{
 		{
-			name: "placeholder test",
-			args: args{},
-			want: &BlobStorageProvider{
-				Client:            nil,
-				CredentialManager: NewCredentialMinterCredentialManager(nil),
-				ConfigManager:     NewDefaultConfigManager(nil),
+			name: "successfully create new blob storage provider",
+			args: args{
+				client: moqClient.NewSigsClientMoqWithScheme(scheme),
 			},
 		},
+		{
+			name: "fail to create new blob storage provider",
+			args: args{
+				client: func() client.Client {
+					mc := moqClient.NewSigsClientMoqWithScheme(scheme)
+					mc.GetFunc = func(ctx context.Context, key k8sTypes.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+						return errors.New("generic error")
+					}
+					return mc
+				}(),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewGCPBlobStorageProvider(tt.args.client); !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("NewGCPBlobStorageProvider() = %v, want %v", got, tt.want)
+			got, err := NewGCPBlobStorageProvider(tt.args.client, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewGCPBlobStorageProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := &BlobStorageProvider{
+				Client:            tt.args.client,
+				CredentialManager: NewCredentialMinterCredentialManager(tt.args.client),
+				ConfigManager:     NewDefaultConfigManager(tt.args.client),
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("NewGCPBlobStorageProvider() = %v, want %v", got, want)
 			}
 		})
 	}
@@ -68,6 +97,7 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 		blobStorageInstance *providers.BlobStorageInstance
 		statusMessage       types.StatusMessage
 		wantErr             bool
+		wantEvent           string
 	}{
 		{
 			name: "failure creating blob storage",
@@ -86,6 +116,7 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 			blobStorageInstance: nil,
 			statusMessage:       "failed to reconcile gcp blob storage provider credentials for blob storage instance " + blobstorageProviderName,
 			wantErr:             true,
+			wantEvent:           "Warning CredentialsFailed",
 		},
 		{
 			name: "success creating blob storage",
@@ -127,7 +158,13 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			bsp := NewGCPBlobStorageProvider(tt.fields.Client)
+			recorder := record.NewFakeRecorder(10)
+			bsp := &BlobStorageProvider{
+				Client:            tt.fields.Client,
+				CredentialManager: NewCredentialMinterCredentialManager(tt.fields.Client),
+				ConfigManager:     NewDefaultConfigManager(tt.fields.Client),
+				Recorder:          recorder,
+			}
 			blobStorageInstance, statusMessage, err := bsp.CreateStorage(tt.args.ctx, tt.args.bs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateStorage() error = %v, wantErr %v", err, tt.wantErr)
@@ -139,6 +176,16 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 			if statusMessage != tt.statusMessage {
 				t.Errorf("CreateStorage() statusMessage = %v, want %v", statusMessage, tt.statusMessage)
 			}
+			select {
+			case event := <-recorder.Events:
+				if tt.wantEvent == "" || !strings.HasPrefix(event, tt.wantEvent) {
+					t.Errorf("CreateStorage() event = %v, want %v", event, tt.wantEvent)
+				}
+			default:
+				if tt.wantEvent != "" {
+					t.Errorf("CreateStorage() recorded no event, want %v", tt.wantEvent)
+				}
+			}
 		})
 	}
 }
@@ -221,7 +268,12 @@ func TestBlobStorageProvider_DeleteStorage(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			bsp := NewGCPBlobStorageProvider(tt.fields.Client)
+			bsp := &BlobStorageProvider{
+				Client:            tt.fields.Client,
+				CredentialManager: NewCredentialMinterCredentialManager(tt.fields.Client),
+				ConfigManager:     NewDefaultConfigManager(tt.fields.Client),
+				Recorder:          record.NewFakeRecorder(10),
+			}
 			statusMessage, err := bsp.DeleteStorage(tt.args.ctx, tt.args.bs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeleteStorage() error = %v, wantErr %v", err, tt.wantErr)
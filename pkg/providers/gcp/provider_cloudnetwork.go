@@ -0,0 +1,248 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/internal/k8sutil"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/option"
+	"google.golang.org/api/servicenetworking/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	cloudNetworkProviderName = "gcp-vpc"
+)
+
+var _ providers.CloudNetworkProvider = (*CloudNetworkProvider)(nil)
+
+// CloudNetworkProvider reconciles the ip address range reserved in the cluster vpc for postgres and redis cloud
+// resources and the service networking connection peering it with the cluster vpc
+type CloudNetworkProvider struct {
+	Client            client.Client
+	Logger            *logrus.Entry
+	CredentialManager CredentialManager
+	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
+}
+
+func NewGCPCloudNetworkProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*CloudNetworkProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
+	return &CloudNetworkProvider{
+		Client:            client,
+		Logger:            logger.WithFields(logrus.Fields{"provider": cloudNetworkProviderName}),
+		CredentialManager: cm,
+		ConfigManager:     NewDefaultConfigManager(client),
+		Recorder:          recorder,
+	}, nil
+}
+
+func (p *CloudNetworkProvider) GetName() string {
+	return cloudNetworkProviderName
+}
+
+func (p *CloudNetworkProvider) SupportsStrategy(deploymentStrategy string) bool {
+	return deploymentStrategy == providers.GCPDeploymentStrategy
+}
+
+func (p *CloudNetworkProvider) GetReconcileTime(cn *v1alpha1.CloudNetwork) time.Duration {
+	if cn.Status.Phase != croType.PhaseComplete {
+		return time.Second * 60
+	}
+	return resources.GetForcedReconcileTimeOrDefault(defaultReconcileTime)
+}
+
+// ReconcileCloudNetwork reserves the ip address range from the `_network` strategy of the cloud network tier and
+// connects it to the cluster vpc, the range and connection are reported in the status
+func (p *CloudNetworkProvider) ReconcileCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (*providers.CloudNetworkInstance, croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "ReconcileCloudNetwork")
+	logger.Infof("reconciling cloud network %s", cn.Name)
+
+	// the network is only deleted once no cloud resources are created in it
+	if err := resources.CreateFinalizer(ctx, p.Client, cn, DefaultFinalizer); err != nil {
+		return nil, "failed to set finalizer", err
+	}
+	networkManager, msg, err := p.getNetworkManager(ctx, cn, logger)
+	if err != nil {
+		return nil, msg, err
+	}
+
+	method, err := networkManager.GetNetworkConnectionMethod(ctx, p.ConfigManager, cn.Spec.Tier)
+	if err != nil {
+		msg := "failed to get network connection method"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	// private service connect endpoints are created in the cluster subnets for each cloud resource, the network has
+	// no ip address range or connection of its own
+	if method == NetworkConnectionMethodPrivateServiceConnect {
+		cn.Status.NetworkID = ""
+		cn.Status.IPRange = ""
+		cn.Status.CidrBlock = ""
+		cn.Status.ConnectionMethod = string(method)
+		cn.Status.ConnectionID = ""
+		cn.Status.ConnectionState = ""
+		return &providers.CloudNetworkInstance{}, croType.StatusMessage("private service connect endpoints are created in the cluster vpc for each cloud resource"), nil
+	}
+
+	address, service, msg, err := reconcileNetworkIpRange(ctx, networkManager, p.ConfigManager, cn.Spec.Tier)
+	if address != nil {
+		cn.Status.NetworkID = address.GetNetwork()
+		cn.Status.IPRange = address.GetName()
+		cn.Status.CidrBlock = fmt.Sprintf("%s/%d", address.GetAddress(), address.GetPrefixLength())
+	}
+	if err != nil || service == nil {
+		return nil, msg, err
+	}
+	cn.Status.ConnectionMethod = defaultServiceConnectionURI
+	cn.Status.ConnectionID = service.Peering
+	cn.Status.ConnectionState = ""
+	if address != nil {
+		cn.Status.ConnectionState = address.GetStatus()
+	}
+	return &providers.CloudNetworkInstance{NetworkID: cn.Status.NetworkID}, croType.StatusMessage(fmt.Sprintf("ip address range %s connected to the cluster vpc", cn.Status.IPRange)), nil
+}
+
+// DeleteCloudNetwork removes the service networking connection, its peering and the ip address range, deletion is
+// blocked while postgres or redis cloud resources are created in the network
+func (p *CloudNetworkProvider) DeleteCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (croType.StatusMessage, error) {
+	logger := p.Logger.WithField("action", "DeleteCloudNetwork")
+	logger.Infof("reconciling cloud network %s", cn.Name)
+
+	inUse, err := resources.CloudNetworkInUse(ctx, p.Client, providers.GCPDeploymentStrategy)
+	if err != nil {
+		msg := "failed to check cloud resources created in the cloud network"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if inUse > 0 {
+		msg := fmt.Sprintf("deletion blocked, %d postgres and redis resources are created in the cloud network", inUse)
+		resources.RecordWarningEvent(p.Recorder, cn, resources.EventReasonDeletionBlocked, "%s", msg)
+		return croType.StatusMessage(msg), nil
+	}
+
+	networkManager, msg, err := p.getNetworkManager(ctx, cn, logger)
+	if err != nil {
+		return msg, err
+	}
+	if msg, err = deleteNetworkComponents(ctx, networkManager); err != nil || msg != croType.StatusEmpty {
+		return msg, err
+	}
+
+	if err = resources.DeleteFinalizer(ctx, p.Client, cn, DefaultFinalizer); err != nil {
+		msg := "failed to update instance as part of finalizer reconcile"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	return croType.StatusEmpty, nil
+}
+
+// getNetworkManager returns a network manager using the provider credentials of the operator namespace and the project
+// of the `_network` strategy of the cloud network tier
+func (p *CloudNetworkProvider) getNetworkManager(ctx context.Context, cn *v1alpha1.CloudNetwork, logger *logrus.Entry) (NetworkManager, croType.StatusMessage, error) {
+	strategyConfig, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.NetworkResourceType, cn.Spec.Tier)
+	if err != nil {
+		msg := "failed to retrieve _network strategy config"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	// the cloud network is cluster scoped, the provider credentials of the operator namespace are used
+	ns, err := k8sutil.GetOperatorNamespace()
+	if err != nil {
+		msg := "failed to get operator namespace"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, ns, providers.NetworkResourceType)
+	if err != nil {
+		msg := "failed to reconcile gcp provider credentials for cloud network"
+		resources.RecordWarningEvent(p.Recorder, cn, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	networkManager, err := NewNetworkManager(ctx, strategyConfig.ProjectID, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
+	if err != nil {
+		msg := "failed to initialise network manager"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	return networkManager, croType.StatusEmpty, nil
+}
+
+// reconcileNetwork reserves the ip address range cloud resources are created in and connects it to the cluster vpc.
+// the `_network` strategy of the cloud network tier is used when a cloud network exists, cloud resources wait for it
+// to be ready. no ip address range is reserved when cloud resources are connected with private service connect
+func reconcileNetwork(ctx context.Context, c client.Client, networkManager NetworkManager, configManager ConfigManager, tier string) (*computepb.Address, NetworkConnectionMethod, croType.StatusMessage, error) {
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, c)
+	if err != nil {
+		errMsg := "failed to get cloud network"
+		return nil, "", croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if cloudNetwork != nil {
+		if !resources.IsCloudNetworkReady(cloudNetwork) {
+			return nil, "", croType.StatusMessage(fmt.Sprintf("waiting for cloud network %s to become ready", cloudNetwork.Name)), nil
+		}
+		tier = cloudNetwork.Spec.Tier
+	}
+	method, err := networkManager.GetNetworkConnectionMethod(ctx, configManager, tier)
+	if err != nil {
+		errMsg := "failed to get network connection method"
+		return nil, "", croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if method == NetworkConnectionMethodPrivateServiceConnect {
+		return nil, method, croType.StatusEmpty, nil
+	}
+	address, _, msg, err := reconcileNetworkIpRange(ctx, networkManager, configManager, tier)
+	return address, method, msg, err
+}
+
+// reconcileNetworkIpRange reserves the ip address range from the `_network` strategy of the tier and creates the
+// service networking connection, a nil connection is returned with a status message while either is pending
+func reconcileNetworkIpRange(ctx context.Context, networkManager NetworkManager, configManager ConfigManager, tier string) (*computepb.Address, *servicenetworking.Connection, croType.StatusMessage, error) {
+	// get cidr block from _network strat map, based on the tier
+	ipRangeCidr, err := networkManager.ReconcileNetworkProviderConfig(ctx, configManager, tier)
+	if err != nil {
+		errMsg := "failed to reconcile network provider config"
+		return nil, nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	address, msg, err := networkManager.CreateNetworkIpRange(ctx, ipRangeCidr)
+	if err != nil || msg != "" {
+		return address, nil, msg, err
+	}
+	service, msg, err := networkManager.CreateNetworkService(ctx)
+	if err != nil || msg != "" {
+		return address, nil, msg, err
+	}
+	return address, service, croType.StatusEmpty, nil
+}
+
+// deleteNetworkComponents removes the peering, service networking connection and ip address range, a status message
+// is returned while their deletion is in progress
+func deleteNetworkComponents(ctx context.Context, networkManager NetworkManager) (croType.StatusMessage, error) {
+	if err := networkManager.DeleteNetworkPeering(ctx); err != nil {
+		msg := "failed to delete cluster network peering"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if err := networkManager.DeleteNetworkService(ctx); err != nil {
+		msg := "failed to delete cluster network service"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if err := networkManager.DeleteNetworkIpRange(ctx); err != nil {
+		msg := "failed to delete network IP range"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	exist, err := networkManager.ComponentsExist(ctx)
+	if err != nil {
+		msg := "failed to check if components exist"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if exist {
+		return croType.StatusMessage("network component deletion in progress"), nil
+	}
+	return croType.StatusEmpty, nil
+}
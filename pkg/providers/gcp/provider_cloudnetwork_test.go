@@ -0,0 +1,107 @@
+package gcp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"cloud.google.com/go/compute/apiv1/computepb"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"google.golang.org/api/servicenetworking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utils "k8s.io/utils/ptr"
+)
+
+func buildTestCloudNetwork(ready bool) *v1alpha1.CloudNetwork {
+	cn := &v1alpha1.CloudNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.CloudNetworkName},
+		Spec:       v1alpha1.CloudNetworkSpec{Tier: "development"},
+	}
+	resources.SetCloudNetworkReady(cn, ready, resources.CloudNetworkReasonReady, "")
+	return cn
+}
+
+func buildMockCloudNetworkManager(tier *string, method NetworkConnectionMethod) *NetworkManagerMock {
+	return &NetworkManagerMock{
+		GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, t string) (NetworkConnectionMethod, error) {
+			*tier = t
+			return method, nil
+		},
+		ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, t string) (*net.IPNet, error) {
+			*tier = t
+			return &net.IPNet{Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length)}, nil
+		},
+		CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
+			return &computepb.Address{Name: utils.To(gcpTestIpRangeName)}, "", nil
+		},
+		CreateNetworkServiceFunc: func(ctx context.Context) (*servicenetworking.Connection, types.StatusMessage, error) {
+			return &servicenetworking.Connection{}, "", nil
+		},
+	}
+}
+
+func TestReconcileNetwork(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name        string
+		objs        []runtime.Object
+		method      NetworkConnectionMethod
+		wantTier    string
+		wantAddress bool
+		wantMsg     types.StatusMessage
+	}{
+		{
+			name:        "test tier of the cloud resource used without a cloud network",
+			wantTier:    "production",
+			wantAddress: true,
+		},
+		{
+			name:        "test tier of the cloud network used when it is ready",
+			objs:        []runtime.Object{buildTestCloudNetwork(true)},
+			wantTier:    "development",
+			wantAddress: true,
+		},
+		{
+			name:     "test no ip address range reserved with private service connect",
+			method:   NetworkConnectionMethodPrivateServiceConnect,
+			wantTier: "production",
+		},
+		{
+			name:    "test cloud resources wait for the cloud network to be ready",
+			objs:    []runtime.Object{buildTestCloudNetwork(false)},
+			wantMsg: "waiting for cloud network cluster to become ready",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tier string
+			method := tt.method
+			if method == "" {
+				method = NetworkConnectionMethodServiceNetworking
+			}
+			address, gotMethod, msg, err := reconcileNetwork(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, tt.objs...), buildMockCloudNetworkManager(&tier, method), nil, "production")
+			if err != nil {
+				t.Fatalf("reconcileNetwork() error = %v", err)
+			}
+			if tt.wantMsg == "" && gotMethod != method {
+				t.Errorf("reconcileNetwork() method = %s, want %s", gotMethod, method)
+			}
+			if msg != tt.wantMsg {
+				t.Errorf("reconcileNetwork() msg = %s, want %s", msg, tt.wantMsg)
+			}
+			if (address != nil) != tt.wantAddress {
+				t.Errorf("reconcileNetwork() address = %v, wantAddress %v", address, tt.wantAddress)
+			}
+			if tier != tt.wantTier {
+				t.Errorf("reconcileNetwork() used tier %s, want %s", tier, tt.wantTier)
+			}
+		})
+	}
+}
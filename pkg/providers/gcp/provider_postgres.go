@@ -7,6 +7,7 @@ import (
 	"k8s.io/utils/ptr"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,7 +19,6 @@ import (
 	"cloud.google.com/go/compute/apiv1/computepb"
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
-	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	errorUtil "github.com/pkg/errors"
@@ -28,6 +28,8 @@ import (
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	v1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
@@ -59,20 +61,26 @@ type PostgresProvider struct {
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
 	TCPPinger         resources.ConnectionTester
+	Recorder          record.EventRecorder
 }
 
 type CreateInstanceRequest struct {
 	Instance *gcpiface.DatabaseInstance `json:"instance,omitempty"`
 }
 
-func NewGCPPostgresProvider(client client.Client, logger *logrus.Entry) *PostgresProvider {
+func NewGCPPostgresProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*PostgresProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &PostgresProvider{
 		Client:            client,
 		Logger:            logger.WithFields(logrus.Fields{"provider": postgresProviderName}),
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
 		TCPPinger:         resources.NewConnectionTestManager(),
-	}
+		Recorder:          recorder,
+	}, nil
 }
 
 func (p *PostgresProvider) GetName() string {
@@ -101,42 +109,60 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile gcp postgres provider credentials for postgres instance %s", pg.Name)
+		resources.RecordWarningEvent(p.Recorder, pg, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return nil, croType.StatusMessage(errMsg), fmt.Errorf("%s: %w", errMsg, err)
 	}
 
-	sqlClient, err := gcpiface.NewSQLAdminService(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Logger)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
 	if err != nil {
-		errMsg := "could not initialise new SQL Admin Service"
+		errMsg := "failed to build gcp client option from postgres strategy"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	networkProject, err := GetNetworkProjectFromStrategy(ctx, p.Client, strategyConfig)
+	if err != nil {
+		errMsg := "failed to get gcp network project"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	networkManager, err := NewNetworkManager(ctx, strategyConfig.ProjectID, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
+	sqlClient, err := gcpiface.NewSQLAdminService(ctx, clientOption, p.Logger)
 	if err != nil {
-		errMsg := "failed to initialise network manager"
+		errMsg := "could not initialise new SQL Admin Service"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
-	// get cidr block from _network strat map, based on tier from postgres cr
-	ipRangeCidr, err := networkManager.ReconcileNetworkProviderConfig(ctx, p.ConfigManager, pg.Spec.Tier)
+
+	projectsClient, err := gcpiface.NewProjectsAPI(ctx, clientOption)
 	if err != nil {
-		errMsg := "failed to reconcile network provider config"
+		errMsg := "could not initialise projects client"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
-	address, msg, err := networkManager.CreateNetworkIpRange(ctx, ipRangeCidr)
-	if err != nil || msg != "" {
-		return nil, msg, err
+
+	networkManager, err := NewNetworkManager(ctx, networkProject, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
+	if err != nil {
+		errMsg := "failed to initialise network manager"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
-	_, msg, err = networkManager.CreateNetworkService(ctx)
+	// reserve the ip range from the _network strat map, based on tier from postgres cr or the cloud network
+	address, method, msg, err := reconcileNetwork(ctx, p.Client, networkManager, p.ConfigManager, pg.Spec.Tier)
 	if err != nil || msg != "" {
 		return nil, msg, err
 	}
 
-	instance, statusMessage, err := p.reconcileCloudSQLInstance(ctx, pg, sqlClient, strategyConfig, address)
+	instance, statusMessage, err := p.reconcileCloudSQLInstance(ctx, pg, sqlClient, projectsClient, networkManager, strategyConfig, address, method)
 	if err != nil || instance == nil {
 		return nil, statusMessage, err
 	}
+	pdd, ok := instance.DeploymentDetails.(*providers.PostgresDeploymentDetails)
+	if !ok {
+		msg := "unexpected cloudSQL instance deployment details"
+		return nil, croType.StatusMessage(msg), errorUtil.New(msg)
+	}
+	if err := networkManager.ReconcileNetworkAccess(ctx, resources.GetResourceIdentifier(ctx, pg), pdd.Host, int32(pdd.Port), pg.Spec.NetworkAccess); err != nil {
+		msg := "failed to reconcile cloudSQL instance network access"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
 	if pg.Spec.SnapshotFrequency != "" && pg.Spec.SnapshotRetention != "" {
 		statusMessage, err = p.reconcileCloudSqlInstanceSnapshots(ctx, pg)
 		if err != nil {
@@ -148,7 +174,7 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, pg *v1alpha1.P
 	return instance, statusMessage, err
 }
 
-func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1alpha1.Postgres, sqladminService gcpiface.SQLAdminService, strategyConfig *StrategyConfig, address *computepb.Address) (*providers.PostgresInstance, croType.StatusMessage, error) {
+func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1alpha1.Postgres, sqladminService gcpiface.SQLAdminService, projectsClient gcpiface.ProjectsAPI, networkManager NetworkManager, strategyConfig *StrategyConfig, address *computepb.Address, method NetworkConnectionMethod) (*providers.PostgresInstance, croType.StatusMessage, error) {
 	logger := p.Logger.WithField("action", "reconcileCloudSQLInstance")
 	logger.Infof("reconciling cloudSQL instance")
 
@@ -165,7 +191,7 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
 
-	gcpInstanceConfig, err := p.buildCloudSQLCreateStrategy(ctx, pg, strategyConfig, sec, address)
+	gcpInstanceConfig, err := p.buildCloudSQLCreateStrategy(ctx, pg, strategyConfig, sec, address, method)
 	if err != nil {
 		msg := "failed to build and verify gcp cloudSQL instance configuration"
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
@@ -177,11 +203,17 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 	defer p.exposePostgresInstanceMetrics(ctx, pg, foundInstance)
+	defer p.setPostgresSnapshotMetrics(ctx, pg, sqladminService, strategyConfig.ProjectID, foundInstance)
 
 	if foundInstance != nil {
-		if !annotations.Has(pg, ResourceIdentifierAnnotation) {
-			annotations.Add(pg, ResourceIdentifierAnnotation, foundInstance.Name)
-			if err := p.Client.Update(ctx, pg); err != nil {
+		// take over an existing instance the first time it is found
+		if resources.IsAdopted(pg.Spec.ResourceTypeSpec) && !resources.HasResourceIdentifier(ctx, pg) {
+			if msg, err := p.adoptCloudSQLInstance(ctx, pg, sqladminService, strategyConfig, gcpInstanceConfig, foundInstance, sec); err != nil {
+				return nil, msg, err
+			}
+		}
+		if !resources.HasResourceIdentifier(ctx, pg) {
+			if err := resources.SetResourceIdentifier(ctx, p.Client, pg, foundInstance.Name); err != nil {
 				msg := "failed to add annotation to postgres cr"
 				return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 			}
@@ -192,14 +224,24 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 	}
 
 	if foundInstance == nil {
+		// an instance which is adopted is never created
+		if resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
+			msg := fmt.Sprintf("external cloudSQL instance %s of Postgres CR %s in %s namespace was not found", pg.Spec.ExternalResourceID, pg.Name, pg.Namespace)
+			return nil, croType.StatusMessage(msg), errorUtil.New(msg)
+		}
+		preflight := preflightCloudSQLInstance(ctx, projectsClient, sqladminService, strategyConfig.ProjectID, gcpInstanceConfig)
+		if !resources.ReportPreflight(p.Recorder, pg, &pg.Status, preflight) {
+			msg := fmt.Sprintf("preflight checks failed for cloudSQL instance %s: %s", gcpInstanceConfig.Name, preflight)
+			return nil, croType.StatusMessage(msg), errorUtil.New(msg)
+		}
 		logger.Infof("no instance found, creating one")
 		_, err := sqladminService.CreateInstance(ctx, strategyConfig.ProjectID, gcpInstanceConfig.MapToGcpDatabaseInstance())
 		if err != nil && !resources.IsNotFoundError(err) {
 			msg := "failed to create cloudSQL instance"
 			return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
-		annotations.Add(pg, ResourceIdentifierAnnotation, gcpInstanceConfig.Name)
-		if err := p.Client.Update(ctx, pg); err != nil {
+		resources.RecordEvent(p.Recorder, pg, resources.EventReasonCreateStarted, "started provisioning cloudSQL instance %s", gcpInstanceConfig.Name)
+		if err := resources.SetResourceIdentifier(ctx, p.Client, pg, gcpInstanceConfig.Name); err != nil {
 			msg := "failed to add annotation"
 			return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
@@ -212,6 +254,16 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 		return nil, croType.StatusMessage(msg), nil
 	}
 
+	var pendingMaintenance []string
+	if foundInstance.ScheduledMaintenance != nil && foundInstance.ScheduledMaintenance.StartTime != "" {
+		pendingMaintenance = append(pendingMaintenance, fmt.Sprintf("maintenance scheduled for cloudSQL instance %s at %s", foundInstance.Name, foundInstance.ScheduledMaintenance.StartTime))
+	}
+	resources.ReportMaintenance(p.Recorder, pg, &pg.Status, pendingMaintenance)
+
+	// modifications are applied on every reconcile, drift from the strategy is reported and corrected below
+	drift := buildCloudSQLDrift(gcpInstanceConfig, foundInstance)
+	resources.ReportDrift(ctx, p.Client, p.Recorder, pg, &pg.Status, drift, string(providers.PostgresResourceType), foundInstance.Name, postgresProviderName)
+
 	logger.Infof("building cloudSQL update config for: %s", foundInstance.Name)
 	modifiedInstance, err := p.buildCloudSQLUpdateStrategy(gcpInstanceConfig, foundInstance)
 	if err != nil {
@@ -219,20 +271,29 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
-	if modifiedInstance != nil {
+	dryRun := resources.IsDryRun(pg)
+	if dryRun {
+		logger.Infof("dry-run mode enabled, not modifying cloudSQL instance: %s", foundInstance.Name)
+		resources.SetPendingChanges(p.Recorder, pg, &pg.Status, buildCloudSQLPendingChanges(modifiedInstance, foundInstance))
+	} else {
+		resources.SetPendingChanges(p.Recorder, pg, &pg.Status, nil)
+	}
+
+	if modifiedInstance != nil && !dryRun {
 		logger.Infof("modifying cloudSQL instance: %s", foundInstance.Name)
 		_, err := sqladminService.ModifyInstance(ctx, strategyConfig.ProjectID, foundInstance.Name, modifiedInstance)
 		if err != nil && !resources.IsConflictError(err) {
 			msg := fmt.Sprintf("failed to modify cloudsql instance: %s", foundInstance.Name)
 			return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 		}
+		if err == nil {
+			resources.RecordEvent(p.Recorder, pg, resources.EventReasonModifyApplied, "modified cloudSQL instance %s", foundInstance.Name)
+		}
 	}
 
-	var host string
-	for i := range foundInstance.IpAddresses {
-		if foundInstance.IpAddresses[i].Type == "PRIVATE" {
-			host = foundInstance.IpAddresses[i].IpAddress
-		}
+	host, msg, err := p.getCloudSQLInstanceHost(ctx, networkManager, foundInstance, method)
+	if err != nil || msg != "" {
+		return nil, msg, err
 	}
 	pdd := &providers.PostgresDeploymentDetails{
 		Username: string(sec.Data[defaultPostgresUserKey]),
@@ -241,9 +302,67 @@ func (p *PostgresProvider) reconcileCloudSQLInstance(ctx context.Context, pg *v1
 		Database: defaultDeploymentDatabase,
 		Port:     defaultGCPPostgresPort,
 	}
-	msg := fmt.Sprintf("successfully reconciled cloudsql instance %s", foundInstance.Name)
+	msg = croType.StatusMessage(fmt.Sprintf("successfully reconciled cloudsql instance %s", foundInstance.Name))
 	p.Logger.Info(msg)
-	return &providers.PostgresInstance{DeploymentDetails: pdd}, croType.StatusMessage(msg), nil
+	return &providers.PostgresInstance{DeploymentDetails: pdd}, msg, nil
+}
+
+// getCloudSQLInstanceHost returns the private ip address of the cloudSQL instance, the address of the private service
+// connect endpoint of the instance is returned instead when the instance is connected with private service connect
+func (p *PostgresProvider) getCloudSQLInstanceHost(ctx context.Context, networkManager NetworkManager, foundInstance *sqladmin.DatabaseInstance, method NetworkConnectionMethod) (string, croType.StatusMessage, error) {
+	if method != NetworkConnectionMethodPrivateServiceConnect {
+		var host string
+		for i := range foundInstance.IpAddresses {
+			if foundInstance.IpAddresses[i].Type == "PRIVATE" {
+				host = foundInstance.IpAddresses[i].IpAddress
+			}
+		}
+		return host, croType.StatusEmpty, nil
+	}
+	if foundInstance.PscServiceAttachmentLink == "" {
+		msg := fmt.Sprintf("waiting for the private service connect service attachment of cloudSQL instance %s", foundInstance.Name)
+		return "", croType.StatusMessage(msg), nil
+	}
+	endpoint, msg, err := networkManager.CreatePrivateServiceConnectEndpoint(ctx, foundInstance.Name, foundInstance.Region, foundInstance.PscServiceAttachmentLink)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to create private service connect endpoint for cloudSQL instance %s", foundInstance.Name)
+		return "", croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if msg != croType.StatusEmpty {
+		return "", msg, nil
+	}
+	return endpoint.GetAddress(), croType.StatusEmpty, nil
+}
+
+// adoptCloudSQLInstance takes over an existing cloudSQL instance, it verifies the instance is a postgres instance which
+// is not managed by another cr and resets the password of the user if requested. the operator labels are applied by the
+// update strategy
+func (p *PostgresProvider) adoptCloudSQLInstance(ctx context.Context, pg *v1alpha1.Postgres, sqladminService gcpiface.SQLAdminService, strategyConfig *StrategyConfig, gcpInstanceConfig *gcpiface.DatabaseInstance, foundInstance *sqladmin.DatabaseInstance, sec *v1.Secret) (croType.StatusMessage, error) {
+	if !strings.HasPrefix(foundInstance.DatabaseVersion, "POSTGRES") {
+		msg := fmt.Sprintf("cloudSQL instance %s has database version %s, only postgres instances can be adopted", foundInstance.Name, foundInstance.DatabaseVersion)
+		return croType.StatusMessage(msg), errorUtil.New(msg)
+	}
+	var foundLabels map[string]string
+	if foundInstance.Settings != nil {
+		foundLabels = foundInstance.Settings.UserLabels
+	}
+	if err := resources.ValidateAdoption(gcpInstanceConfig.Settings.UserLabels, foundLabels, buildAdoptionOwnershipLabelKeys()); err != nil {
+		msg := fmt.Sprintf("cloudSQL instance %s can not be adopted", foundInstance.Name)
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if pg.Spec.ResetCredentials {
+		p.Logger.Infof("resetting password of user %s of cloudSQL instance %s", sec.Data[defaultPostgresUserKey], foundInstance.Name)
+		_, err := sqladminService.UpdateUser(ctx, strategyConfig.ProjectID, foundInstance.Name, &sqladmin.User{
+			Name:     string(sec.Data[defaultPostgresUserKey]),
+			Password: string(sec.Data[defaultPostgresPasswordKey]),
+		})
+		if err != nil {
+			msg := fmt.Sprintf("failed to reset password of cloudSQL instance %s", foundInstance.Name)
+			return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+		}
+	}
+	resources.RecordEvent(p.Recorder, pg, resources.EventReasonAdopted, "adopted existing cloudSQL instance %s", foundInstance.Name)
+	return "", nil
 }
 
 // DeletePostgres will set the postgres deletion timestamp, reconcile provider credentials so that the postgres instance
@@ -261,13 +380,25 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, pg *v1alpha1.Post
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
 
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		errMsg := fmt.Sprintf("failed to reconcile gcp postgres provider credentials for postgres instance %s", pg.Name)
+		resources.RecordWarningEvent(p.Recorder, pg, resources.EventReasonCredentialsFailed, "%s: %v", errMsg, err)
 		return croType.StatusMessage(errMsg), fmt.Errorf("%s: %w", errMsg, err)
 	}
 
-	sqlClient, err := gcpiface.NewSQLAdminService(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Logger)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		errMsg := "failed to build gcp client option from postgres strategy"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	networkProject, err := GetNetworkProjectFromStrategy(ctx, p.Client, strategyConfig)
+	if err != nil {
+		errMsg := "failed to get gcp network project"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	sqlClient, err := gcpiface.NewSQLAdminService(ctx, clientOption, p.Logger)
 	if err != nil {
 		errMsg := "could not initialise new SQL Admin Service"
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
@@ -278,19 +409,35 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, pg *v1alpha1.Post
 		errMsg := "failed to check if this cr is the last cr of type postgres and redis"
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	// the network is deleted with the cloud network when one exists
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		errMsg := "failed to get cloud network"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+	if cloudNetwork != nil {
+		isLastResource = false
+	}
 
-	networkManager, err := NewNetworkManager(ctx, strategyConfig.ProjectID, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
+	networkManager, err := NewNetworkManager(ctx, networkProject, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
 	if err != nil {
 		errMsg := "failed to initialise network manager"
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	return p.deleteCloudSQLInstance(ctx, networkManager, sqlClient, strategyConfig, pg, isLastResource)
+	storageClient, err := gcpiface.NewStorageAPI(ctx, clientOption, logger)
+	if err != nil {
+		errMsg := "could not initialise storage client"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	return p.deleteCloudSQLInstance(ctx, networkManager, sqlClient, storageClient, strategyConfig, pg, isLastResource)
 }
 
 // deleteCloudSQLInstance will retrieve the instance required using the cloudSQLDeleteConfig
 // and delete this instance if it is not already pending delete. The credentials and finalizer are then removed.
-func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkManager NetworkManager, sqladminService gcpiface.SQLAdminService, strategyConfig *StrategyConfig, pg *v1alpha1.Postgres, isLastResource bool) (croType.StatusMessage, error) {
+// The deletion policy of the cr can keep the instance instead, or export a final snapshot before it is deleted.
+func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkManager NetworkManager, sqladminService gcpiface.SQLAdminService, storageClient gcpiface.StorageAPI, strategyConfig *StrategyConfig, pg *v1alpha1.Postgres, isLastResource bool) (croType.StatusMessage, error) {
 	logger := p.Logger.WithField("action", "deleteCloudSQLInstance")
 
 	cloudSQLDeleteConfig, err := p.buildCloudSQLDeleteStrategy(ctx, pg, strategyConfig)
@@ -312,6 +459,41 @@ func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkMa
 			p.Logger.Info(statusMessage)
 			return croType.StatusMessage(statusMessage), nil
 		}
+		if resources.IsRetained(pg.Spec.ResourceTypeSpec) {
+			if labels, changed := buildOrphanedLabels(foundInstance.Settings.UserLabels); changed {
+				update := &sqladmin.DatabaseInstance{
+					Settings: &sqladmin.Settings{
+						UserLabels: labels,
+					},
+				}
+				_, err := sqladminService.ModifyInstance(ctx, strategyConfig.ProjectID, foundInstance.Name, update)
+				if err != nil && !resources.IsConflictError(err) {
+					msg := fmt.Sprintf("failed to label cloudsql instance %s as orphaned", foundInstance.Name)
+					return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+				}
+			}
+			return resources.RetainResource(ctx, p.Client, p.Recorder, pg, DefaultFinalizer, foundInstance.Name)
+		}
+		if resources.IsFinalSnapshotRequired(pg.Spec.ResourceTypeSpec) {
+			exported, err := exportFinalSnapshot(ctx, storageClient, p.Recorder, pg, strategyConfig, foundInstance.Name, fmt.Sprintf("serviceAccount:%s", foundInstance.ServiceAccountEmailAddress), func() error {
+				_, err := sqladminService.ExportDatabase(ctx, strategyConfig.ProjectID, foundInstance.Name, &sqladmin.InstancesExportRequest{
+					ExportContext: &sqladmin.ExportContext{
+						Databases: []string{"postgres"},
+						FileType:  "SQL",
+						Uri:       buildFinalSnapshotURI(foundInstance.Name),
+					},
+				})
+				return err
+			})
+			if err != nil {
+				msg := fmt.Sprintf("failed to export final snapshot of cloudsql instance %s", foundInstance.Name)
+				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+			}
+			if !exported {
+				msg := fmt.Sprintf("final snapshot export in progress for cloudsql instance %s", foundInstance.Name)
+				return croType.StatusMessage(msg), nil
+			}
+		}
 		if foundInstance.Settings.DeletionProtectionEnabled {
 			update := &sqladmin.DatabaseInstance{
 				Settings: &sqladmin.Settings{
@@ -325,6 +507,7 @@ func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkMa
 				return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 			}
 			msg := fmt.Sprintf("disabling deletion protection for cloudsql instance %s", foundInstance.Name)
+			resources.RecordEvent(p.Recorder, pg, resources.EventReasonDeletionBlocked, "deletion protection is enabled on cloudSQL instance %s, removing it before deletion", foundInstance.Name)
 			return croType.StatusMessage(msg), nil
 		}
 		_, err = sqladminService.DeleteInstance(ctx, strategyConfig.ProjectID, foundInstance.Name)
@@ -336,6 +519,20 @@ func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkMa
 		return croType.StatusMessage(msg), nil
 	}
 
+	if err := networkManager.DeleteNetworkAccess(ctx, cloudSQLDeleteConfig.Name); err != nil {
+		msg := "failed to delete cloudSQL instance network access"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	// the private service connect endpoint is only found when the instance was connected with private service connect
+	endpointDeleted, err := networkManager.DeletePrivateServiceConnectEndpoint(ctx, cloudSQLDeleteConfig.Name, strategyConfig.Region)
+	if err != nil {
+		msg := "failed to delete cloudSQL instance private service connect endpoint"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	if !endpointDeleted {
+		return croType.StatusMessage(fmt.Sprintf("deletion in progress for private service connect endpoint of cloudsql instance %s", cloudSQLDeleteConfig.Name)), nil
+	}
+
 	logger.Info("deleting cloudSQL secret")
 	sec := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -372,8 +569,7 @@ func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkMa
 		}
 	}
 
-	resources.RemoveFinalizer(&pg.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, pg); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, pg, DefaultFinalizer); err != nil {
 		msg := "failed to update instance as part of finalizer reconcile"
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
@@ -389,7 +585,7 @@ func (p *PostgresProvider) deleteCloudSQLInstance(ctx context.Context, networkMa
 func (p *PostgresProvider) setPostgresDeletionTimestampMetric(ctx context.Context, pg *v1alpha1.Postgres) {
 	if pg.DeletionTimestamp != nil && !pg.DeletionTimestamp.IsZero() {
 
-		instanceName, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultGcpIdentifierLength)
+		instanceName, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 		if err != nil || instanceName == "" {
 			p.Logger.Errorf("unable to build instance name")
 			return
@@ -424,7 +620,7 @@ func buildPostgresStatusMetricsLabels(cr *v1alpha1.Postgres, clusterID, instance
 	return labels
 }
 
-func (p *PostgresProvider) buildCloudSQLCreateStrategy(ctx context.Context, pg *v1alpha1.Postgres, strategyConfig *StrategyConfig, sec *v1.Secret, address *computepb.Address) (*gcpiface.DatabaseInstance, error) {
+func (p *PostgresProvider) buildCloudSQLCreateStrategy(ctx context.Context, pg *v1alpha1.Postgres, strategyConfig *StrategyConfig, sec *v1.Secret, address *computepb.Address, method NetworkConnectionMethod) (*gcpiface.DatabaseInstance, error) {
 	createStrategy := &CreateInstanceRequest{
 		Instance: &gcpiface.DatabaseInstance{},
 	}
@@ -432,8 +628,9 @@ func (p *PostgresProvider) buildCloudSQLCreateStrategy(ctx context.Context, pg *
 		return nil, errorUtil.Wrap(err, "failed to unmarshal gcp postgres create request")
 	}
 	instance := createStrategy.Instance
-	if instance.Name == "" {
-		instanceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultGcpIdentifierLength)
+	// an adopted instance is always identified by the external resource id of the cr
+	if instance.Name == "" || resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
+		instanceID, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 		if err != nil {
 			return nil, errorUtil.Wrap(err, "failed to build gcp postgres instance id from object")
 		}
@@ -503,12 +700,29 @@ func (p *PostgresProvider) buildCloudSQLCreateStrategy(ctx context.Context, pg *
 	if instance.Settings.IpConfiguration.Ipv4Enabled == nil {
 		instance.Settings.IpConfiguration.Ipv4Enabled = ptr.To(defaultIPConfigIPV4Enabled)
 	}
-	if instance.Settings.IpConfiguration.AllocatedIpRange == "" {
-		instance.Settings.IpConfiguration.AllocatedIpRange = address.GetName()
+	// an instance connected with private service connect has no private ip address in the cluster vpc, only the
+	// project of the cluster is allowed to connect to its service attachment
+	if method == NetworkConnectionMethodPrivateServiceConnect {
+		if instance.Settings.IpConfiguration.PscConfig == nil {
+			instance.Settings.IpConfiguration.PscConfig = &sqladmin.PscConfig{}
+		}
+		instance.Settings.IpConfiguration.PscConfig.PscEnabled = true
+		if len(instance.Settings.IpConfiguration.PscConfig.AllowedConsumerProjects) == 0 {
+			instance.Settings.IpConfiguration.PscConfig.AllowedConsumerProjects = []string{strategyConfig.ProjectID}
+		}
+	} else {
+		if instance.Settings.IpConfiguration.AllocatedIpRange == "" {
+			instance.Settings.IpConfiguration.AllocatedIpRange = address.GetName()
+		}
+		if instance.Settings.IpConfiguration.PrivateNetwork == "" {
+			instance.Settings.IpConfiguration.PrivateNetwork = address.GetNetwork()
+		}
 	}
-	if instance.Settings.IpConfiguration.PrivateNetwork == "" {
-		instance.Settings.IpConfiguration.PrivateNetwork = address.GetNetwork()
+	authorizedNetworks, err := buildAuthorizedNetworks(instance.Settings.IpConfiguration.AuthorizedNetworks, pg.Spec.NetworkAccess)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to build gcp postgres instance authorized networks")
 	}
+	instance.Settings.IpConfiguration.AuthorizedNetworks = authorizedNetworks
 	return instance, nil
 }
 
@@ -517,8 +731,8 @@ func (p *PostgresProvider) buildCloudSQLDeleteStrategy(ctx context.Context, pg *
 	if err := json.Unmarshal(strategyConfig.DeleteStrategy, deleteStrategy); err != nil {
 		return nil, errorUtil.Wrap(err, "failed to unmarshal gcp postgres delete request")
 	}
-	if deleteStrategy.Name == "" {
-		instanceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultGcpIdentifierLength)
+	if deleteStrategy.Name == "" || resources.IsAdopted(pg.Spec.ResourceTypeSpec) {
+		instanceID, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 		if err != nil {
 			return nil, errorUtil.Wrap(err, "failed to build gcp postgres instance id from object")
 		}
@@ -612,6 +826,11 @@ func (p *PostgresProvider) buildCloudSQLUpdateStrategy(cloudSQLConfig *gcpiface.
 			modifiedInstance.Settings.IpConfiguration.ForceSendFields = append(modifiedInstance.Settings.IpConfiguration.ForceSendFields, "Ipv4Enabled")
 			updateFound = true
 		}
+		if authorizedNetworksChanged(cloudSQLConfig.Settings.IpConfiguration.AuthorizedNetworks, foundInstance.Settings.IpConfiguration.AuthorizedNetworks) {
+			modifiedInstance.Settings.IpConfiguration.AuthorizedNetworks = cloudSQLConfig.Settings.IpConfiguration.AuthorizedNetworks
+			modifiedInstance.Settings.IpConfiguration.ForceSendFields = append(modifiedInstance.Settings.IpConfiguration.ForceSendFields, "AuthorizedNetworks")
+			updateFound = true
+		}
 	}
 
 	if cloudSQLConfig.Settings.MaintenanceWindow != nil && foundInstance.Settings.MaintenanceWindow != nil {
@@ -641,6 +860,147 @@ func (p *PostgresProvider) buildCloudSQLUpdateStrategy(cloudSQLConfig *gcpiface.
 	return modifiedInstance, nil
 }
 
+// buildCloudSQLDrift compares the attributes of the found cloudSQL instance against the create strategy, the data disk
+// size is only drift when it is smaller than the strategy as storage auto resize grows it
+func buildCloudSQLDrift(cloudSQLConfig *gcpiface.DatabaseInstance, foundInstance *sqladmin.DatabaseInstance) *resources.Drift {
+	drift := &resources.Drift{}
+	drift.Compare("databaseVersion", foundInstance.DatabaseVersion, cloudSQLConfig.DatabaseVersion)
+	if cloudSQLConfig.DiskEncryptionConfiguration != nil {
+		var kmsKeyName string
+		if foundInstance.DiskEncryptionConfiguration != nil {
+			kmsKeyName = foundInstance.DiskEncryptionConfiguration.KmsKeyName
+		}
+		drift.Compare("diskEncryptionConfiguration.kmsKeyName", kmsKeyName, cloudSQLConfig.DiskEncryptionConfiguration.KmsKeyName)
+	}
+	settings, found := cloudSQLConfig.Settings, foundInstance.Settings
+	if settings == nil || found == nil {
+		return drift
+	}
+	drift.Compare("settings.tier", found.Tier, settings.Tier)
+	drift.Compare("settings.availabilityType", found.AvailabilityType, settings.AvailabilityType)
+	drift.Compare("settings.dataDiskType", found.DataDiskType, settings.DataDiskType)
+	if settings.DataDiskSizeGb != 0 && found.DataDiskSizeGb < settings.DataDiskSizeGb {
+		drift.Compare("settings.dataDiskSizeGb", found.DataDiskSizeGb, settings.DataDiskSizeGb)
+	}
+	drift.Compare("settings.storageAutoResize", found.StorageAutoResize, settings.StorageAutoResize)
+	if settings.StorageAutoResizeLimit != 0 {
+		drift.Compare("settings.storageAutoResizeLimit", found.StorageAutoResizeLimit, settings.StorageAutoResizeLimit)
+	}
+	drift.Compare("settings.deletionProtectionEnabled", found.DeletionProtectionEnabled, settings.DeletionProtectionEnabled)
+	drift.CompareTags("settings.userLabels", found.UserLabels, settings.UserLabels)
+	if backup := settings.BackupConfiguration; backup != nil {
+		foundBackup := found.BackupConfiguration
+		if foundBackup == nil {
+			foundBackup = &sqladmin.BackupConfiguration{}
+		}
+		drift.Compare("settings.backupConfiguration.enabled", foundBackup.Enabled, backup.Enabled)
+		drift.Compare("settings.backupConfiguration.pointInTimeRecoveryEnabled", foundBackup.PointInTimeRecoveryEnabled, backup.PointInTimeRecoveryEnabled)
+		drift.Compare("settings.backupConfiguration.startTime", foundBackup.StartTime, backup.StartTime)
+		if backup.TransactionLogRetentionDays != 0 {
+			drift.Compare("settings.backupConfiguration.transactionLogRetentionDays", foundBackup.TransactionLogRetentionDays, backup.TransactionLogRetentionDays)
+		}
+		if retention := backup.BackupRetentionSettings; retention != nil {
+			foundRetention := foundBackup.BackupRetentionSettings
+			if foundRetention == nil {
+				foundRetention = &sqladmin.BackupRetentionSettings{}
+			}
+			drift.Compare("settings.backupConfiguration.backupRetentionSettings.retentionUnit", foundRetention.RetentionUnit, retention.RetentionUnit)
+			if retention.RetainedBackups != 0 {
+				drift.Compare("settings.backupConfiguration.backupRetentionSettings.retainedBackups", foundRetention.RetainedBackups, retention.RetainedBackups)
+			}
+		}
+	}
+	if ipConfiguration := settings.IpConfiguration; ipConfiguration != nil {
+		foundIpConfiguration := found.IpConfiguration
+		if foundIpConfiguration == nil {
+			foundIpConfiguration = &sqladmin.IpConfiguration{}
+		}
+		drift.Compare("settings.ipConfiguration.ipv4Enabled", foundIpConfiguration.Ipv4Enabled, ipConfiguration.Ipv4Enabled)
+		drift.Compare("settings.ipConfiguration.privateNetwork", foundIpConfiguration.PrivateNetwork, ipConfiguration.PrivateNetwork)
+		drift.Compare("settings.ipConfiguration.requireSsl", foundIpConfiguration.RequireSsl, ipConfiguration.RequireSsl)
+		drift.Compare("settings.ipConfiguration.allocatedIpRange", foundIpConfiguration.AllocatedIpRange, ipConfiguration.AllocatedIpRange)
+		if ipConfiguration.PscConfig != nil {
+			foundPscEnabled := foundIpConfiguration.PscConfig != nil && foundIpConfiguration.PscConfig.PscEnabled
+			drift.Compare("settings.ipConfiguration.pscConfig.pscEnabled", foundPscEnabled, ipConfiguration.PscConfig.PscEnabled)
+		}
+	}
+	if maintenanceWindow := settings.MaintenanceWindow; maintenanceWindow != nil {
+		foundMaintenanceWindow := found.MaintenanceWindow
+		if foundMaintenanceWindow == nil {
+			foundMaintenanceWindow = &sqladmin.MaintenanceWindow{}
+		}
+		drift.Compare("settings.maintenanceWindow.day", foundMaintenanceWindow.Day, maintenanceWindow.Day)
+		drift.Compare("settings.maintenanceWindow.hour", foundMaintenanceWindow.Hour, maintenanceWindow.Hour)
+	}
+	return drift
+}
+
+// buildCloudSQLPendingChanges returns the fields sent by the modify request as pending changes against the found
+// instance, a field is sent when it is set or forced
+func buildCloudSQLPendingChanges(modifiedInstance *sqladmin.DatabaseInstance, foundInstance *sqladmin.DatabaseInstance) []croType.PendingChange {
+	if modifiedInstance == nil {
+		return nil
+	}
+	var changes []croType.PendingChange
+	if settings := modifiedInstance.Settings; settings != nil && foundInstance.Settings != nil {
+		found := foundInstance.Settings
+		if resources.Contains(settings.ForceSendFields, "DeletionProtectionEnabled") {
+			changes = append(changes, resources.NewPendingChange("settings.deletionProtectionEnabled", found.DeletionProtectionEnabled, settings.DeletionProtectionEnabled))
+		}
+		if settings.StorageAutoResize != nil {
+			changes = append(changes, resources.NewPendingChange("settings.storageAutoResize", found.StorageAutoResize, settings.StorageAutoResize))
+		}
+		if settings.Tier != "" {
+			changes = append(changes, resources.NewPendingChange("settings.tier", found.Tier, settings.Tier))
+		}
+		if settings.AvailabilityType != "" {
+			changes = append(changes, resources.NewPendingChange("settings.availabilityType", found.AvailabilityType, settings.AvailabilityType))
+		}
+		if settings.StorageAutoResizeLimit != 0 {
+			changes = append(changes, resources.NewPendingChange("settings.storageAutoResizeLimit", found.StorageAutoResizeLimit, settings.StorageAutoResizeLimit))
+		}
+		if settings.DataDiskSizeGb != 0 {
+			changes = append(changes, resources.NewPendingChange("settings.dataDiskSizeGb", found.DataDiskSizeGb, settings.DataDiskSizeGb))
+		}
+		if settings.UserLabels != nil {
+			changes = append(changes, resources.NewPendingChange("settings.userLabels", found.UserLabels, settings.UserLabels))
+		}
+		if backup := settings.BackupConfiguration; backup != nil && found.BackupConfiguration != nil {
+			if resources.Contains(backup.ForceSendFields, "Enabled") {
+				changes = append(changes, resources.NewPendingChange("settings.backupConfiguration.enabled", found.BackupConfiguration.Enabled, backup.Enabled))
+			}
+			if resources.Contains(backup.ForceSendFields, "PointInTimeRecoveryEnabled") {
+				changes = append(changes, resources.NewPendingChange("settings.backupConfiguration.pointInTimeRecoveryEnabled", found.BackupConfiguration.PointInTimeRecoveryEnabled, backup.PointInTimeRecoveryEnabled))
+			}
+			if retention := backup.BackupRetentionSettings; retention != nil && found.BackupConfiguration.BackupRetentionSettings != nil {
+				if retention.RetentionUnit != "" {
+					changes = append(changes, resources.NewPendingChange("settings.backupConfiguration.backupRetentionSettings.retentionUnit", found.BackupConfiguration.BackupRetentionSettings.RetentionUnit, retention.RetentionUnit))
+				}
+				if retention.RetainedBackups != 0 {
+					changes = append(changes, resources.NewPendingChange("settings.backupConfiguration.backupRetentionSettings.retainedBackups", found.BackupConfiguration.BackupRetentionSettings.RetainedBackups, retention.RetainedBackups))
+				}
+			}
+		}
+		if ipConfiguration := settings.IpConfiguration; ipConfiguration != nil && found.IpConfiguration != nil {
+			if resources.Contains(ipConfiguration.ForceSendFields, "Ipv4Enabled") {
+				changes = append(changes, resources.NewPendingChange("settings.ipConfiguration.ipv4Enabled", found.IpConfiguration.Ipv4Enabled, ipConfiguration.Ipv4Enabled))
+			}
+		}
+		if maintenanceWindow := settings.MaintenanceWindow; maintenanceWindow != nil && found.MaintenanceWindow != nil {
+			if maintenanceWindow.Day != 0 {
+				changes = append(changes, resources.NewPendingChange("settings.maintenanceWindow.day", found.MaintenanceWindow.Day, maintenanceWindow.Day))
+			}
+			if resources.Contains(maintenanceWindow.ForceSendFields, "Hour") {
+				changes = append(changes, resources.NewPendingChange("settings.maintenanceWindow.hour", found.MaintenanceWindow.Hour, maintenanceWindow.Hour))
+			}
+		}
+	}
+	if modifiedInstance.DatabaseVersion != "" {
+		changes = append(changes, resources.NewPendingChange("databaseVersion", foundInstance.DatabaseVersion, modifiedInstance.DatabaseVersion))
+	}
+	return changes
+}
+
 func (p *PostgresProvider) exposePostgresInstanceMetrics(ctx context.Context, pg *v1alpha1.Postgres, instance *sqladmin.DatabaseInstance) {
 	if instance == nil {
 		return
@@ -676,7 +1036,7 @@ func (p *PostgresProvider) exposePostgresInstanceMetrics(ctx context.Context, pg
 					host = instance.IpAddresses[i].IpAddress
 				}
 			}
-			if success := p.TCPPinger.TCPConnection(host, defaultGCPPostgresPort); success {
+			if p.testPostgresConnection(ctx, pg, host, genericLabels) {
 				instanceConnectable = 1
 			}
 		}
@@ -685,6 +1045,64 @@ func (p *PostgresProvider) exposePostgresInstanceMetrics(ctx context.Context, pg
 	resources.SetMetric(resources.DefaultPostgresConnectionMetricName, genericLabels, instanceConnectable)
 }
 
+// setPostgresSnapshotMetrics exposes the creation time of the latest successful cloudsql backup run or completed operator snapshot
+// cloudsql does not report the size of a backup, so only the timestamp metric is exposed
+func (p *PostgresProvider) setPostgresSnapshotMetrics(ctx context.Context, pg *v1alpha1.Postgres, sqladminService gcpiface.SQLAdminService, projectID string, instance *sqladmin.DatabaseInstance) {
+	if instance == nil {
+		return
+	}
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		p.Logger.Errorf("failed to get cluster id while exposing snapshot metrics for postgres instance %s", instance.Name)
+		return
+	}
+	backupRuns, err := sqladminService.ListBackupRuns(ctx, projectID, instance.Name)
+	if err != nil {
+		p.Logger.Errorf("failed to list backup runs while exposing snapshot metrics for postgres instance %s: %v", instance.Name, err)
+		return
+	}
+	var snapshots []*resources.SnapshotMetricInfo
+	for _, backupRun := range backupRuns {
+		if backupRun.Status != "SUCCESSFUL" {
+			continue
+		}
+		endTime, err := time.Parse(time.RFC3339, backupRun.EndTime)
+		if err != nil {
+			p.Logger.Errorf("failed to parse end time of backup run %d for postgres instance %s: %v", backupRun.Id, instance.Name, err)
+			continue
+		}
+		snapshots = append(snapshots, &resources.SnapshotMetricInfo{CreateTime: endTime})
+	}
+	latestSnapshot, err := getLatestPostgresSnapshot(ctx, p.Client, pg.Name, pg.Namespace)
+	if err != nil {
+		p.Logger.Errorf("failed to get latest snapshot while exposing snapshot metrics for postgres instance %s: %v", instance.Name, err)
+		return
+	}
+	if latestSnapshot != nil {
+		snapshots = append(snapshots, &resources.SnapshotMetricInfo{CreateTime: latestSnapshot.CreationTimestamp.Time})
+	}
+	genericLabels := resources.BuildGenericMetricLabels(pg.ObjectMeta, clusterID, instance.Name, postgresProviderName)
+	resources.SetLatestSnapshotMetrics(resources.DefaultPostgresLatestSnapshotTimestampMetricName, resources.DefaultPostgresLatestSnapshotSizeBytesMetricName, genericLabels, snapshots)
+}
+
+// testPostgresConnection checks the generated credentials can be used to query the cloudsql instance
+func (p *PostgresProvider) testPostgresConnection(ctx context.Context, pg *v1alpha1.Postgres, host string, labels map[string]string) bool {
+	sec := &v1.Secret{}
+	if err := p.Client.Get(ctx, types.NamespacedName{Name: pg.Name + defaultCredSecSuffix, Namespace: pg.Namespace}, sec); err != nil {
+		p.Logger.Errorf("failed to get credentials secret while testing connection to postgres instance: %v", err)
+		return false
+	}
+	result := p.TCPPinger.PostgresConnection(ctx, &resources.PostgresConnectionDetails{
+		Host:     host,
+		Port:     defaultGCPPostgresPort,
+		Username: string(sec.Data[defaultPostgresUserKey]),
+		Password: string(sec.Data[defaultPostgresPasswordKey]),
+		Database: defaultDeploymentDatabase,
+	})
+	resources.SetConnectionProbeMetrics(resources.ConnectionProbeResourceTypePostgres, labels, result)
+	return result.Success
+}
+
 func healthyPostgresInstanceStates() []string {
 	return []string{
 		"PENDING_CREATE",
@@ -777,7 +1195,7 @@ func (p *PostgresProvider) reconcileCloudSqlInstanceSnapshots(ctx context.Contex
 }
 
 func (p *PostgresProvider) createSnapshot(ctx context.Context, pg *v1alpha1.Postgres) error {
-	instanceName := annotations.Get(pg, ResourceIdentifierAnnotation)
+	instanceName := resources.GetResourceIdentifier(ctx, pg)
 	p.Logger.Infof("creating new snapshot for postgres instance %s", instanceName)
 	snapshot := &v1alpha1.PostgresSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
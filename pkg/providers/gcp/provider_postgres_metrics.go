@@ -8,7 +8,6 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/api/option"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,10 +26,14 @@ type PostgresMetricsProvider struct {
 }
 
 func NewGCPPostgresMetricsProvider(client client.Client, logger *logrus.Entry) (*PostgresMetricsProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &PostgresMetricsProvider{
 		Client:            client,
 		Logger:            logger.WithFields(logrus.Fields{"providers": postgresMetricProviderName}),
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
 	}, nil
 }
@@ -45,11 +48,14 @@ func (p *PostgresMetricsProvider) ScrapePostgresMetrics(ctx context.Context, pg
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve postgres strategy config: %w", err)
 	}
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to reconcile postgres provider credentials: %w", err)
 	}
-	clientOption := option.WithCredentialsJSON(creds.ServiceAccountJson)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build gcp client option from postgres strategy: %w", err)
+	}
 	metricClient, err := gcpiface.NewMetricAPI(ctx, clientOption, p.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialise metric client: %w", err)
@@ -58,7 +64,7 @@ func (p *PostgresMetricsProvider) ScrapePostgresMetrics(ctx context.Context, pg
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster id: %w", err)
 	}
-	instanceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, pg.ObjectMeta, defaultGcpIdentifierLength)
+	instanceID, err := resources.BuildResourceIdentifier(ctx, p.Client, pg.ObjectMeta, pg.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 	if err != nil {
 		return nil, fmt.Errorf("error building instance id: %w", err)
 	}
@@ -1,6 +1,7 @@
 package gcp
 
 import (
+	"cloud.google.com/go/storage"
 	"context"
 	"encoding/json"
 	"errors"
@@ -45,6 +46,19 @@ func buildTestPostgres() *v1alpha1.Postgres {
 	return postgres
 }
 
+func buildTestPostgresDeletionPolicy(policy types.DeletionPolicy) *v1alpha1.Postgres {
+	postgres := buildTestPostgres()
+	postgres.Spec.DeletionPolicy = policy
+	return postgres
+}
+
+func buildTestPostgresAdopted(externalResourceID string) *v1alpha1.Postgres {
+	postgres := buildTestPostgresWithoutAnnotation()
+	postgres.Spec.ExternalResourceID = externalResourceID
+	postgres.Spec.ResetCredentials = true
+	return postgres
+}
+
 func buildTestPostgresPhase(phase types.StatusPhase) *v1alpha1.Postgres {
 	postgres := buildTestPostgres()
 	postgres.Status.Phase = phase
@@ -98,6 +112,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 		strategyConfig  *StrategyConfig
 		p               *v1alpha1.Postgres
 		sqladminService *gcpiface.MockSqlClient
+		storageClient   gcpiface.StorageAPI
 		networkManager  NetworkManager
 		isLastResource  bool
 		projectID       string
@@ -115,7 +130,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -180,6 +195,112 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			want:    "failed to delete cloudsql instance: " + gcpTestPostgresInstanceName,
 			wantErr: true,
 		},
+		{
+			name: "success keeping and labelling instance with the retain deletion policy",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				strategyConfig: &StrategyConfig{
+					Region:         gcpTestRegion,
+					ProjectID:      gcpTestProjectId,
+					CreateStrategy: json.RawMessage(`{"instance": {"Name": "gcptestclustertestNsgcpcloudsql"}}`),
+					DeleteStrategy: json.RawMessage(`{}`),
+				},
+				p:              buildTestPostgresDeletionPolicy(types.DeletionPolicyRetain),
+				networkManager: buildMockNetworkManager(),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:        gcpTestPostgresInstanceName,
+							State:       "RUNNABLE",
+							Settings:    &sqladmin.Settings{DeletionProtectionEnabled: true},
+							IpAddresses: []*sqladmin.IpMapping{{}},
+						}, nil
+					}
+					sqlClient.ModifyInstanceFn = func(ctx context.Context, s string, s2 string, instance *sqladmin.DatabaseInstance) (*sqladmin.Operation, error) {
+						if instance.Settings.UserLabels["integreatly-org_orphaned"] != "true" {
+							return nil, errors.New("missing orphaned label")
+						}
+						return &sqladmin.Operation{}, nil
+					}
+					sqlClient.DeleteInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.Operation, error) {
+						return nil, errors.New("instance must not be deleted")
+					}
+				}),
+				isLastResource: true,
+			},
+			want:    "deletion policy is Retain, " + gcpTestPostgresInstanceName + " kept",
+			wantErr: false,
+		},
+		{
+			name: "success starting final snapshot export with the snapshot deletion policy",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				strategyConfig: &StrategyConfig{
+					Region:         gcpTestRegion,
+					ProjectID:      gcpTestProjectId,
+					CreateStrategy: json.RawMessage(`{"instance": {"Name": "gcptestclustertestNsgcpcloudsql"}}`),
+					DeleteStrategy: json.RawMessage(`{}`),
+				},
+				p:              buildTestPostgresDeletionPolicy(types.DeletionPolicySnapshot),
+				networkManager: buildMockNetworkManager(),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:        gcpTestPostgresInstanceName,
+							State:       "RUNNABLE",
+							Settings:    &sqladmin.Settings{DeletionProtectionEnabled: false},
+							IpAddresses: []*sqladmin.IpMapping{{}},
+						}, nil
+					}
+					sqlClient.DeleteInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.Operation, error) {
+						return nil, errors.New("instance must not be deleted before the final snapshot exists")
+					}
+				}),
+				storageClient: gcpiface.GetMockStorageClient(func(storageClient *gcpiface.MockStorageClient) {
+					storageClient.GetObjectMetadataFn = func(ctx context.Context, bucket, object string) (*storage.ObjectAttrs, error) {
+						return nil, storage.ErrObjectNotExist
+					}
+				}),
+			},
+			want:    "final snapshot export in progress for cloudsql instance " + gcpTestPostgresInstanceName,
+			wantErr: false,
+		},
+		{
+			name: "success deleting instance once the final snapshot exists with the snapshot deletion policy",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
+				Logger: logrus.NewEntry(logrus.StandardLogger()),
+			},
+			args: args{
+				strategyConfig: &StrategyConfig{
+					Region:         gcpTestRegion,
+					ProjectID:      gcpTestProjectId,
+					CreateStrategy: json.RawMessage(`{"instance": {"Name": "gcptestclustertestNsgcpcloudsql"}}`),
+					DeleteStrategy: json.RawMessage(`{}`),
+				},
+				p:              buildTestPostgresDeletionPolicy(types.DeletionPolicySnapshot),
+				networkManager: buildMockNetworkManager(),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:        gcpTestPostgresInstanceName,
+							State:       "RUNNABLE",
+							Settings:    &sqladmin.Settings{DeletionProtectionEnabled: false},
+							IpAddresses: []*sqladmin.IpMapping{{}},
+						}, nil
+					}
+				}),
+				storageClient: gcpiface.GetMockStorageClient(nil),
+			},
+			want:    "deletion in progress for cloudsql instance " + gcpTestPostgresInstanceName,
+			wantErr: false,
+		},
 		{
 			name: "error when getting cloud sql instance",
 			fields: fields{
@@ -358,7 +479,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: func() client.Client {
 					mc := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil))
-					mc.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return fmt.Errorf("generic error")
 					}
 					return mc
@@ -374,8 +495,9 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 				},
 				p: &v1alpha1.Postgres{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      postgresProviderName,
-						Namespace: testNs,
+						Name:       postgresProviderName,
+						Namespace:  testNs,
+						Finalizers: []string{DefaultFinalizer},
 						Annotations: map[string]string{
 							ResourceIdentifierAnnotation: testName,
 						},
@@ -427,7 +549,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -450,6 +572,12 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
+					DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+						return true, nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return fmt.Errorf("generic error")
 					},
@@ -465,7 +593,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -488,6 +616,12 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
+					DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+						return true, nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -506,7 +640,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -529,6 +663,12 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
+					DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+						return true, nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -550,7 +690,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -573,6 +713,12 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
+					DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+						return true, nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -597,7 +743,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSecret(), buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return &Credentials{}, nil
 					},
 				},
@@ -620,6 +766,12 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
+					DeletePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string) (bool, error) {
+						return true, nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -648,7 +800,7 @@ func TestPostgresProvider_deleteCloudSQLInstance(t *testing.T) {
 				CredentialManager: tt.fields.CredentialManager,
 				TCPPinger:         resources.BuildMockConnectionTester(),
 			}
-			got, err := pp.deleteCloudSQLInstance(context.TODO(), tt.args.networkManager, tt.args.sqladminService, tt.args.strategyConfig, tt.args.p, tt.args.isLastResource)
+			got, err := pp.deleteCloudSQLInstance(context.TODO(), tt.args.networkManager, tt.args.sqladminService, tt.args.storageClient, tt.args.strategyConfig, tt.args.p, tt.args.isLastResource)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeleteCloudSQLInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1036,8 +1188,10 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 	type args struct {
 		p               *v1alpha1.Postgres
 		sqladminService gcpiface.SQLAdminService
+		networkManager  NetworkManager
 		strategyConfig  *StrategyConfig
 		address         *computepb.Address
+		method          NetworkConnectionMethod
 	}
 	tests := []struct {
 		name    string
@@ -1274,7 +1428,7 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 							defaultPostgresPasswordKey: []byte(testPassword),
 						},
 					}, buildTestPostgres(), buildTestGcpInfrastructure(nil))
-					mc.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return errors.New("failed to add annotation")
 					}
 					return mc
@@ -1308,7 +1462,7 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 							defaultPostgresPasswordKey: []byte(testPassword),
 						},
 					}, buildTestPostgres(), buildTestGcpInfrastructure(nil))
-					mc.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return errors.New("failed to add annotation")
 					}
 					return mc
@@ -1324,9 +1478,11 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 						Name:      postgresProviderName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "postgres",
-						Tier: "development",
+					Spec: v1alpha1.PostgresSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "postgres",
+							Tier: "development",
+						},
 					},
 				},
 				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
@@ -1476,6 +1632,223 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 			want:    "successfully reconciled cloudsql instance gcptestclustertestNsgcpcloudsql",
 			wantErr: false,
 		},
+		{
+			name: "success reconciling cloudSQL instance connected with private service connect",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      postgresProviderName + defaultCredSecSuffix,
+					Namespace: testNs,
+				},
+					Data: map[string][]byte{
+						defaultPostgresUserKey:     []byte(testUser),
+						defaultPostgresPasswordKey: []byte(testPassword),
+					},
+				}, buildTestPostgres(), buildTestGcpInfrastructure(nil)),
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(nil),
+				ConfigManager:     nil,
+			},
+			args: args{
+				p: buildTestPostgres(),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:                     gcpTestPostgresInstanceName,
+							Region:                   gcpTestRegion,
+							State:                    "RUNNABLE",
+							DatabaseVersion:          defaultGCPCLoudSQLDatabaseVersion,
+							PscServiceAttachmentLink: "projects/p-tenant/regions/" + gcpTestRegion + "/serviceAttachments/a-1",
+							Settings: &sqladmin.Settings{
+								BackupConfiguration: &sqladmin.BackupConfiguration{
+									BackupRetentionSettings: &sqladmin.BackupRetentionSettings{},
+								},
+								IpConfiguration: &sqladmin.IpConfiguration{
+									PscConfig: &sqladmin.PscConfig{PscEnabled: true},
+								},
+								MaintenanceWindow: &sqladmin.MaintenanceWindow{},
+							},
+						}, nil
+					}
+				}),
+				networkManager: &NetworkManagerMock{
+					CreatePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, types.StatusMessage, error) {
+						return &computepb.Address{Name: utils.To(buildPrivateServiceConnectEndpointName(name)), Address: utils.To("10.0.32.5")}, "", nil
+					},
+				},
+				strategyConfig: &StrategyConfig{
+					ProjectID:      "sample-project-id",
+					CreateStrategy: json.RawMessage(`{"instance":{}}`),
+				},
+				method: NetworkConnectionMethodPrivateServiceConnect,
+			},
+			want:    "successfully reconciled cloudsql instance gcptestclustertestNsgcpcloudsql",
+			wantErr: false,
+		},
+		{
+			name: "waiting for private service connect service attachment of cloudSQL instance",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      postgresProviderName + defaultCredSecSuffix,
+					Namespace: testNs,
+				},
+					Data: map[string][]byte{
+						defaultPostgresUserKey:     []byte(testUser),
+						defaultPostgresPasswordKey: []byte(testPassword),
+					},
+				}, buildTestPostgres(), buildTestGcpInfrastructure(nil)),
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(nil),
+				ConfigManager:     nil,
+			},
+			args: args{
+				p: buildTestPostgres(),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:                     gcpTestPostgresInstanceName,
+							Region:                   gcpTestRegion,
+							State:                    "RUNNABLE",
+							DatabaseVersion:          defaultGCPCLoudSQLDatabaseVersion,
+							PscServiceAttachmentLink: "",
+							Settings: &sqladmin.Settings{
+								BackupConfiguration: &sqladmin.BackupConfiguration{
+									BackupRetentionSettings: &sqladmin.BackupRetentionSettings{},
+								},
+								IpConfiguration: &sqladmin.IpConfiguration{
+									PscConfig: &sqladmin.PscConfig{PscEnabled: true},
+								},
+								MaintenanceWindow: &sqladmin.MaintenanceWindow{},
+							},
+						}, nil
+					}
+				}),
+				networkManager: &NetworkManagerMock{
+					CreatePrivateServiceConnectEndpointFunc: func(ctx context.Context, name string, region string, serviceAttachment string) (*computepb.Address, types.StatusMessage, error) {
+						return &computepb.Address{Name: utils.To(buildPrivateServiceConnectEndpointName(name)), Address: utils.To("10.0.32.5")}, "", nil
+					},
+				},
+				strategyConfig: &StrategyConfig{
+					ProjectID:      "sample-project-id",
+					CreateStrategy: json.RawMessage(`{"instance":{}}`),
+				},
+				method: NetworkConnectionMethodPrivateServiceConnect,
+			},
+			want:    "waiting for the private service connect service attachment of cloudSQL instance gcptestclustertestNsgcpcloudsql",
+			wantErr: false,
+		},
+		{
+			name: "error when adopted cloudSQL instance is not found",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      postgresProviderName + defaultCredSecSuffix,
+					Namespace: testNs,
+				},
+					Data: map[string][]byte{
+						defaultPostgresUserKey:     []byte(testUser),
+						defaultPostgresPasswordKey: []byte(testPassword),
+					},
+				}, buildTestPostgresAdopted("existing-db"), buildTestGcpInfrastructure(nil)),
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(nil),
+				ConfigManager:     nil,
+			},
+			args: args{
+				p:               buildTestPostgresAdopted("existing-db"),
+				sqladminService: gcpiface.GetMockSQLClient(nil),
+				strategyConfig: &StrategyConfig{
+					ProjectID:      "sample-project-id",
+					CreateStrategy: json.RawMessage(`{"instance":{}}`),
+				},
+				address: buildValidGcpAddressRange(gcpTestIpRangeName),
+			},
+			want:    "external cloudSQL instance existing-db of Postgres CR " + postgresProviderName + " in " + testNs + " namespace was not found",
+			wantErr: true,
+		},
+		{
+			name: "error when adopted cloudSQL instance is not a postgres instance",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      postgresProviderName + defaultCredSecSuffix,
+					Namespace: testNs,
+				},
+					Data: map[string][]byte{
+						defaultPostgresUserKey:     []byte(testUser),
+						defaultPostgresPasswordKey: []byte(testPassword),
+					},
+				}, buildTestPostgresAdopted("existing-db"), buildTestGcpInfrastructure(nil)),
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(nil),
+				ConfigManager:     nil,
+			},
+			args: args{
+				p: buildTestPostgresAdopted("existing-db"),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:            "existing-db",
+							DatabaseVersion: "MYSQL_8_0",
+							State:           "RUNNABLE",
+						}, nil
+					}
+				}),
+				strategyConfig: &StrategyConfig{
+					ProjectID:      "sample-project-id",
+					CreateStrategy: json.RawMessage(`{"instance":{}}`),
+				},
+				address: buildValidGcpAddressRange(gcpTestIpRangeName),
+			},
+			want:    "cloudSQL instance existing-db has database version MYSQL_8_0, only postgres instances can be adopted",
+			wantErr: true,
+		},
+		{
+			name: "success adopting cloudSQL instance and resetting credentials",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:      postgresProviderName + defaultCredSecSuffix,
+					Namespace: testNs,
+				},
+					Data: map[string][]byte{
+						defaultPostgresUserKey:     []byte(testUser),
+						defaultPostgresPasswordKey: []byte(testPassword),
+					},
+				}, buildTestPostgresAdopted("existing-db"), buildTestGcpInfrastructure(nil)),
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(nil),
+				ConfigManager:     nil,
+			},
+			args: args{
+				p: buildTestPostgresAdopted("existing-db"),
+				sqladminService: gcpiface.GetMockSQLClient(func(sqlClient *gcpiface.MockSqlClient) {
+					sqlClient.GetInstanceFn = func(ctx context.Context, s string, s2 string) (*sqladmin.DatabaseInstance, error) {
+						return &sqladmin.DatabaseInstance{
+							Name:            "existing-db",
+							DatabaseVersion: "POSTGRES_13",
+							State:           "RUNNABLE",
+							Settings: &sqladmin.Settings{
+								BackupConfiguration: &sqladmin.BackupConfiguration{
+									BackupRetentionSettings: &sqladmin.BackupRetentionSettings{},
+								},
+								IpConfiguration:   &sqladmin.IpConfiguration{},
+								MaintenanceWindow: &sqladmin.MaintenanceWindow{},
+							},
+						}, nil
+					}
+					sqlClient.UpdateUserFn = func(ctx context.Context, s string, s2 string, user *sqladmin.User) (*sqladmin.Operation, error) {
+						if user.Name != testUser || user.Password != testPassword {
+							return nil, errors.New("unexpected user")
+						}
+						return &sqladmin.Operation{}, nil
+					}
+				}),
+				strategyConfig: &StrategyConfig{
+					ProjectID:      "sample-project-id",
+					CreateStrategy: json.RawMessage(`{"instance":{}}`),
+				},
+				address: buildValidGcpAddressRange(gcpTestIpRangeName),
+			},
+			want:    "successfully reconciled cloudsql instance existing-db",
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1486,7 +1859,7 @@ func TestPostgresProvider_reconcileCloudSQLInstance(t *testing.T) {
 				ConfigManager:     tt.fields.ConfigManager,
 				TCPPinger:         resources.BuildMockConnectionTester(),
 			}
-			_, got1, err := pp.reconcileCloudSQLInstance(context.TODO(), tt.args.p, tt.args.sqladminService, tt.args.strategyConfig, tt.args.address)
+			_, got1, err := pp.reconcileCloudSQLInstance(context.TODO(), tt.args.p, tt.args.sqladminService, gcpiface.GetMockProjectsClient(nil), tt.args.networkManager, tt.args.strategyConfig, tt.args.address, tt.args.method)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("reconcileCloudSQLInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1889,7 +2262,7 @@ func TestPostgresProvider_ReconcilePostgres(t *testing.T) {
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgres(), buildTestGcpInfrastructure(nil)),
 				Logger: logrus.NewEntry(logrus.StandardLogger()),
 				CredentialManager: &CredentialManagerMock{
-					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string) (*Credentials, error) {
+					ReconcileProviderCredentialsFunc: func(ctx context.Context, ns string, rt providers.ResourceType) (*Credentials, error) {
 						return nil, errors.New("generic error")
 					},
 				},
@@ -1943,6 +2316,7 @@ func TestPostgresProvider_buildCloudSQLCreateStrategy(t *testing.T) {
 		strategyConfig *StrategyConfig
 		sec            *corev1.Secret
 		address        *computepb.Address
+		method         NetworkConnectionMethod
 	}
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -1971,9 +2345,11 @@ func TestPostgresProvider_buildCloudSQLCreateStrategy(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "postgres",
-						Tier: "development",
+					Spec: v1alpha1.PostgresSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "postgres",
+							Tier: "development",
+						},
 					},
 				},
 				strategyConfig: &StrategyConfig{
@@ -2051,7 +2427,7 @@ func TestPostgresProvider_buildCloudSQLCreateStrategy(t *testing.T) {
 			p := &PostgresProvider{
 				Client: tt.fields.Client,
 			}
-			got, err := p.buildCloudSQLCreateStrategy(context.TODO(), tt.args.pg, tt.args.strategyConfig, tt.args.sec, tt.args.address)
+			got, err := p.buildCloudSQLCreateStrategy(context.TODO(), tt.args.pg, tt.args.strategyConfig, tt.args.sec, tt.args.address, tt.args.method)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildCloudSQLCreateStrategy() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -2099,9 +2475,11 @@ func TestPostgresProvider_buildCloudSQLDeleteStrategy(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "postgres",
-						Tier: "development",
+					Spec: v1alpha1.PostgresSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "postgres",
+							Tier: "development",
+						},
 					},
 				},
 				strategyConfig: &StrategyConfig{
@@ -12,7 +12,6 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
 	errorUtil "github.com/pkg/errors"
 	str2duration "github.com/xhit/go-str2duration/v2"
-	"google.golang.org/api/option"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
@@ -20,6 +19,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,15 +41,21 @@ type PostgresSnapshotProvider struct {
 	logger            *logrus.Entry
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
+	Recorder          record.EventRecorder
 }
 
-func NewGCPPostgresSnapshotProvider(client client.Client, logger *logrus.Entry) *PostgresSnapshotProvider {
+func NewGCPPostgresSnapshotProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*PostgresSnapshotProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &PostgresSnapshotProvider{
 		client:            client,
 		logger:            logger.WithFields(logrus.Fields{"provider": postgresProviderName}),
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
-	}
+		Recorder:          recorder,
+	}, nil
 }
 
 func (p *PostgresSnapshotProvider) GetName() string {
@@ -78,17 +84,23 @@ func (p *PostgresSnapshotProvider) CreatePostgresSnapshot(ctx context.Context, s
 		msg := "failed to retrieve postgres strategy config"
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		msg := fmt.Sprintf("failed to reconcile gcp provider credentials for postgres instance %s", pg.Name)
+		resources.RecordWarningEvent(p.Recorder, snap, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
-	storageClient, err := gcpiface.NewStorageAPI(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), logger)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		msg := "failed to build gcp client option from postgres strategy"
+		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	storageClient, err := gcpiface.NewStorageAPI(ctx, clientOption, logger)
 	if err != nil {
 		msg := "could not initialise storage client"
 		return nil, croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
-	sqlClient, err := gcpiface.NewSQLAdminService(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), p.logger)
+	sqlClient, err := gcpiface.NewSQLAdminService(ctx, clientOption, p.logger)
 	if err != nil {
 		errMsg := "could not initialise sql admin service"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
@@ -98,12 +110,23 @@ func (p *PostgresSnapshotProvider) CreatePostgresSnapshot(ctx context.Context, s
 
 func (p *PostgresSnapshotProvider) DeletePostgresSnapshot(ctx context.Context, snap *v1alpha1.PostgresSnapshot, pg *v1alpha1.Postgres) (croType.StatusMessage, error) {
 	logger := p.logger.WithField("action", "DeletePostgresSnapshot")
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace)
+	strategyConfig, err := p.ConfigManager.ReadStorageStrategy(ctx, providers.PostgresResourceType, pg.Spec.Tier)
+	if err != nil {
+		msg := "failed to retrieve postgres strategy config"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, pg.Namespace, providers.PostgresResourceType)
 	if err != nil {
 		msg := fmt.Sprintf("failed to reconcile gcp provider credentials for postgres instance %s", pg.Name)
+		resources.RecordWarningEvent(p.Recorder, snap, resources.EventReasonCredentialsFailed, "%s: %v", msg, err)
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
 	}
-	storageClient, err := gcpiface.NewStorageAPI(ctx, option.WithCredentialsJSON(creds.ServiceAccountJson), logger)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		msg := "failed to build gcp client option from postgres strategy"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	storageClient, err := gcpiface.NewStorageAPI(ctx, clientOption, logger)
 	if err != nil {
 		msg := "could not initialise storage client"
 		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
@@ -118,8 +141,7 @@ func (p *PostgresSnapshotProvider) reconcilePostgresSnapshot(ctx context.Context
 		return nil, croType.StatusMessage(errMsg), errorUtil.New(errMsg)
 	}
 	snapshotID := snap.Name
-	snap.Status.SnapshotID = snapshotID
-	if err := p.client.Status().Update(ctx, snap); err != nil {
+	if err := resources.PatchStatus(ctx, p.client, snap, func() { snap.Status.SnapshotID = snapshotID }); err != nil {
 		errMsg := fmt.Sprintf("failed to update snapshot %s in namespace %s", snap.Name, snap.Namespace)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
@@ -208,6 +230,9 @@ func (p *PostgresSnapshotProvider) createPostgresSnapshot(ctx context.Context, s
 		errMsg := fmt.Sprintf("failed to export database from postgres instance %s", instanceName)
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	if err == nil {
+		resources.RecordEvent(p.Recorder, snap, resources.EventReasonCreateStarted, "started export of cloudSQL instance %s to gs://%s/%s", instanceName, instanceName, snap.Status.SnapshotID)
+	}
 	msg := fmt.Sprintf("snapshot creation started for %s", snap.Name)
 	return croType.StatusMessage(msg), nil
 }
@@ -223,8 +248,7 @@ func (p *PostgresSnapshotProvider) reconcileSkipDelete(ctx context.Context, snap
 		return croType.StatusMessage(msg), nil
 	}
 	if snap.Name == latestSnapshot.Name {
-		snap.Spec.SkipDelete = true
-		if err = p.client.Update(ctx, snap); err != nil {
+		if err = resources.PatchObject(ctx, p.client, snap, func() { snap.Spec.SkipDelete = true }); err != nil {
 			errMsg := fmt.Sprintf("failed to update postgres snapshot %s", snap.Name)
 			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 		}
@@ -239,8 +263,7 @@ func (p *PostgresSnapshotProvider) reconcileSkipDelete(ctx context.Context, snap
 			if snapshots[i].Name == latestSnapshot.Name {
 				continue
 			}
-			snapshots[i].Spec.SkipDelete = false
-			if err = p.client.Update(ctx, snapshots[i]); err != nil {
+			if err = resources.PatchObject(ctx, p.client, snapshots[i], func() { snapshots[i].Spec.SkipDelete = false }); err != nil {
 				errMsg := "failed to remove skipDelete from postgres snapshot cr"
 				return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 			}
@@ -277,8 +300,7 @@ func (p *PostgresSnapshotProvider) deletePostgresSnapshot(ctx context.Context, s
 			}
 		}
 	}
-	resources.RemoveFinalizer(&snap.ObjectMeta, DefaultFinalizer)
-	if err := p.client.Update(ctx, snap); err != nil {
+	if err := resources.DeleteFinalizer(ctx, p.client, snap, DefaultFinalizer); err != nil {
 		errMsg := "failed to update snapshot as part of finalizer reconcile"
 		return croType.StatusMessage(errMsg), errorUtil.Wrapf(err, errMsg)
 	}
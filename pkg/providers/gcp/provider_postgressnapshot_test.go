@@ -659,7 +659,7 @@ func TestPostgresProvider_reconcileSkipDelete(t *testing.T) {
 			fields: fields{
 				Client: func() k8sclient.Client {
 					mc := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresSnapshot())
-					mc.UpdateFunc = func(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj k8sclient.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
 						return errors.New("generic error")
 					}
 					return mc
@@ -743,7 +743,7 @@ func TestPostgresProvider_deletePostgresSnapshot(t *testing.T) {
 			fields: fields{
 				Client: func() k8sclient.Client {
 					mc := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestLatestPostgresSnapshot(gcpTestPostgresSnapshotName))
-					mc.UpdateFunc = func(ctx context.Context, obj k8sclient.Object, opts ...k8sclient.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj k8sclient.Object, patch k8sclient.Patch, opts ...k8sclient.PatchOption) error {
 						return errors.New("generic error")
 					}
 					return mc
@@ -751,7 +751,11 @@ func TestPostgresProvider_deletePostgresSnapshot(t *testing.T) {
 				Logger: logrus.NewEntry(logrus.StandardLogger()),
 			},
 			args: args{
-				snap: buildTestLatestPostgresSnapshot(gcpTestPostgresSnapshotName),
+				snap: func() *v1alpha1.PostgresSnapshot {
+					snap := buildTestLatestPostgresSnapshot(gcpTestPostgresSnapshotName)
+					snap.Finalizers = []string{DefaultFinalizer}
+					return snap
+				}(),
 			},
 			want:    "failed to update snapshot as part of finalizer reconcile",
 			wantErr: true,
@@ -4,15 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
-	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
 
 	"cloud.google.com/go/compute/apiv1/computepb"
@@ -24,6 +23,7 @@ import (
 	errorUtil "github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/api/option"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,16 +41,22 @@ type RedisProvider struct {
 	CredentialManager CredentialManager
 	ConfigManager     ConfigManager
 	TCPPinger         resources.ConnectionTester
+	Recorder          record.EventRecorder
 }
 
-func NewGCPRedisProvider(client client.Client, logger *logrus.Entry) *RedisProvider {
+func NewGCPRedisProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) (*RedisProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &RedisProvider{
 		Client:            client,
 		Logger:            logger.WithFields(logrus.Fields{"provider": redisProviderName}),
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
 		TCPPinger:         resources.NewConnectionTestManager(),
-	}
+		Recorder:          recorder,
+	}, nil
 }
 
 func (p *RedisProvider) GetName() string {
@@ -82,13 +88,23 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 		statusMessage := "failed to set finalizer"
 		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.RedisResourceType)
 	if err != nil {
 		statusMessage := fmt.Sprintf("failed to reconcile gcp redis provider credentials for redis instance %s", r.Name)
+		resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonCredentialsFailed, "%s: %v", statusMessage, err)
+		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		statusMessage := "failed to build gcp client option from redis strategy"
+		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	networkProject, err := GetNetworkProjectFromStrategy(ctx, p.Client, strategyConfig)
+	if err != nil {
+		statusMessage := "failed to get gcp network project"
 		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	clientOption := option.WithCredentialsJSON(creds.ServiceAccountJson)
-	networkManager, err := NewNetworkManager(ctx, strategyConfig.ProjectID, clientOption, p.Client, logger)
+	networkManager, err := NewNetworkManager(ctx, networkProject, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
 	if err != nil {
 		statusMessage := "failed to initialise network manager"
 		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
@@ -98,22 +114,24 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 		statusMessage := "could not initialise redis client"
 		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	return p.createRedisInstance(ctx, networkManager, redisClient, strategyConfig, r)
-}
-
-func (p *RedisProvider) createRedisInstance(ctx context.Context, networkManager NetworkManager, redisClient gcpiface.RedisAPI, strategyConfig *StrategyConfig, r *v1alpha1.Redis) (*providers.RedisCluster, croType.StatusMessage, error) {
-	ipRangeCidr, err := networkManager.ReconcileNetworkProviderConfig(ctx, p.ConfigManager, r.Spec.Tier)
+	projectsClient, err := gcpiface.NewProjectsAPI(ctx, clientOption)
 	if err != nil {
-		errMsg := "failed to reconcile network provider config"
-		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		statusMessage := "could not initialise projects client"
+		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	address, msg, err := networkManager.CreateNetworkIpRange(ctx, ipRangeCidr)
+	return p.createRedisInstance(ctx, networkManager, redisClient, projectsClient, strategyConfig, r)
+}
+
+func (p *RedisProvider) createRedisInstance(ctx context.Context, networkManager NetworkManager, redisClient gcpiface.RedisAPI, projectsClient gcpiface.ProjectsAPI, strategyConfig *StrategyConfig, r *v1alpha1.Redis) (*providers.RedisCluster, croType.StatusMessage, error) {
+	// reserve the ip range from the _network strat map, based on tier from redis cr or the cloud network
+	address, method, msg, err := reconcileNetwork(ctx, p.Client, networkManager, p.ConfigManager, r.Spec.Tier)
 	if err != nil || msg != "" {
 		return nil, msg, err
 	}
-	_, msg, err = networkManager.CreateNetworkService(ctx)
-	if err != nil || msg != "" {
-		return nil, msg, err
+	// memorystore for redis instances are only reachable through a service networking connection
+	if method == NetworkConnectionMethodPrivateServiceConnect {
+		statusMessage := fmt.Sprintf("gcp redis instances do not support the %s network connection method, please update `_network` strategy", method)
+		return nil, croType.StatusMessage(statusMessage), errorUtil.New(statusMessage)
 	}
 	createInstanceRequest, err := p.buildCreateInstanceRequest(ctx, r, strategyConfig, address)
 	if err != nil {
@@ -128,9 +146,17 @@ func (p *RedisProvider) createRedisInstance(ctx context.Context, networkManager
 	defer p.exposeRedisInstanceMetrics(ctx, r, foundInstance)
 
 	if foundInstance != nil {
-		if !annotations.Has(r, ResourceIdentifierAnnotation) {
-			annotations.Add(r, ResourceIdentifierAnnotation, createInstanceRequest.InstanceId)
-			if err := p.Client.Update(ctx, r); err != nil {
+		// take over an existing instance the first time it is found, the operator labels are applied by the update
+		// request
+		if resources.IsAdopted(r.Spec.ResourceTypeSpec) && !resources.HasResourceIdentifier(ctx, r) {
+			if err := resources.ValidateAdoption(createInstanceRequest.Instance.Labels, foundInstance.Labels, buildAdoptionOwnershipLabelKeys()); err != nil {
+				statusMessage := fmt.Sprintf("gcp redis instance %s can not be adopted", createInstanceRequest.Instance.Name)
+				return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+			}
+			resources.RecordEvent(p.Recorder, r, resources.EventReasonAdopted, "adopted existing gcp redis instance %s", createInstanceRequest.Instance.Name)
+		}
+		if !resources.HasResourceIdentifier(ctx, r) {
+			if err := resources.SetResourceIdentifier(ctx, p.Client, r, createInstanceRequest.InstanceId); err != nil {
 				statusMessage := "failed to add annotation to redis cr"
 				return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 			}
@@ -141,15 +167,23 @@ func (p *RedisProvider) createRedisInstance(ctx context.Context, networkManager
 	}
 
 	if foundInstance == nil {
+		// an instance which is adopted is never created
+		if resources.IsAdopted(r.Spec.ResourceTypeSpec) {
+			statusMessage := fmt.Sprintf("external gcp redis instance %s of Redis CR %s in %s namespace was not found", r.Spec.ExternalResourceID, r.Name, r.Namespace)
+			return nil, croType.StatusMessage(statusMessage), errorUtil.New(statusMessage)
+		}
+		preflight := preflightRedisInstance(ctx, projectsClient, strategyConfig.ProjectID)
+		if !resources.ReportPreflight(p.Recorder, r, &r.Status, preflight) {
+			statusMessage := fmt.Sprintf("preflight checks failed for gcp redis instance %s: %s", createInstanceRequest.Instance.Name, preflight)
+			return nil, croType.StatusMessage(statusMessage), errorUtil.New(statusMessage)
+		}
 		_, err = redisClient.CreateInstance(ctx, createInstanceRequest)
 		if err != nil {
 			statusMessage := fmt.Sprintf("failed to create gcp redis instance %s", createInstanceRequest.Instance.Name)
 			return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 		}
-		_, err = controllerutil.CreateOrUpdate(ctx, p.Client, r, func() error {
-			annotations.Add(r, ResourceIdentifierAnnotation, createInstanceRequest.InstanceId)
-			return nil
-		})
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonCreateStarted, "started provisioning gcp redis instance %s", createInstanceRequest.Instance.Name)
+		err = resources.SetResourceIdentifier(ctx, p.Client, r, createInstanceRequest.InstanceId)
 		if err != nil {
 			statusMessage := "failed to add annotation to redis cr"
 			return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
@@ -162,19 +196,45 @@ func (p *RedisProvider) createRedisInstance(ctx context.Context, networkManager
 		return nil, croType.StatusMessage(statusMessage), nil
 	}
 
-	if updateInstanceRequest := p.buildUpdateInstanceRequest(createInstanceRequest.Instance, foundInstance); updateInstanceRequest != nil {
+	var pendingMaintenance []string
+	if foundInstance.MaintenanceSchedule != nil && foundInstance.MaintenanceSchedule.StartTime != nil {
+		pendingMaintenance = append(pendingMaintenance, fmt.Sprintf("maintenance scheduled for gcp redis instance %s at %s", createInstanceRequest.Instance.Name, foundInstance.MaintenanceSchedule.StartTime.AsTime().Format(time.RFC3339)))
+	}
+	resources.ReportMaintenance(p.Recorder, r, &r.Status, pendingMaintenance)
+
+	// modifications are applied on every reconcile, drift from the strategy is reported and corrected below
+	drift := buildRedisDrift(createInstanceRequest.Instance, foundInstance)
+	resources.ReportDrift(ctx, p.Client, p.Recorder, r, &r.Status, drift, string(providers.RedisResourceType), createInstanceRequest.Instance.Name, redisProviderName)
+
+	updateInstanceRequest := p.buildUpdateInstanceRequest(createInstanceRequest.Instance, foundInstance)
+	upgradeInstanceRequest := p.buildUpgradeInstanceRequest(createInstanceRequest.Instance, foundInstance)
+	if resources.IsDryRun(r) {
+		p.Logger.Infof("dry-run mode enabled, not updating gcp redis instance %s", createInstanceRequest.Instance.Name)
+		resources.SetPendingChanges(p.Recorder, r, &r.Status, buildRedisPendingChanges(updateInstanceRequest, upgradeInstanceRequest, foundInstance))
+		updateInstanceRequest, upgradeInstanceRequest = nil, nil
+	} else {
+		resources.SetPendingChanges(p.Recorder, r, &r.Status, nil)
+	}
+	if updateInstanceRequest != nil {
 		_, err = redisClient.UpdateInstance(ctx, updateInstanceRequest)
 		if err != nil {
 			statusMessage := fmt.Sprintf("failed to update gcp redis instance %s", createInstanceRequest.Instance.Name)
 			return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 		}
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonModifyApplied, "updated gcp redis instance %s", createInstanceRequest.Instance.Name)
 	}
-	if upgradeInstanceRequest := p.buildUpgradeInstanceRequest(createInstanceRequest.Instance, foundInstance); upgradeInstanceRequest != nil {
+	if upgradeInstanceRequest != nil {
 		_, err = redisClient.UpgradeInstance(ctx, upgradeInstanceRequest)
 		if err != nil {
 			statusMessage := fmt.Sprintf("failed to upgrade gcp redis instance %s", createInstanceRequest.Instance.Name)
 			return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 		}
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonServiceUpdateApplied, "upgraded gcp redis instance %s to version %s", createInstanceRequest.Instance.Name, upgradeInstanceRequest.RedisVersion)
+	}
+
+	if err = networkManager.ReconcileNetworkAccess(ctx, createInstanceRequest.InstanceId, foundInstance.Host, foundInstance.Port, r.Spec.NetworkAccess); err != nil {
+		statusMessage := fmt.Sprintf("failed to reconcile network access of gcp redis instance %s", createInstanceRequest.Instance.Name)
+		return nil, croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
 
 	rdd := &providers.RedisDeploymentDetails{
@@ -195,9 +255,10 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 		statusMessage := "failed to retrieve redis strategy config"
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.RedisResourceType)
 	if err != nil {
 		statusMessage := fmt.Sprintf("failed to reconcile gcp redis provider credentials for redis instance %s", r.Name)
+		resources.RecordWarningEvent(p.Recorder, r, resources.EventReasonCredentialsFailed, "%s: %v", statusMessage, err)
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
 	isLastResource, err := resources.IsLastResource(ctx, p.Client)
@@ -205,8 +266,26 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 		statusMessage := "failed to check if this cr is the last cr of type postgres and redis"
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	clientOption := option.WithCredentialsJSON(creds.ServiceAccountJson)
-	networkManager, err := NewNetworkManager(ctx, strategyConfig.ProjectID, clientOption, p.Client, logger)
+	// the network is deleted with the cloud network when one exists
+	cloudNetwork, err := resources.GetCloudNetwork(ctx, p.Client)
+	if err != nil {
+		statusMessage := "failed to get cloud network"
+		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	if cloudNetwork != nil {
+		isLastResource = false
+	}
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		statusMessage := "failed to build gcp client option from redis strategy"
+		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	networkProject, err := GetNetworkProjectFromStrategy(ctx, p.Client, strategyConfig)
+	if err != nil {
+		statusMessage := "failed to get gcp network project"
+		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	networkManager, err := NewNetworkManager(ctx, networkProject, option.WithCredentialsJSON(creds.ServiceAccountJson), p.Client, logger)
 	if err != nil {
 		statusMessage := "failed to initialise network manager"
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
@@ -216,11 +295,18 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 		statusMessage := "could not initialise redis client"
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
 	}
-	return p.deleteRedisInstance(ctx, networkManager, redisClient, strategyConfig, r, isLastResource)
+	storageClient, err := gcpiface.NewStorageAPI(ctx, clientOption, logger)
+	if err != nil {
+		statusMessage := "could not initialise storage client"
+		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+	return p.deleteRedisInstance(ctx, networkManager, redisClient, storageClient, strategyConfig, r, isLastResource)
 }
 
-func (p *RedisProvider) deleteRedisInstance(ctx context.Context, networkManager NetworkManager, redisClient gcpiface.RedisAPI, strategyConfig *StrategyConfig, r *v1alpha1.Redis, isLastResource bool) (croType.StatusMessage, error) {
-	deleteInstanceRequest, err := p.buildDeleteInstanceRequest(r, strategyConfig)
+// deleteRedisInstance deletes the memorystore instance, the deletion policy of the cr can keep the instance instead, or
+// export a final snapshot before it is deleted
+func (p *RedisProvider) deleteRedisInstance(ctx context.Context, networkManager NetworkManager, redisClient gcpiface.RedisAPI, storageClient gcpiface.StorageAPI, strategyConfig *StrategyConfig, r *v1alpha1.Redis, isLastResource bool) (croType.StatusMessage, error) {
+	deleteInstanceRequest, err := p.buildDeleteInstanceRequest(ctx, r, strategyConfig)
 	if err != nil {
 		statusMessage := "failed to build delete gcp redis instance request"
 		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
@@ -236,6 +322,49 @@ func (p *RedisProvider) deleteRedisInstance(ctx context.Context, networkManager
 			statusMessage := fmt.Sprintf("deletion in progress for gcp redis instance %s", deleteInstanceRequest.Name)
 			return croType.StatusMessage(statusMessage), nil
 		}
+		if resources.IsRetained(r.Spec.ResourceTypeSpec) {
+			if labels, changed := buildOrphanedLabels(foundInstance.Labels); changed {
+				_, err = redisClient.UpdateInstance(ctx, &redispb.UpdateInstanceRequest{
+					UpdateMask: &fieldmaskpb.FieldMask{
+						Paths: []string{"labels"},
+					},
+					Instance: &redispb.Instance{
+						Name:   deleteInstanceRequest.Name,
+						Labels: labels,
+					},
+				})
+				if err != nil {
+					statusMessage := fmt.Sprintf("failed to label gcp redis instance %s as orphaned", deleteInstanceRequest.Name)
+					return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+				}
+			}
+			return resources.RetainResource(ctx, p.Client, p.Recorder, r, DefaultFinalizer, deleteInstanceRequest.Name)
+		}
+		if resources.IsFinalSnapshotRequired(r.Spec.ResourceTypeSpec) {
+			// the bucket is named after the instance id, the last segment of the instance name
+			instanceName := path.Base(deleteInstanceRequest.Name)
+			exported, err := exportFinalSnapshot(ctx, storageClient, p.Recorder, r, strategyConfig, instanceName, foundInstance.PersistenceIamIdentity, func() error {
+				_, err := redisClient.ExportInstance(ctx, &redispb.ExportInstanceRequest{
+					Name: deleteInstanceRequest.Name,
+					OutputConfig: &redispb.OutputConfig{
+						Destination: &redispb.OutputConfig_GcsDestination{
+							GcsDestination: &redispb.GcsDestination{
+								Uri: buildFinalSnapshotURI(instanceName),
+							},
+						},
+					},
+				})
+				return err
+			})
+			if err != nil {
+				statusMessage := fmt.Sprintf("failed to export final snapshot of gcp redis instance %s", deleteInstanceRequest.Name)
+				return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+			}
+			if !exported {
+				statusMessage := fmt.Sprintf("final snapshot export in progress for gcp redis instance %s", deleteInstanceRequest.Name)
+				return croType.StatusMessage(statusMessage), nil
+			}
+		}
 		_, err = redisClient.DeleteInstance(ctx, deleteInstanceRequest)
 		if err != nil {
 			statusMessage := fmt.Sprintf("failed to delete gcp redis instance %s", deleteInstanceRequest.Name)
@@ -245,6 +374,11 @@ func (p *RedisProvider) deleteRedisInstance(ctx context.Context, networkManager
 		return croType.StatusMessage(statusMessage), nil
 	}
 
+	if err = networkManager.DeleteNetworkAccess(ctx, path.Base(deleteInstanceRequest.Name)); err != nil {
+		statusMessage := fmt.Sprintf("failed to delete network access of gcp redis instance %s", deleteInstanceRequest.Name)
+		return croType.StatusMessage(statusMessage), errorUtil.Wrap(err, statusMessage)
+	}
+
 	// remove networking components
 	if isLastResource {
 		if err = networkManager.DeleteNetworkPeering(ctx); err != nil {
@@ -270,8 +404,7 @@ func (p *RedisProvider) deleteRedisInstance(ctx context.Context, networkManager
 	}
 
 	// remove the finalizer added by the provider
-	resources.RemoveFinalizer(&r.ObjectMeta, DefaultFinalizer)
-	if err = p.Client.Update(ctx, r); err != nil {
+	if err = resources.SetResourceDeleted(ctx, p.Client, r, DefaultFinalizer); err != nil {
 		statusMessage := fmt.Sprintf("failed to update instance %s as part of finalizer reconcile", r.Name)
 		return croType.StatusMessage(statusMessage), errorUtil.Wrapf(err, statusMessage)
 	}
@@ -284,7 +417,7 @@ func (p *RedisProvider) exposeRedisInstanceMetrics(ctx context.Context, r *v1alp
 	if instance == nil {
 		return
 	}
-	instanceName := annotations.Get(r, ResourceIdentifierAnnotation)
+	instanceName := resources.GetResourceIdentifier(ctx, r)
 	if instanceName == "" {
 		p.Logger.Errorf("failed to find %s annotation while exposing metrics for redis instance %s", ResourceIdentifierAnnotation, instanceName)
 	}
@@ -312,7 +445,14 @@ func (p *RedisProvider) exposeRedisInstanceMetrics(ctx context.Context, r *v1alp
 	var instanceConnectable float64
 	if resources.Contains(healthyRedisInstanceStates(), instanceState) {
 		instanceHealthy = 1
-		if success := p.TCPPinger.TCPConnection(instance.Host, int(instance.Port)); success {
+		// memorystore is created without auth enabled so only PING is sent
+		result := p.TCPPinger.RedisConnection(ctx, &resources.RedisConnectionDetails{
+			Host: instance.Host,
+			Port: int(instance.Port),
+			TLS:  instance.TransitEncryptionMode == redispb.Instance_SERVER_AUTHENTICATION,
+		})
+		resources.SetConnectionProbeMetrics(resources.ConnectionProbeResourceTypeRedis, genericLabels, result)
+		if result.Success {
 			instanceConnectable = 1
 		}
 	}
@@ -334,7 +474,7 @@ func healthyRedisInstanceStates() []string {
 // https://github.com/kubernetes/kube-state-metrics/blob/0bfc2981f9c281c78e33052abdc2d621630562b9/internal/store/pod.go#L200-L218
 func (p *RedisProvider) setRedisDeletionTimestampMetric(ctx context.Context, r *v1alpha1.Redis) {
 	if r.DeletionTimestamp != nil && !r.DeletionTimestamp.IsZero() {
-		instanceName := annotations.Get(r, ResourceIdentifierAnnotation)
+		instanceName := resources.GetResourceIdentifier(ctx, r)
 		if instanceName == "" {
 			p.Logger.Errorf("failed to find %s annotation while exposing metrics for redis cr %s", ResourceIdentifierAnnotation, r.Name)
 			return
@@ -357,8 +497,9 @@ func (p *RedisProvider) buildCreateInstanceRequest(ctx context.Context, r *v1alp
 	if createInstanceRequest.Parent == "" {
 		createInstanceRequest.Parent = fmt.Sprintf(redisParentFormat, strategyConfig.ProjectID, strategyConfig.Region)
 	}
-	if createInstanceRequest.InstanceId == "" {
-		instanceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, r.ObjectMeta, defaultGcpIdentifierLength)
+	// an adopted instance is always identified by the external resource id of the cr
+	if createInstanceRequest.InstanceId == "" || resources.IsAdopted(r.Spec.ResourceTypeSpec) {
+		instanceID, err := resources.BuildResourceIdentifier(ctx, p.Client, r.ObjectMeta, r.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 		if err != nil {
 			return nil, errorUtil.Wrap(err, "failed to build gcp redis instance id from object")
 		}
@@ -425,13 +566,13 @@ func (p *RedisProvider) buildCreateInstanceRequest(ctx context.Context, r *v1alp
 	return createInstanceRequest, nil
 }
 
-func (p *RedisProvider) buildDeleteInstanceRequest(r *v1alpha1.Redis, strategyConfig *StrategyConfig) (*redispb.DeleteInstanceRequest, error) {
+func (p *RedisProvider) buildDeleteInstanceRequest(ctx context.Context, r *v1alpha1.Redis, strategyConfig *StrategyConfig) (*redispb.DeleteInstanceRequest, error) {
 	deleteInstanceRequest := &redispb.DeleteInstanceRequest{}
 	if err := json.Unmarshal(strategyConfig.DeleteStrategy, deleteInstanceRequest); err != nil {
 		return nil, errorUtil.Wrap(err, "failed to unmarshal gcp redis delete strategy")
 	}
 	if deleteInstanceRequest.Name == "" {
-		resourceID := annotations.Get(r, ResourceIdentifierAnnotation)
+		resourceID := resources.GetResourceIdentifier(ctx, r)
 		if resourceID == "" {
 			return nil, fmt.Errorf("failed to find gcp redis instance name from annotations")
 		}
@@ -474,6 +615,60 @@ func (p *RedisProvider) buildUpdateInstanceRequest(instanceConfig *redispb.Insta
 	return updateInstanceReq
 }
 
+// buildRedisDrift compares the attributes of the found memorystore instance against the create strategy
+func buildRedisDrift(instanceConfig *redispb.Instance, instance *redispb.Instance) *resources.Drift {
+	drift := &resources.Drift{}
+	if instanceConfig.Tier != redispb.Instance_TIER_UNSPECIFIED {
+		drift.Compare("tier", instance.Tier.String(), instanceConfig.Tier.String())
+	}
+	if instanceConfig.MemorySizeGb != 0 {
+		drift.Compare("memory_size_gb", instance.MemorySizeGb, instanceConfig.MemorySizeGb)
+	}
+	if instanceConfig.ReplicaCount != 0 {
+		drift.Compare("replica_count", instance.ReplicaCount, instanceConfig.ReplicaCount)
+	}
+	drift.Compare("redis_version", instance.RedisVersion, instanceConfig.RedisVersion)
+	drift.Compare("location_id", instance.LocationId, instanceConfig.LocationId)
+	drift.Compare("authorized_network", instance.AuthorizedNetwork, instanceConfig.AuthorizedNetwork)
+	if instanceConfig.ConnectMode != redispb.Instance_CONNECT_MODE_UNSPECIFIED {
+		drift.Compare("connect_mode", instance.ConnectMode.String(), instanceConfig.ConnectMode.String())
+	}
+	if instanceConfig.TransitEncryptionMode != redispb.Instance_TRANSIT_ENCRYPTION_MODE_UNSPECIFIED {
+		drift.Compare("transit_encryption_mode", instance.TransitEncryptionMode.String(), instanceConfig.TransitEncryptionMode.String())
+	}
+	drift.Compare("auth_enabled", instance.AuthEnabled, instanceConfig.AuthEnabled)
+	if isMaintenancePolicyOutdated(instance.MaintenancePolicy, instanceConfig.MaintenancePolicy) {
+		drift.Compare("maintenance_policy", instance.MaintenancePolicy.GetWeeklyMaintenanceWindow(), instanceConfig.MaintenancePolicy.GetWeeklyMaintenanceWindow())
+	}
+	drift.CompareTags("labels", instance.Labels, instanceConfig.Labels)
+	drift.CompareTags("redis_configs", instance.RedisConfigs, instanceConfig.RedisConfigs)
+	return drift
+}
+
+// buildRedisPendingChanges returns the fields sent by the update and upgrade requests as pending changes against the
+// found instance
+func buildRedisPendingChanges(updateInstanceReq *redispb.UpdateInstanceRequest, upgradeInstanceReq *redispb.UpgradeInstanceRequest, instance *redispb.Instance) []croType.PendingChange {
+	var changes []croType.PendingChange
+	if updateInstanceReq != nil {
+		for _, path := range updateInstanceReq.UpdateMask.Paths {
+			switch path {
+			case "memory_size_gb":
+				changes = append(changes, resources.NewPendingChange(path, instance.MemorySizeGb, updateInstanceReq.Instance.MemorySizeGb))
+			case "labels":
+				changes = append(changes, resources.NewPendingChange(path, instance.Labels, updateInstanceReq.Instance.Labels))
+			case "redis_configs":
+				changes = append(changes, resources.NewPendingChange(path, instance.RedisConfigs, updateInstanceReq.Instance.RedisConfigs))
+			case "maintenance_policy":
+				changes = append(changes, resources.NewPendingChange(path, instance.MaintenancePolicy.GetWeeklyMaintenanceWindow(), updateInstanceReq.Instance.MaintenancePolicy.GetWeeklyMaintenanceWindow()))
+			}
+		}
+	}
+	if upgradeInstanceReq != nil {
+		changes = append(changes, resources.NewPendingChange("redis_version", instance.RedisVersion, upgradeInstanceReq.RedisVersion))
+	}
+	return changes
+}
+
 func isMaintenancePolicyOutdated(a *redispb.MaintenancePolicy, b *redispb.MaintenancePolicy) bool {
 	if a == nil && b == nil {
 		return false
@@ -9,7 +9,6 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp/gcpiface"
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/api/option"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"math"
@@ -34,10 +33,14 @@ type RedisMetricsProvider struct {
 }
 
 func NewGCPRedisMetricsProvider(client client.Client, logger *logrus.Entry) (*RedisMetricsProvider, error) {
+	cm, err := NewCredentialManager(client)
+	if err != nil {
+		return nil, err
+	}
 	return &RedisMetricsProvider{
 		Client:            client,
 		Logger:            logger.WithFields(logrus.Fields{"providers": redisMetricProviderName}),
-		CredentialManager: NewCredentialMinterCredentialManager(client),
+		CredentialManager: cm,
 		ConfigManager:     NewDefaultConfigManager(client),
 	}, nil
 }
@@ -52,11 +55,14 @@ func (p *RedisMetricsProvider) ScrapeRedisMetrics(ctx context.Context, r *v1alph
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve redis strategy config: %w", err)
 	}
-	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace)
+	creds, err := p.CredentialManager.ReconcileProviderCredentials(ctx, r.Namespace, providers.RedisResourceType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to reconcile redis provider credentials: %w", err)
 	}
-	clientOption := option.WithCredentialsJSON(creds.ServiceAccountJson)
+	clientOption, err := CreateClientOptionFromStrategy(ctx, creds, strategyConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build gcp client option from redis strategy: %w", err)
+	}
 	metricClient, err := gcpiface.NewMetricAPI(ctx, clientOption, p.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialise metric client: %w", err)
@@ -65,7 +71,7 @@ func (p *RedisMetricsProvider) ScrapeRedisMetrics(ctx context.Context, r *v1alph
 	if err != nil {
 		return nil, fmt.Errorf("error getting cluster id: %w", err)
 	}
-	instanceID, err := resources.BuildInfraNameFromObject(ctx, p.Client, r.ObjectMeta, defaultGcpIdentifierLength)
+	instanceID, err := resources.BuildResourceIdentifier(ctx, p.Client, r.ObjectMeta, r.Spec.ResourceTypeSpec, defaultGcpIdentifierLength)
 	if err != nil {
 		return nil, fmt.Errorf("error building instance id: %w", err)
 	}
@@ -133,7 +139,7 @@ func getMetrics(ctx context.Context, metricClient gcpiface.MetricApi, opts getMe
 					projectID: opts.projectID,
 					filter:    fmt.Sprintf(opts.filterTemplate, opts.monitoringResourceType, opts.instanceID, metric.ProviderMetricName),
 					interval: &monitoringpb.TimeInterval{
-						StartTime: timestamppb.New(time.Now().Add(-resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration))),
+						StartTime: timestamppb.New(time.Now().Add(-metric.GetPeriodOrDefault(resources.GetMetricReconcileTimeOrDefault(resources.MetricsWatchDuration)))),
 						EndTime:   timestamppb.Now(),
 					},
 					labels: opts.defaultLabels,
@@ -174,7 +180,7 @@ func getMetricData(ctx context.Context, metricClient gcpiface.MetricApi, opts ge
 		Filter: opts.filter,
 		Aggregation: &monitoringpb.Aggregation{
 			PerSeriesAligner:   monitoringpb.Aggregation_Aligner(monitoringpb.Aggregation_Aligner_value[opts.metric.Statistic]),
-			AlignmentPeriod:    durationpb.New(resources.MetricsWatchDuration),
+			AlignmentPeriod:    durationpb.New(opts.metric.GetPeriodOrDefault(resources.MetricsWatchDuration)),
 			CrossSeriesReducer: opts.reducer,
 		},
 		Interval: opts.interval,
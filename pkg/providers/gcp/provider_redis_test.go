@@ -1,8 +1,10 @@
 package gcp
 
 import (
+	"cloud.google.com/go/storage"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -29,11 +31,13 @@ import (
 
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
 	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,24 +57,48 @@ func buildTestComputeAddress(argsMap map[string]string) *computepb.Address {
 }
 
 func TestNewGCPRedisProvider(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
 	type args struct {
 		client client.Client
 		logger *logrus.Entry
 	}
 	tests := []struct {
-		name string
-		args args
+		name    string
+		args    args
+		wantErr bool
 	}{
 		{
-			name: "placeholder test",
+			name: "successfully create new redis provider",
+			args: args{
+				client: moqClient.NewSigsClientMoqWithScheme(scheme),
+				logger: logrus.NewEntry(logrus.StandardLogger()),
+			},
+		},
+		{
+			name: "fail to create new redis provider",
 			args: args{
+				client: func() client.Client {
+					mc := moqClient.NewSigsClientMoqWithScheme(scheme)
+					mc.GetFunc = func(ctx context.Context, key k8sTypes.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+						return errors.New("generic error")
+					}
+					return mc
+				}(),
 				logger: logrus.NewEntry(logrus.StandardLogger()),
 			},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewGCPRedisProvider(tt.args.client, tt.args.logger); got == nil {
+			got, err := NewGCPRedisProvider(tt.args.client, tt.args.logger, record.NewFakeRecorder(10))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewGCPRedisProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got == nil {
 				t.Errorf("NewGCPRedisProvider() got = %v, want non-nil result", got)
 			}
 		})
@@ -88,6 +116,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 		ctx            context.Context
 		networkManager NetworkManager
 		redisClient    gcpiface.RedisAPI
+		storageClient  gcpiface.StorageAPI
 		strategyConfig *StrategyConfig
 		r              *v1alpha1.Redis
 		isLastResource bool
@@ -121,8 +150,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -136,12 +167,105 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						return &redis.DeleteInstanceOperation{}, nil
 					}
 				}),
+				networkManager: buildMockNetworkManager(),
 				strategyConfig: buildTestStrategyConfig(),
 				isLastResource: false,
 			},
 			want:    types.StatusMessage(fmt.Sprintf("delete detected, gcp redis instance %s deletion started", instanceID)),
 			wantErr: false,
 		},
+		{
+			name: "success keeping and labelling an existing redis instance with the retain deletion policy",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme,
+					buildTestGcpInfrastructure(nil),
+					buildTestGcpStrategyConfigMap(nil),
+				),
+			},
+			args: args{
+				r: &v1alpha1.Redis{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							ResourceIdentifierAnnotation: testName,
+						},
+						Name:      testName,
+						Namespace: testNs,
+					},
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier:           "development",
+							DeletionPolicy: types.DeletionPolicyRetain,
+						},
+					},
+				},
+				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
+					redisClient.GetInstanceFn = func(ctx context.Context, request *redispb.GetInstanceRequest, option ...gax.CallOption) (*redispb.Instance, error) {
+						return &redispb.Instance{
+							Name:  gcpTestRedisInstanceName,
+							State: redispb.Instance_READY,
+						}, nil
+					}
+					redisClient.UpdateInstanceFn = func(ctx context.Context, request *redispb.UpdateInstanceRequest, option ...gax.CallOption) (*redis.UpdateInstanceOperation, error) {
+						if request.Instance.Labels["integreatly-org_orphaned"] != "true" {
+							return nil, errors.New("missing orphaned label")
+						}
+						return &redis.UpdateInstanceOperation{}, nil
+					}
+					redisClient.DeleteInstanceFn = func(ctx context.Context, request *redispb.DeleteInstanceRequest, option ...gax.CallOption) (*redis.DeleteInstanceOperation, error) {
+						return nil, errors.New("instance must not be deleted")
+					}
+				}),
+				strategyConfig: buildTestStrategyConfig(),
+				isLastResource: true,
+			},
+			want:    types.StatusMessage(fmt.Sprintf("deletion policy is Retain, %s kept", instanceID)),
+			wantErr: false,
+		},
+		{
+			name: "success starting final snapshot export of an existing redis instance with the snapshot deletion policy",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme,
+					buildTestGcpInfrastructure(nil),
+					buildTestGcpStrategyConfigMap(nil),
+				),
+			},
+			args: args{
+				r: &v1alpha1.Redis{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							ResourceIdentifierAnnotation: testName,
+						},
+						Name:      testName,
+						Namespace: testNs,
+					},
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier:           "development",
+							DeletionPolicy: types.DeletionPolicySnapshot,
+						},
+					},
+				},
+				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
+					redisClient.GetInstanceFn = func(ctx context.Context, request *redispb.GetInstanceRequest, option ...gax.CallOption) (*redispb.Instance, error) {
+						return &redispb.Instance{
+							Name:  gcpTestRedisInstanceName,
+							State: redispb.Instance_READY,
+						}, nil
+					}
+					redisClient.DeleteInstanceFn = func(ctx context.Context, request *redispb.DeleteInstanceRequest, option ...gax.CallOption) (*redis.DeleteInstanceOperation, error) {
+						return nil, errors.New("instance must not be deleted before the final snapshot exists")
+					}
+				}),
+				storageClient: gcpiface.GetMockStorageClient(func(storageClient *gcpiface.MockStorageClient) {
+					storageClient.GetObjectMetadataFn = func(ctx context.Context, bucket, object string) (*storage.ObjectAttrs, error) {
+						return nil, storage.ErrObjectNotExist
+					}
+				}),
+				strategyConfig: buildTestStrategyConfig(),
+			},
+			want:    types.StatusMessage(fmt.Sprintf("final snapshot export in progress for gcp redis instance %s", instanceID)),
+			wantErr: false,
+		},
 		{
 			name: "success reconciling when an existing redis instance is already in progress of deletion",
 			fields: fields{
@@ -159,8 +283,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -174,6 +300,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						return &redis.DeleteInstanceOperation{}, nil
 					}
 				}),
+				networkManager: buildMockNetworkManager(),
 				strategyConfig: buildTestStrategyConfig(),
 				isLastResource: false,
 			},
@@ -197,8 +324,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 							Name:      testName,
 							Namespace: testNs,
 						},
-						Spec: types.ResourceTypeSpec{
-							Tier: "development",
+						Spec: v1alpha1.RedisSpec{
+							ResourceTypeSpec: types.ResourceTypeSpec{
+								Tier: "development",
+							},
 						},
 					},
 				),
@@ -213,8 +342,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Namespace:       testNs,
 						ResourceVersion: "999",
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -222,6 +353,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						return nil, resources.NewMockAPIError(grpcCodes.NotFound)
 					}
 				}),
+				networkManager: buildMockNetworkManager(),
 				strategyConfig: buildTestStrategyConfig(),
 				isLastResource: false,
 			},
@@ -258,8 +390,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -273,6 +407,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						return &redis.DeleteInstanceOperation{}, fmt.Errorf("generic error")
 					}
 				}),
+				networkManager: buildMockNetworkManager(),
 				strategyConfig: buildTestStrategyConfig(),
 				isLastResource: false,
 			},
@@ -296,8 +431,10 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -319,7 +456,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						buildTestGcpInfrastructure(nil),
 						buildTestGcpStrategyConfigMap(nil),
 					)
-					mc.UpdateFunc = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					mc.PatchFunc = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
 						return fmt.Errorf("generic error")
 					}
 					return mc
@@ -331,11 +468,14 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Annotations: map[string]string{
 							ResourceIdentifierAnnotation: testName,
 						},
-						Name:      testName,
-						Namespace: testNs,
+						Name:       testName,
+						Namespace:  testNs,
+						Finalizers: []string{DefaultFinalizer},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				redisClient: gcpiface.GetMockRedisClient(func(redisClient *gcpiface.MockRedisClient) {
@@ -346,6 +486,7 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						return &redis.DeleteInstanceOperation{}, nil
 					}
 				}),
+				networkManager: buildMockNetworkManager(),
 				strategyConfig: buildTestStrategyConfig(),
 				isLastResource: false,
 			},
@@ -375,11 +516,16 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return fmt.Errorf("generic error")
 					},
@@ -421,11 +567,16 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -470,11 +621,16 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -522,11 +678,16 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -577,11 +738,16 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 				networkManager: &NetworkManagerMock{
+					DeleteNetworkAccessFunc: func(ctx context.Context, resourceID string) error {
+						return nil
+					},
 					DeleteNetworkPeeringFunc: func(contextMoqParam context.Context) error {
 						return nil
 					},
@@ -612,8 +778,15 @@ func TestRedisProvider_deleteRedisInstance(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := NewGCPRedisProvider(tt.fields.Client, logrus.NewEntry(logrus.StandardLogger()))
-			statusMessage, err := p.deleteRedisInstance(context.TODO(), tt.args.networkManager, tt.args.redisClient, tt.args.strategyConfig, tt.args.r, tt.args.isLastResource)
+			p := &RedisProvider{
+				Client:            tt.fields.Client,
+				Logger:            logrus.NewEntry(logrus.StandardLogger()),
+				CredentialManager: NewCredentialMinterCredentialManager(tt.fields.Client),
+				ConfigManager:     NewDefaultConfigManager(tt.fields.Client),
+				TCPPinger:         resources.NewConnectionTestManager(),
+				Recorder:          record.NewFakeRecorder(10),
+			}
+			statusMessage, err := p.deleteRedisInstance(context.TODO(), tt.args.networkManager, tt.args.redisClient, tt.args.storageClient, tt.args.strategyConfig, tt.args.r, tt.args.isLastResource)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("deleteRedisInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -821,7 +994,7 @@ func TestRedisProvider_buildDeleteInstanceRequest(t *testing.T) {
 				CredentialManager: tt.fields.CredentialManager,
 				ConfigManager:     tt.fields.ConfigManager,
 			}
-			got, err := p.buildDeleteInstanceRequest(tt.args.r, tt.args.strategyConfig)
+			got, err := p.buildDeleteInstanceRequest(context.TODO(), tt.args.r, tt.args.strategyConfig)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("buildDeleteInstanceRequest() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -938,8 +1111,10 @@ func TestRedisProvider_buildCreateInstanceRequest(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Size: "7",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Size: "7",
+						},
 					},
 				},
 				strategyConfig: &StrategyConfig{
@@ -971,8 +1146,10 @@ func TestRedisProvider_buildCreateInstanceRequest(t *testing.T) {
 						Name:      testName,
 						Namespace: testNs,
 					},
-					Spec: types.ResourceTypeSpec{
-						Size: "invalid",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Size: "invalid",
+						},
 					},
 				},
 				strategyConfig: &StrategyConfig{
@@ -1093,6 +1270,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1125,8 +1308,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1137,10 +1322,18 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			wantErr:       false,
 		},
 		{
-			name:   "fail to reconcile network provider config",
-			fields: fields{},
+			name: "fail to reconcile network provider config",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return nil, fmt.Errorf("generic error")
 					},
@@ -1152,10 +1345,35 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			wantErr:       true,
 		},
 		{
-			name:   "fail to create network service",
-			fields: fields{},
+			name: "fail to create redis instance connected with private service connect",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
+			args: args{
+				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodPrivateServiceConnect, nil
+					},
+				},
+				r: &v1alpha1.Redis{},
+			},
+			redisCluster:  nil,
+			statusMessage: "gcp redis instances do not support the privateServiceConnect network connection method, please update `_network` strategy",
+			wantErr:       true,
+		},
+		{
+			name: "fail to create network service",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1172,10 +1390,18 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			wantErr:       true,
 		},
 		{
-			name:   "fail to create network service",
-			fields: fields{},
+			name: "fail to create network service",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1195,10 +1421,18 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			wantErr:       true,
 		},
 		{
-			name:   "fail to build create redis instance request",
-			fields: fields{},
+			name: "fail to build create redis instance request",
+			fields: fields{
+				Client: moqClient.NewSigsClientMoqWithScheme(scheme),
+			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1227,6 +1461,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1262,6 +1502,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1306,6 +1552,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1350,6 +1602,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1387,6 +1645,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					ReconcileNetworkProviderConfigFunc: func(ctx context.Context, configManager ConfigManager, tier string) (*net.IPNet, error) {
 						return &net.IPNet{
 							Mask: net.CIDRMask(defaultIpRangeCIDRMask, defaultIpv4Length),
@@ -1430,6 +1694,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1462,8 +1732,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1483,6 +1755,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1519,8 +1797,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1542,6 +1822,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1585,8 +1871,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1608,6 +1896,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1644,8 +1938,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1665,6 +1961,12 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 			},
 			args: args{
 				networkManager: &NetworkManagerMock{
+					GetNetworkConnectionMethodFunc: func(ctx context.Context, configManager ConfigManager, tier string) (NetworkConnectionMethod, error) {
+						return NetworkConnectionMethodServiceNetworking, nil
+					},
+					ReconcileNetworkAccessFunc: func(ctx context.Context, resourceID string, ip string, port int32, access *croType.NetworkAccess) error {
+						return nil
+					},
 					CreateNetworkIpRangeFunc: func(ctx context.Context, cidrRange *net.IPNet) (*computepb.Address, types.StatusMessage, error) {
 						return buildTestComputeAddress(map[string]string{"status": computepb.Address_RESERVED.String()}), "", nil
 					},
@@ -1708,8 +2010,10 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 							ResourceIdentifierAnnotation: testName,
 						},
 					},
-					Spec: types.ResourceTypeSpec{
-						Tier: "development",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Tier: "development",
+						},
 					},
 				},
 			},
@@ -1727,7 +2031,7 @@ func TestRedisProvider_createRedisInstance(t *testing.T) {
 				ConfigManager:     tt.fields.ConfigManager,
 				TCPPinger:         resources.BuildMockConnectionTester(),
 			}
-			redisCluster, statusMessage, err := p.createRedisInstance(context.TODO(), tt.args.networkManager, tt.args.redisClient, tt.args.strategyConfig, tt.args.r)
+			redisCluster, statusMessage, err := p.createRedisInstance(context.TODO(), tt.args.networkManager, tt.args.redisClient, gcpiface.GetMockProjectsClient(nil), tt.args.strategyConfig, tt.args.r)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("createRedisInstance() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1823,6 +2127,62 @@ func TestRedisProvider_buildUpdateInstanceRequest(t *testing.T) {
 	}
 }
 
+func Test_buildRedisPendingChanges(t *testing.T) {
+	type args struct {
+		updateInstanceReq  *redispb.UpdateInstanceRequest
+		upgradeInstanceReq *redispb.UpgradeInstanceRequest
+		instance           *redispb.Instance
+	}
+	tests := []struct {
+		name string
+		args args
+		want []croType.PendingChange
+	}{
+		{
+			name: "success building pending changes from update and upgrade requests",
+			args: args{
+				updateInstanceReq: &redispb.UpdateInstanceRequest{
+					UpdateMask: &fieldmaskpb.FieldMask{
+						Paths: []string{"memory_size_gb", "labels"},
+					},
+					Instance: &redispb.Instance{
+						MemorySizeGb: 5,
+						Labels: map[string]string{
+							"testKey": "testValue",
+						},
+					},
+				},
+				upgradeInstanceReq: &redispb.UpgradeInstanceRequest{
+					RedisVersion: "REDIS_7_0",
+				},
+				instance: &redispb.Instance{
+					MemorySizeGb: 1,
+					RedisVersion: "REDIS_6_X",
+				},
+			},
+			want: []croType.PendingChange{
+				{Field: "memory_size_gb", Current: "1", Desired: "5"},
+				{Field: "labels", Desired: `{"testKey":"testValue"}`},
+				{Field: "redis_version", Current: "REDIS_6_X", Desired: "REDIS_7_0"},
+			},
+		},
+		{
+			name: "success building no pending changes without requests",
+			args: args{
+				instance: &redispb.Instance{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildRedisPendingChanges(tt.args.updateInstanceReq, tt.args.upgradeInstanceReq, tt.args.instance); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildRedisPendingChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRedisProvider_buildUpgradeInstanceRequest(t *testing.T) {
 	type args struct {
 		instanceConfig *redispb.Instance
@@ -2022,8 +2382,8 @@ func TestRedisProvider_exposeRedisInstanceMetrics(t *testing.T) {
 			fields: fields{
 				Client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestGcpInfrastructure(nil)),
 				TCPPinger: &resources.ConnectionTesterMock{
-					TCPConnectionFunc: func(host string, port int) bool {
-						return false
+					RedisConnectionFunc: func(ctx context.Context, details *resources.RedisConnectionDetails) *resources.ConnectionResult {
+						return &resources.ConnectionResult{Success: false, FailureReason: resources.ConnectionFailureReasonDial, Error: fmt.Errorf("connection refused")}
 					},
 				},
 			},
@@ -2155,3 +2515,56 @@ func TestRedisProvider_setRedisDeletionTimestampMetric(t *testing.T) {
 		})
 	}
 }
+
+func Test_buildRedisDrift(t *testing.T) {
+	type args struct {
+		instanceConfig *redispb.Instance
+		instance       *redispb.Instance
+	}
+	tests := []struct {
+		name string
+		args args
+		want []resources.DriftedAttribute
+	}{
+		{
+			name: "success building drift from the strategy",
+			args: args{
+				instanceConfig: &redispb.Instance{
+					Tier:         redispb.Instance_STANDARD_HA,
+					MemorySizeGb: 5,
+					RedisVersion: "REDIS_7_0",
+					Labels: map[string]string{
+						"testKey": "testValue",
+					},
+				},
+				instance: &redispb.Instance{
+					Tier:         redispb.Instance_BASIC,
+					MemorySizeGb: 5,
+					RedisVersion: "REDIS_7_0",
+					LocationId:   "europe-west2-a",
+				},
+			},
+			want: []resources.DriftedAttribute{
+				{Name: "tier", Current: "BASIC", Desired: "STANDARD_HA"},
+				{Name: "labels[testKey]", Current: "", Desired: "testValue"},
+			},
+		},
+		{
+			name: "success building no drift when the strategy does not set attributes",
+			args: args{
+				instanceConfig: &redispb.Instance{},
+				instance: &redispb.Instance{
+					Tier:         redispb.Instance_BASIC,
+					MemorySizeGb: 5,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := buildRedisDrift(tt.args.instanceConfig, tt.args.instance); !reflect.DeepEqual(got.Attributes, tt.want) {
+				t.Errorf("buildRedisDrift() = %v, want %v", got.Attributes, tt.want)
+			}
+		})
+	}
+}
@@ -19,12 +19,8 @@ func buildDefaultRedisTags(ctx context.Context, client k8sclient.Client, r *v1al
 		return nil, errorUtil.Wrapf(err, "failed to get default redis tags")
 	}
 	tags := make(map[string]string, len(defaultTags))
-	// GCP labels cannot have uppercase, dash or slash characters
-	// ref: https://cloud.google.com/resource-manager/docs/creating-managing-labels#requirements
-	replacer := strings.NewReplacer(".", "-", "/", "_")
 	for _, tag := range defaultTags {
-		key := strings.ToLower(replacer.Replace(tag.Key))
-		value := strings.ToLower(replacer.Replace(tag.Value))
+		key, value := buildGcpLabel(tag)
 		tags[key] = value
 	}
 	return tags, nil
@@ -36,13 +32,28 @@ func buildDefaultPostgresTags(ctx context.Context, client k8sclient.Client, pg *
 		return nil, errorUtil.Wrapf(err, "failed to get default postgres tags")
 	}
 	tags := make(map[string]string, len(defaultTags))
-	// GCP labels cannot have uppercase, dash or slash characters
-	// ref: https://cloud.google.com/resource-manager/docs/creating-managing-labels#requirements
-	replacer := strings.NewReplacer(".", "-", "/", "_")
 	for _, tag := range defaultTags {
-		key := strings.ToLower(replacer.Replace(tag.Key))
-		value := strings.ToLower(replacer.Replace(tag.Value))
+		key, value := buildGcpLabel(tag)
 		tags[key] = value
 	}
 	return tags, nil
 }
+
+// buildGcpLabel converts a tag into a label key and value
+func buildGcpLabel(tag *resources.Tag) (string, string) {
+	// GCP labels cannot have uppercase, dash or slash characters
+	// ref: https://cloud.google.com/resource-manager/docs/creating-managing-labels#requirements
+	replacer := strings.NewReplacer(".", "-", "/", "_")
+	return strings.ToLower(replacer.Replace(tag.Key)), strings.ToLower(replacer.Replace(tag.Value))
+}
+
+// buildAdoptionOwnershipLabelKeys returns the label keys identifying the cluster and custom resource managing an
+// instance, the keys of the ownership tags converted into labels
+func buildAdoptionOwnershipLabelKeys() []string {
+	var keys []string
+	for _, key := range resources.AdoptionOwnershipTagKeys() {
+		labelKey, _ := buildGcpLabel(&resources.Tag{Key: key})
+		keys = append(keys, labelKey)
+	}
+	return keys
+}
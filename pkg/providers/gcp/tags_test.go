@@ -41,8 +41,10 @@ func Test_buildDefaultRedisTags(t *testing.T) {
 					ObjectMeta: v1.ObjectMeta{
 						Name: testName,
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "testType",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "testType",
+						},
 					},
 				},
 			},
@@ -62,8 +64,10 @@ func Test_buildDefaultRedisTags(t *testing.T) {
 					ObjectMeta: v1.ObjectMeta{
 						Name: testName,
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "testType",
+					Spec: v1alpha1.RedisSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "testType",
+						},
 					},
 				},
 			},
@@ -109,8 +113,10 @@ func Test_buildDefaultPostgresTags(t *testing.T) {
 					ObjectMeta: v1.ObjectMeta{
 						Name: testName,
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "testType",
+					Spec: v1alpha1.PostgresSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "testType",
+						},
 					},
 				},
 			},
@@ -130,8 +136,10 @@ func Test_buildDefaultPostgresTags(t *testing.T) {
 					ObjectMeta: v1.ObjectMeta{
 						Name: testName,
 					},
-					Spec: types.ResourceTypeSpec{
-						Type: "testType",
+					Spec: v1alpha1.PostgresSpec{
+						ResourceTypeSpec: types.ResourceTypeSpec{
+							Type: "testType",
+						},
 					},
 				},
 			},
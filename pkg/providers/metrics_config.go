@@ -0,0 +1,150 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultMetricsConfigMapName is the config map operators can use to define additional cloud metrics
+	DefaultMetricsConfigMapName = "cloud-resource-metrics"
+)
+
+// metricNameRegexp matches valid prometheus metric names
+var metricNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+
+// MetricDefinition describes an additional gauge metric exposed by the cloudmetrics controller
+// it is read from the metrics config map, keyed by resource type e.g.
+//
+//	postgres: |
+//	  [{"name": "cro_postgres_database_connections_average", "help": "The number of database connections in use. Units: Count",
+//	    "providerType": {"aws": {"providerMetricName": "DatabaseConnections", "statistic": "Average", "period": "5m"}}}]
+type MetricDefinition struct {
+	// Name the name of the metric exposed via cro
+	Name string `json:"name"`
+	// Help the help text of the metric exposed via cro
+	Help string `json:"help"`
+	// ProviderType maps a deployment strategy to the metric scraped from that provider
+	ProviderType map[string]MetricProviderDefinition `json:"providerType"`
+}
+
+// MetricProviderDefinition describes the provider specific metric to scrape for a MetricDefinition
+type MetricProviderDefinition struct {
+	// ProviderMetricName the metric we scrape from the cloud provider
+	ProviderMetricName string `json:"providerMetricName"`
+	// Statistic the type of metric value we return e.g. Average, Sum, Maximum for aws or ALIGN_MEAN for gcp
+	// it is not required for openshift
+	Statistic string `json:"statistic,omitempty"`
+	// Period the aggregation period of the metric e.g. 5m, defaults to the metrics reconcile time
+	Period string `json:"period,omitempty"`
+}
+
+// MetricTypes converts the definition to the provider specific metric types, keyed by deployment strategy
+func (d *MetricDefinition) MetricTypes() (map[string]CloudProviderMetricType, error) {
+	metricTypes := map[string]CloudProviderMetricType{}
+	for strategy, providerMetric := range d.ProviderType {
+		if providerMetric.ProviderMetricName == "" {
+			return nil, errorUtil.New(fmt.Sprintf("metric %s has no provider metric name for strategy %s", d.Name, strategy))
+		}
+		// openshift metrics are read directly from the deployment, so are not aggregated
+		if providerMetric.Statistic == "" && strategy != OpenShiftDeploymentStrategy {
+			return nil, errorUtil.New(fmt.Sprintf("metric %s has no statistic for strategy %s", d.Name, strategy))
+		}
+		var period time.Duration
+		if providerMetric.Period != "" {
+			var err error
+			period, err = time.ParseDuration(providerMetric.Period)
+			if err != nil {
+				return nil, errorUtil.Wrapf(err, "failed to parse period for metric %s and strategy %s", d.Name, strategy)
+			}
+			if period < time.Minute || period%time.Minute != 0 {
+				return nil, errorUtil.New(fmt.Sprintf("period for metric %s and strategy %s must be a whole number of minutes", d.Name, strategy))
+			}
+		}
+		metricTypes[strategy] = CloudProviderMetricType{
+			PrometheusMetricName: d.Name,
+			ProviderMetricName:   providerMetric.ProviderMetricName,
+			Statistic:            providerMetric.Statistic,
+			Period:               period,
+		}
+	}
+	return metricTypes, nil
+}
+
+//go:generate moq -out metrics_config_moq.go . MetricsConfigManager
+type MetricsConfigManager interface {
+	GetMetricDefinitionsForResourceType(ctx context.Context, rt ResourceType) ([]*MetricDefinition, error)
+}
+
+var _ MetricsConfigManager = (*ConfigMapMetricsConfigManager)(nil)
+
+type ConfigMapMetricsConfigManager struct {
+	client             client.Client
+	configMapName      string
+	configMapNamespace string
+}
+
+func NewMetricsConfigManager(cm string, namespace string, client client.Client) *ConfigMapMetricsConfigManager {
+	if cm == "" {
+		cm = DefaultMetricsConfigMapName
+	}
+	if namespace == "" {
+		namespace = DefaultConfigNamespace
+	}
+	return &ConfigMapMetricsConfigManager{
+		client:             client,
+		configMapName:      cm,
+		configMapNamespace: namespace,
+	}
+}
+
+// GetMetricDefinitionsForResourceType returns the additional metrics defined for a resource type
+// an empty list is returned if the config map or the resource type entry does not exist
+func (m *ConfigMapMetricsConfigManager) GetMetricDefinitionsForResourceType(ctx context.Context, rt ResourceType) ([]*MetricDefinition, error) {
+	cm, err := resources.GetConfigMapOrDefault(ctx, m.client, types.NamespacedName{Name: m.configMapName, Namespace: m.configMapNamespace}, m.buildDefaultConfigMap())
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to read metrics config from configmap %s in namespace %s", m.configMapName, m.configMapNamespace)
+	}
+	rawDefinitions := cm.Data[string(rt)]
+	if rawDefinitions == "" {
+		return []*MetricDefinition{}, nil
+	}
+	var definitions []*MetricDefinition
+	if err = json.Unmarshal([]byte(rawDefinitions), &definitions); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to unmarshal metrics config for resource type %s", rt)
+	}
+	seen := map[string]bool{}
+	for _, definition := range definitions {
+		if !metricNameRegexp.MatchString(definition.Name) {
+			return nil, errorUtil.New(fmt.Sprintf("invalid metric name %q for resource type %s", definition.Name, rt))
+		}
+		if seen[definition.Name] {
+			return nil, errorUtil.New(fmt.Sprintf("metric %s is defined more than once for resource type %s", definition.Name, rt))
+		}
+		seen[definition.Name] = true
+		if _, err = definition.MetricTypes(); err != nil {
+			return nil, errorUtil.Wrapf(err, "invalid metrics config for resource type %s", rt)
+		}
+	}
+	return definitions, nil
+}
+
+func (m *ConfigMapMetricsConfigManager) buildDefaultConfigMap() *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      m.configMapName,
+			Namespace: m.configMapNamespace,
+		},
+		Data: map[string]string{},
+	}
+}
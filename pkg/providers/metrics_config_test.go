@@ -0,0 +1,157 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func buildTestMetricsConfigMap(data map[string]string) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      "test",
+			Namespace: "test",
+		},
+		Data: data,
+	}
+}
+
+func TestConfigMapMetricsConfigManager_GetMetricDefinitionsForResourceType(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1.AddToScheme(scheme)
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cases := []struct {
+		name         string
+		resourceType ResourceType
+		client       client.Client
+		expectError  bool
+		validate     func(definitions []*MetricDefinition) error
+	}{
+		{
+			name:         "test metric definitions are unmarshalled successfully when configmap is structured correctly",
+			resourceType: PostgresResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(PostgresResourceType): `[{"name": "cro_postgres_database_connections_average", "help": "test", "providerType": {"aws": {"providerMetricName": "DatabaseConnections", "statistic": "Average", "period": "10m"}}}]`,
+			})),
+			validate: func(definitions []*MetricDefinition) error {
+				if len(definitions) != 1 {
+					return errors.New("expected a single metric definition")
+				}
+				metricTypes, err := definitions[0].MetricTypes()
+				if err != nil {
+					return err
+				}
+				metricType := metricTypes[AWSDeploymentStrategy]
+				if metricType.PrometheusMetricName != "cro_postgres_database_connections_average" || metricType.ProviderMetricName != "DatabaseConnections" || metricType.Period != 10*time.Minute {
+					return errors.New("metric definition has incorrect structure")
+				}
+				return nil
+			},
+		},
+		{
+			name:         "test no metric definitions are returned when configmap does not exist",
+			resourceType: RedisResourceType,
+			client:       moqClient.NewSigsClientMoqWithScheme(scheme),
+			validate: func(definitions []*MetricDefinition) error {
+				if len(definitions) != 0 {
+					return errors.New("expected no metric definitions")
+				}
+				return nil
+			},
+		},
+		{
+			name:         "test no metric definitions are returned when resource type is not defined",
+			resourceType: RedisResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(PostgresResourceType): `[]`,
+			})),
+			validate: func(definitions []*MetricDefinition) error {
+				if len(definitions) != 0 {
+					return errors.New("expected no metric definitions")
+				}
+				return nil
+			},
+		},
+		{
+			name:         "test error when metric name is invalid",
+			resourceType: RedisResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(RedisResourceType): `[{"name": "cro-redis-connections", "providerType": {"aws": {"providerMetricName": "CurrConnections", "statistic": "Average"}}}]`,
+			})),
+			expectError: true,
+		},
+		{
+			name:         "test error when metric is defined more than once",
+			resourceType: RedisResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(RedisResourceType): `[{"name": "cro_redis_connections", "providerType": {"aws": {"providerMetricName": "CurrConnections", "statistic": "Average"}}},
+					{"name": "cro_redis_connections", "providerType": {"aws": {"providerMetricName": "CurrConnections", "statistic": "Maximum"}}}]`,
+			})),
+			expectError: true,
+		},
+		{
+			name:         "test error when statistic is missing",
+			resourceType: RedisResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(RedisResourceType): `[{"name": "cro_redis_connections", "providerType": {"aws": {"providerMetricName": "CurrConnections"}}}]`,
+			})),
+			expectError: true,
+		},
+		{
+			name:         "test error when period is not a whole number of minutes",
+			resourceType: PostgresResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(PostgresResourceType): `[{"name": "cro_postgres_replication_lag", "providerType": {"aws": {"providerMetricName": "ReplicaLag", "statistic": "Maximum", "period": "90s"}}}]`,
+			})),
+			expectError: true,
+		},
+		{
+			name:         "test error when config is not valid json",
+			resourceType: PostgresResourceType,
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsConfigMap(map[string]string{
+				string(PostgresResourceType): `{`,
+			})),
+			expectError: true,
+		},
+		{
+			name:         "failed to read metrics config from configmap",
+			resourceType: PostgresResourceType,
+			client: func() client.Client {
+				mc := moqClient.NewSigsClientMoqWithScheme(scheme)
+				mc.GetFunc = func(ctx context.Context, key types.NamespacedName, obj client.Object, opts ...client.GetOption) error {
+					return errors.New("failed to read metrics config from configmap")
+				}
+				return mc
+			}(),
+			expectError: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cm := NewMetricsConfigManager("test", "test", tc.client)
+			definitions, err := cm.GetMetricDefinitionsForResourceType(context.TODO(), tc.resourceType)
+			if err != nil {
+				if tc.expectError {
+					return
+				}
+				t.Fatal("failed to read metric definitions", err)
+			}
+			if tc.expectError {
+				t.Fatal("expected error but got none")
+			}
+			if err = tc.validate(definitions); err != nil {
+				t.Fatal("failed to validate metric definitions", err)
+			}
+		})
+	}
+}
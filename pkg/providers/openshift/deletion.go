@@ -0,0 +1,39 @@
+package openshift
+
+import (
+	"context"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// orphanObjects labels the objects kept by the deletion policy of a cr as orphaned, objects that do not exist are
+// skipped
+func orphanObjects(ctx context.Context, c client.Client, objs ...client.Object) error {
+	orphanedTag := resources.BuildOrphanedTag()
+	for _, obj := range objs {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+			return errorUtil.Wrapf(err, "failed to get %s", obj.GetName())
+		}
+		if obj.GetLabels()[orphanedTag.Key] == orphanedTag.Value {
+			continue
+		}
+		err := resources.PatchObject(ctx, c, obj, func() {
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = map[string]string{}
+			}
+			labels[orphanedTag.Key] = orphanedTag.Value
+			obj.SetLabels(labels)
+		})
+		if err != nil {
+			return errorUtil.Wrapf(err, "failed to label %s as orphaned", obj.GetName())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package openshift
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// provider metric names which can be scraped from any openshift deployment
+// these are used as the provider metric name in a providers.CloudProviderMetricType
+const (
+	// PodCPUUtilizationMetric the pod cpu usage as a percentage of the pod cpu limit
+	PodCPUUtilizationMetric = "pod_cpu_utilization"
+	// PodMemoryLimitMetric the pod memory limit in bytes
+	PodMemoryLimitMetric = "pod_memory_limit"
+	// PodMemoryAvailableMetric the pod memory limit minus the pod memory working set in bytes
+	PodMemoryAvailableMetric = "pod_memory_available"
+	// DataVolumeAvailableMetric the available space on the data volume in bytes
+	DataVolumeAvailableMetric = "data_volume_available"
+)
+
+// podMetricsScraper scrapes the metrics common to all openshift deployments
+// the pod resource usage is only requested once per scrape, and only if it is needed
+type podMetricsScraper struct {
+	podCommander     resources.PodCommander
+	podMetricsGetter resources.PodMetricsGetter
+	deployment       *appsv1.Deployment
+	dataVolumePath   string
+	usage            *resources.PodResourceUsage
+}
+
+// scrape returns the value of a common metric, ok is false if the metric is not a common metric
+func (s *podMetricsScraper) scrape(ctx context.Context, providerMetricName string) (value float64, ok bool, err error) {
+	switch providerMetricName {
+	case PodCPUUtilizationMetric:
+		usage, err := s.getUsage(ctx)
+		if err != nil {
+			return 0, true, err
+		}
+		if usage.CPULimit.IsZero() {
+			return 0, true, errorUtil.New(fmt.Sprintf("deployment %s has no cpu limit", s.deployment.Name))
+		}
+		return float64(usage.CPUUsage.MilliValue()) / float64(usage.CPULimit.MilliValue()) * 100, true, nil
+	case PodMemoryLimitMetric:
+		usage, err := s.getUsage(ctx)
+		if err != nil {
+			return 0, true, err
+		}
+		return float64(usage.MemoryLimit.Value()), true, nil
+	case PodMemoryAvailableMetric:
+		usage, err := s.getUsage(ctx)
+		if err != nil {
+			return 0, true, err
+		}
+		if usage.MemoryLimit.IsZero() {
+			return 0, true, errorUtil.New(fmt.Sprintf("deployment %s has no memory limit", s.deployment.Name))
+		}
+		return float64(usage.MemoryLimit.Value() - usage.MemoryUsage.Value()), true, nil
+	case DataVolumeAvailableMetric:
+		out, err := s.podCommander.ExecIntoPodWithOutput(s.deployment, fmt.Sprintf("df -B1 --output=avail %s | tail -n 1", s.dataVolumePath))
+		if err != nil {
+			return 0, true, errorUtil.Wrap(err, "failed to get data volume usage")
+		}
+		value, err := parseFloatOutput(out)
+		return value, true, err
+	}
+	return 0, false, nil
+}
+
+func (s *podMetricsScraper) getUsage(ctx context.Context) (*resources.PodResourceUsage, error) {
+	if s.usage != nil {
+		return s.usage, nil
+	}
+	usage, err := s.podMetricsGetter.GetPodResourceUsage(ctx, s.deployment)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get pod resource usage")
+	}
+	s.usage = usage
+	return usage, nil
+}
+
+// parseFloatOutput parses a single numeric value returned from a command run in a pod
+func parseFloatOutput(out string) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(out), 64)
+	if err != nil {
+		return 0, errorUtil.Wrapf(err, "failed to parse command output %q", out)
+	}
+	return value, nil
+}
@@ -0,0 +1,99 @@
+package openshift
+
+import (
+	"context"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// hostNetworkPolicyGroupLabel is set by openshift on the namespaces of the host network, such as the ingress
+	// controllers using the host network
+	hostNetworkPolicyGroupLabel = "policy-group.network.openshift.io/host-network"
+)
+
+// reconcileNetworkPolicy ensures the pods of the deployment named after the cr only accept connections on port from the
+// sources allowed by the network access of the cr. every pod of the cluster is allowed, the host network is allowed
+// unless only the cluster pods are, the allowed cidr blocks are allowed as ip blocks. the network policy is removed
+// when the cr has no network access
+func reconcileNetworkPolicy(ctx context.Context, c client.Client, name string, ns string, port int, access *croType.NetworkAccess) error {
+	if access == nil {
+		return deleteNetworkPolicy(ctx, c, name, ns)
+	}
+	spec, err := buildNetworkPolicySpec(name, port, access)
+	if err != nil {
+		return err
+	}
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+	}
+	or, err := controllerutil.CreateOrUpdate(ctx, c, np, func() error {
+		np.Spec = spec
+		return nil
+	})
+	if err != nil {
+		return errorUtil.Wrapf(err, "failed to create or update network policy %s, action was %s", name, or)
+	}
+	return nil
+}
+
+// deleteNetworkPolicy removes the network policy of the deployment named after the cr
+func deleteNetworkPolicy(ctx context.Context, c client.Client, name string, ns string) error {
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+	}
+	if err := c.Delete(ctx, np); err != nil && !k8serr.IsNotFound(err) {
+		return errorUtil.Wrapf(err, "failed to delete network policy %s", name)
+	}
+	return nil
+}
+
+func buildNetworkPolicySpec(name string, port int, access *croType.NetworkAccess) (networkingv1.NetworkPolicySpec, error) {
+	if _, err := resources.ParseCIDRs(access.AllowedCIDRs...); err != nil {
+		return networkingv1.NetworkPolicySpec{}, errorUtil.Wrap(err, "invalid allowed cidr block in network access")
+	}
+	peers := []networkingv1.NetworkPolicyPeer{
+		{NamespaceSelector: &metav1.LabelSelector{}},
+	}
+	if !access.ClusterPodsOnly {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{hostNetworkPolicyGroupLabel: ""},
+			},
+		})
+	}
+	for _, cidr := range access.AllowedCIDRs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+	protocol := corev1.ProtocolTCP
+	targetPort := intstr.FromInt(port)
+	return networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"deployment": name},
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &protocol, Port: &targetPort}},
+				From:  peers,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,94 @@
+package openshift
+
+import (
+	"context"
+	"testing"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileNetworkPolicy(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	existingPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testRedisName,
+			Namespace: testRedisNamespace,
+		},
+	}
+	tests := []struct {
+		name        string
+		client      client.Client
+		access      *croType.NetworkAccess
+		wantPolicy  bool
+		wantPeers   int
+		wantIPBlock string
+		wantErr     bool
+	}{
+		{
+			name:       "network policy allows cluster pods and host network",
+			client:     moqClient.NewSigsClientMoqWithScheme(scheme),
+			access:     &croType.NetworkAccess{},
+			wantPolicy: true,
+			wantPeers:  2,
+		},
+		{
+			name:        "network policy allows cluster pods and allowed cidr blocks only",
+			client:      moqClient.NewSigsClientMoqWithScheme(scheme, existingPolicy),
+			access:      &croType.NetworkAccess{ClusterPodsOnly: true, AllowedCIDRs: []string{"10.2.0.0/16"}},
+			wantPolicy:  true,
+			wantPeers:   2,
+			wantIPBlock: "10.2.0.0/16",
+		},
+		{
+			name:   "network policy is removed without network access",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, existingPolicy),
+		},
+		{
+			name:    "invalid allowed cidr block",
+			client:  moqClient.NewSigsClientMoqWithScheme(scheme),
+			access:  &croType.NetworkAccess{AllowedCIDRs: []string{"10.2.0.0"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := reconcileNetworkPolicy(context.TODO(), tt.client, testRedisName, testRedisNamespace, redisPort, tt.access)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reconcileNetworkPolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			np := &networkingv1.NetworkPolicy{}
+			err = tt.client.Get(context.TODO(), types.NamespacedName{Name: testRedisName, Namespace: testRedisNamespace}, np)
+			if !tt.wantPolicy {
+				if !k8serr.IsNotFound(err) {
+					t.Fatalf("reconcileNetworkPolicy() expected no network policy, got error %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("reconcileNetworkPolicy() failed to get network policy: %v", err)
+			}
+			if np.Spec.PodSelector.MatchLabels["deployment"] != testRedisName {
+				t.Errorf("reconcileNetworkPolicy() pod selector = %v, want deployment %s", np.Spec.PodSelector.MatchLabels, testRedisName)
+			}
+			if len(np.Spec.Ingress) != 1 || np.Spec.Ingress[0].Ports[0].Port.IntValue() != redisPort {
+				t.Fatalf("reconcileNetworkPolicy() unexpected ingress rules %v", np.Spec.Ingress)
+			}
+			peers := np.Spec.Ingress[0].From
+			if len(peers) != tt.wantPeers {
+				t.Errorf("reconcileNetworkPolicy() peers = %d, want %d", len(peers), tt.wantPeers)
+			}
+			if tt.wantIPBlock != "" && (peers[len(peers)-1].IPBlock == nil || peers[len(peers)-1].IPBlock.CIDR != tt.wantIPBlock) {
+				t.Errorf("reconcileNetworkPolicy() expected ip block %s, got %v", tt.wantIPBlock, peers[len(peers)-1])
+			}
+		})
+	}
+}
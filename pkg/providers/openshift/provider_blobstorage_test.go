@@ -46,10 +46,12 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 						Name:      "test",
 						Namespace: "test",
 					},
-					Spec: croType.ResourceTypeSpec{
-						SecretRef: &croType.SecretRef{
-							Name:      "test-sec",
-							Namespace: "",
+					Spec: v1alpha1.BlobStorageSpec{
+						ResourceTypeSpec: croType.ResourceTypeSpec{
+							SecretRef: &croType.SecretRef{
+								Name:      "test-sec",
+								Namespace: "",
+							},
 						},
 					},
 					Status: croType.ResourceTypeStatus{},
@@ -92,10 +94,12 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 						Name:      "test",
 						Namespace: "test",
 					},
-					Spec: croType.ResourceTypeSpec{
-						SecretRef: &croType.SecretRef{
-							Name:      "test-sec",
-							Namespace: "",
+					Spec: v1alpha1.BlobStorageSpec{
+						ResourceTypeSpec: croType.ResourceTypeSpec{
+							SecretRef: &croType.SecretRef{
+								Name:      "test-sec",
+								Namespace: "",
+							},
 						},
 					},
 					Status: croType.ResourceTypeStatus{
@@ -142,10 +146,12 @@ func TestBlobStorageProvider_CreateStorage(t *testing.T) {
 						Name:      "test",
 						Namespace: "test",
 					},
-					Spec: croType.ResourceTypeSpec{
-						SecretRef: &croType.SecretRef{
-							Name:      "test-sec",
-							Namespace: "",
+					Spec: v1alpha1.BlobStorageSpec{
+						ResourceTypeSpec: croType.ResourceTypeSpec{
+							SecretRef: &croType.SecretRef{
+								Name:      "test-sec",
+								Namespace: "",
+							},
 						},
 					},
 					Status: croType.ResourceTypeStatus{
@@ -10,6 +10,8 @@ import (
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"k8s.io/apimachinery/pkg/types"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
@@ -61,14 +64,16 @@ type PostgresProvider struct {
 	Logger        *logrus.Entry
 	ConfigManager ConfigManager
 	PodCommander  resources.PodCommander
+	Recorder      record.EventRecorder
 }
 
-func NewOpenShiftPostgresProvider(client client.Client, cs *kubernetes.Clientset, logger *logrus.Entry) *PostgresProvider {
+func NewOpenShiftPostgresProvider(client client.Client, cs *kubernetes.Clientset, logger *logrus.Entry, recorder record.EventRecorder) *PostgresProvider {
 	return &PostgresProvider{
 		Client:        client,
 		PodCommander:  &resources.OpenShiftPodCommander{ClientSet: cs},
 		Logger:        logger.WithFields(logrus.Fields{"provider": postgresProviderName}),
 		ConfigManager: NewDefaultConfigManager(client),
+		Recorder:      recorder,
 	}
 }
 
@@ -117,15 +122,24 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, ps *v1alpha1.P
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 	// deploy deployment
-	if err := p.CreateDeployment(ctx, buildDefaultPostgresDeployment(ps), postgresCfg); err != nil {
+	or, err := p.CreateDeployment(ctx, buildDefaultPostgresDeployment(ps), postgresCfg)
+	if err != nil {
 		errMsg := fmt.Sprintf("failed to create or update postgres deployment for instance %s", ps.Name)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	if or == controllerutil.OperationResultCreated {
+		resources.RecordEvent(p.Recorder, ps, resources.EventReasonCreateStarted, "created postgres deployment %s", ps.Name)
+	}
 	// deploy service
 	if err := p.CreateService(ctx, buildDefaultPostgresService(ps), postgresCfg); err != nil {
 		errMsg := fmt.Sprintf("failed to create or update postgres service for instance %s", ps.Name)
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	// deploy network policy
+	if err := reconcileNetworkPolicy(ctx, p.Client, ps.Name, ps.Namespace, defaultPostgresPort, ps.Spec.NetworkAccess); err != nil {
+		errMsg := fmt.Sprintf("failed to reconcile postgres network policy for instance %s", ps.Name)
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
 
 	// check deployment status
 	dpl := &appsv1.Deployment{}
@@ -179,6 +193,22 @@ func (p *PostgresProvider) ReconcilePostgres(ctx context.Context, ps *v1alpha1.P
 }
 
 func (p *PostgresProvider) DeletePostgres(ctx context.Context, ps *v1alpha1.Postgres) (croType.StatusMessage, error) {
+	// keep every object of the postgres deployment with the retain deletion policy
+	if resources.IsRetained(ps.Spec.ResourceTypeSpec) {
+		err := orphanObjects(ctx, p.Client,
+			&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: ps.Name, Namespace: ps.Namespace}},
+			&v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: ps.Name, Namespace: ps.Namespace}},
+			&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: defaultCredentialsSec, Namespace: ps.Namespace}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: ps.Name, Namespace: ps.Namespace}},
+			&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: ps.Name, Namespace: ps.Namespace}},
+		)
+		if err != nil {
+			errMsg := "failed to label postgres objects as orphaned"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		return resources.RetainResource(ctx, p.Client, p.Recorder, ps, DefaultFinalizer, ps.Name)
+	}
+
 	// delete service
 	p.Logger.Info("deleting postgres service")
 	svc := &v1.Service{
@@ -193,18 +223,32 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, ps *v1alpha1.Post
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	// delete pvc
-	p.Logger.Info("deleting postgres persistent volume claim")
+	// delete network policy
+	if err = deleteNetworkPolicy(ctx, p.Client, ps.Name, ps.Namespace); err != nil {
+		errMsg := "failed to delete postgres network policy"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	// delete pvc, with the snapshot deletion policy the pvc is kept as the final snapshot of the data
 	pvc := &v1.PersistentVolumeClaim{
 		ObjectMeta: controllerruntime.ObjectMeta{
 			Name:      ps.Name,
 			Namespace: ps.Namespace,
 		},
 	}
-	err = p.Client.Delete(ctx, pvc)
-	if err != nil && !k8serr.IsNotFound(err) {
-		errMsg := "failed to delete postgres persistent volume claim"
-		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	if resources.IsFinalSnapshotRequired(ps.Spec.ResourceTypeSpec) {
+		if err = orphanObjects(ctx, p.Client, pvc); err != nil {
+			errMsg := "failed to label postgres persistent volume claim as orphaned"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		resources.RecordEvent(p.Recorder, ps, resources.EventReasonFinalSnapshot, "persistent volume claim %s is kept as the final snapshot", pvc.Name)
+	} else {
+		p.Logger.Info("deleting postgres persistent volume claim")
+		err = p.Client.Delete(ctx, pvc)
+		if err != nil && !k8serr.IsNotFound(err) {
+			errMsg := "failed to delete postgres persistent volume claim"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
 	}
 
 	// delete secret
@@ -237,8 +281,7 @@ func (p *PostgresProvider) DeletePostgres(ctx context.Context, ps *v1alpha1.Post
 
 	// remove the finalizer added by the provider
 	p.Logger.Info("Removing postgres finalizer")
-	resources.RemoveFinalizer(&ps.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, ps); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, ps, DefaultFinalizer); err != nil {
 		errMsg := "failed to update instance as part of the postgres finalizer reconcile"
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
@@ -262,7 +305,7 @@ func (p *PostgresProvider) getPostgresConfig(ctx context.Context, ps *v1alpha1.P
 	return postgresCfg, stratCfg, nil
 }
 
-func (p *PostgresProvider) CreateDeployment(ctx context.Context, d *appsv1.Deployment, postgresCfg *PostgresStrat) error {
+func (p *PostgresProvider) CreateDeployment(ctx context.Context, d *appsv1.Deployment, postgresCfg *PostgresStrat) (controllerutil.OperationResult, error) {
 	or, err := immutableCreateOrUpdate(ctx, p.Client, d, func(existing runtime.Object) error {
 		e := existing.(*appsv1.Deployment)
 
@@ -275,9 +318,9 @@ func (p *PostgresProvider) CreateDeployment(ctx context.Context, d *appsv1.Deplo
 		return nil
 	})
 	if err != nil {
-		return errorUtil.Wrapf(err, "failed to create or update deployment %s, action was %s", d.Name, or)
+		return or, errorUtil.Wrapf(err, "failed to create or update deployment %s, action was %s", d.Name, or)
 	}
-	return nil
+	return or, nil
 }
 
 func (p *PostgresProvider) CreateService(ctx context.Context, s *v1.Service, postgresCfg *PostgresStrat) error {
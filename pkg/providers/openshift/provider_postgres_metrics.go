@@ -0,0 +1,143 @@
+// postgres metric provider scrapes metrics for a single in-cluster postgres deployment
+//
+// this providers does
+//   - query postgres in the deployment pod for database metrics
+//   - read the deployment pod resource usage from the cluster metrics api
+//   - return generic cloud metric data to metric controller to be exposed
+//
+// this provider does not
+//   - expose the metrics, this is controller at a higher level (controller)
+package openshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	postgresMetricProviderName = "openshift postgres metrics provider"
+	postgresDataVolumePath     = "/var/lib/pgsql/data"
+
+	// PostgresDatabaseSizeMetric the total size of all databases in bytes, from pg_database_size
+	PostgresDatabaseSizeMetric = "pg_database_size"
+	// PostgresConnectionsMetric the number of connections to the server, from pg_stat_activity
+	PostgresConnectionsMetric = "pg_stat_activity_count"
+	// PostgresVolumeCapacityMetric the capacity of the postgres persistent volume claim in bytes
+	PostgresVolumeCapacityMetric = "pvc_capacity"
+)
+
+// postgresMetricQueries maps a provider metric name to the query used to scrape it
+var postgresMetricQueries = map[string]string{
+	PostgresDatabaseSizeMetric: "SELECT sum(pg_database_size(datname)) FROM pg_database",
+	PostgresConnectionsMetric:  "SELECT count(*) FROM pg_stat_activity",
+}
+
+var _ providers.PostgresMetricsProvider = (*PostgresMetricsProvider)(nil)
+
+type PostgresMetricsProvider struct {
+	Client           client.Client
+	Logger           *logrus.Entry
+	PodCommander     resources.PodCommander
+	PodMetricsGetter resources.PodMetricsGetter
+}
+
+func NewOpenShiftPostgresMetricsProvider(client client.Client, cs *kubernetes.Clientset, logger *logrus.Entry) *PostgresMetricsProvider {
+	return &PostgresMetricsProvider{
+		Client:           client,
+		Logger:           logger.WithFields(logrus.Fields{"providers": postgresMetricProviderName}),
+		PodCommander:     &resources.OpenShiftPodCommander{ClientSet: cs},
+		PodMetricsGetter: &resources.OpenShiftPodMetricsGetter{ClientSet: cs},
+	}
+}
+
+func (p *PostgresMetricsProvider) SupportsStrategy(strategy string) bool {
+	return strategy == providers.OpenShiftDeploymentStrategy
+}
+
+// ScrapePostgresMetrics returns scraped metrics to metric controller
+// a metric which fails to be scraped is logged and skipped, so it does not prevent other metrics being exposed
+func (p *PostgresMetricsProvider) ScrapePostgresMetrics(ctx context.Context, postgres *v1alpha1.Postgres, metricTypes []providers.CloudProviderMetricType) (*providers.ScrapeMetricsData, error) {
+	logger := resources.NewActionLoggerWithFields(p.Logger, map[string]interface{}{
+		resources.LoggingKeyAction: "ScrapeMetrics",
+		"Resource":                 postgres.Name,
+	})
+	logger.Infof("reconciling postgres metrics %s", postgres.Name)
+
+	dpl := &appsv1.Deployment{}
+	if err := p.Client.Get(ctx, types.NamespacedName{Name: postgres.Name, Namespace: postgres.Namespace}, dpl); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get postgres deployment %s", postgres.Name)
+	}
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "error getting clusterID")
+	}
+
+	scraper := &podMetricsScraper{
+		podCommander:     p.PodCommander,
+		podMetricsGetter: p.PodMetricsGetter,
+		deployment:       dpl,
+		dataVolumePath:   postgresDataVolumePath,
+	}
+	var metrics []*providers.GenericCloudMetric
+	for _, metricType := range metricTypes {
+		value, err := p.scrapeMetric(ctx, scraper, dpl, metricType)
+		if err != nil {
+			logger.Errorf("failed to scrape postgres metric %s: %v", metricType.PrometheusMetricName, err)
+			continue
+		}
+		metrics = append(metrics, &providers.GenericCloudMetric{
+			Name:   metricType.PrometheusMetricName,
+			Labels: resources.BuildGenericMetricLabels(postgres.ObjectMeta, clusterID, dpl.Name, postgresProviderName),
+			Value:  value,
+		})
+	}
+	return &providers.ScrapeMetricsData{
+		Metrics: metrics,
+	}, nil
+}
+
+func (p *PostgresMetricsProvider) scrapeMetric(ctx context.Context, scraper *podMetricsScraper, dpl *appsv1.Deployment, metricType providers.CloudProviderMetricType) (float64, error) {
+	if query, ok := postgresMetricQueries[metricType.ProviderMetricName]; ok {
+		out, err := p.PodCommander.ExecIntoPodWithOutput(dpl, fmt.Sprintf("psql -tA -c %q", query))
+		if err != nil {
+			return 0, errorUtil.Wrapf(err, "failed to query %s", metricType.ProviderMetricName)
+		}
+		return parseFloatOutput(out)
+	}
+	if metricType.ProviderMetricName == PostgresVolumeCapacityMetric {
+		return p.getVolumeCapacity(ctx, dpl)
+	}
+	value, ok, err := scraper.scrape(ctx, metricType.ProviderMetricName)
+	if !ok {
+		return 0, errorUtil.New(fmt.Sprintf("unsupported provider metric %s", metricType.ProviderMetricName))
+	}
+	return value, err
+}
+
+// getVolumeCapacity returns the capacity of the postgres persistent volume claim, which shares the name of the
+// deployment, falling back to the requested storage while the claim is not bound
+func (p *PostgresMetricsProvider) getVolumeCapacity(ctx context.Context, dpl *appsv1.Deployment) (float64, error) {
+	pvc := &v1.PersistentVolumeClaim{}
+	if err := p.Client.Get(ctx, types.NamespacedName{Name: dpl.Name, Namespace: dpl.Namespace}, pvc); err != nil {
+		return 0, errorUtil.Wrapf(err, "failed to get postgres persistent volume claim %s", dpl.Name)
+	}
+	capacity, ok := pvc.Status.Capacity[v1.ResourceStorage]
+	if !ok {
+		capacity, ok = pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	}
+	if !ok {
+		return 0, errorUtil.New(fmt.Sprintf("persistent volume claim %s has no storage capacity", pvc.Name))
+	}
+	return float64(capacity.Value()), nil
+}
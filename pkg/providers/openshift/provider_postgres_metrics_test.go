@@ -0,0 +1,114 @@
+package openshift
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestPostgresMetricsProvider_ScrapePostgresMetrics(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	if err = configv1.Install(scheme); err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	metricTypes := []providers.CloudProviderMetricType{
+		{PrometheusMetricName: resources.PostgresAllocatedStorageMetricName, ProviderMetricName: PostgresVolumeCapacityMetric},
+		{PrometheusMetricName: "cro_postgres_database_size", ProviderMetricName: PostgresDatabaseSizeMetric},
+		{PrometheusMetricName: "cro_postgres_connections", ProviderMetricName: PostgresConnectionsMetric},
+		{PrometheusMetricName: resources.PostgresFreeStorageAverageMetricName, ProviderMetricName: DataVolumeAvailableMetric},
+		{PrometheusMetricName: resources.PostgresCPUUtilizationAverageMetricName, ProviderMetricName: PodCPUUtilizationMetric},
+		{PrometheusMetricName: resources.PostgresFreeableMemoryAverageMetricName, ProviderMetricName: PodMemoryAvailableMetric},
+		{PrometheusMetricName: resources.PostgresMaxMemoryMetricName, ProviderMetricName: PodMemoryLimitMetric},
+	}
+	tests := []struct {
+		name             string
+		client           client.Client
+		podCommander     resources.PodCommander
+		podMetricsGetter resources.PodMetricsGetter
+		want             map[string]float64
+		wantErr          bool
+	}{
+		{
+			name:   "test metrics are scraped from postgres and pod resource usage",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresDeployment(), buildTestPostgresPVC(), buildTestMetricsInfra()),
+			podCommander: &resources.PodCommanderMock{
+				ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+					switch {
+					case strings.Contains(cmd, "pg_database_size"):
+						return "8396800\n", nil
+					case strings.Contains(cmd, "pg_stat_activity"):
+						return "12\n", nil
+					case strings.Contains(cmd, "df"):
+						return "1073741824\n", nil
+					}
+					return "", errors.New("unexpected command")
+				},
+			},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			want: map[string]float64{
+				resources.PostgresAllocatedStorageMetricName:      5368709120,
+				"cro_postgres_database_size":                      8396800,
+				"cro_postgres_connections":                        12,
+				resources.PostgresFreeStorageAverageMetricName:    1073741824,
+				resources.PostgresCPUUtilizationAverageMetricName: 25,
+				resources.PostgresFreeableMemoryAverageMetricName: 805306368,
+				resources.PostgresMaxMemoryMetricName:             1073741824,
+			},
+		},
+		{
+			name:   "test metrics which fail to scrape are skipped",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresDeployment(), buildTestMetricsInfra()),
+			podCommander: &resources.PodCommanderMock{
+				ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+					return "psql: error", nil
+				},
+			},
+			podMetricsGetter: &resources.PodMetricsGetterMock{
+				GetPodResourceUsageFunc: func(ctx context.Context, dpl *appsv1.Deployment) (*resources.PodResourceUsage, error) {
+					return nil, errors.New("metrics api unavailable")
+				},
+			},
+			want: map[string]float64{},
+		},
+		{
+			name:             "test error when postgres deployment does not exist",
+			client:           moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsInfra()),
+			podCommander:     &resources.PodCommanderMock{},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewOpenShiftPostgresMetricsProvider(tt.client, nil, testLogger)
+			p.PodCommander = tt.podCommander
+			p.PodMetricsGetter = tt.podMetricsGetter
+			got, err := p.ScrapePostgresMetrics(context.TODO(), buildTestPostgresCR(), metricTypes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ScrapePostgresMetrics() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Metrics) != len(tt.want) {
+				t.Fatalf("ScrapePostgresMetrics() got %d metrics, want %d", len(got.Metrics), len(tt.want))
+			}
+			for _, metric := range got.Metrics {
+				if metric.Value != tt.want[metric.Name] {
+					t.Errorf("ScrapePostgresMetrics() metric %s = %v, want %v", metric.Name, metric.Value, tt.want[metric.Name])
+				}
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -39,7 +40,7 @@ func buildTestPostgresCR() *v1alpha1.Postgres {
 			Namespace:       testPostgresNamespace,
 			ResourceVersion: FakeResourceVersion,
 		},
-		Spec:   croType.ResourceTypeSpec{},
+		Spec:   v1alpha1.PostgresSpec{},
 		Status: croType.ResourceTypeStatus{},
 	}
 }
@@ -281,6 +282,56 @@ func TestOpenShiftPostgresProvider_DeletePostgres(t *testing.T) {
 	}
 }
 
+func TestOpenShiftPostgresProvider_DeletePostgresDeletionPolicy(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name           string
+		deletionPolicy croType.DeletionPolicy
+		wantDeployment bool
+	}{
+		{
+			name:           "test retain deletion policy keeps the deployment",
+			deletionPolicy: croType.DeletionPolicyRetain,
+			wantDeployment: true,
+		},
+		{
+			name:           "test delete deletion policy deletes the deployment",
+			deletionPolicy: croType.DeletionPolicyDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postgres := buildTestPostgresCR()
+			postgres.Spec.DeletionPolicy = tt.deletionPolicy
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestPostgresDeploymentReady(), postgres)
+			p := &PostgresProvider{
+				Client: c,
+				Logger: testLogger,
+			}
+			if _, err := p.DeletePostgres(context.TODO(), postgres); err != nil {
+				t.Fatalf("DeletePostgres() error = %v", err)
+			}
+			dpl := &appsv1.Deployment{}
+			err := c.Get(context.TODO(), client.ObjectKey{Name: postgres.Name, Namespace: postgres.Namespace}, dpl)
+			if tt.wantDeployment {
+				if err != nil {
+					t.Fatalf("DeletePostgres() deleted the deployment: %v", err)
+				}
+				if dpl.Labels[resources.BuildOrphanedTag().Key] != resources.TagOrphanedVal {
+					t.Errorf("DeletePostgres() deployment labels = %v, want orphaned label", dpl.Labels)
+				}
+				return
+			}
+			if !k8serr.IsNotFound(err) {
+				t.Errorf("DeletePostgres() kept the deployment, error = %v", err)
+			}
+		})
+	}
+}
+
 func TestOpenShiftPostgresProvider_overrideDefaults(t *testing.T) {
 	scheme, err := buildTestScheme()
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -51,13 +53,15 @@ type RedisProvider struct {
 	Client        client.Client
 	Logger        *logrus.Entry
 	ConfigManager ConfigManager
+	Recorder      record.EventRecorder
 }
 
-func NewOpenShiftRedisProvider(client client.Client, logger *logrus.Entry) *RedisProvider {
+func NewOpenShiftRedisProvider(client client.Client, logger *logrus.Entry, recorder record.EventRecorder) *RedisProvider {
 	return &RedisProvider{
 		Client:        client,
 		Logger:        logger.WithFields(logrus.Fields{"provider": redisProviderName}),
 		ConfigManager: NewDefaultConfigManager(client),
+		Recorder:      recorder,
 	}
 }
 
@@ -100,15 +104,24 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 	// deploy deployment
-	if err := p.CreateDeployment(ctx, buildDefaultRedisDeployment(r), redisConfig); err != nil {
+	or, err := p.CreateDeployment(ctx, buildDefaultRedisDeployment(r), redisConfig)
+	if err != nil {
 		errMsg := "failed to create or update redis deployment"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	if or == controllerutil.OperationResultCreated {
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonCreateStarted, "created redis deployment %s", r.Name)
+	}
 	// deploy service
 	if err := p.CreateService(ctx, buildDefaultRedisService(r), redisConfig); err != nil {
 		errMsg := "failed to create or update redis service"
 		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
+	// deploy network policy
+	if err := reconcileNetworkPolicy(ctx, p.Client, r.Name, r.Namespace, redisPort, r.Spec.NetworkAccess); err != nil {
+		errMsg := "failed to reconcile redis network policy"
+		return nil, croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
 
 	// check deployment status
 	dpl := &appsv1.Deployment{}
@@ -134,6 +147,22 @@ func (p *RedisProvider) CreateRedis(ctx context.Context, r *v1alpha1.Redis) (*pr
 }
 
 func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (croType.StatusMessage, error) {
+	// keep every object of the redis deployment with the retain deletion policy
+	if resources.IsRetained(r.Spec.ResourceTypeSpec) {
+		err := orphanObjects(ctx, p.Client,
+			&corev1.Service{ObjectMeta: controllerruntime.ObjectMeta{Name: r.Name, Namespace: r.Namespace}},
+			&corev1.PersistentVolumeClaim{ObjectMeta: controllerruntime.ObjectMeta{Name: r.Name, Namespace: r.Namespace}},
+			&corev1.ConfigMap{ObjectMeta: controllerruntime.ObjectMeta{Name: redisConfigMapName, Namespace: r.Namespace}},
+			&appsv1.Deployment{ObjectMeta: controllerruntime.ObjectMeta{Name: r.Name, Namespace: r.Namespace}},
+			&networkingv1.NetworkPolicy{ObjectMeta: controllerruntime.ObjectMeta{Name: r.Name, Namespace: r.Namespace}},
+		)
+		if err != nil {
+			errMsg := "failed to label redis objects as orphaned"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		return resources.RetainResource(ctx, p.Client, p.Recorder, r, DefaultFinalizer, r.Name)
+	}
+
 	// delete service
 	p.Logger.Info("Deleting redis service")
 	svc := &corev1.Service{
@@ -148,18 +177,32 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
 
-	// delete pvc
-	p.Logger.Info("Deleting redis persistent volume claim")
+	// delete network policy
+	if err = deleteNetworkPolicy(ctx, p.Client, r.Name, r.Namespace); err != nil {
+		errMsg := "failed to delete network policy"
+		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	}
+
+	// delete pvc, with the snapshot deletion policy the pvc is kept as the final snapshot of the data
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: controllerruntime.ObjectMeta{
 			Name:      r.Name,
 			Namespace: r.Namespace,
 		},
 	}
-	err = p.Client.Delete(ctx, pvc)
-	if err != nil && !k8serr.IsNotFound(err) {
-		errMsg := "failed to delete persistent volume claim"
-		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+	if resources.IsFinalSnapshotRequired(r.Spec.ResourceTypeSpec) {
+		if err = orphanObjects(ctx, p.Client, pvc); err != nil {
+			errMsg := "failed to label persistent volume claim as orphaned"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
+		resources.RecordEvent(p.Recorder, r, resources.EventReasonFinalSnapshot, "persistent volume claim %s is kept as the final snapshot", pvc.Name)
+	} else {
+		p.Logger.Info("Deleting redis persistent volume claim")
+		err = p.Client.Delete(ctx, pvc)
+		if err != nil && !k8serr.IsNotFound(err) {
+			errMsg := "failed to delete persistent volume claim"
+			return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
+		}
 	}
 
 	// delete config map
@@ -192,8 +235,7 @@ func (p *RedisProvider) DeleteRedis(ctx context.Context, r *v1alpha1.Redis) (cro
 
 	// remove the finalizer added by the provider
 	p.Logger.Info("Removing finalizer")
-	resources.RemoveFinalizer(&r.ObjectMeta, DefaultFinalizer)
-	if err := p.Client.Update(ctx, r); err != nil {
+	if err := resources.SetResourceDeleted(ctx, p.Client, r, DefaultFinalizer); err != nil {
 		errMsg := "failed to update instance as part of finalizer reconcile"
 		return croType.StatusMessage(errMsg), errorUtil.Wrap(err, errMsg)
 	}
@@ -217,7 +259,7 @@ func (p *RedisProvider) getRedisConfig(ctx context.Context, r *v1alpha1.Redis) (
 	return redisConfig, stratCfg, nil
 }
 
-func (p *RedisProvider) CreateDeployment(ctx context.Context, d *appsv1.Deployment, redisCfg *RedisStrat) error {
+func (p *RedisProvider) CreateDeployment(ctx context.Context, d *appsv1.Deployment, redisCfg *RedisStrat) (controllerutil.OperationResult, error) {
 	or, err := immutableCreateOrUpdate(ctx, p.Client, d, func(existing runtime.Object) error {
 		e := existing.(*appsv1.Deployment)
 		if redisCfg.RedisDeploymentSpec == nil {
@@ -228,9 +270,9 @@ func (p *RedisProvider) CreateDeployment(ctx context.Context, d *appsv1.Deployme
 		return nil
 	})
 	if err != nil {
-		return errorUtil.Wrapf(err, "failed to create or update deployment %s, action was %s", d.Name, or)
+		return or, errorUtil.Wrapf(err, "failed to create or update deployment %s, action was %s", d.Name, or)
 	}
-	return nil
+	return or, nil
 }
 
 func (p *RedisProvider) CreateService(ctx context.Context, s *corev1.Service, redisCfg *RedisStrat) error {
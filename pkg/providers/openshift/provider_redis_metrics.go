@@ -0,0 +1,260 @@
+// redis metric provider scrapes metrics for a single in-cluster redis deployment
+//
+// this providers does
+//   - query redis INFO in the deployment pod for memory and cpu metrics
+//   - read the deployment pod resource usage from the cluster metrics api
+//   - return generic cloud metric data to metric controller to be exposed
+//
+// this provider does not
+//   - expose the metrics, this is controller at a higher level (controller)
+package openshift
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	redisMetricProviderName = "openshift redis metrics provider"
+	redisDataVolumePath     = "/var/lib/redis/data"
+
+	// RedisMemoryUsagePercentageMetric used_memory as a percentage of maxmemory, or of the pod memory limit if maxmemory is not set
+	RedisMemoryUsagePercentageMetric = "redis_memory_usage_percentage"
+	// RedisFreeableMemoryMetric maxmemory, or the pod memory limit if maxmemory is not set, minus used_memory in bytes
+	RedisFreeableMemoryMetric = "redis_freeable_memory"
+	// RedisEngineCPUUtilizationMetric the percentage of cpu time used by the redis server process since the previous scrape
+	RedisEngineCPUUtilizationMetric = "redis_engine_cpu_utilization"
+	// RedisConnectedClientsMetric connected_clients from redis INFO
+	RedisConnectedClientsMetric = "redis_connected_clients"
+)
+
+var _ providers.RedisMetricsProvider = (*RedisMetricsProvider)(nil)
+
+// redisCPUSample is the cumulative cpu time used by a redis server at a point in time
+type redisCPUSample struct {
+	cpuSeconds float64
+	takenAt    time.Time
+}
+
+type RedisMetricsProvider struct {
+	Client           client.Client
+	Logger           *logrus.Entry
+	PodCommander     resources.PodCommander
+	PodMetricsGetter resources.PodMetricsGetter
+
+	// cpuSamples stores the previous cpu sample per redis, engine cpu utilization is calculated from the difference
+	cpuSamples     map[string]redisCPUSample
+	cpuSamplesLock sync.Mutex
+}
+
+func NewOpenShiftRedisMetricsProvider(client client.Client, cs *kubernetes.Clientset, logger *logrus.Entry) *RedisMetricsProvider {
+	return &RedisMetricsProvider{
+		Client:           client,
+		Logger:           logger.WithFields(logrus.Fields{"providers": redisMetricProviderName}),
+		PodCommander:     &resources.OpenShiftPodCommander{ClientSet: cs},
+		PodMetricsGetter: &resources.OpenShiftPodMetricsGetter{ClientSet: cs},
+		cpuSamples:       map[string]redisCPUSample{},
+	}
+}
+
+func (p *RedisMetricsProvider) SupportsStrategy(strategy string) bool {
+	return strategy == providers.OpenShiftDeploymentStrategy
+}
+
+// ScrapeRedisMetrics returns scraped metrics to metric controller
+// a metric which fails to be scraped is logged and skipped, so it does not prevent other metrics being exposed
+func (p *RedisMetricsProvider) ScrapeRedisMetrics(ctx context.Context, redis *v1alpha1.Redis, metricTypes []providers.CloudProviderMetricType) (*providers.ScrapeMetricsData, error) {
+	logger := resources.NewActionLoggerWithFields(p.Logger, map[string]interface{}{
+		resources.LoggingKeyAction: "ScrapeMetrics",
+		"Resource":                 redis.Name,
+	})
+	logger.Infof("reconciling redis metrics %s", redis.Name)
+
+	dpl := &appsv1.Deployment{}
+	if err := p.Client.Get(ctx, types.NamespacedName{Name: redis.Name, Namespace: redis.Namespace}, dpl); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get redis deployment %s", redis.Name)
+	}
+	clusterID, err := resources.GetClusterID(ctx, p.Client)
+	if err != nil {
+		return nil, errorUtil.Wrap(err, "error getting clusterID")
+	}
+
+	scraper := &podMetricsScraper{
+		podCommander:     p.PodCommander,
+		podMetricsGetter: p.PodMetricsGetter,
+		deployment:       dpl,
+		dataVolumePath:   redisDataVolumePath,
+	}
+	// redis INFO is only requested once per scrape, and only if it is needed
+	var info map[string]string
+	getInfo := func() (map[string]string, error) {
+		if info != nil {
+			return info, nil
+		}
+		out, err := p.PodCommander.ExecIntoPodWithOutput(dpl, "redis-cli INFO memory && redis-cli INFO cpu && redis-cli INFO clients")
+		if err != nil {
+			return nil, errorUtil.Wrap(err, "failed to get redis info")
+		}
+		info = parseRedisInfo(out)
+		return info, nil
+	}
+
+	var metrics []*providers.GenericCloudMetric
+	for _, metricType := range metricTypes {
+		value, ok, err := p.scrapeMetric(ctx, scraper, getInfo, redis, metricType)
+		if err != nil {
+			logger.Errorf("failed to scrape redis metric %s: %v", metricType.PrometheusMetricName, err)
+			continue
+		}
+		if !ok {
+			logger.Infof("redis metric %s is not yet available", metricType.PrometheusMetricName)
+			continue
+		}
+		metrics = append(metrics, &providers.GenericCloudMetric{
+			Name:   metricType.PrometheusMetricName,
+			Labels: resources.BuildGenericMetricLabels(redis.ObjectMeta, clusterID, dpl.Name, redisProviderName),
+			Value:  value,
+		})
+	}
+	return &providers.ScrapeMetricsData{
+		Metrics: metrics,
+	}, nil
+}
+
+// scrapeMetric returns the value of a metric, ok is false if there is no value available yet
+func (p *RedisMetricsProvider) scrapeMetric(ctx context.Context, scraper *podMetricsScraper, getInfo func() (map[string]string, error), redis *v1alpha1.Redis, metricType providers.CloudProviderMetricType) (value float64, ok bool, err error) {
+	switch metricType.ProviderMetricName {
+	case RedisMemoryUsagePercentageMetric, RedisFreeableMemoryMetric:
+		info, err := getInfo()
+		if err != nil {
+			return 0, false, err
+		}
+		usedMemory, err := getRedisInfoValue(info, "used_memory")
+		if err != nil {
+			return 0, false, err
+		}
+		maxMemory, err := p.getMaxMemory(ctx, scraper, info)
+		if err != nil {
+			return 0, false, err
+		}
+		if metricType.ProviderMetricName == RedisFreeableMemoryMetric {
+			return maxMemory - usedMemory, true, nil
+		}
+		return usedMemory / maxMemory * 100, true, nil
+	case RedisConnectedClientsMetric:
+		info, err := getInfo()
+		if err != nil {
+			return 0, false, err
+		}
+		value, err := getRedisInfoValue(info, "connected_clients")
+		return value, err == nil, err
+	case RedisEngineCPUUtilizationMetric:
+		info, err := getInfo()
+		if err != nil {
+			return 0, false, err
+		}
+		return p.calculateEngineCPUUtilization(fmt.Sprintf("%s/%s", redis.Namespace, redis.Name), info, time.Now())
+	}
+	value, supported, err := scraper.scrape(ctx, metricType.ProviderMetricName)
+	if !supported {
+		return 0, false, errorUtil.New(fmt.Sprintf("unsupported provider metric %s", metricType.ProviderMetricName))
+	}
+	return value, err == nil, err
+}
+
+// getMaxMemory returns maxmemory from redis INFO, falling back to the pod memory limit if maxmemory is not set
+func (p *RedisMetricsProvider) getMaxMemory(ctx context.Context, scraper *podMetricsScraper, info map[string]string) (float64, error) {
+	maxMemory, err := getRedisInfoValue(info, "maxmemory")
+	if err != nil {
+		return 0, err
+	}
+	if maxMemory > 0 {
+		return maxMemory, nil
+	}
+	memoryLimit, _, err := scraper.scrape(ctx, PodMemoryLimitMetric)
+	if err != nil {
+		return 0, err
+	}
+	if memoryLimit <= 0 {
+		return 0, errorUtil.New("redis maxmemory and pod memory limit are not set")
+	}
+	return memoryLimit, nil
+}
+
+// calculateEngineCPUUtilization returns the cpu utilization of the redis server since the previous sample was taken
+// no value is available on the first scrape, or if the redis server has restarted since the previous scrape
+func (p *RedisMetricsProvider) calculateEngineCPUUtilization(key string, info map[string]string, now time.Time) (float64, bool, error) {
+	systemSeconds, err := getRedisInfoValue(info, "used_cpu_sys")
+	if err != nil {
+		return 0, false, err
+	}
+	userSeconds, err := getRedisInfoValue(info, "used_cpu_user")
+	if err != nil {
+		return 0, false, err
+	}
+	current := redisCPUSample{cpuSeconds: systemSeconds + userSeconds, takenAt: now}
+
+	p.cpuSamplesLock.Lock()
+	defer p.cpuSamplesLock.Unlock()
+	if p.cpuSamples == nil {
+		p.cpuSamples = map[string]redisCPUSample{}
+	}
+	previous, found := p.cpuSamples[key]
+	p.cpuSamples[key] = current
+	elapsed := current.takenAt.Sub(previous.takenAt).Seconds()
+	if !found || elapsed <= 0 || current.cpuSeconds < previous.cpuSeconds {
+		return 0, false, nil
+	}
+	return roundFloat((current.cpuSeconds-previous.cpuSeconds)/elapsed*100, 2), true, nil
+}
+
+// parseRedisInfo parses the output of redis INFO into a map of fields
+func parseRedisInfo(out string) map[string]string {
+	info := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		info[field] = value
+	}
+	return info
+}
+
+func getRedisInfoValue(info map[string]string, field string) (float64, error) {
+	raw, ok := info[field]
+	if !ok {
+		return 0, errorUtil.New(fmt.Sprintf("redis info field %s not found", field))
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, errorUtil.Wrapf(err, "failed to parse redis info field %s", field)
+	}
+	return value, nil
+}
+
+func roundFloat(val float64, precision uint) float64 {
+	ratio := math.Pow(10, float64(precision))
+	return math.Round(val*ratio) / ratio
+}
@@ -0,0 +1,183 @@
+package openshift
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testRedisInfo = `# Memory
+used_memory:1048576
+maxmemory:4194304
+
+# CPU
+used_cpu_sys:10.5
+used_cpu_user:9.5
+
+# Clients
+connected_clients:7
+`
+
+func buildTestMetricsInfra() *configv1.Infrastructure {
+	return &configv1.Infrastructure{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name: "cluster",
+		},
+		Status: configv1.InfrastructureStatus{
+			InfrastructureName: "test-cluster",
+		},
+	}
+}
+
+func buildTestPodMetricsGetter() *resources.PodMetricsGetterMock {
+	return &resources.PodMetricsGetterMock{
+		GetPodResourceUsageFunc: func(ctx context.Context, dpl *appsv1.Deployment) (*resources.PodResourceUsage, error) {
+			return &resources.PodResourceUsage{
+				CPUUsage:    resource.MustParse("125m"),
+				MemoryUsage: resource.MustParse("256Mi"),
+				CPULimit:    resource.MustParse("500m"),
+				MemoryLimit: resource.MustParse("1Gi"),
+			}, nil
+		},
+	}
+}
+
+func buildTestRedisMetricTypes() []providers.CloudProviderMetricType {
+	return []providers.CloudProviderMetricType{
+		{PrometheusMetricName: resources.RedisMemoryUsagePercentageAverageMetricName, ProviderMetricName: RedisMemoryUsagePercentageMetric},
+		{PrometheusMetricName: resources.RedisFreeableMemoryAverageMetricName, ProviderMetricName: RedisFreeableMemoryMetric},
+		{PrometheusMetricName: resources.RedisCPUUtilizationAverageMetricName, ProviderMetricName: PodCPUUtilizationMetric},
+		{PrometheusMetricName: resources.RedisEngineCPUUtilizationAverageMetricName, ProviderMetricName: RedisEngineCPUUtilizationMetric},
+		{PrometheusMetricName: "cro_redis_connected_clients", ProviderMetricName: RedisConnectedClientsMetric},
+	}
+}
+
+func TestRedisMetricsProvider_ScrapeRedisMetrics(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	if err = configv1.Install(scheme); err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name             string
+		client           client.Client
+		podCommander     resources.PodCommander
+		podMetricsGetter resources.PodMetricsGetter
+		metricTypes      []providers.CloudProviderMetricType
+		want             map[string]float64
+		wantErr          bool
+	}{
+		{
+			name:   "test metrics are scraped from redis info and pod resource usage",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestDeploymentReady(), buildTestMetricsInfra()),
+			podCommander: &resources.PodCommanderMock{
+				ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+					return testRedisInfo, nil
+				},
+			},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			metricTypes:      buildTestRedisMetricTypes(),
+			want: map[string]float64{
+				resources.RedisMemoryUsagePercentageAverageMetricName: 25,
+				resources.RedisFreeableMemoryAverageMetricName:        3145728,
+				resources.RedisCPUUtilizationAverageMetricName:        25,
+				"cro_redis_connected_clients":                         7,
+			},
+		},
+		{
+			name:   "test pod memory limit is used when maxmemory is not set",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestDeploymentReady(), buildTestMetricsInfra()),
+			podCommander: &resources.PodCommanderMock{
+				ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+					return strings.Replace(testRedisInfo, "maxmemory:4194304", "maxmemory:0", 1), nil
+				},
+			},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			metricTypes: []providers.CloudProviderMetricType{
+				{PrometheusMetricName: resources.RedisFreeableMemoryAverageMetricName, ProviderMetricName: RedisFreeableMemoryMetric},
+			},
+			want: map[string]float64{
+				resources.RedisFreeableMemoryAverageMetricName: 1072693248,
+			},
+		},
+		{
+			name:   "test metrics which fail to scrape are skipped",
+			client: moqClient.NewSigsClientMoqWithScheme(scheme, buildTestDeploymentReady(), buildTestMetricsInfra()),
+			podCommander: &resources.PodCommanderMock{
+				ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+					return "", errors.New("exec failed")
+				},
+			},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			metricTypes:      buildTestRedisMetricTypes(),
+			want: map[string]float64{
+				resources.RedisCPUUtilizationAverageMetricName: 25,
+			},
+		},
+		{
+			name:             "test error when redis deployment does not exist",
+			client:           moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMetricsInfra()),
+			podCommander:     &resources.PodCommanderMock{},
+			podMetricsGetter: buildTestPodMetricsGetter(),
+			metricTypes:      buildTestRedisMetricTypes(),
+			wantErr:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewOpenShiftRedisMetricsProvider(tt.client, nil, testLogger)
+			p.PodCommander = tt.podCommander
+			p.PodMetricsGetter = tt.podMetricsGetter
+			got, err := p.ScrapeRedisMetrics(context.TODO(), buildTestRedisCR(), tt.metricTypes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ScrapeRedisMetrics() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got.Metrics) != len(tt.want) {
+				t.Fatalf("ScrapeRedisMetrics() got %d metrics, want %d", len(got.Metrics), len(tt.want))
+			}
+			for _, metric := range got.Metrics {
+				if metric.Value != tt.want[metric.Name] {
+					t.Errorf("ScrapeRedisMetrics() metric %s = %v, want %v", metric.Name, metric.Value, tt.want[metric.Name])
+				}
+				if metric.Labels[resources.LabelStrategyKey] != redisProviderName {
+					t.Errorf("ScrapeRedisMetrics() metric %s has strategy label %s, want %s", metric.Name, metric.Labels[resources.LabelStrategyKey], redisProviderName)
+				}
+			}
+		})
+	}
+}
+
+func TestRedisMetricsProvider_calculateEngineCPUUtilization(t *testing.T) {
+	p := NewOpenShiftRedisMetricsProvider(nil, nil, testLogger)
+	now := time.Now()
+
+	if _, ok, err := p.calculateEngineCPUUtilization("test", parseRedisInfo("used_cpu_sys:10\nused_cpu_user:10"), now); err != nil || ok {
+		t.Fatalf("expected no value on first sample, got ok %v, err %v", ok, err)
+	}
+	got, ok, err := p.calculateEngineCPUUtilization("test", parseRedisInfo("used_cpu_sys:25\nused_cpu_user:25"), now.Add(100*time.Second))
+	if err != nil || !ok {
+		t.Fatalf("expected value on second sample, got ok %v, err %v", ok, err)
+	}
+	if got != 30 {
+		t.Fatalf("calculateEngineCPUUtilization() = %v, want 30", got)
+	}
+	if _, ok, err = p.calculateEngineCPUUtilization("test", parseRedisInfo("used_cpu_sys:1\nused_cpu_user:1"), now.Add(200*time.Second)); err != nil || ok {
+		t.Fatalf("expected no value after redis restart, got ok %v, err %v", ok, err)
+	}
+}
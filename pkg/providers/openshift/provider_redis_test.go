@@ -16,6 +16,7 @@ import (
 	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
@@ -44,6 +45,10 @@ func buildTestScheme() (*runtime.Scheme, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = networkingv1.AddToScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
 	return scheme, nil
 }
 
@@ -54,7 +59,7 @@ func buildTestRedisCR() *v1alpha1.Redis {
 			Namespace:       testRedisNamespace,
 			ResourceVersion: FakeResourceVersion,
 		},
-		Spec:   croType.ResourceTypeSpec{},
+		Spec:   v1alpha1.RedisSpec{},
 		Status: croType.ResourceTypeStatus{},
 	}
 }
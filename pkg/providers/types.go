@@ -47,6 +47,11 @@ type RedisSnapshotInstance struct {
 	Name string
 }
 
+// CloudNetworkInstance is returned once the network cloud resources are created in is ready
+type CloudNetworkInstance struct {
+	NetworkID string
+}
+
 type BlobStorageProvider interface {
 	GetName() string
 	SupportsStrategy(s string) bool
@@ -87,6 +92,14 @@ type RedisSnapshotProvider interface {
 	DeleteRedisSnapshot(ctx context.Context, snapshot *v1alpha1.RedisSnapshot, redis *v1alpha1.Redis) (croType.StatusMessage, error)
 }
 
+type CloudNetworkProvider interface {
+	GetName() string
+	SupportsStrategy(s string) bool
+	GetReconcileTime(cn *v1alpha1.CloudNetwork) time.Duration
+	ReconcileCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (*CloudNetworkInstance, croType.StatusMessage, error)
+	DeleteCloudNetwork(ctx context.Context, cn *v1alpha1.CloudNetwork) (croType.StatusMessage, error)
+}
+
 // RedisDeploymentDetails provider specific details about the AWS Redis Cluster created
 type RedisDeploymentDetails struct {
 	URI  string
@@ -135,6 +148,16 @@ type CloudProviderMetricType struct {
 	ProviderMetricName string
 	//Statistic the type of metric value we return e.g. Average, Sum, Max, Min etc.
 	Statistic string
+	//Period the aggregation period of the metric, the metric reconcile time is used if not set
+	Period time.Duration
+}
+
+// GetPeriodOrDefault returns the aggregation period of the metric, or the default if no period is set
+func (t CloudProviderMetricType) GetPeriodOrDefault(defaultTo time.Duration) time.Duration {
+	if t.Period > 0 {
+		return t.Period
+	}
+	return defaultTo
 }
 
 // ScrapeMetricsData is a wrapper for output of scrape metrics
@@ -153,6 +176,11 @@ type PostgresMetricsProvider interface {
 	ScrapePostgresMetrics(ctx context.Context, postgres *v1alpha1.Postgres, metricTypes []CloudProviderMetricType) (*ScrapeMetricsData, error)
 }
 
+type BlobStorageMetricsProvider interface {
+	SupportsStrategy(s string) bool
+	ScrapeBlobStorageMetrics(ctx context.Context, blobStorage *v1alpha1.BlobStorage, metricTypes []CloudProviderMetricType) (*ScrapeMetricsData, error)
+}
+
 func (gcm *GenericCloudMetric) IsIncludedInSlice(metrics []*GenericCloudMetric) bool {
 	for _, metric := range metrics {
 		if gcm.Name == metric.Name && gcm.Value == metric.Value {
@@ -0,0 +1,201 @@
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Migration describes how a custom resource is moved to a new strategy when the strategy for its type changes in the
+// cloud-resource-config config map
+//
+// The cloud resource of the new strategy is provisioned next to the existing one, the data is copied across, then the
+// result of the new strategy is passed to OnResult so the connection secret is switched in a single update. The cloud
+// resource migrated from is kept until the migration is confirmed
+type Migration[T client.Object, R any] struct {
+	// Enabled reports whether the custom resource opted in to migrating to a new strategy
+	Enabled func(instance T) bool
+	// Confirmed reports whether the cloud resource migrated from can be deleted
+	Confirmed func(instance T) bool
+	// Status returns the migration status field of the custom resource
+	Status func(instance T) **croType.MigrationStatus
+	// CopyData copies the data from the cloud resource migrated from to the one migrated to, it returns true once the
+	// copy is complete
+	CopyData func(ctx context.Context, instance T, from, to *R) (bool, croType.StatusMessage, error)
+	// Cleanup removes anything created to copy the data once the migration is complete, optional
+	Cleanup func(ctx context.Context, instance T) error
+}
+
+// provider returns the provider supporting the strategy, nil if none does
+func (r *Reconciler[T, R]) provider(strategy string) Provider[T, R] {
+	for _, p := range r.config.Providers {
+		if p.SupportsStrategy(strategy) {
+			return p
+		}
+	}
+	return nil
+}
+
+// migrate moves the custom resource from the strategy in its status to the configured strategy, the existing cloud
+// resource keeps being reconciled until the connection secret is switched to the new one
+func (r *Reconciler[T, R]) migrate(ctx context.Context, instance T, status Status, fromStrategy, toStrategy string) (ctrl.Result, error) {
+	migration := r.config.Migration
+	from, to := r.provider(fromStrategy), r.provider(toStrategy)
+	if from == nil || to == nil {
+		if err := r.updatePhase(ctx, instance, croType.PhaseFailed, croType.StatusUnsupportedType); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, errorUtil.Errorf("unsupported migration from strategy %s to %s", fromStrategy, toStrategy)
+	}
+
+	migrationStatus := migration.Status(instance)
+	if *migrationStatus != nil && (*migrationStatus).Phase == croType.MigrationPhaseSwitched {
+		msg := croType.StatusMessage(fmt.Sprintf("migration from %s to %s is not confirmed, the previous migration must be confirmed before migrating to %s", (*migrationStatus).FromStrategy, (*migrationStatus).ToStrategy, toStrategy))
+		if err := r.updatePhase(ctx, instance, croType.PhaseFailed, msg); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true, RequeueAfter: from.GetReconcileTime(instance)}, nil
+	}
+	if *migrationStatus == nil || (*migrationStatus).FromStrategy != fromStrategy || (*migrationStatus).ToStrategy != toStrategy {
+		base := r.snapshot(instance)
+		*migrationStatus = &croType.MigrationStatus{
+			FromStrategy:           fromStrategy,
+			FromResourceIdentifier: annotations.Get(instance, annotations.ResourceIdentifier),
+			ToStrategy:             toStrategy,
+			Phase:                  croType.MigrationPhaseProvisioning,
+		}
+		if status.Provider != nil {
+			(*migrationStatus).FromProvider = *status.Provider
+		}
+		if err := r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.GetName(), instance.GetNamespace())
+		}
+		r.logger.Infof("migrating %s %s from strategy %s to %s", r.config.Name, instance.GetName(), fromStrategy, toStrategy)
+		resources.RecordEvent(r.recorder, instance, resources.EventReasonMigrationStarted, "started migration from %s to %s", fromStrategy, toStrategy)
+	}
+	fromID, toID := (*migrationStatus).FromResourceIdentifier, (*migrationStatus).ToResourceIdentifier
+
+	// both cloud resources are reconciled, each provider is passed the resource identifier of its own cloud resource
+	// and reports the one it assigns in the migration scope. the providers may update the custom resource, so the
+	// migration status is only read back right before it is patched
+	fromResult, msg, err := from.Reconcile(resources.WithMigrationScope(ctx, &resources.MigrationScope{ResourceIdentifier: fromID}), instance)
+	if err != nil {
+		return r.migrationFailed(ctx, instance, croType.StatusMessage(fmt.Sprintf("migration to %s failed to reconcile %s cloud resource: %s", toStrategy, fromStrategy, msg)).WrapError(err), err)
+	}
+	if fromResult == nil {
+		return r.migrationPending(ctx, instance, from, croType.MigrationPhaseProvisioning, toID, croType.StatusMessage(fmt.Sprintf("migration to %s waiting on %s cloud resource: %s", toStrategy, fromStrategy, msg)))
+	}
+	toScope := &resources.MigrationScope{ResourceIdentifier: toID}
+	toResult, msg, err := to.Reconcile(resources.WithMigrationScope(ctx, toScope), instance)
+	toID = toScope.ResourceIdentifier
+	if err != nil {
+		return r.migrationFailed(ctx, instance, croType.StatusMessage(fmt.Sprintf("migration to %s failed to provision cloud resource: %s", toStrategy, msg)).WrapError(err), err)
+	}
+	if toResult == nil {
+		return r.migrationPending(ctx, instance, to, croType.MigrationPhaseProvisioning, toID, croType.StatusMessage(fmt.Sprintf("migration to %s provisioning cloud resource: %s", toStrategy, msg)))
+	}
+
+	done, msg, err := migration.CopyData(ctx, instance, fromResult, toResult)
+	if err != nil {
+		return r.migrationFailed(ctx, instance, croType.StatusMessage(fmt.Sprintf("migration to %s failed to copy data: %s", toStrategy, msg)).WrapError(err), err)
+	}
+	if !done {
+		return r.migrationPending(ctx, instance, to, croType.MigrationPhaseCopying, toID, croType.StatusMessage(fmt.Sprintf("migration to %s copying data: %s", toStrategy, msg)))
+	}
+
+	// the connection secret is switched to the new cloud resource, the previous one is kept until confirmed
+	if err = resources.PatchObject(ctx, r.client, instance, func() {
+		setResourceIdentifier(instance, toID)
+	}); err != nil {
+		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update resource identifier of instance %s", instance.GetName())
+	}
+	base := r.snapshot(instance)
+	if r.config.OnResult != nil {
+		if err = r.config.OnResult(ctx, instance, toResult); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	ms := *migrationStatus
+	ms.ToResourceIdentifier = toID
+	ms.Phase = croType.MigrationPhaseSwitched
+	*status.Strategy = toStrategy
+	if status.Provider != nil {
+		*status.Provider = to.GetName()
+	}
+	*status.Phase = croType.PhaseComplete
+	*status.Message = croType.StatusMessage(fmt.Sprintf("migrated from %s to %s, the %s cloud resource is kept until the migration is confirmed", fromStrategy, toStrategy, fromStrategy))
+	if err = r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.GetName(), instance.GetNamespace())
+	}
+	resources.RecordEvent(r.recorder, instance, resources.EventReasonMigrationSwitched, "switched connection secret from %s to %s", fromStrategy, toStrategy)
+	return ctrl.Result{Requeue: true, RequeueAfter: to.GetReconcileTime(instance)}, nil
+}
+
+// completeMigration deletes the cloud resource migrated from once the migration is confirmed, the migration is complete
+// once the provider reported the delete in the migration scope through resources.SetResourceDeleted
+func (r *Reconciler[T, R]) completeMigration(ctx context.Context, instance T, ms *croType.MigrationStatus) error {
+	migration := r.config.Migration
+	fromStrategy := ms.FromStrategy
+	from := r.provider(fromStrategy)
+	if from == nil {
+		return errorUtil.Errorf("unsupported deployment strategy %s to delete migrated cloud resource", ms.FromStrategy)
+	}
+	scope := &resources.MigrationScope{ResourceIdentifier: ms.FromResourceIdentifier}
+	msg, err := from.Delete(resources.WithMigrationScope(ctx, scope), instance)
+	if err != nil {
+		return errorUtil.Wrapf(err, "failed to delete %s cloud resource migrated from: %s", ms.FromStrategy, msg)
+	}
+	if !scope.Deleted {
+		r.logger.Infof("waiting on %s cloud resource migrated from to delete: %s", ms.FromStrategy, msg)
+		return nil
+	}
+	if migration.Cleanup != nil {
+		if err = migration.Cleanup(ctx, instance); err != nil {
+			return errorUtil.Wrap(err, "failed to clean up migration")
+		}
+	}
+	// the provider may have updated the custom resource, the migration status is read back before it is patched
+	base := r.snapshot(instance)
+	(*migration.Status(instance)).Phase = croType.MigrationPhaseComplete
+	if err = r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+		return errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.GetName(), instance.GetNamespace())
+	}
+	resources.RecordEvent(r.recorder, instance, resources.EventReasonMigrationCompleted, "deleted %s cloud resource migrated from", fromStrategy)
+	return nil
+}
+
+// migrationPending records the progress of the migration and requeues the custom resource
+func (r *Reconciler[T, R]) migrationPending(ctx context.Context, instance T, p Provider[T, R], phase croType.MigrationPhase, toID string, msg croType.StatusMessage) (ctrl.Result, error) {
+	r.logger.Info(msg)
+	base := r.snapshot(instance)
+	ms := *r.config.Migration.Status(instance)
+	ms.Phase = phase
+	ms.ToResourceIdentifier = toID
+	if err := r.patchPhase(ctx, instance, base, croType.PhaseInProgress, msg); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
+}
+
+// migrationFailed records the failure of the migration, the migration is retried from its current phase
+func (r *Reconciler[T, R]) migrationFailed(ctx context.Context, instance T, msg croType.StatusMessage, err error) (ctrl.Result, error) {
+	if updateErr := r.updatePhase(ctx, instance, croType.PhaseFailed, msg); updateErr != nil {
+		return ctrl.Result{}, updateErr
+	}
+	return ctrl.Result{}, err
+}
+
+// setResourceIdentifier sets the resource identifier annotation of the custom resource, an empty id removes it
+func setResourceIdentifier(instance client.Object, id string) {
+	if id == "" {
+		annotations.Remove(instance, annotations.ResourceIdentifier)
+		return
+	}
+	annotations.Add(instance, annotations.ResourceIdentifier, id)
+}
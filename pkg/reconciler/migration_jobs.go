@@ -0,0 +1,69 @@
+package reconciler
+
+import (
+	"strings"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+)
+
+const (
+	postgresMigrationImage = "registry.redhat.io/rhel9/postgresql-15"
+	redisMigrationImage    = "registry.redhat.io/rhel9/redis-7"
+
+	// postgresMigrationScript restores a dump of the database migrated from into the database migrated to, objects
+	// are recreated so a retried copy starts from a clean database
+	postgresMigrationScript = `set -o errexit -o pipefail
+PGPASSWORD="$FROM_PASSWORD" pg_dump --no-owner --no-acl --clean --if-exists -h "$FROM_HOST" -p "$FROM_PORT" -U "$FROM_USERNAME" "$FROM_DATABASE" \
+  | PGPASSWORD="$TO_PASSWORD" psql -v ON_ERROR_STOP=1 -q -h "$TO_HOST" -p "$TO_PORT" -U "$TO_USERNAME" "$TO_DATABASE"
+`
+
+	// redisMigrationScript copies every key with DUMP and RESTORE, MIGRATE and replication are not available on all
+	// managed redis services
+	redisMigrationScript = `set -o errexit -o pipefail
+from() { redis-cli -h "$FROM_URI" -p "$FROM_PORT" "$@"; }
+to() { redis-cli -h "$TO_URI" -p "$TO_PORT" "$@"; }
+from --scan | while IFS= read -r key; do
+  ttl=$(from PTTL "$key")
+  # the key expired since it was listed
+  if [ "$ttl" = "-2" ]; then continue; fi
+  if [ "$ttl" -lt 0 ]; then ttl=0; fi
+  # redis-cli appends a new line to the raw dump
+  out=$(from --raw DUMP "$key" | head -c -1 | to -x RESTORE "$key" "$ttl" REPLACE)
+  if [ "$out" != "OK" ]; then
+    echo "failed to copy key $key: $out" >&2
+    exit 1
+  fi
+done
+`
+)
+
+// PostgresMigrationJob returns the job copying the database of a postgres instance migrated to a new strategy
+func PostgresMigrationJob(from, to *providers.PostgresInstance) resources.MigrationJob {
+	return resources.MigrationJob{
+		Image:  postgresMigrationImage,
+		Script: postgresMigrationScript,
+		Env:    migrationJobEnv(from.DeploymentDetails, to.DeploymentDetails),
+	}
+}
+
+// RedisMigrationJob returns the job copying the keys of a redis cluster migrated to a new strategy
+func RedisMigrationJob(from, to *providers.RedisCluster) resources.MigrationJob {
+	return resources.MigrationJob{
+		Image:  redisMigrationImage,
+		Script: redisMigrationScript,
+		Env:    migrationJobEnv(from.DeploymentDetails, to.DeploymentDetails),
+	}
+}
+
+// migrationJobEnv exposes the connection details of both cloud resources to the migration script, prefixed with FROM_
+// and TO_
+func migrationJobEnv(from, to providers.DeploymentDetails) map[string]string {
+	env := map[string]string{}
+	for prefix, details := range map[string]providers.DeploymentDetails{"FROM_": from, "TO_": to} {
+		for k, v := range details.Data() {
+			env[prefix+strings.ToUpper(k)] = string(v)
+		}
+	}
+	return env
+}
@@ -0,0 +1,199 @@
+package reconciler
+
+import (
+	"context"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	errorUtil "github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PostgresProviders adapts postgres providers to the generic provider interface
+func PostgresProviders(ps ...providers.PostgresProvider) []Provider[*v1alpha1.Postgres, providers.PostgresInstance] {
+	adapted := make([]Provider[*v1alpha1.Postgres, providers.PostgresInstance], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &postgresProvider{PostgresProvider: p})
+	}
+	return adapted
+}
+
+type postgresProvider struct {
+	providers.PostgresProvider
+}
+
+func (p *postgresProvider) Reconcile(ctx context.Context, instance *v1alpha1.Postgres) (*providers.PostgresInstance, croType.StatusMessage, error) {
+	return p.ReconcilePostgres(ctx, instance)
+}
+
+func (p *postgresProvider) Delete(ctx context.Context, instance *v1alpha1.Postgres) (croType.StatusMessage, error) {
+	return p.DeletePostgres(ctx, instance)
+}
+
+// RedisProviders adapts redis providers to the generic provider interface
+func RedisProviders(ps ...providers.RedisProvider) []Provider[*v1alpha1.Redis, providers.RedisCluster] {
+	adapted := make([]Provider[*v1alpha1.Redis, providers.RedisCluster], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &redisProvider{RedisProvider: p})
+	}
+	return adapted
+}
+
+type redisProvider struct {
+	providers.RedisProvider
+}
+
+func (p *redisProvider) Reconcile(ctx context.Context, instance *v1alpha1.Redis) (*providers.RedisCluster, croType.StatusMessage, error) {
+	return p.CreateRedis(ctx, instance)
+}
+
+func (p *redisProvider) Delete(ctx context.Context, instance *v1alpha1.Redis) (croType.StatusMessage, error) {
+	return p.DeleteRedis(ctx, instance)
+}
+
+// BlobStorageProviders adapts blob storage providers to the generic provider interface
+func BlobStorageProviders(ps ...providers.BlobStorageProvider) []Provider[*v1alpha1.BlobStorage, providers.BlobStorageInstance] {
+	adapted := make([]Provider[*v1alpha1.BlobStorage, providers.BlobStorageInstance], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &blobStorageProvider{BlobStorageProvider: p})
+	}
+	return adapted
+}
+
+type blobStorageProvider struct {
+	providers.BlobStorageProvider
+}
+
+func (p *blobStorageProvider) Reconcile(ctx context.Context, instance *v1alpha1.BlobStorage) (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+	return p.CreateStorage(ctx, instance)
+}
+
+func (p *blobStorageProvider) Delete(ctx context.Context, instance *v1alpha1.BlobStorage) (croType.StatusMessage, error) {
+	return p.DeleteStorage(ctx, instance)
+}
+
+// CloudNetworkProviders adapts cloud network providers to the generic provider interface
+func CloudNetworkProviders(ps ...providers.CloudNetworkProvider) []Provider[*v1alpha1.CloudNetwork, providers.CloudNetworkInstance] {
+	adapted := make([]Provider[*v1alpha1.CloudNetwork, providers.CloudNetworkInstance], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &cloudNetworkProvider{CloudNetworkProvider: p})
+	}
+	return adapted
+}
+
+type cloudNetworkProvider struct {
+	providers.CloudNetworkProvider
+}
+
+func (p *cloudNetworkProvider) Reconcile(ctx context.Context, instance *v1alpha1.CloudNetwork) (*providers.CloudNetworkInstance, croType.StatusMessage, error) {
+	return p.ReconcileCloudNetwork(ctx, instance)
+}
+
+func (p *cloudNetworkProvider) Delete(ctx context.Context, instance *v1alpha1.CloudNetwork) (croType.StatusMessage, error) {
+	return p.DeleteCloudNetwork(ctx, instance)
+}
+
+// PostgresSnapshotProviders adapts postgres snapshot providers to the generic provider interface, the postgres
+// resource a snapshot is taken from is read using the client on every call
+func PostgresSnapshotProviders(c client.Client, ps ...providers.PostgresSnapshotProvider) []Provider[*v1alpha1.PostgresSnapshot, providers.PostgresSnapshotInstance] {
+	adapted := make([]Provider[*v1alpha1.PostgresSnapshot, providers.PostgresSnapshotInstance], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &postgresSnapshotProvider{PostgresSnapshotProvider: p, client: c})
+	}
+	return adapted
+}
+
+type postgresSnapshotProvider struct {
+	providers.PostgresSnapshotProvider
+	client client.Client
+}
+
+func (p *postgresSnapshotProvider) Reconcile(ctx context.Context, instance *v1alpha1.PostgresSnapshot) (*providers.PostgresSnapshotInstance, croType.StatusMessage, error) {
+	postgresCr, err := GetSnapshotPostgres(ctx, p.client, instance)
+	if err != nil {
+		return nil, "failed to get postgres resource", err
+	}
+	return p.CreatePostgresSnapshot(ctx, instance, postgresCr)
+}
+
+func (p *postgresSnapshotProvider) Delete(ctx context.Context, instance *v1alpha1.PostgresSnapshot) (croType.StatusMessage, error) {
+	postgresCr, err := GetSnapshotPostgres(ctx, p.client, instance)
+	if err != nil {
+		return "failed to get postgres resource", err
+	}
+	return p.DeletePostgresSnapshot(ctx, instance, postgresCr)
+}
+
+// RedisSnapshotProviders adapts redis snapshot providers to the generic provider interface, the redis resource a
+// snapshot is taken from is read using the client on every call
+//
+// redis snapshots are only taken once, a complete snapshot is returned as is without calling the provider
+func RedisSnapshotProviders(c client.Client, ps ...providers.RedisSnapshotProvider) []Provider[*v1alpha1.RedisSnapshot, providers.RedisSnapshotInstance] {
+	adapted := make([]Provider[*v1alpha1.RedisSnapshot, providers.RedisSnapshotInstance], 0, len(ps))
+	for _, p := range ps {
+		adapted = append(adapted, &redisSnapshotProvider{RedisSnapshotProvider: p, client: c})
+	}
+	return adapted
+}
+
+type redisSnapshotProvider struct {
+	providers.RedisSnapshotProvider
+	client client.Client
+}
+
+func (p *redisSnapshotProvider) Reconcile(ctx context.Context, instance *v1alpha1.RedisSnapshot) (*providers.RedisSnapshotInstance, croType.StatusMessage, error) {
+	if instance.Status.Phase == croType.PhaseComplete {
+		return &providers.RedisSnapshotInstance{Name: instance.Status.SnapshotID}, instance.Status.Message, nil
+	}
+	redisCr, err := GetSnapshotRedis(ctx, p.client, instance)
+	if err != nil {
+		return nil, "failed to get redis resource", err
+	}
+	return p.CreateRedisSnapshot(ctx, instance, redisCr)
+}
+
+func (p *redisSnapshotProvider) Delete(ctx context.Context, instance *v1alpha1.RedisSnapshot) (croType.StatusMessage, error) {
+	redisCr, err := GetSnapshotRedis(ctx, p.client, instance)
+	if err != nil {
+		return "failed to get redis resource", err
+	}
+	return p.DeleteRedisSnapshot(ctx, instance, redisCr)
+}
+
+// GetSnapshotPostgres returns the postgres resource a postgres snapshot is taken from
+func GetSnapshotPostgres(ctx context.Context, c client.Client, snapshot *v1alpha1.PostgresSnapshot) (*v1alpha1.Postgres, error) {
+	postgresCr := &v1alpha1.Postgres{}
+	if err := c.Get(ctx, types.NamespacedName{Name: snapshot.Spec.ResourceName, Namespace: snapshot.Namespace}, postgresCr); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get postgres resource %s", snapshot.Spec.ResourceName)
+	}
+	return postgresCr, nil
+}
+
+// GetSnapshotRedis returns the redis resource a redis snapshot is taken from
+func GetSnapshotRedis(ctx context.Context, c client.Client, snapshot *v1alpha1.RedisSnapshot) (*v1alpha1.Redis, error) {
+	redisCr := &v1alpha1.Redis{}
+	if err := c.Get(ctx, types.NamespacedName{Name: snapshot.Spec.ResourceName, Namespace: snapshot.Namespace}, redisCr); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get redis resource %s", snapshot.Spec.ResourceName)
+	}
+	return redisCr, nil
+}
+
+// StrategyFromConfig returns a strategy hook reading the deployment strategy from the cloud-resource-config config map
+// in the namespace of the custom resource, deploymentType returns the deployment type to look up and pick returns the
+// strategy for the resource type from the matching mapping
+func StrategyFromConfig[T client.Object](c client.Client, deploymentType func(ctx context.Context, instance T) (string, croType.StatusMessage, error), pick func(*providers.DeploymentStrategyMapping) string) func(ctx context.Context, instance T) (string, croType.StatusMessage, error) {
+	return func(ctx context.Context, instance T) (string, croType.StatusMessage, error) {
+		deployment, msg, err := deploymentType(ctx, instance)
+		if err != nil {
+			return "", msg, err
+		}
+		cfgMgr := providers.NewConfigManager(providers.DefaultProviderConfigMapName, instance.GetNamespace(), c)
+		stratMap, err := cfgMgr.GetStrategyMappingForDeploymentType(ctx, deployment)
+		if err != nil {
+			return "", croType.StatusDeploymentConfigNotFound, errorUtil.Wrapf(err, "failed to read deployment type config for deployment %s", deployment)
+		}
+		return pick(stratMap), croType.StatusEmpty, nil
+	}
+}
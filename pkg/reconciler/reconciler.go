@@ -0,0 +1,261 @@
+// Package reconciler provides a generic reconciler shared by the cloud resource controllers.
+//
+// Every cloud resource controller follows the same flow, resolve the strategy for the custom resource, select the
+// provider supporting that strategy, then either delete the resource, pause it or reconcile it through the provider,
+// updating the status phase and requeueing as it goes. Controllers only describe how their custom resource maps onto
+// that flow through a Config.
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	errorUtil "github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Provider reconciles a custom resource of type T against a single deployment strategy
+//
+// Reconcile returns a nil result while the resource is still being reconciled
+type Provider[T client.Object, R any] interface {
+	GetName() string
+	SupportsStrategy(strategy string) bool
+	GetReconcileTime(instance T) time.Duration
+	Reconcile(ctx context.Context, instance T) (*R, croType.StatusMessage, error)
+	Delete(ctx context.Context, instance T) (croType.StatusMessage, error)
+}
+
+// Status points at the status fields of a custom resource managed by the reconciler
+//
+// Provider is optional, snapshot custom resources do not record the provider used
+type Status struct {
+	Phase    *croType.StatusPhase
+	Message  *croType.StatusMessage
+	Strategy *string
+	Provider *string
+	// Conditions of custom resources which are created once and then updated, used to tell creates and updates apart in
+	// events, optional
+	Conditions *[]metav1.Condition
+}
+
+// Config describes how a custom resource maps onto the generic reconcile flow
+type Config[T client.Object, R any] struct {
+	// Name of the resource type, used in log and error messages
+	Name string
+	// NewObject returns an empty custom resource to fetch into
+	NewObject func() T
+	// Providers available to reconcile the custom resource, the first supporting the strategy is used
+	Providers []Provider[T, R]
+	// Strategy returns the deployment strategy configured for the custom resource
+	Strategy func(ctx context.Context, instance T) (string, croType.StatusMessage, error)
+	// Status returns the status fields of the custom resource
+	Status func(instance T) Status
+	// SkipCreate reports whether the custom resource should be paused rather than reconciled, optional
+	SkipCreate func(instance T) bool
+	// OnPending is called when the provider has not returned a result, optional
+	OnPending func(instance T)
+	// OnResult is called with the provider result before the custom resource is marked complete, optional
+	OnResult func(ctx context.Context, instance T, result *R) error
+	// Finally is called at the end of every reconcile of an existing custom resource, optional
+	Finally func(ctx context.Context, instance T)
+	// Migration moves custom resources which opted in to a new strategy when the configured strategy changes, optional
+	Migration *Migration[T, R]
+}
+
+// Reconciler reconciles custom resources of type T through the provider matching their strategy
+type Reconciler[T client.Object, R any] struct {
+	client   client.Client
+	logger   *logrus.Entry
+	recorder record.EventRecorder
+	config   Config[T, R]
+}
+
+var _ reconcile.Reconciler = &Reconciler[client.Object, struct{}]{}
+
+// New returns a generic reconciler for the custom resource described by the config
+func New[T client.Object, R any](client client.Client, logger *logrus.Entry, recorder record.EventRecorder, config Config[T, R]) *Reconciler[T, R] {
+	return &Reconciler[T, R]{
+		client:   client,
+		logger:   logger,
+		recorder: recorder,
+		config:   config,
+	}
+}
+
+func (r *Reconciler[T, R]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.logger.Infof("reconciling %s", r.config.Name)
+
+	instance := r.config.NewObject()
+	if err := r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+	status := r.config.Status(instance)
+	previousPhase := *status.Phase
+	created := status.Conditions != nil && resources.IsCreated(*status.Conditions)
+	defer func() {
+		resources.RecordPhaseEvent(r.recorder, instance, previousPhase, *status.Phase, *status.Message, created)
+	}()
+	if r.config.Finally != nil {
+		defer r.config.Finally(ctx, instance)
+	}
+
+	configuredStrategy, msg, err := r.config.Strategy(ctx, instance)
+	if err != nil {
+		if updateErr := r.updatePhase(ctx, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
+			return ctrl.Result{}, updateErr
+		}
+		return ctrl.Result{}, errorUtil.Wrapf(err, "failed to resolve strategy for %s %s", r.config.Name, instance.GetName())
+	}
+
+	// the strategy is fixed once set, changes to the cloud-resource-config config map only apply to new resources unless
+	// the custom resource opted in to a migration
+	strategyToUse := configuredStrategy
+	if *status.Strategy != "" {
+		strategyToUse = *status.Strategy
+		if strategyToUse != configuredStrategy && r.config.Migration != nil && r.config.Migration.Enabled(instance) && instance.GetDeletionTimestamp() == nil {
+			return r.migrate(ctx, instance, status, strategyToUse, configuredStrategy)
+		}
+		if strategyToUse != configuredStrategy {
+			r.logger.Infof("strategy and provider already set, changing of cloud-resource-config config maps not allowed in existing installation. the existing strategy is '%s' , cloud-resource-config is now set to '%s'. operator will continue to use existing strategy", strategyToUse, configuredStrategy)
+		}
+	}
+
+	// the cloud resource migrated from is deleted once the migration is confirmed
+	if r.config.Migration != nil && instance.GetDeletionTimestamp() == nil {
+		ms := *r.config.Migration.Status(instance)
+		if ms != nil && ms.Phase == croType.MigrationPhaseSwitched && r.config.Migration.Confirmed(instance) {
+			if err = r.completeMigration(ctx, instance, ms); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	for _, p := range r.config.Providers {
+		if !p.SupportsStrategy(strategyToUse) {
+			continue
+		}
+		if *status.Strategy != strategyToUse || (status.Provider != nil && *status.Provider != p.GetName()) {
+			base := r.snapshot(instance)
+			*status.Strategy = strategyToUse
+			if status.Provider != nil {
+				*status.Provider = p.GetName()
+			}
+			if err = r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.GetName(), instance.GetNamespace())
+			}
+		}
+
+		// delete the resource if the deletion timestamp exists
+		if instance.GetDeletionTimestamp() != nil {
+			msg, err := p.Delete(ctx, instance)
+			if err != nil {
+				if updateErr := r.updatePhase(ctx, instance, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
+					return ctrl.Result{}, updateErr
+				}
+				return ctrl.Result{}, errorUtil.Wrapf(err, "failed to perform provider-specific %s deletion", r.config.Name)
+			}
+
+			r.logger.Infof("waiting on %s to successfully delete", r.config.Name)
+			if err = r.updatePhase(ctx, instance, croType.PhaseDeleteInProgress, msg); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
+		}
+
+		// handle skip create
+		if r.config.SkipCreate != nil && r.config.SkipCreate(instance) {
+			r.logger.Infof("skipCreate found, skipping %s reconcile", r.config.Name)
+			if err = r.updatePhase(ctx, instance, croType.PhasePaused, croType.StatusSkipCreate); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
+		}
+
+		// providers record status changes such as the version on the instance, take the base of the status patch before
+		// reconciling so those changes are sent with the phase
+		base := r.snapshot(instance)
+		result, msg, err := p.Reconcile(ctx, instance)
+		if err != nil {
+			if r.config.OnPending != nil {
+				r.config.OnPending(instance)
+			}
+			if updateErr := r.patchPhase(ctx, instance, base, croType.PhaseFailed, msg.WrapError(err)); updateErr != nil {
+				return ctrl.Result{}, updateErr
+			}
+			return ctrl.Result{}, err
+		}
+		if result == nil {
+			r.logger.Info(msg)
+			if r.config.OnPending != nil {
+				r.config.OnPending(instance)
+			}
+			if err = r.patchPhase(ctx, instance, base, croType.PhaseInProgress, msg); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
+		}
+
+		if r.config.OnResult != nil {
+			if err = r.config.OnResult(ctx, instance, result); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		*status.Phase = croType.PhaseComplete
+		*status.Message = msg
+		if status.Conditions != nil {
+			resources.SetCreated(status.Conditions, instance.GetGeneration())
+		}
+		if err = r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+			return ctrl.Result{}, errorUtil.Wrapf(err, "failed to update instance %s in namespace %s", instance.GetName(), instance.GetNamespace())
+		}
+		return ctrl.Result{Requeue: true, RequeueAfter: p.GetReconcileTime(instance)}, nil
+	}
+
+	// unsupported strategy
+	if err = r.updatePhase(ctx, instance, croType.PhaseFailed, croType.StatusUnsupportedType); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, errorUtil.New(fmt.Sprintf("unsupported deployment strategy %s", strategyToUse))
+}
+
+// updatePhase sets the status phase and message of the custom resource, an empty message leaves the status untouched
+// as providers return one once the custom resource is gone
+func (r *Reconciler[T, R]) updatePhase(ctx context.Context, instance T, phase croType.StatusPhase, msg croType.StatusMessage) error {
+	return r.patchPhase(ctx, instance, r.snapshot(instance), phase, msg)
+}
+
+// patchPhase sets the status phase and message of the custom resource and patches every status change made since base
+// was taken, so concurrent changes to other fields of the custom resource are kept
+func (r *Reconciler[T, R]) patchPhase(ctx context.Context, instance T, base client.Object, phase croType.StatusPhase, msg croType.StatusMessage) error {
+	if msg == croType.StatusEmpty {
+		return nil
+	}
+	status := r.config.Status(instance)
+	*status.Phase = phase
+	*status.Message = msg
+	if err := r.client.Status().Patch(ctx, instance, client.MergeFrom(base)); err != nil {
+		return errorUtil.Wrap(err, "failed to update resource status phase and message")
+	}
+	return nil
+}
+
+// snapshot returns a copy of the custom resource to compute status patches against
+func (r *Reconciler[T, R]) snapshot(instance T) client.Object {
+	return instance.DeepCopyObject().(client.Object)
+}
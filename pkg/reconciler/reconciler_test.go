@@ -0,0 +1,517 @@
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	croapis "github.com/integr8ly/cloud-resource-operator/apis"
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/resources"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testName      = "test"
+	testNamespace = "test"
+)
+
+type fakeBlobStorageProvider struct {
+	strategy string
+	create   func() (*providers.BlobStorageInstance, croType.StatusMessage, error)
+	delete   func() (croType.StatusMessage, error)
+	// client, resourceID and deleted make the provider record its resource identifier on create and remove its
+	// finalizer on delete, as the real providers do
+	client     client.Client
+	resourceID string
+	deleted    bool
+}
+
+var _ providers.BlobStorageProvider = &fakeBlobStorageProvider{}
+
+func (p *fakeBlobStorageProvider) GetName() string {
+	return "fake-" + p.strategy
+}
+
+func (p *fakeBlobStorageProvider) SupportsStrategy(s string) bool {
+	return s == p.strategy
+}
+
+func (p *fakeBlobStorageProvider) GetReconcileTime(_ *v1alpha1.BlobStorage) time.Duration {
+	return time.Minute
+}
+
+func (p *fakeBlobStorageProvider) CreateStorage(ctx context.Context, bs *v1alpha1.BlobStorage) (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+	if p.resourceID != "" {
+		if err := resources.SetResourceIdentifier(ctx, p.client, bs, p.resourceID); err != nil {
+			return nil, "failed to set resource identifier", err
+		}
+	}
+	return p.create()
+}
+
+func (p *fakeBlobStorageProvider) DeleteStorage(ctx context.Context, bs *v1alpha1.BlobStorage) (croType.StatusMessage, error) {
+	msg, err := p.delete()
+	if err == nil && p.deleted {
+		if err = resources.SetResourceDeleted(ctx, p.client, bs, "test"); err != nil {
+			return "failed to remove finalizer", err
+		}
+	}
+	return msg, err
+}
+
+func buildTestScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := croapis.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+func buildTestBlobStorage(modifyFn func(bs *v1alpha1.BlobStorage)) *v1alpha1.BlobStorage {
+	bs := &v1alpha1.BlobStorage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testName,
+			Namespace: testNamespace,
+		},
+		Spec: v1alpha1.BlobStorageSpec{
+			ResourceTypeSpec: croType.ResourceTypeSpec{
+				Type: "aws",
+			},
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(bs)
+	}
+	return bs
+}
+
+func buildTestReconciler(c client.Client, recorder record.EventRecorder, p providers.BlobStorageProvider, results *[]string) *Reconciler[*v1alpha1.BlobStorage, providers.BlobStorageInstance] {
+	return New(c, logrus.NewEntry(logrus.StandardLogger()), recorder, Config[*v1alpha1.BlobStorage, providers.BlobStorageInstance]{
+		Name:      "blob storage",
+		NewObject: func() *v1alpha1.BlobStorage { return &v1alpha1.BlobStorage{} },
+		Providers: BlobStorageProviders(p),
+		Strategy: StrategyFromConfig(c, func(_ context.Context, instance *v1alpha1.BlobStorage) (string, croType.StatusMessage, error) {
+			return instance.Spec.Type, croType.StatusEmpty, nil
+		}, func(stratMap *providers.DeploymentStrategyMapping) string {
+			return stratMap.BlobStorage
+		}),
+		Status: func(instance *v1alpha1.BlobStorage) Status {
+			return Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+		},
+		SkipCreate: func(instance *v1alpha1.BlobStorage) bool {
+			return instance.Spec.SkipCreate
+		},
+		OnPending: func(_ *v1alpha1.BlobStorage) {
+			*results = append(*results, "pending")
+		},
+		OnResult: func(_ context.Context, _ *v1alpha1.BlobStorage, _ *providers.BlobStorageInstance) error {
+			*results = append(*results, "result")
+			return nil
+		},
+		Finally: func(_ context.Context, _ *v1alpha1.BlobStorage) {
+			*results = append(*results, "finally")
+		},
+	})
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name         string
+		instance     *v1alpha1.BlobStorage
+		provider     *fakeBlobStorageProvider
+		want         ctrl.Result
+		wantErr      bool
+		wantPhase    croType.StatusPhase
+		wantMessage  croType.StatusMessage
+		wantStrategy string
+		wantHooks    []string
+		wantEvent    string
+	}{
+		{
+			name:     "test custom resource is complete when the provider returns a result",
+			instance: buildTestBlobStorage(nil),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+				create: func() (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+					return &providers.BlobStorageInstance{}, "creation successful", nil
+				},
+			},
+			want:         ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			wantPhase:    croType.PhaseComplete,
+			wantMessage:  "creation successful",
+			wantStrategy: "aws",
+			wantHooks:    []string{"result", "finally"},
+			wantEvent:    "Normal CreateCompleted creation successful",
+		},
+		{
+			name: "test update completed is recorded when a created custom resource completes",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Status.Phase = croType.PhaseInProgress
+				resources.SetCreated(&bs.Status.Conditions, bs.Generation)
+			}),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+				create: func() (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+					return &providers.BlobStorageInstance{}, "update successful", nil
+				},
+			},
+			want:         ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			wantPhase:    croType.PhaseComplete,
+			wantMessage:  "update successful",
+			wantStrategy: "aws",
+			wantHooks:    []string{"result", "finally"},
+			wantEvent:    "Normal UpdateCompleted update successful",
+		},
+		{
+			name:     "test custom resource is in progress when the provider returns no result",
+			instance: buildTestBlobStorage(nil),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+				create: func() (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+					return nil, "creation in progress", nil
+				},
+			},
+			want:         ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			wantPhase:    croType.PhaseInProgress,
+			wantMessage:  "creation in progress",
+			wantStrategy: "aws",
+			wantHooks:    []string{"pending", "finally"},
+			wantEvent:    "Normal InProgress creation in progress",
+		},
+		{
+			name:     "test custom resource is failed when the provider returns an error",
+			instance: buildTestBlobStorage(nil),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+				create: func() (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+					return nil, "failed to create", errors.New("provider error")
+				},
+			},
+			wantErr:      true,
+			wantPhase:    croType.PhaseFailed,
+			wantMessage:  "failed to create: provider error",
+			wantStrategy: "aws",
+			wantHooks:    []string{"pending", "finally"},
+			wantEvent:    "Warning Failed failed to create: provider error",
+		},
+		{
+			name: "test custom resource is paused when skip create is set",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Spec.SkipCreate = true
+			}),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+			},
+			want:         ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			wantPhase:    croType.PhasePaused,
+			wantMessage:  croType.StatusSkipCreate,
+			wantStrategy: "aws",
+			wantHooks:    []string{"finally"},
+			wantEvent:    "Normal Paused " + string(croType.StatusSkipCreate),
+		},
+		{
+			name: "test custom resource is deleting when the deletion timestamp is set",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				bs.Finalizers = []string{"test"}
+				bs.Status.Phase = croType.PhaseComplete
+			}),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+				delete: func() (croType.StatusMessage, error) {
+					return "deletion in progress", nil
+				},
+			},
+			want:         ctrl.Result{Requeue: true, RequeueAfter: time.Minute},
+			wantPhase:    croType.PhaseDeleteInProgress,
+			wantMessage:  "deletion in progress",
+			wantStrategy: "aws",
+			wantHooks:    []string{"finally"},
+			wantEvent:    "Normal DeletionInProgress deletion in progress",
+		},
+		{
+			name: "test existing strategy is used over the config map strategy",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Status.Strategy = "openshift"
+			}),
+			provider: &fakeBlobStorageProvider{
+				strategy: "aws",
+			},
+			wantErr:      true,
+			wantPhase:    croType.PhaseFailed,
+			wantMessage:  croType.StatusUnsupportedType,
+			wantStrategy: "openshift",
+			wantHooks:    []string{"finally"},
+			wantEvent:    "Warning Failed " + string(croType.StatusUnsupportedType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, tt.instance)
+			recorder := record.NewFakeRecorder(10)
+			var hooks []string
+			r := buildTestReconciler(c, recorder, tt.provider, &hooks)
+			got, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: testName, Namespace: testNamespace}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Reconcile() got = %v, want %v", got, tt.want)
+			}
+			instance := &v1alpha1.BlobStorage{}
+			if err = c.Get(context.TODO(), types.NamespacedName{Name: testName, Namespace: testNamespace}, instance); err != nil {
+				t.Fatal("failed to get blob storage", err)
+			}
+			if instance.Status.Phase != tt.wantPhase || instance.Status.Message != tt.wantMessage {
+				t.Errorf("Reconcile() status = %s %q, want %s %q", instance.Status.Phase, instance.Status.Message, tt.wantPhase, tt.wantMessage)
+			}
+			if instance.Status.Strategy != tt.wantStrategy {
+				t.Errorf("Reconcile() strategy = %s, want %s", instance.Status.Strategy, tt.wantStrategy)
+			}
+			if len(hooks) != len(tt.wantHooks) {
+				t.Fatalf("Reconcile() hooks = %v, want %v", hooks, tt.wantHooks)
+			}
+			for i := range hooks {
+				if hooks[i] != tt.wantHooks[i] {
+					t.Errorf("Reconcile() hooks = %v, want %v", hooks, tt.wantHooks)
+				}
+			}
+			event := ""
+			select {
+			case event = <-recorder.Events:
+			default:
+			}
+			if event != tt.wantEvent {
+				t.Errorf("Reconcile() event = %q, want %q", event, tt.wantEvent)
+			}
+		})
+	}
+}
+
+func TestReconciler_Reconcile_NotFound(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	var hooks []string
+	r := buildTestReconciler(moqClient.NewSigsClientMoqWithScheme(scheme), record.NewFakeRecorder(1), &fakeBlobStorageProvider{strategy: "aws"}, &hooks)
+	got, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: testName, Namespace: testNamespace}})
+	if err != nil {
+		t.Fatalf("Reconcile() unexpected error = %v", err)
+	}
+	if got != (ctrl.Result{}) || len(hooks) != 0 {
+		t.Errorf("Reconcile() got = %v with hooks %v, want no requeue and no hooks", got, hooks)
+	}
+}
+
+func buildTestMigrationReconciler(c client.Client, recorder record.EventRecorder, from, to providers.BlobStorageProvider, allowed, confirmed, copied bool, results *[]string) *Reconciler[*v1alpha1.BlobStorage, providers.BlobStorageInstance] {
+	return New(c, logrus.NewEntry(logrus.StandardLogger()), recorder, Config[*v1alpha1.BlobStorage, providers.BlobStorageInstance]{
+		Name:      "blob storage",
+		NewObject: func() *v1alpha1.BlobStorage { return &v1alpha1.BlobStorage{} },
+		Providers: BlobStorageProviders(from, to),
+		Strategy: func(_ context.Context, _ *v1alpha1.BlobStorage) (string, croType.StatusMessage, error) {
+			return "gcp", croType.StatusEmpty, nil
+		},
+		Status: func(instance *v1alpha1.BlobStorage) Status {
+			return Status{Phase: &instance.Status.Phase, Message: &instance.Status.Message, Strategy: &instance.Status.Strategy, Provider: &instance.Status.Provider, Conditions: &instance.Status.Conditions}
+		},
+		OnResult: func(_ context.Context, _ *v1alpha1.BlobStorage, _ *providers.BlobStorageInstance) error {
+			*results = append(*results, "result")
+			return nil
+		},
+		Migration: &Migration[*v1alpha1.BlobStorage, providers.BlobStorageInstance]{
+			Enabled: func(_ *v1alpha1.BlobStorage) bool {
+				return allowed
+			},
+			Confirmed: func(_ *v1alpha1.BlobStorage) bool {
+				return confirmed
+			},
+			Status: func(instance *v1alpha1.BlobStorage) **croType.MigrationStatus {
+				return &instance.Status.Migration
+			},
+			CopyData: func(_ context.Context, _ *v1alpha1.BlobStorage, _, _ *providers.BlobStorageInstance) (bool, croType.StatusMessage, error) {
+				*results = append(*results, "copy")
+				return copied, "copying", nil
+			},
+			Cleanup: func(_ context.Context, _ *v1alpha1.BlobStorage) error {
+				*results = append(*results, "cleanup")
+				return nil
+			},
+		},
+	})
+}
+
+func TestReconciler_Reconcile_Migration(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	created := func() (*providers.BlobStorageInstance, croType.StatusMessage, error) {
+		return &providers.BlobStorageInstance{}, "creation successful", nil
+	}
+	tests := []struct {
+		name                   string
+		instance               *v1alpha1.BlobStorage
+		allowed                bool
+		confirmed              bool
+		copied                 bool
+		deleted                bool
+		wantErr                bool
+		wantPhase              croType.StatusPhase
+		wantStrategy           string
+		wantMigrationPhase     croType.MigrationPhase
+		wantResourceIdentifier string
+		wantFinalizers         []string
+		wantHooks              []string
+	}{
+		{
+			name: "test strategy is kept when the custom resource did not opt in to migrations",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Status.Strategy = "aws"
+			}),
+			wantPhase:    croType.PhaseComplete,
+			wantStrategy: "aws",
+			wantHooks:    []string{"result"},
+		},
+		{
+			name: "test migration waits on the data copy",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Annotations = map[string]string{"resourceIdentifier": "aws-id"}
+				bs.Status.Strategy = "aws"
+			}),
+			allowed:                true,
+			wantPhase:              croType.PhaseInProgress,
+			wantStrategy:           "aws",
+			wantMigrationPhase:     croType.MigrationPhaseCopying,
+			wantResourceIdentifier: "aws-id",
+			wantHooks:              []string{"copy"},
+		},
+		{
+			name: "test connection secret is switched once the data is copied",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Annotations = map[string]string{"resourceIdentifier": "aws-id"}
+				bs.Status.Strategy = "aws"
+			}),
+			allowed:                true,
+			copied:                 true,
+			wantPhase:              croType.PhaseComplete,
+			wantStrategy:           "gcp",
+			wantMigrationPhase:     croType.MigrationPhaseSwitched,
+			wantResourceIdentifier: "gcp-id",
+			wantHooks:              []string{"copy", "result"},
+		},
+		{
+			name: "test cloud resource migrated from is kept until the migration is confirmed",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Status.Strategy = "gcp"
+				bs.Status.Migration = &croType.MigrationStatus{FromStrategy: "aws", ToStrategy: "gcp", Phase: croType.MigrationPhaseSwitched}
+			}),
+			allowed:                true,
+			wantPhase:              croType.PhaseComplete,
+			wantStrategy:           "gcp",
+			wantMigrationPhase:     croType.MigrationPhaseSwitched,
+			wantResourceIdentifier: "gcp-id",
+			wantHooks:              []string{"result"},
+		},
+		{
+			name: "test migration is complete once the cloud resource migrated from is deleted",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Finalizers = []string{"test"}
+				bs.Status.Strategy = "gcp"
+				bs.Status.Migration = &croType.MigrationStatus{FromStrategy: "aws", ToStrategy: "gcp", Phase: croType.MigrationPhaseSwitched}
+			}),
+			allowed:                true,
+			confirmed:              true,
+			deleted:                true,
+			wantPhase:              croType.PhaseComplete,
+			wantStrategy:           "gcp",
+			wantMigrationPhase:     croType.MigrationPhaseComplete,
+			wantResourceIdentifier: "gcp-id",
+			wantFinalizers:         []string{"test"},
+			wantHooks:              []string{"delete aws", "cleanup", "result"},
+		},
+		{
+			name: "test migration waits on the cloud resource migrated from to delete",
+			instance: buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+				bs.Finalizers = []string{"test"}
+				bs.Status.Strategy = "gcp"
+				bs.Status.Migration = &croType.MigrationStatus{FromStrategy: "aws", ToStrategy: "gcp", Phase: croType.MigrationPhaseSwitched}
+			}),
+			allowed:                true,
+			confirmed:              true,
+			wantPhase:              croType.PhaseComplete,
+			wantStrategy:           "gcp",
+			wantMigrationPhase:     croType.MigrationPhaseSwitched,
+			wantResourceIdentifier: "gcp-id",
+			wantFinalizers:         []string{"test"},
+			wantHooks:              []string{"delete aws", "result"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, tt.instance)
+			var hooks []string
+			from := &fakeBlobStorageProvider{strategy: "aws", create: created, delete: func() (croType.StatusMessage, error) {
+				hooks = append(hooks, "delete aws")
+				return "deletion in progress", nil
+			}, client: c, deleted: tt.deleted}
+			to := &fakeBlobStorageProvider{strategy: "gcp", create: created, client: c, resourceID: "gcp-id"}
+			r := buildTestMigrationReconciler(c, record.NewFakeRecorder(10), from, to, tt.allowed, tt.confirmed, tt.copied, &hooks)
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Name: testName, Namespace: testNamespace}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			instance := &v1alpha1.BlobStorage{}
+			if err = c.Get(context.TODO(), types.NamespacedName{Name: testName, Namespace: testNamespace}, instance); err != nil {
+				t.Fatal("failed to get blob storage", err)
+			}
+			if instance.Status.Phase != tt.wantPhase {
+				t.Errorf("Reconcile() phase = %s, want %s", instance.Status.Phase, tt.wantPhase)
+			}
+			if instance.Status.Strategy != tt.wantStrategy {
+				t.Errorf("Reconcile() strategy = %s, want %s", instance.Status.Strategy, tt.wantStrategy)
+			}
+			var migrationPhase croType.MigrationPhase
+			if instance.Status.Migration != nil {
+				migrationPhase = instance.Status.Migration.Phase
+			}
+			if migrationPhase != tt.wantMigrationPhase {
+				t.Errorf("Reconcile() migration phase = %s, want %s", migrationPhase, tt.wantMigrationPhase)
+			}
+			if got := instance.Annotations["resourceIdentifier"]; got != tt.wantResourceIdentifier {
+				t.Errorf("Reconcile() resource identifier = %s, want %s", got, tt.wantResourceIdentifier)
+			}
+			if !reflect.DeepEqual(instance.Finalizers, tt.wantFinalizers) {
+				t.Errorf("Reconcile() finalizers = %v, want %v", instance.Finalizers, tt.wantFinalizers)
+			}
+			if len(hooks) != len(tt.wantHooks) {
+				t.Fatalf("Reconcile() hooks = %v, want %v", hooks, tt.wantHooks)
+			}
+			for i := range hooks {
+				if hooks[i] != tt.wantHooks[i] {
+					t.Errorf("Reconcile() hooks = %v, want %v", hooks, tt.wantHooks)
+				}
+			}
+		})
+	}
+}
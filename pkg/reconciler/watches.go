@@ -0,0 +1,180 @@
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/gcp"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/openshift"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// strategyConfigMaps maps the strategy config maps to the deployment strategy they configure
+var strategyConfigMaps = map[string]string{
+	aws.DefaultConfigMapName:       providers.AWSDeploymentStrategy,
+	gcp.DefaultConfigMapName:       providers.GCPDeploymentStrategy,
+	openshift.DefaultConfigMapName: providers.OpenShiftDeploymentStrategy,
+}
+
+// Spec returns the deployment type and tier of a custom resource
+type Spec struct {
+	Type string
+	Tier string
+}
+
+// Watch describes the custom resources affected by changes to the provider and strategy config maps
+type Watch[T client.Object] struct {
+	// ResourceType is the key of the resource type in the strategy config maps
+	ResourceType providers.ResourceType
+	// List returns the custom resources in a namespace
+	List func(ctx context.Context, c client.Client, namespace string) ([]T, error)
+	// Spec returns the deployment type and tier of the custom resource
+	Spec func(instance T) Spec
+}
+
+// ConfigMapHandler returns an event handler enqueueing the custom resources affected by a change to the
+// cloud-resource-config config map or one of the provider strategy config maps
+//
+// a change to a strategy config map enqueues the custom resources using that strategy whose tier changed, including
+// changes to the network strategy. a change to cloud-resource-config enqueues the custom resources whose deployment
+// type now maps to a different strategy for the resource type
+func (r *Reconciler[T, R]) ConfigMapHandler(watch Watch[T]) handler.EventHandler {
+	enqueue := func(ctx context.Context, oldCm, newCm *corev1.ConfigMap, q workqueue.RateLimitingInterface) {
+		cm := newCm
+		if cm == nil {
+			cm = oldCm
+		}
+		affected := r.affectedBy(watch, cm.Name, dataOf(oldCm), dataOf(newCm))
+		if affected == nil {
+			return
+		}
+		instances, err := watch.List(ctx, r.client, cm.Namespace)
+		if err != nil {
+			r.logger.Errorf("failed to list %s affected by config map %s: %v", r.config.Name, cm.Name, err)
+			return
+		}
+		for _, instance := range instances {
+			if !affected(instance) {
+				continue
+			}
+			r.logger.Infof("config map %s changed, reconciling %s %s", cm.Name, r.config.Name, instance.GetName())
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.GetName(), Namespace: instance.GetNamespace()}})
+		}
+	}
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+			if cm, ok := e.Object.(*corev1.ConfigMap); ok {
+				enqueue(ctx, nil, cm, q)
+			}
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+			oldCm, oldOk := e.ObjectOld.(*corev1.ConfigMap)
+			newCm, newOk := e.ObjectNew.(*corev1.ConfigMap)
+			if oldOk && newOk {
+				enqueue(ctx, oldCm, newCm, q)
+			}
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.RateLimitingInterface) {
+			if cm, ok := e.Object.(*corev1.ConfigMap); ok {
+				enqueue(ctx, cm, nil, q)
+			}
+		},
+	}
+}
+
+// affectedBy returns a filter matching the custom resources affected by a change to the named config map, nil when
+// the config map is not one the custom resources depend on or no relevant entry changed
+func (r *Reconciler[T, R]) affectedBy(watch Watch[T], name string, oldData, newData map[string]string) func(T) bool {
+	if name == providers.DefaultProviderConfigMapName {
+		changedTypes := changedKeys(deploymentStrategies(oldData, watch.ResourceType), deploymentStrategies(newData, watch.ResourceType))
+		if len(changedTypes) == 0 {
+			return nil
+		}
+		return func(instance T) bool {
+			return changedTypes[watch.Spec(instance).Type]
+		}
+	}
+	strategy, ok := strategyConfigMaps[name]
+	if !ok {
+		return nil
+	}
+	changedTiers := changedKeys(tierStrategies(oldData[string(watch.ResourceType)]), tierStrategies(newData[string(watch.ResourceType)]))
+	for tier := range changedKeys(tierStrategies(oldData[string(providers.NetworkResourceType)]), tierStrategies(newData[string(providers.NetworkResourceType)])) {
+		changedTiers[tier] = true
+	}
+	if len(changedTiers) == 0 {
+		return nil
+	}
+	return func(instance T) bool {
+		return *r.config.Status(instance).Strategy == strategy && (changedTiers["*"] || changedTiers[watch.Spec(instance).Tier])
+	}
+}
+
+func dataOf(cm *corev1.ConfigMap) map[string]string {
+	if cm == nil {
+		return nil
+	}
+	return cm.Data
+}
+
+// deploymentStrategies returns the strategy of the resource type for every deployment type in cloud-resource-config
+func deploymentStrategies(data map[string]string, rt providers.ResourceType) map[string]string {
+	strategies := map[string]string{}
+	for deploymentType, raw := range data {
+		mapping := map[string]string{}
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			strategies[deploymentType] = raw
+			continue
+		}
+		strategies[deploymentType] = mapping[string(rt)]
+	}
+	return strategies
+}
+
+// tierStrategies returns the compacted strategy of every tier in a strategy config map entry
+func tierStrategies(raw string) map[string]string {
+	strategies := map[string]string{}
+	if raw == "" {
+		return strategies
+	}
+	tiers := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(raw), &tiers); err != nil {
+		// an unparsable entry affects every tier, record it against the wildcard so it differs from any valid entry
+		strategies["*"] = raw
+		return strategies
+	}
+	for tier, strategy := range tiers {
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, strategy); err != nil {
+			strategies[tier] = string(strategy)
+			continue
+		}
+		strategies[tier] = compacted.String()
+	}
+	return strategies
+}
+
+// changedKeys returns the keys whose values differ between the two maps
+func changedKeys(oldValues, newValues map[string]string) map[string]bool {
+	changed := map[string]bool{}
+	for k, v := range oldValues {
+		if newValues[k] != v {
+			changed[k] = true
+		}
+	}
+	for k, v := range newValues {
+		if oldValues[k] != v {
+			changed[k] = true
+		}
+	}
+	return changed
+}
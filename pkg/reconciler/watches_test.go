@@ -0,0 +1,168 @@
+package reconciler
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers"
+	"github.com/integr8ly/cloud-resource-operator/pkg/providers/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func buildTestWatch() Watch[*v1alpha1.BlobStorage] {
+	return Watch[*v1alpha1.BlobStorage]{
+		ResourceType: providers.BlobStorageResourceType,
+		List: func(ctx context.Context, c client.Client, namespace string) ([]*v1alpha1.BlobStorage, error) {
+			list := &v1alpha1.BlobStorageList{}
+			if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+				return nil, err
+			}
+			instances := make([]*v1alpha1.BlobStorage, 0, len(list.Items))
+			for i := range list.Items {
+				instances = append(instances, &list.Items[i])
+			}
+			return instances, nil
+		},
+		Spec: func(instance *v1alpha1.BlobStorage) Spec {
+			return Spec{Type: instance.Spec.Type, Tier: instance.Spec.Tier}
+		},
+	}
+}
+
+func buildTestWatchedBlobStorage(name, deploymentType, tier, strategy string) *v1alpha1.BlobStorage {
+	return buildTestBlobStorage(func(bs *v1alpha1.BlobStorage) {
+		bs.Name = name
+		bs.Spec.Type = deploymentType
+		bs.Spec.Tier = tier
+		bs.Status.Strategy = strategy
+	})
+}
+
+func buildTestConfigMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Data: data,
+	}
+}
+
+func TestReconciler_ConfigMapHandler(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	instances := []runtime.Object{
+		buildTestWatchedBlobStorage("aws-production", "managed", "production", providers.AWSDeploymentStrategy),
+		buildTestWatchedBlobStorage("aws-development", "managed", "development", providers.AWSDeploymentStrategy),
+		buildTestWatchedBlobStorage("gcp-production", "gcp", "production", providers.GCPDeploymentStrategy),
+	}
+	tests := []struct {
+		name  string
+		old   *corev1.ConfigMap
+		new   *corev1.ConfigMap
+		wants []string
+	}{
+		{
+			name: "test only resources using the strategy and tier changed are enqueued",
+			old: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"blobstorage": `{"development": {"region": "eu-west-1"}, "production": {"region": "eu-west-1"}}`,
+			}),
+			new: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"blobstorage": `{"development": {"region": "eu-west-1"}, "production": {"region": "us-east-1"}}`,
+			}),
+			wants: []string{"aws-production"},
+		},
+		{
+			name: "test formatting changes do not enqueue resources",
+			old: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"blobstorage": `{"production": {"region": "eu-west-1"}}`,
+			}),
+			new: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"blobstorage": `{ "production": { "region":  "eu-west-1" } }`,
+			}),
+		},
+		{
+			name: "test changes to other resource types do not enqueue resources",
+			old: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"postgres": `{"production": {"region": "eu-west-1"}}`,
+			}),
+			new: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"postgres": `{"production": {"region": "us-east-1"}}`,
+			}),
+		},
+		{
+			name: "test network strategy changes enqueue resources of the changed tier",
+			old: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"_network": `{"development": {"region": "eu-west-1"}}`,
+			}),
+			new: buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{
+				"_network": `{"development": {"region": "us-east-1"}}`,
+			}),
+			wants: []string{"aws-development"},
+		},
+		{
+			name: "test cloud-resource-config changes enqueue resources of the changed deployment type",
+			old: buildTestConfigMap(providers.DefaultProviderConfigMapName, map[string]string{
+				"managed": `{"blobstorage": "aws", "postgres": "aws"}`,
+				"gcp":     `{"blobstorage": "gcp", "postgres": "gcp"}`,
+			}),
+			new: buildTestConfigMap(providers.DefaultProviderConfigMapName, map[string]string{
+				"managed": `{"blobstorage": "openshift", "postgres": "aws"}`,
+				"gcp":     `{"blobstorage": "gcp", "postgres": "openshift"}`,
+			}),
+			wants: []string{"aws-development", "aws-production"},
+		},
+		{
+			name:  "test creating a strategy config map enqueues resources using the strategy",
+			new:   buildTestConfigMap(aws.DefaultConfigMapName, map[string]string{"blobstorage": `{"production": {"region": "eu-west-1"}}`}),
+			wants: []string{"aws-production"},
+		},
+		{
+			name: "test unrelated config maps do not enqueue resources",
+			old:  buildTestConfigMap("unrelated", map[string]string{"blobstorage": "a"}),
+			new:  buildTestConfigMap("unrelated", map[string]string{"blobstorage": "b"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, instances...)
+			var hooks []string
+			r := buildTestReconciler(c, record.NewFakeRecorder(1), &fakeBlobStorageProvider{strategy: "aws"}, &hooks)
+			h := r.ConfigMapHandler(buildTestWatch())
+			q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer q.ShutDown()
+			if tt.old == nil {
+				h.Create(context.TODO(), event.CreateEvent{Object: tt.new}, q)
+			} else {
+				h.Update(context.TODO(), event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new}, q)
+			}
+			var got []string
+			for q.Len() > 0 {
+				item, _ := q.Get()
+				got = append(got, item.(reconcile.Request).Name)
+				q.Done(item)
+			}
+			sort.Strings(got)
+			if len(got) != len(tt.wants) {
+				t.Fatalf("ConfigMapHandler() enqueued = %v, want %v", got, tt.wants)
+			}
+			for i := range got {
+				if got[i] != tt.wants[i] {
+					t.Errorf("ConfigMapHandler() enqueued = %v, want %v", got, tt.wants)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsAdopted returns true if the custom resource adopts an existing cloud resource instead of creating one
+func IsAdopted(spec croType.ResourceTypeSpec) bool {
+	return spec.ExternalResourceID != ""
+}
+
+// BuildResourceIdentifier returns the identifier of the cloud resource of a custom resource, the external resource id
+// when an existing cloud resource is adopted, otherwise the infra name built from the object
+func BuildResourceIdentifier(ctx context.Context, c client.Client, om controllerruntime.ObjectMeta, spec croType.ResourceTypeSpec, n int) (string, error) {
+	if IsAdopted(spec) {
+		return spec.ExternalResourceID, nil
+	}
+	return BuildInfraNameFromObject(ctx, c, om, n)
+}
+
+// AdoptionOwnershipTagKeys returns the keys of the default tags identifying the cluster and custom resource managing a
+// cloud resource
+func AdoptionOwnershipTagKeys() []string {
+	return []string{GetOrganizationTag() + "clusterID", GetOrganizationTag() + "resource-name"}
+}
+
+// ValidateAdoption returns an error if a cloud resource being adopted is managed by another cluster or custom resource,
+// expected are the default tags of the custom resource and found are the tags of the cloud resource. cloud resources
+// without the ownership tags are not managed and can be adopted
+func ValidateAdoption(expected, found map[string]string, ownershipKeys []string) error {
+	for _, key := range ownershipKeys {
+		value, ok := found[key]
+		if ok && value != expected[key] {
+			return fmt.Errorf("cloud resource is tagged %s=%s, it is managed by another custom resource", key, value)
+		}
+	}
+	return nil
+}
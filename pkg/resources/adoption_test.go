@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestBuildResourceIdentifier(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := configv1.Install(fakeScheme); err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	om := metav1.ObjectMeta{Name: "test", Namespace: "test"}
+	c := moqClient.NewSigsClientMoqWithScheme(fakeScheme, newFakeAwsInfrastructure())
+	want, err := BuildInfraNameFromObject(context.TODO(), c, om, 40)
+	if err != nil {
+		t.Fatal("failed to build infra name", err)
+	}
+	got, err := BuildResourceIdentifier(context.TODO(), c, om, croType.ResourceTypeSpec{}, 40)
+	if err != nil || got != want {
+		t.Errorf("BuildResourceIdentifier() = %s, %v, want %s", got, err, want)
+	}
+	got, err = BuildResourceIdentifier(context.TODO(), c, om, croType.ResourceTypeSpec{ExternalResourceID: "existing-db"}, 40)
+	if err != nil || got != "existing-db" {
+		t.Errorf("BuildResourceIdentifier() = %s, %v, want existing-db", got, err)
+	}
+}
+
+func TestValidateAdoption(t *testing.T) {
+	keys := AdoptionOwnershipTagKeys()
+	expected := map[string]string{keys[0]: "cluster", keys[1]: "test"}
+	tests := []struct {
+		name    string
+		found   map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "test untagged resource can be adopted",
+			found: map[string]string{"team": "db"},
+		},
+		{
+			name:  "test resource tagged for the same cr can be adopted",
+			found: map[string]string{keys[0]: "cluster", keys[1]: "test"},
+		},
+		{
+			name:    "test resource managed by another cluster can not be adopted",
+			found:   map[string]string{keys[0]: "other-cluster"},
+			wantErr: true,
+		},
+		{
+			name:    "test resource managed by another cr can not be adopted",
+			found:   map[string]string{keys[0]: "cluster", keys[1]: "other"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateAdoption(expected, tt.found, keys); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAdoption() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	errorUtil "github.com/pkg/errors"
+)
+
+const (
+	ipv4Bits = 8 * net.IPv4len
+	// allocationStepMask spaces allocated blocks one /16 apart, so a block can grow without clashing with the next one
+	allocationStepMask = 16
+)
+
+// PrivateCIDRPools returns the private ranges a cidr block for a standalone network is allocated from
+func PrivateCIDRPools() []*net.IPNet {
+	pools, _ := ParseCIDRs("10.0.0.0/8", "172.16.0.0/12")
+	return pools
+}
+
+// ParseCIDRs parses cidr blocks in notation like 10.0.0.0/16, empty strings are skipped
+func ParseCIDRs(cidrs ...string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, cidr := range cidrs {
+		if cidr == "" {
+			continue
+		}
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errorUtil.Wrapf(err, "failed to parse cidr block %s", cidr)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// CIDRsOverlap returns true if the cidr blocks share any address
+func CIDRsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// FindOverlappingCIDR returns the first reserved cidr block overlapping the cidr block, nil if none does
+func FindOverlappingCIDR(cidr *net.IPNet, reserved []*net.IPNet) *net.IPNet {
+	for _, r := range reserved {
+		if r != nil && CIDRsOverlap(cidr, r) {
+			return r
+		}
+	}
+	return nil
+}
+
+// SplitCIDR returns every block with the mask size of toCIDR within fromCIDR, starting at toCIDR
+func SplitCIDR(fromCIDR, toCIDR *net.IPNet) []net.IPNet {
+	mask, _ := toCIDR.Mask.Size()
+	block := uint64(1) << (ipv4Bits - mask)
+	start := uint64(ipToUint32(toCIDR.IP.Mask(toCIDR.Mask)))
+	end := uint64(lastIPUint32(fromCIDR))
+	var networks []net.IPNet
+	for ip := start; ip <= end; ip += block {
+		networks = append(networks, net.IPNet{IP: uint32ToIP(uint32(ip)), Mask: toCIDR.Mask})
+	}
+	return networks
+}
+
+// AllocateCIDR returns the first cidr block with the mask size in the pools which does not overlap any reserved block
+//
+// a block is tried at the start of every /16 of the pools, or of every block for masks lower than /16, so allocations
+// for different networks are far enough apart to be extended later
+func AllocateCIDR(pools []*net.IPNet, mask int, reserved []*net.IPNet) (*net.IPNet, error) {
+	if mask <= 0 || mask > ipv4Bits {
+		return nil, fmt.Errorf("invalid cidr mask size /%d", mask)
+	}
+	stepMask := allocationStepMask
+	if mask < stepMask {
+		stepMask = mask
+	}
+	step := uint64(1) << (ipv4Bits - stepMask)
+	for _, pool := range pools {
+		poolMask, _ := pool.Mask.Size()
+		if poolMask > mask {
+			continue
+		}
+		end := uint64(lastIPUint32(pool))
+		for ip := uint64(ipToUint32(pool.IP)); ip <= end; ip += step {
+			candidate := &net.IPNet{IP: uint32ToIP(uint32(ip)), Mask: net.CIDRMask(mask, ipv4Bits)}
+			if FindOverlappingCIDR(candidate, reserved) == nil {
+				return candidate, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no free /%d cidr block found in %s", mask, pools)
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func uint32ToIP(i uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, i)
+	return ip
+}
+
+func lastIPUint32(n *net.IPNet) uint32 {
+	ones, _ := n.Mask.Size()
+	return ipToUint32(n.IP.Mask(n.Mask)) | (uint32(1)<<(ipv4Bits-ones) - 1)
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func buildTestCIDRs(t *testing.T, cidrs ...string) []*net.IPNet {
+	nets, err := ParseCIDRs(cidrs...)
+	if err != nil {
+		t.Fatal("failed to parse cidr blocks", err)
+	}
+	return nets
+}
+
+func TestAllocateCIDR(t *testing.T) {
+	type args struct {
+		pools    []string
+		mask     int
+		reserved []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "first block of the pool is allocated when nothing is reserved",
+			args: args{
+				pools: []string{"10.0.0.0/8"},
+				mask:  26,
+			},
+			want: "10.0.0.0/26",
+		},
+		{
+			name: "blocks overlapping reserved cidr blocks are skipped",
+			args: args{
+				pools:    []string{"10.0.0.0/8"},
+				mask:     26,
+				reserved: []string{"10.0.0.0/14", "10.5.0.0/16", "10.4.0.0/24"},
+			},
+			want: "10.6.0.0/26",
+		},
+		{
+			name: "blocks larger than /16 are allocated at every block",
+			args: args{
+				pools:    []string{"10.0.0.0/8"},
+				mask:     14,
+				reserved: []string{"10.1.0.0/16"},
+			},
+			want: "10.4.0.0/14",
+		},
+		{
+			name: "next pool is used when the first pool is full",
+			args: args{
+				pools:    []string{"10.0.0.0/8", "172.16.0.0/12"},
+				mask:     22,
+				reserved: []string{"10.0.0.0/8"},
+			},
+			want: "172.16.0.0/22",
+		},
+		{
+			name: "error when no block is free",
+			args: args{
+				pools:    []string{"10.0.0.0/8"},
+				mask:     22,
+				reserved: []string{"0.0.0.0/0"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error on invalid mask size",
+			args: args{
+				pools: []string{"10.0.0.0/8"},
+				mask:  33,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AllocateCIDR(buildTestCIDRs(t, tt.args.pools...), tt.args.mask, buildTestCIDRs(t, tt.args.reserved...))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AllocateCIDR() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("AllocateCIDR() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitCIDR(t *testing.T) {
+	from := buildTestCIDRs(t, "10.0.0.0/24")[0]
+	to := buildTestCIDRs(t, "10.0.0.128/26")[0]
+	var got []string
+	for _, n := range SplitCIDR(from, to) {
+		got = append(got, n.String())
+	}
+	want := []string{"10.0.0.128/26", "10.0.0.192/26"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitCIDR() got = %v, want %v", got, want)
+	}
+}
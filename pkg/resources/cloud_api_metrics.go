@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	customMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	DefaultCloudAPIRequestsMetricName  = "cro_cloud_api_requests_total"
+	DefaultCloudAPILatencyMetricName   = "cro_cloud_api_request_duration_seconds"
+	DefaultCloudAPIErrorsMetricName    = "cro_cloud_api_errors_total"
+	DefaultCloudAPIThrottlesMetricName = "cro_cloud_api_throttles_total"
+	DefaultCloudAPIRetriesMetricName   = "cro_cloud_api_retries_total"
+)
+
+var (
+	cloudAPILabelNames = []string{LabelProviderKey, LabelServiceKey, LabelOperationKey, LabelRegionKey}
+
+	cloudAPIRequestsVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DefaultCloudAPIRequestsMetricName,
+		Help: "The number of calls made to a cloud provider api",
+	}, cloudAPILabelNames)
+	cloudAPILatencyVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    DefaultCloudAPILatencyMetricName,
+		Help:    "The time taken for a call to a cloud provider api to complete, including retries",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, cloudAPILabelNames)
+	cloudAPIErrorsVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DefaultCloudAPIErrorsMetricName,
+		Help: "The number of calls made to a cloud provider api which failed, by error code",
+	}, append(append([]string{}, cloudAPILabelNames...), LabelErrorCodeKey))
+	cloudAPIThrottlesVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DefaultCloudAPIThrottlesMetricName,
+		Help: "The number of calls made to a cloud provider api which were throttled",
+	}, cloudAPILabelNames)
+	cloudAPIRetriesVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DefaultCloudAPIRetriesMetricName,
+		Help: "The number of times calls made to a cloud provider api were retried",
+	}, cloudAPILabelNames)
+)
+
+func init() {
+	customMetrics.Registry.MustRegister(cloudAPIRequestsVec, cloudAPILatencyVec, cloudAPIErrorsVec, cloudAPIThrottlesVec, cloudAPIRetriesVec)
+}
+
+// CloudAPICall describes a single call made to a cloud provider api
+type CloudAPICall struct {
+	Provider  string
+	Service   string
+	Operation string
+	Region    string
+	Duration  time.Duration
+	// ErrorCode is empty when the call succeeded
+	ErrorCode string
+	// Throttles is the number of attempts which were rejected by the cloud provider rate limits
+	Throttles int
+	// Retries is the number of times the call was retried by the sdk
+	Retries int
+}
+
+// ObserveCloudAPICall exposes the count, latency, error code, throttling and retries of a call made to a cloud provider api
+func ObserveCloudAPICall(call *CloudAPICall) {
+	labels := prometheus.Labels{
+		LabelProviderKey:  call.Provider,
+		LabelServiceKey:   call.Service,
+		LabelOperationKey: call.Operation,
+		LabelRegionKey:    call.Region,
+	}
+	cloudAPIRequestsVec.With(labels).Inc()
+	cloudAPILatencyVec.With(labels).Observe(call.Duration.Seconds())
+	if call.Throttles > 0 {
+		cloudAPIThrottlesVec.With(labels).Add(float64(call.Throttles))
+	}
+	if call.Retries > 0 {
+		cloudAPIRetriesVec.With(labels).Add(float64(call.Retries))
+	}
+	if call.ErrorCode != "" {
+		labels[LabelErrorCodeKey] = call.ErrorCode
+		cloudAPIErrorsVec.With(labels).Inc()
+	}
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	errorUtil "github.com/pkg/errors"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CloudNetworkReadyConditionType is the status condition reporting whether cloud resources can be created in the
+	// network of the cloud network custom resource
+	CloudNetworkReadyConditionType = "Ready"
+
+	CloudNetworkReasonReady    = "NetworkReady"
+	CloudNetworkReasonNotReady = "NetworkNotReady"
+)
+
+// GetCloudNetwork returns the cloud network custom resource of the cluster, nil is returned when it does not exist and
+// the network is managed by the postgres and redis providers
+func GetCloudNetwork(ctx context.Context, c client.Client) (*v1alpha1.CloudNetwork, error) {
+	cn := &v1alpha1.CloudNetwork{}
+	if err := c.Get(ctx, types.NamespacedName{Name: v1alpha1.CloudNetworkName}, cn); err != nil {
+		if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, errorUtil.Wrap(err, "failed to get cloud network")
+	}
+	return cn, nil
+}
+
+// IsCloudNetworkReady returns true if the ready condition of the cloud network is true and it is not being deleted
+func IsCloudNetworkReady(cn *v1alpha1.CloudNetwork) bool {
+	return cn.DeletionTimestamp == nil && meta.IsStatusConditionTrue(cn.Status.Conditions, CloudNetworkReadyConditionType)
+}
+
+// SetCloudNetworkReady sets the ready condition in the status of the cloud network
+func SetCloudNetworkReady(cn *v1alpha1.CloudNetwork, ready bool, reason, message string) {
+	status := metav1.ConditionFalse
+	if ready {
+		status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&cn.Status.Conditions, metav1.Condition{
+		Type:               CloudNetworkReadyConditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: cn.Generation,
+	})
+}
+
+// CloudNetworkInUse returns the number of postgres and redis custom resources, in all namespaces, created with the
+// given strategy. the network of a cloud network can not be deleted while cloud resources are created in it
+func CloudNetworkInUse(ctx context.Context, c client.Client, strategy string) (int, error) {
+	inUse := 0
+	postgresList := &v1alpha1.PostgresList{}
+	if err := c.List(ctx, postgresList); err != nil {
+		return 0, errorUtil.Wrap(err, "failed to retrieve postgres cr(s)")
+	}
+	for _, pg := range postgresList.Items {
+		if pg.Status.Strategy == strategy {
+			inUse++
+		}
+	}
+	redisList := &v1alpha1.RedisList{}
+	if err := c.List(ctx, redisList); err != nil {
+		return 0, errorUtil.Wrap(err, "failed to retrieve redis cr(s)")
+	}
+	for _, r := range redisList.Items {
+		if r.Status.Strategy == strategy {
+			inUse++
+		}
+	}
+	return inUse, nil
+}
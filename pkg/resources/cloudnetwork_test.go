@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetCloudNetwork(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cn, err := GetCloudNetwork(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme))
+	if err != nil || cn != nil {
+		t.Errorf("GetCloudNetwork() = %v, %v, want nil when the cloud network does not exist", cn, err)
+	}
+	existing := &v1alpha1.CloudNetwork{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.CloudNetworkName}}
+	cn, err = GetCloudNetwork(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, existing))
+	if err != nil || cn == nil || cn.Name != v1alpha1.CloudNetworkName {
+		t.Errorf("GetCloudNetwork() = %v, %v, want the cloud network named %s", cn, err, v1alpha1.CloudNetworkName)
+	}
+}
+
+func TestIsCloudNetworkReady(t *testing.T) {
+	cn := &v1alpha1.CloudNetwork{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.CloudNetworkName}}
+	if IsCloudNetworkReady(cn) {
+		t.Error("IsCloudNetworkReady() = true, want false without a ready condition")
+	}
+	SetCloudNetworkReady(cn, true, CloudNetworkReasonReady, "network ready")
+	if !IsCloudNetworkReady(cn) {
+		t.Error("IsCloudNetworkReady() = false, want true with a true ready condition")
+	}
+	now := metav1.Now()
+	cn.DeletionTimestamp = &now
+	if IsCloudNetworkReady(cn) {
+		t.Error("IsCloudNetworkReady() = true, want false while the cloud network is being deleted")
+	}
+	cn.DeletionTimestamp = nil
+	SetCloudNetworkReady(cn, false, CloudNetworkReasonNotReady, "network not ready")
+	if IsCloudNetworkReady(cn) {
+		t.Error("IsCloudNetworkReady() = true, want false with a false ready condition")
+	}
+	if len(cn.Status.Conditions) != 1 {
+		t.Errorf("expected a single ready condition, got %d conditions", len(cn.Status.Conditions))
+	}
+}
+
+func TestCloudNetworkInUse(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name     string
+		objs     []runtime.Object
+		strategy string
+		want     int
+	}{
+		{
+			name:     "test cloud network not in use without resources",
+			strategy: "aws",
+		},
+		{
+			name: "test resources created with the strategy use the cloud network",
+			objs: []runtime.Object{
+				&v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "a"}, Status: croType.ResourceTypeStatus{Strategy: "aws"}},
+				&v1alpha1.Redis{ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "b"}, Status: croType.ResourceTypeStatus{Strategy: "aws"}},
+			},
+			strategy: "aws",
+			want:     2,
+		},
+		{
+			name: "test resources created with another strategy do not use the cloud network",
+			objs: []runtime.Object{
+				&v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "pg", Namespace: "a"}, Status: croType.ResourceTypeStatus{Strategy: "openshift"}},
+				&v1alpha1.Redis{ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "b"}, Status: croType.ResourceTypeStatus{Strategy: "aws"}},
+			},
+			strategy: "aws",
+			want:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CloudNetworkInUse(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, tt.objs...), tt.strategy)
+			if err != nil {
+				t.Fatalf("CloudNetworkInUse() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CloudNetworkInUse() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
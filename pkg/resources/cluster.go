@@ -3,12 +3,15 @@ package resources
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 
 	configv1 "github.com/openshift/api/config/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -77,9 +80,10 @@ func GetPlatformType(ctx context.Context, c client.Client) (configv1.PlatformTyp
 	return infra.Status.PlatformStatus.Type, nil
 }
 
-//go:generate moq -out cluster_moq.go . PodCommander
+//go:generate moq -out cluster_moq.go . PodCommander PodMetricsGetter
 type PodCommander interface {
 	ExecIntoPod(dpl *appsv1.Deployment, cmd string) error
+	ExecIntoPodWithOutput(dpl *appsv1.Deployment, cmd string) (string, error)
 }
 
 type OpenShiftPodCommander struct {
@@ -98,6 +102,87 @@ func (pc *OpenShiftPodCommander) ExecIntoPod(dpl *appsv1.Deployment, cmd string)
 	return nil
 }
 
+// ExecIntoPodWithOutput runs a command in the deployment pod and returns stdout
+func (pc *OpenShiftPodCommander) ExecIntoPodWithOutput(dpl *appsv1.Deployment, cmd string) (string, error) {
+	toRun := []string{"/bin/bash", "-c", cmd}
+	podName, err := getDeploymentPod(pc.ClientSet, dpl)
+	if err != nil {
+		return "", err
+	}
+	if podName == "" {
+		return "", errorUtil.New(fmt.Sprintf("no pod found for deployment %s in namespace %s", dpl.Name, dpl.Namespace))
+	}
+	stdout, stderr, err := runExec(pc.ClientSet, toRun, podName, dpl.Namespace)
+	if err != nil {
+		return "", errorUtil.Wrapf(err, "failed to exec, %s", stderr)
+	}
+	return stdout, nil
+}
+
+// PodResourceUsage is the cpu and memory usage of a deployment pod, summed across its containers,
+// along with the limits set on those containers
+type PodResourceUsage struct {
+	CPUUsage    resource.Quantity
+	MemoryUsage resource.Quantity
+	CPULimit    resource.Quantity
+	MemoryLimit resource.Quantity
+}
+
+type PodMetricsGetter interface {
+	GetPodResourceUsage(ctx context.Context, dpl *appsv1.Deployment) (*PodResourceUsage, error)
+}
+
+// OpenShiftPodMetricsGetter reads pod resource usage from the cluster metrics api (metrics.k8s.io)
+type OpenShiftPodMetricsGetter struct {
+	ClientSet *kubernetes.Clientset
+}
+
+// podMetrics is the subset of the metrics.k8s.io PodMetrics type we require
+type podMetrics struct {
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+func (g *OpenShiftPodMetricsGetter) GetPodResourceUsage(ctx context.Context, dpl *appsv1.Deployment) (*PodResourceUsage, error) {
+	podName, err := getDeploymentPod(g.ClientSet, dpl)
+	if err != nil {
+		return nil, err
+	}
+	if podName == "" {
+		return nil, errorUtil.New(fmt.Sprintf("no pod found for deployment %s in namespace %s", dpl.Name, dpl.Namespace))
+	}
+	pod, err := g.ClientSet.CoreV1().Pods(dpl.Namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get pod %s", podName)
+	}
+	raw, err := g.ClientSet.CoreV1().RESTClient().Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", dpl.Namespace, "pods", podName).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to get pod metrics for pod %s", podName)
+	}
+	metrics := &podMetrics{}
+	if err = json.Unmarshal(raw, metrics); err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to unmarshal pod metrics for pod %s", podName)
+	}
+	return buildPodResourceUsage(pod, metrics), nil
+}
+
+func buildPodResourceUsage(pod *corev1.Pod, metrics *podMetrics) *PodResourceUsage {
+	usage := &PodResourceUsage{}
+	for _, container := range metrics.Containers {
+		usage.CPUUsage.Add(container.Usage[corev1.ResourceCPU])
+		usage.MemoryUsage.Add(container.Usage[corev1.ResourceMemory])
+	}
+	for _, container := range pod.Spec.Containers {
+		usage.CPULimit.Add(container.Resources.Limits[corev1.ResourceCPU])
+		usage.MemoryLimit.Add(container.Resources.Limits[corev1.ResourceMemory])
+	}
+	return usage
+}
+
 // run exec command on pod
 func runExec(cs *kubernetes.Clientset, command []string, pod, ns string) (string, string, error) {
 	req := cs.CoreV1().RESTClient().Post().
@@ -4,6 +4,7 @@
 package resources
 
 import (
+	"context"
 	appsv1 "k8s.io/api/apps/v1"
 	"sync"
 )
@@ -21,6 +22,9 @@ var _ PodCommander = &PodCommanderMock{}
 //			ExecIntoPodFunc: func(dpl *appsv1.Deployment, cmd string) error {
 //				panic("mock out the ExecIntoPod method")
 //			},
+//			ExecIntoPodWithOutputFunc: func(dpl *appsv1.Deployment, cmd string) (string, error) {
+//				panic("mock out the ExecIntoPodWithOutput method")
+//			},
 //		}
 //
 //		// use mockedPodCommander in code that requires PodCommander
@@ -31,6 +35,9 @@ type PodCommanderMock struct {
 	// ExecIntoPodFunc mocks the ExecIntoPod method.
 	ExecIntoPodFunc func(dpl *appsv1.Deployment, cmd string) error
 
+	// ExecIntoPodWithOutputFunc mocks the ExecIntoPodWithOutput method.
+	ExecIntoPodWithOutputFunc func(dpl *appsv1.Deployment, cmd string) (string, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ExecIntoPod holds details about calls to the ExecIntoPod method.
@@ -40,8 +47,16 @@ type PodCommanderMock struct {
 			// Cmd is the cmd argument value.
 			Cmd string
 		}
+		// ExecIntoPodWithOutput holds details about calls to the ExecIntoPodWithOutput method.
+		ExecIntoPodWithOutput []struct {
+			// Dpl is the dpl argument value.
+			Dpl *appsv1.Deployment
+			// Cmd is the cmd argument value.
+			Cmd string
+		}
 	}
-	lockExecIntoPod sync.RWMutex
+	lockExecIntoPod           sync.RWMutex
+	lockExecIntoPodWithOutput sync.RWMutex
 }
 
 // ExecIntoPod calls ExecIntoPodFunc.
@@ -79,3 +94,111 @@ func (mock *PodCommanderMock) ExecIntoPodCalls() []struct {
 	mock.lockExecIntoPod.RUnlock()
 	return calls
 }
+
+// ExecIntoPodWithOutput calls ExecIntoPodWithOutputFunc.
+func (mock *PodCommanderMock) ExecIntoPodWithOutput(dpl *appsv1.Deployment, cmd string) (string, error) {
+	if mock.ExecIntoPodWithOutputFunc == nil {
+		panic("PodCommanderMock.ExecIntoPodWithOutputFunc: method is nil but PodCommander.ExecIntoPodWithOutput was just called")
+	}
+	callInfo := struct {
+		Dpl *appsv1.Deployment
+		Cmd string
+	}{
+		Dpl: dpl,
+		Cmd: cmd,
+	}
+	mock.lockExecIntoPodWithOutput.Lock()
+	mock.calls.ExecIntoPodWithOutput = append(mock.calls.ExecIntoPodWithOutput, callInfo)
+	mock.lockExecIntoPodWithOutput.Unlock()
+	return mock.ExecIntoPodWithOutputFunc(dpl, cmd)
+}
+
+// ExecIntoPodWithOutputCalls gets all the calls that were made to ExecIntoPodWithOutput.
+// Check the length with:
+//
+//	len(mockedPodCommander.ExecIntoPodWithOutputCalls())
+func (mock *PodCommanderMock) ExecIntoPodWithOutputCalls() []struct {
+	Dpl *appsv1.Deployment
+	Cmd string
+} {
+	var calls []struct {
+		Dpl *appsv1.Deployment
+		Cmd string
+	}
+	mock.lockExecIntoPodWithOutput.RLock()
+	calls = mock.calls.ExecIntoPodWithOutput
+	mock.lockExecIntoPodWithOutput.RUnlock()
+	return calls
+}
+
+// Ensure, that PodMetricsGetterMock does implement PodMetricsGetter.
+// If this is not the case, regenerate this file with moq.
+var _ PodMetricsGetter = &PodMetricsGetterMock{}
+
+// PodMetricsGetterMock is a mock implementation of PodMetricsGetter.
+//
+//	func TestSomethingThatUsesPodMetricsGetter(t *testing.T) {
+//
+//		// make and configure a mocked PodMetricsGetter
+//		mockedPodMetricsGetter := &PodMetricsGetterMock{
+//			GetPodResourceUsageFunc: func(ctx context.Context, dpl *appsv1.Deployment) (*PodResourceUsage, error) {
+//				panic("mock out the GetPodResourceUsage method")
+//			},
+//		}
+//
+//		// use mockedPodMetricsGetter in code that requires PodMetricsGetter
+//		// and then make assertions.
+//
+//	}
+type PodMetricsGetterMock struct {
+	// GetPodResourceUsageFunc mocks the GetPodResourceUsage method.
+	GetPodResourceUsageFunc func(ctx context.Context, dpl *appsv1.Deployment) (*PodResourceUsage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPodResourceUsage holds details about calls to the GetPodResourceUsage method.
+		GetPodResourceUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Dpl is the dpl argument value.
+			Dpl *appsv1.Deployment
+		}
+	}
+	lockGetPodResourceUsage sync.RWMutex
+}
+
+// GetPodResourceUsage calls GetPodResourceUsageFunc.
+func (mock *PodMetricsGetterMock) GetPodResourceUsage(ctx context.Context, dpl *appsv1.Deployment) (*PodResourceUsage, error) {
+	if mock.GetPodResourceUsageFunc == nil {
+		panic("PodMetricsGetterMock.GetPodResourceUsageFunc: method is nil but PodMetricsGetter.GetPodResourceUsage was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Dpl *appsv1.Deployment
+	}{
+		Ctx: ctx,
+		Dpl: dpl,
+	}
+	mock.lockGetPodResourceUsage.Lock()
+	mock.calls.GetPodResourceUsage = append(mock.calls.GetPodResourceUsage, callInfo)
+	mock.lockGetPodResourceUsage.Unlock()
+	return mock.GetPodResourceUsageFunc(ctx, dpl)
+}
+
+// GetPodResourceUsageCalls gets all the calls that were made to GetPodResourceUsage.
+// Check the length with:
+//
+//	len(mockedPodMetricsGetter.GetPodResourceUsageCalls())
+func (mock *PodMetricsGetterMock) GetPodResourceUsageCalls() []struct {
+	Ctx context.Context
+	Dpl *appsv1.Deployment
+} {
+	var calls []struct {
+		Ctx context.Context
+		Dpl *appsv1.Deployment
+	}
+	mock.lockGetPodResourceUsage.RLock()
+	calls = mock.calls.GetPodResourceUsage
+	mock.lockGetPodResourceUsage.RUnlock()
+	return calls
+}
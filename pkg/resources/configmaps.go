@@ -2,6 +2,9 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
+
 	"github.com/sirupsen/logrus"
 
 	errorUtil "github.com/pkg/errors"
@@ -22,3 +25,58 @@ func GetConfigMapOrDefault(ctx context.Context, c client.Client, name types.Name
 	}
 	return cm, nil
 }
+
+// UpdateTierStrategy sets the field of the strategy for the tier of a resource type in a strategy config map, every other
+// field of the strategy config map is kept as it is. the default config map is created if the config map does not exist
+func UpdateTierStrategy(ctx context.Context, c client.Client, name types.NamespacedName, def *v1.ConfigMap, rt, tier, field string, value json.RawMessage) error {
+	cm := &v1.ConfigMap{}
+	exists := true
+	if err := c.Get(ctx, name, cm); err != nil {
+		if !errors.IsNotFound(err) {
+			return errorUtil.Wrapf(err, "failed to get config map %s", name.Name)
+		}
+		cm, exists = def, false
+	}
+	var strategyMapping map[string]map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(cm.Data[rt]), &strategyMapping); err != nil {
+		return errorUtil.Wrapf(err, "failed to unmarshal strategy mapping for resource type %s", rt)
+	}
+	if strategyMapping[tier] == nil {
+		return errorUtil.Errorf("no strategy found for resource type %s and tier %s", rt, tier)
+	}
+	strategyMapping[tier][field] = value
+	rawStrategyMapping, err := json.Marshal(strategyMapping)
+	if err != nil {
+		return errorUtil.Wrapf(err, "failed to marshal strategy mapping for resource type %s", rt)
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[rt] = string(rawStrategyMapping)
+	if !exists {
+		return errorUtil.Wrapf(c.Create(ctx, cm), "failed to create config map %s", name.Name)
+	}
+	return errorUtil.Wrapf(c.Update(ctx, cm), "failed to update config map %s", name.Name)
+}
+
+// SetJSONField sets a top level field of a json object, fields differing from the field only in case are replaced as
+// they unmarshal into the same struct field
+func SetJSONField(raw json.RawMessage, field string, value interface{}) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, errorUtil.Wrap(err, "failed to unmarshal json object")
+		}
+	}
+	for k := range fields {
+		if strings.EqualFold(k, field) {
+			delete(fields, k)
+		}
+	}
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, errorUtil.Wrapf(err, "failed to marshal json field %s", field)
+	}
+	fields[field] = rawValue
+	return json.Marshal(fields)
+}
@@ -1,15 +1,92 @@
 package resources
 
 import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"errors"
 	"fmt"
-	"github.com/sirupsen/logrus"
 	"net"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultConnectionProbeTimeout the maximum time a protocol-level connection probe can take
+	defaultConnectionProbeTimeout = 10 * time.Second
+	// defaultPostgresSSLMode encrypts the probe connection without verifying the server certificate
+	defaultPostgresSSLMode = "require"
 )
 
+// connection probe failure reasons, used to label failed connection probe metrics
+const (
+	ConnectionFailureReasonDial     = "dial"
+	ConnectionFailureReasonTimeout  = "timeout"
+	ConnectionFailureReasonTLS      = "tls"
+	ConnectionFailureReasonAuth     = "auth"
+	ConnectionFailureReasonQuery    = "query"
+	ConnectionFailureReasonNotFound = "not_found"
+	ConnectionFailureReasonUnknown  = "unknown"
+)
+
+// PostgresConnectionDetails the details required to authenticate with a postgres instance
+type PostgresConnectionDetails struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Database string
+	// SSLMode defaults to require if not set
+	SSLMode string
+}
+
+// RedisConnectionDetails the details required to authenticate with a redis instance
+type RedisConnectionDetails struct {
+	Host string
+	Port int
+	// Password is sent using AUTH if set
+	Password string
+	TLS      bool
+}
+
+// ConnectionResult the outcome of a protocol-level connection probe
+type ConnectionResult struct {
+	Success bool
+	Latency time.Duration
+	// FailureReason is one of the ConnectionFailureReason constants, it is empty if the probe succeeded
+	FailureReason string
+	Error         error
+}
+
+// ConnectionProbeError allows the caller of a probe to set the reason a probe failed
+type ConnectionProbeError struct {
+	Reason string
+	Err    error
+}
+
+func NewConnectionProbeError(reason string, err error) *ConnectionProbeError {
+	return &ConnectionProbeError{Reason: reason, Err: err}
+}
+
+func (e *ConnectionProbeError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Reason, e.Err)
+}
+
+func (e *ConnectionProbeError) Unwrap() error {
+	return e.Err
+}
+
 //go:generate moq -out connection_tester_moq.go . ConnectionTester
 type ConnectionTester interface {
 	TCPConnection(host string, port int) bool
+	PostgresConnection(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult
+	RedisConnection(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult
+	BlobStorageConnection(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult
 }
 
 var _ ConnectionTester = (*ConnectionTestManager)(nil)
@@ -37,10 +114,215 @@ func (m *ConnectionTestManager) TCPConnection(host string, port int) bool {
 	return true
 }
 
+// PostgresConnection authenticates with a postgres instance and runs SELECT 1
+func (m *ConnectionTestManager) PostgresConnection(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult {
+	logrus.Info(fmt.Sprintf("testing postgres connection to host: %s", details.Host))
+	ctx, cancel := context.WithTimeout(ctx, defaultConnectionProbeTimeout)
+	defer cancel()
+
+	sslMode := details.SSLMode
+	if sslMode == "" {
+		sslMode = defaultPostgresSSLMode
+	}
+	connector, err := pq.NewConnector(fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s connect_timeout=%d",
+		quotePostgresConnValue(details.Host),
+		details.Port,
+		quotePostgresConnValue(details.Username),
+		quotePostgresConnValue(details.Password),
+		quotePostgresConnValue(details.Database),
+		sslMode,
+		int(defaultConnectionProbeTimeout.Seconds())))
+	if err != nil {
+		return buildFailedConnectionResult(details.Host, 0, ConnectionFailureReasonUnknown, err)
+	}
+	db := sql.OpenDB(connector)
+	defer func() {
+		if err := db.Close(); err != nil {
+			logrus.Error(fmt.Sprintf("postgres connection failed to close to host: %s", details.Host))
+		}
+	}()
+
+	start := time.Now()
+	var result int
+	if err := db.QueryRowContext(ctx, "SELECT 1").Scan(&result); err != nil {
+		return buildFailedConnectionResult(details.Host, time.Since(start), classifyPostgresError(err), err)
+	}
+	return &ConnectionResult{Success: true, Latency: time.Since(start)}
+}
+
+// RedisConnection authenticates with a redis instance using AUTH, if a password is set, and sends PING
+func (m *ConnectionTestManager) RedisConnection(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult {
+	logrus.Info(fmt.Sprintf("testing redis connection to host: %s", details.Host))
+	ctx, cancel := context.WithTimeout(ctx, defaultConnectionProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	addr := net.JoinHostPort(details.Host, strconv.Itoa(details.Port))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return buildFailedConnectionResult(details.Host, time.Since(start), classifyConnectionError(err), err)
+	}
+	if details.TLS {
+		// the certificate of a managed redis instance is signed by a private ca of the cloud provider
+		// the probe checks the instance is usable, not the identity of the instance
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: details.Host, InsecureSkipVerify: true}) // #nosec G402 -- only used to probe connectivity
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			reason := ConnectionFailureReasonTLS
+			if classifyConnectionError(err) == ConnectionFailureReasonTimeout {
+				reason = ConnectionFailureReasonTimeout
+			}
+			return buildFailedConnectionResult(details.Host, time.Since(start), reason, err)
+		}
+		conn = tlsConn
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Error(fmt.Sprintf("redis connection failed to close to host: %s", details.Host))
+		}
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return buildFailedConnectionResult(details.Host, time.Since(start), ConnectionFailureReasonUnknown, err)
+		}
+	}
+
+	reader := bufio.NewReader(conn)
+	if details.Password != "" {
+		reply, err := sendRedisCommand(conn, reader, "AUTH", details.Password)
+		if err != nil {
+			return buildFailedConnectionResult(details.Host, time.Since(start), classifyConnectionError(err), err)
+		}
+		if reply != "OK" {
+			return buildFailedConnectionResult(details.Host, time.Since(start), ConnectionFailureReasonAuth, fmt.Errorf("unexpected reply to AUTH: %s", reply))
+		}
+	}
+	reply, err := sendRedisCommand(conn, reader, "PING")
+	if err != nil {
+		return buildFailedConnectionResult(details.Host, time.Since(start), classifyConnectionError(err), err)
+	}
+	if reply != "PONG" {
+		return buildFailedConnectionResult(details.Host, time.Since(start), ConnectionFailureReasonQuery, fmt.Errorf("unexpected reply to PING: %s", reply))
+	}
+	return &ConnectionResult{Success: true, Latency: time.Since(start)}
+}
+
+// BlobStorageConnection runs a HEAD request against a bucket
+// the request is made by the caller as it is specific to the blob storage provider, it can return a
+// ConnectionProbeError to set the reason the probe failed
+func (m *ConnectionTestManager) BlobStorageConnection(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult {
+	logrus.Info(fmt.Sprintf("testing blob storage connection to bucket: %s", bucket))
+	ctx, cancel := context.WithTimeout(ctx, defaultConnectionProbeTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := headBucket(ctx); err != nil {
+		return buildFailedConnectionResult(bucket, time.Since(start), classifyConnectionError(err), err)
+	}
+	return &ConnectionResult{Success: true, Latency: time.Since(start)}
+}
+
+func buildFailedConnectionResult(target string, latency time.Duration, reason string, err error) *ConnectionResult {
+	logrus.Error(fmt.Sprintf("connection check failed for %s. reason : %s, %s", target, reason, err.Error()))
+	return &ConnectionResult{
+		Success:       false,
+		Latency:       latency,
+		FailureReason: reason,
+		Error:         err,
+	}
+}
+
+// sendRedisCommand writes a command using the redis serialization protocol and returns a simple string reply
+func sendRedisCommand(conn net.Conn, reader *bufio.Reader, args ...string) (string, error) {
+	var cmd strings.Builder
+	cmd.WriteString(fmt.Sprintf("*%d\r\n", len(args)))
+	for _, arg := range args {
+		cmd.WriteString(fmt.Sprintf("$%d\r\n%s\r\n", len(arg), arg))
+	}
+	if _, err := conn.Write([]byte(cmd.String())); err != nil {
+		return "", err
+	}
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if strings.HasPrefix(line, "-") {
+		reason := ConnectionFailureReasonQuery
+		if strings.HasPrefix(line, "-NOAUTH") || strings.HasPrefix(line, "-WRONGPASS") || strings.Contains(line, "invalid password") {
+			reason = ConnectionFailureReasonAuth
+		}
+		return "", NewConnectionProbeError(reason, errors.New(strings.TrimPrefix(line, "-")))
+	}
+	return strings.TrimPrefix(line, "+"), nil
+}
+
+// classifyPostgresError returns the failure reason for an error returned from a postgres query
+func classifyPostgresError(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// class 28 is invalid authorization specification
+		// https://www.postgresql.org/docs/current/errcodes-appendix.html
+		if pqErr.Code.Class() == "28" {
+			return ConnectionFailureReasonAuth
+		}
+		return ConnectionFailureReasonQuery
+	}
+	if errors.Is(err, pq.ErrSSLNotSupported) {
+		return ConnectionFailureReasonTLS
+	}
+	return classifyConnectionError(err)
+}
+
+// classifyConnectionError returns the failure reason for a network error
+func classifyConnectionError(err error) string {
+	var probeErr *ConnectionProbeError
+	if errors.As(err, &probeErr) {
+		return probeErr.Reason
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ConnectionFailureReasonTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ConnectionFailureReasonTimeout
+	}
+	var recordHeaderErr tls.RecordHeaderError
+	var certErr *tls.CertificateVerificationError
+	if errors.As(err, &recordHeaderErr) || errors.As(err, &certErr) {
+		return ConnectionFailureReasonTLS
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		if opErr.Op == "remote error" {
+			return ConnectionFailureReasonTLS
+		}
+		return ConnectionFailureReasonDial
+	}
+	return ConnectionFailureReasonUnknown
+}
+
+// quotePostgresConnValue quotes a value so it can be used in a postgres connection string
+func quotePostgresConnValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return fmt.Sprintf("'%s'", value)
+}
+
 func BuildMockConnectionTester() *ConnectionTesterMock {
 	mockTester := &ConnectionTesterMock{}
 	mockTester.TCPConnectionFunc = func(host string, port int) bool {
 		return true
 	}
+	mockTester.PostgresConnectionFunc = func(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult {
+		return &ConnectionResult{Success: true}
+	}
+	mockTester.RedisConnectionFunc = func(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult {
+		return &ConnectionResult{Success: true}
+	}
+	mockTester.BlobStorageConnectionFunc = func(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult {
+		return &ConnectionResult{Success: true}
+	}
 	return mockTester
 }
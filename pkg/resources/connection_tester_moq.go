@@ -4,6 +4,7 @@
 package resources
 
 import (
+	"context"
 	"sync"
 )
 
@@ -17,6 +18,15 @@ var _ ConnectionTester = &ConnectionTesterMock{}
 //
 //		// make and configure a mocked ConnectionTester
 //		mockedConnectionTester := &ConnectionTesterMock{
+//			BlobStorageConnectionFunc: func(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult {
+//				panic("mock out the BlobStorageConnection method")
+//			},
+//			PostgresConnectionFunc: func(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult {
+//				panic("mock out the PostgresConnection method")
+//			},
+//			RedisConnectionFunc: func(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult {
+//				panic("mock out the RedisConnection method")
+//			},
 //			TCPConnectionFunc: func(host string, port int) bool {
 //				panic("mock out the TCPConnection method")
 //			},
@@ -27,11 +37,43 @@ var _ ConnectionTester = &ConnectionTesterMock{}
 //
 //	}
 type ConnectionTesterMock struct {
+	// BlobStorageConnectionFunc mocks the BlobStorageConnection method.
+	BlobStorageConnectionFunc func(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult
+
+	// PostgresConnectionFunc mocks the PostgresConnection method.
+	PostgresConnectionFunc func(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult
+
+	// RedisConnectionFunc mocks the RedisConnection method.
+	RedisConnectionFunc func(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult
+
 	// TCPConnectionFunc mocks the TCPConnection method.
 	TCPConnectionFunc func(host string, port int) bool
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// BlobStorageConnection holds details about calls to the BlobStorageConnection method.
+		BlobStorageConnection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Bucket is the bucket argument value.
+			Bucket string
+			// HeadBucket is the headBucket argument value.
+			HeadBucket func(ctx context.Context) error
+		}
+		// PostgresConnection holds details about calls to the PostgresConnection method.
+		PostgresConnection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Details is the details argument value.
+			Details *PostgresConnectionDetails
+		}
+		// RedisConnection holds details about calls to the RedisConnection method.
+		RedisConnection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Details is the details argument value.
+			Details *RedisConnectionDetails
+		}
 		// TCPConnection holds details about calls to the TCPConnection method.
 		TCPConnection []struct {
 			// Host is the host argument value.
@@ -40,7 +82,122 @@ type ConnectionTesterMock struct {
 			Port int
 		}
 	}
-	lockTCPConnection sync.RWMutex
+	lockBlobStorageConnection sync.RWMutex
+	lockPostgresConnection    sync.RWMutex
+	lockRedisConnection       sync.RWMutex
+	lockTCPConnection         sync.RWMutex
+}
+
+// BlobStorageConnection calls BlobStorageConnectionFunc.
+func (mock *ConnectionTesterMock) BlobStorageConnection(ctx context.Context, bucket string, headBucket func(ctx context.Context) error) *ConnectionResult {
+	if mock.BlobStorageConnectionFunc == nil {
+		panic("ConnectionTesterMock.BlobStorageConnectionFunc: method is nil but ConnectionTester.BlobStorageConnection was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Bucket     string
+		HeadBucket func(ctx context.Context) error
+	}{
+		Ctx:        ctx,
+		Bucket:     bucket,
+		HeadBucket: headBucket,
+	}
+	mock.lockBlobStorageConnection.Lock()
+	mock.calls.BlobStorageConnection = append(mock.calls.BlobStorageConnection, callInfo)
+	mock.lockBlobStorageConnection.Unlock()
+	return mock.BlobStorageConnectionFunc(ctx, bucket, headBucket)
+}
+
+// BlobStorageConnectionCalls gets all the calls that were made to BlobStorageConnection.
+// Check the length with:
+//
+//	len(mockedConnectionTester.BlobStorageConnectionCalls())
+func (mock *ConnectionTesterMock) BlobStorageConnectionCalls() []struct {
+	Ctx        context.Context
+	Bucket     string
+	HeadBucket func(ctx context.Context) error
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Bucket     string
+		HeadBucket func(ctx context.Context) error
+	}
+	mock.lockBlobStorageConnection.RLock()
+	calls = mock.calls.BlobStorageConnection
+	mock.lockBlobStorageConnection.RUnlock()
+	return calls
+}
+
+// PostgresConnection calls PostgresConnectionFunc.
+func (mock *ConnectionTesterMock) PostgresConnection(ctx context.Context, details *PostgresConnectionDetails) *ConnectionResult {
+	if mock.PostgresConnectionFunc == nil {
+		panic("ConnectionTesterMock.PostgresConnectionFunc: method is nil but ConnectionTester.PostgresConnection was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Details *PostgresConnectionDetails
+	}{
+		Ctx:     ctx,
+		Details: details,
+	}
+	mock.lockPostgresConnection.Lock()
+	mock.calls.PostgresConnection = append(mock.calls.PostgresConnection, callInfo)
+	mock.lockPostgresConnection.Unlock()
+	return mock.PostgresConnectionFunc(ctx, details)
+}
+
+// PostgresConnectionCalls gets all the calls that were made to PostgresConnection.
+// Check the length with:
+//
+//	len(mockedConnectionTester.PostgresConnectionCalls())
+func (mock *ConnectionTesterMock) PostgresConnectionCalls() []struct {
+	Ctx     context.Context
+	Details *PostgresConnectionDetails
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Details *PostgresConnectionDetails
+	}
+	mock.lockPostgresConnection.RLock()
+	calls = mock.calls.PostgresConnection
+	mock.lockPostgresConnection.RUnlock()
+	return calls
+}
+
+// RedisConnection calls RedisConnectionFunc.
+func (mock *ConnectionTesterMock) RedisConnection(ctx context.Context, details *RedisConnectionDetails) *ConnectionResult {
+	if mock.RedisConnectionFunc == nil {
+		panic("ConnectionTesterMock.RedisConnectionFunc: method is nil but ConnectionTester.RedisConnection was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Details *RedisConnectionDetails
+	}{
+		Ctx:     ctx,
+		Details: details,
+	}
+	mock.lockRedisConnection.Lock()
+	mock.calls.RedisConnection = append(mock.calls.RedisConnection, callInfo)
+	mock.lockRedisConnection.Unlock()
+	return mock.RedisConnectionFunc(ctx, details)
+}
+
+// RedisConnectionCalls gets all the calls that were made to RedisConnection.
+// Check the length with:
+//
+//	len(mockedConnectionTester.RedisConnectionCalls())
+func (mock *ConnectionTesterMock) RedisConnectionCalls() []struct {
+	Ctx     context.Context
+	Details *RedisConnectionDetails
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Details *RedisConnectionDetails
+	}
+	mock.lockRedisConnection.RLock()
+	calls = mock.calls.RedisConnection
+	mock.lockRedisConnection.RUnlock()
+	return calls
 }
 
 // TCPConnection calls TCPConnectionFunc.
@@ -1,8 +1,14 @@
 package resources
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -55,3 +61,186 @@ func TestConnectionTestManager_TCPConnection(t *testing.T) {
 		})
 	}
 }
+
+// startTestRedisServer starts a server which replies to AUTH and PING, the password is required if set
+func startTestRedisServer(t *testing.T, password string) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting test redis server: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				authenticated := password == ""
+				for {
+					args, err := readTestRedisCommand(reader)
+					if err != nil {
+						return
+					}
+					switch {
+					case args[0] == "AUTH" && len(args) == 2 && args[1] == password:
+						authenticated = true
+						_, _ = conn.Write([]byte("+OK\r\n"))
+					case args[0] == "AUTH":
+						_, _ = conn.Write([]byte("-WRONGPASS invalid username-password pair\r\n"))
+					case args[0] == "PING" && !authenticated:
+						_, _ = conn.Write([]byte("-NOAUTH Authentication required.\r\n"))
+					case args[0] == "PING":
+						_, _ = conn.Write([]byte("+PONG\r\n"))
+					}
+				}
+			}(conn)
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func readTestRedisCommand(reader *bufio.Reader) ([]string, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "*")))
+	if err != nil {
+		return nil, err
+	}
+	var args []string
+	for i := 0; i < count; i++ {
+		if _, err := reader.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, strings.TrimSpace(arg))
+	}
+	return args, nil
+}
+
+func TestConnectionTestManager_RedisConnection(t *testing.T) {
+	openPort := startTestRedisServer(t, "")
+	authPort := startTestRedisServer(t, "test-password")
+	tests := []struct {
+		name       string
+		details    *RedisConnectionDetails
+		wantResult bool
+		wantReason string
+	}{
+		{
+			name:       "success sending ping",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: openPort},
+			wantResult: true,
+		},
+		{
+			name:       "success sending auth and ping",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: authPort, Password: "test-password"},
+			wantResult: true,
+		},
+		{
+			name:       "failure when password is incorrect",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: authPort, Password: "wrong-password"},
+			wantReason: ConnectionFailureReasonAuth,
+		},
+		{
+			name:       "failure when password is required",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: authPort},
+			wantReason: ConnectionFailureReasonAuth,
+		},
+		{
+			name:       "failure when tls is not enabled on the server",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: openPort, TLS: true},
+			wantReason: ConnectionFailureReasonTLS,
+		},
+		{
+			name:       "failure when the server is not reachable",
+			details:    &RedisConnectionDetails{Host: "127.0.0.1", Port: 6666},
+			wantReason: ConnectionFailureReasonDial,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewConnectionTestManager().RedisConnection(context.TODO(), tt.details)
+			if got.Success != tt.wantResult {
+				t.Fatalf("RedisConnection() success = %v, want %v, error %v", got.Success, tt.wantResult, got.Error)
+			}
+			if got.FailureReason != tt.wantReason {
+				t.Errorf("RedisConnection() failure reason = %v, want %v", got.FailureReason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestConnectionTestManager_PostgresConnection(t *testing.T) {
+	got := NewConnectionTestManager().PostgresConnection(context.TODO(), &PostgresConnectionDetails{
+		Host:     "127.0.0.1",
+		Port:     6666,
+		Username: "postgres",
+		Password: "password",
+		Database: "postgres",
+	})
+	if got.Success {
+		t.Fatal("PostgresConnection() expected failure when the server is not reachable")
+	}
+	if got.FailureReason != ConnectionFailureReasonDial {
+		t.Errorf("PostgresConnection() failure reason = %v, want %v", got.FailureReason, ConnectionFailureReasonDial)
+	}
+}
+
+func TestConnectionTestManager_BlobStorageConnection(t *testing.T) {
+	tests := []struct {
+		name       string
+		headBucket func(ctx context.Context) error
+		wantResult bool
+		wantReason string
+	}{
+		{
+			name: "success when head bucket succeeds",
+			headBucket: func(ctx context.Context) error {
+				return nil
+			},
+			wantResult: true,
+		},
+		{
+			name: "failure reason is set by head bucket",
+			headBucket: func(ctx context.Context) error {
+				return NewConnectionProbeError(ConnectionFailureReasonNotFound, errors.New("bucket not found"))
+			},
+			wantReason: ConnectionFailureReasonNotFound,
+		},
+		{
+			name: "failure reason is timeout when head bucket times out",
+			headBucket: func(ctx context.Context) error {
+				return fmt.Errorf("request failed: %w", context.DeadlineExceeded)
+			},
+			wantReason: ConnectionFailureReasonTimeout,
+		},
+		{
+			name: "failure reason is unknown for other errors",
+			headBucket: func(ctx context.Context) error {
+				return errors.New("unexpected error")
+			},
+			wantReason: ConnectionFailureReasonUnknown,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewConnectionTestManager().BlobStorageConnection(context.TODO(), "test-bucket", tt.headBucket)
+			if got.Success != tt.wantResult {
+				t.Fatalf("BlobStorageConnection() success = %v, want %v", got.Success, tt.wantResult)
+			}
+			if got.FailureReason != tt.wantReason {
+				t.Errorf("BlobStorageConnection() failure reason = %v, want %v", got.FailureReason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -12,28 +13,42 @@ import (
 type MonitoringResourceType string
 
 const (
-	BytesInGibiBytes                        = 1073741824
-	DefaultBlobStorageStatusMetricName      = "cro_blobstorage_status_phase"
-	DefaultPostgresAvailMetricName          = "cro_postgres_available"
-	DefaultPostgresConnectionMetricName     = "cro_postgres_connection"
-	DefaultPostgresDeletionMetricName       = "cro_postgres_deletion_timestamp"
-	DefaultPostgresInfoMetricName           = "cro_postgres_info"
-	DefaultPostgresMaintenanceMetricName    = "cro_postgres_service_maintenance"
-	DefaultPostgresSnapshotStatusMetricName = "cro_postgres_snapshot_status_phase"
-	DefaultPostgresStatusMetricName         = "cro_postgres_status_phase"
-	DefaultRedisAvailMetricName             = "cro_redis_available"
-	DefaultRedisConnectionMetricName        = "cro_redis_connection"
-	DefaultRedisDeletionMetricName          = "cro_redis_deletion_timestamp"
-	DefaultRedisInfoMetricName              = "cro_redis_info"
-	DefaultRedisMaintenanceMetricName       = "cro_redis_service_maintenance"
-	DefaultRedisSnapshotNotAvailable        = "cro_redis_snapshot_not_found"
-	DefaultRedisSnapshotStatusMetricName    = "cro_redis_snapshot_status_phase"
-	DefaultRedisStatusMetricName            = "cro_redis_status_phase"
-	DefaultSTSCredentialsSecretMetricName   = "cro_sts_credentials_secret" // #nosec G101 -- false positive (ref: https://securego.io/docs/rules/g101.html)
-	DefaultVpcActionMetricName              = "cro_vpc_action"
+	BytesInGibiBytes                                 = 1073741824
+	DefaultBlobStorageConnectionMetricName           = "cro_blobstorage_connection"
+	DefaultBlobStorageStatusMetricName               = "cro_blobstorage_status_phase"
+	DefaultConnectionProbeLatencyMetricName          = "cro_connection_probe_latency_seconds"
+	DefaultConnectionProbeFailureMetricName          = "cro_connection_probe_failures_total"
+	DefaultPostgresAvailMetricName                   = "cro_postgres_available"
+	DefaultPostgresConnectionMetricName              = "cro_postgres_connection"
+	DefaultPostgresDeletionMetricName                = "cro_postgres_deletion_timestamp"
+	DefaultPostgresInfoMetricName                    = "cro_postgres_info"
+	DefaultPostgresLatestSnapshotTimestampMetricName = "cro_postgres_latest_snapshot_timestamp"
+	DefaultPostgresLatestSnapshotSizeBytesMetricName = "cro_postgres_latest_snapshot_size_bytes"
+	DefaultPostgresMaintenanceMetricName             = "cro_postgres_service_maintenance"
+	DefaultPostgresSnapshotStatusMetricName          = "cro_postgres_snapshot_status_phase"
+	DefaultPostgresStatusMetricName                  = "cro_postgres_status_phase"
+	DefaultRedisAvailMetricName                      = "cro_redis_available"
+	DefaultRedisConnectionMetricName                 = "cro_redis_connection"
+	DefaultRedisDeletionMetricName                   = "cro_redis_deletion_timestamp"
+	DefaultRedisInfoMetricName                       = "cro_redis_info"
+	DefaultRedisLatestSnapshotTimestampMetricName    = "cro_redis_latest_snapshot_timestamp"
+	DefaultRedisLatestSnapshotSizeBytesMetricName    = "cro_redis_latest_snapshot_size_bytes"
+	DefaultRedisMaintenanceMetricName                = "cro_redis_service_maintenance"
+	DefaultRedisSnapshotNotAvailable                 = "cro_redis_snapshot_not_found"
+	DefaultRedisSnapshotStatusMetricName             = "cro_redis_snapshot_status_phase"
+	DefaultRedisStatusMetricName                     = "cro_redis_status_phase"
+	DefaultResourceDriftMetricName                   = "cro_resource_drift"
+	DefaultSTSCredentialsSecretMetricName            = "cro_sts_credentials_secret" // #nosec G101 -- false positive (ref: https://securego.io/docs/rules/g101.html)
+	DefaultVpcActionMetricName                       = "cro_vpc_action"
 
 	MonitoringResourceTypeRedisInstance    MonitoringResourceType = "redis_instance"
 	MonitoringResourceTypeCloudsqlDatabase MonitoringResourceType = "cloudsql_database"
+	MonitoringResourceTypeGcsBucket        MonitoringResourceType = "gcs_bucket"
+
+	BlobStorageSizeBytesAverageMetricName   = "cro_blobstorage_size_bytes_average"
+	BlobStorageObjectCountAverageMetricName = "cro_blobstorage_object_count_average"
+	BlobStorageRequestsSumMetricName        = "cro_blobstorage_requests_sum"
+	BlobStorageServerErrorsSumMetricName    = "cro_blobstorage_server_errors_sum"
 
 	PostgresFreeStorageAverageMetricName    = "cro_postgres_free_storage_average"
 	PostgresCPUUtilizationAverageMetricName = "cro_postgres_cpu_utilization_average"
@@ -45,15 +60,33 @@ const (
 	RedisFreeableMemoryAverageMetricName        = "cro_redis_freeable_memory_average"
 	RedisCPUUtilizationAverageMetricName        = "cro_redis_cpu_utilization_average"
 	RedisEngineCPUUtilizationAverageMetricName  = "cro_redis_engine_cpu_utilization_average"
+
+	ConnectionProbeResourceTypePostgres    = "postgres"
+	ConnectionProbeResourceTypeRedis       = "redis"
+	ConnectionProbeResourceTypeBlobStorage = "blobstorage"
 )
 
 var (
 	// MetricVecs create the map of vectors
 	MetricVecs map[string]prometheus.GaugeVec
 	logger     *logrus.Entry
+
+	// connectionProbeLabelNames are the generic metric labels of the probed resource and its resource type
+	connectionProbeLabelNames = []string{LabelClusterIDKey, LabelResourceIDKey, LabelNamespaceKey, LabelInstanceIDKey, LabelProductNameKey, LabelStrategyKey, LabelResourceTypeKey}
+
+	connectionProbeLatencyVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    DefaultConnectionProbeLatencyMetricName,
+		Help:    "The time taken for a protocol-level connection probe to a resource to complete",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, append(append([]string{}, connectionProbeLabelNames...), LabelSuccessKey))
+	connectionProbeFailureVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: DefaultConnectionProbeFailureMetricName,
+		Help: "The number of failed protocol-level connection probes to a resource by reason",
+	}, append(append([]string{}, connectionProbeLabelNames...), LabelReasonKey))
 )
 
 func init() {
+	customMetrics.Registry.MustRegister(connectionProbeLatencyVec, connectionProbeFailureVec)
 	StartGaugeVector()
 }
 
@@ -146,6 +179,51 @@ func ResetSTSCredentialsSecretMetric() {
 	}
 }
 
+// SnapshotMetricInfo describes a completed snapshot of a resource, either taken automatically by the cloud provider or created by the operator
+type SnapshotMetricInfo struct {
+	CreateTime time.Time
+	// SizeBytes is 0 when the cloud provider does not report the size of the snapshot
+	SizeBytes float64
+}
+
+// SetLatestSnapshotMetrics exposes the creation time and size of the most recent completed snapshot of a resource
+// when no snapshot exists the timestamp is set to 0, so an alert on the age of the latest snapshot fires
+func SetLatestSnapshotMetrics(timestampMetricName, sizeMetricName string, labels map[string]string, snapshots []*SnapshotMetricInfo) {
+	var latest *SnapshotMetricInfo
+	for _, snapshot := range snapshots {
+		if snapshot != nil && (latest == nil || snapshot.CreateTime.After(latest.CreateTime)) {
+			latest = snapshot
+		}
+	}
+	if latest == nil {
+		SetMetric(timestampMetricName, labels, 0)
+		return
+	}
+	SetMetric(timestampMetricName, labels, float64(latest.CreateTime.Unix()))
+	if latest.SizeBytes > 0 {
+		SetMetric(sizeMetricName, labels, latest.SizeBytes)
+	}
+}
+
+// SetConnectionProbeMetrics exposes the latency of a connection probe and, if it failed, the reason it failed
+// only the generic metric labels are used, so the label set is the same for every resource
+func SetConnectionProbeMetrics(resourceType string, labels map[string]string, result *ConnectionResult) {
+	probeLabels := prometheus.Labels{}
+	for _, name := range connectionProbeLabelNames {
+		probeLabels[name] = labels[name]
+	}
+	probeLabels[LabelResourceTypeKey] = resourceType
+	probeLabels[LabelSuccessKey] = strconv.FormatBool(result.Success)
+	connectionProbeLatencyVec.With(probeLabels).Observe(result.Latency.Seconds())
+	if result.Success {
+		return
+	}
+
+	delete(probeLabels, LabelSuccessKey)
+	probeLabels[LabelReasonKey] = result.FailureReason
+	connectionProbeFailureVec.With(probeLabels).Inc()
+}
+
 func IsCompoundMetric(metric string) bool {
 	for _, compoundMetric := range getCompoundMetrics() {
 		if metric == compoundMetric {
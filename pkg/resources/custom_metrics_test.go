@@ -1,6 +1,13 @@
 package resources
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 func TestIsCompoundMetric(t *testing.T) {
 	type args struct {
@@ -67,3 +74,83 @@ func TestIsComputedCpuMetric(t *testing.T) {
 		})
 	}
 }
+
+func TestSetLatestSnapshotMetrics(t *testing.T) {
+	labels := map[string]string{LabelResourceIDKey: "test"}
+	now := time.Now().Truncate(time.Second)
+	tests := []struct {
+		name          string
+		snapshots     []*SnapshotMetricInfo
+		wantTimestamp float64
+		wantSize      float64
+	}{
+		{
+			name: "test latest snapshot is exposed",
+			snapshots: []*SnapshotMetricInfo{
+				{CreateTime: now.Add(-time.Hour), SizeBytes: 1024},
+				{CreateTime: now, SizeBytes: 2048},
+				{CreateTime: now.Add(-2 * time.Hour), SizeBytes: 512},
+			},
+			wantTimestamp: float64(now.Unix()),
+			wantSize:      2048,
+		},
+		{
+			name:          "test timestamp is 0 when no snapshot exists",
+			wantTimestamp: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timestampVec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_latest_snapshot_timestamp"}, []string{LabelResourceIDKey})
+			sizeVec := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_latest_snapshot_size_bytes"}, []string{LabelResourceIDKey})
+			MetricVecs["test_latest_snapshot_timestamp"] = *timestampVec
+			MetricVecs["test_latest_snapshot_size_bytes"] = *sizeVec
+			defer delete(MetricVecs, "test_latest_snapshot_timestamp")
+			defer delete(MetricVecs, "test_latest_snapshot_size_bytes")
+
+			SetLatestSnapshotMetrics("test_latest_snapshot_timestamp", "test_latest_snapshot_size_bytes", labels, tt.snapshots)
+			if got := gaugeValue(t, timestampVec, labels); got != tt.wantTimestamp {
+				t.Errorf("SetLatestSnapshotMetrics() timestamp = %v, want %v", got, tt.wantTimestamp)
+			}
+			if got := gaugeValue(t, sizeVec, labels); got != tt.wantSize {
+				t.Errorf("SetLatestSnapshotMetrics() size = %v, want %v", got, tt.wantSize)
+			}
+		})
+	}
+}
+
+func gaugeValue(t *testing.T, vec *prometheus.GaugeVec, labels map[string]string) float64 {
+	metric := &dto.Metric{}
+	if err := vec.With(labels).Write(metric); err != nil {
+		t.Fatalf("failed to read gauge value: %v", err)
+	}
+	return metric.GetGauge().GetValue()
+}
+
+func TestSetConnectionProbeMetrics(t *testing.T) {
+	genericLabels := BuildGenericMetricLabels(metav1.ObjectMeta{Name: "test", Namespace: "test"}, "cluster", "instance", "aws-rds")
+	failureLabels := prometheus.Labels{}
+	for k, v := range genericLabels {
+		failureLabels[k] = v
+	}
+	failureLabels[LabelResourceTypeKey] = ConnectionProbeResourceTypePostgres
+	failureLabels[LabelReasonKey] = ConnectionFailureReasonTimeout
+	before := counterValue(t, connectionProbeFailureVec, failureLabels)
+
+	// label sets which differ from the generic labels must not panic
+	SetConnectionProbeMetrics(ConnectionProbeResourceTypePostgres, genericLabels, &ConnectionResult{Success: true, Latency: time.Millisecond})
+	SetConnectionProbeMetrics(ConnectionProbeResourceTypePostgres, map[string]string{LabelResourceIDKey: "other", "extra": "label"}, &ConnectionResult{Success: true, Latency: time.Millisecond})
+	SetConnectionProbeMetrics(ConnectionProbeResourceTypePostgres, genericLabels, &ConnectionResult{Success: false, FailureReason: ConnectionFailureReasonTimeout, Latency: time.Second})
+
+	if got := counterValue(t, connectionProbeFailureVec, failureLabels); got != before+1 {
+		t.Errorf("SetConnectionProbeMetrics() failures = %v, want %v", got, before+1)
+	}
+}
+
+func counterValue(t *testing.T, vec *prometheus.CounterVec, labels prometheus.Labels) float64 {
+	metric := &dto.Metric{}
+	if err := vec.With(labels).Write(metric); err != nil {
+		t.Fatalf("failed to read counter value: %v", err)
+	}
+	return metric.GetCounter().GetValue()
+}
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	errorUtil "github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// RetainedResourceLabel marks the config maps recording postgres and redis cloud resources kept by the retain
+	// deletion policy, the shared network is not torn down while any of them exist
+	RetainedResourceLabel = DefaultTagKeyPrefix + "retained-resource"
+	retainedResourceIDKey = "resourceID"
+)
+
+// IsRetained returns true if the cloud resource is kept when the custom resource is deleted
+func IsRetained(spec croType.ResourceTypeSpec) bool {
+	return spec.DeletionPolicy == croType.DeletionPolicyRetain
+}
+
+// IsFinalSnapshotRequired returns true if a final snapshot of the cloud resource must exist before it is deleted
+func IsFinalSnapshotRequired(spec croType.ResourceTypeSpec) bool {
+	return spec.DeletionPolicy == croType.DeletionPolicySnapshot
+}
+
+// RetainResource removes the finalizer from the custom resource without deleting the cloud resource, the provider is
+// expected to have tagged the cloud resource as orphaned. During a migration the finalizer is kept, see
+// SetResourceDeleted
+func RetainResource(ctx context.Context, c client.Client, recorder record.EventRecorder, obj client.Object, finalizer, resourceID string) (croType.StatusMessage, error) {
+	if err := recordRetainedResource(ctx, c, obj, resourceID); err != nil {
+		msg := fmt.Sprintf("failed to record retained resource %s", resourceID)
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	RecordEvent(recorder, obj, EventReasonRetained, "deletion policy is %s, %s is kept and tagged as orphaned", croType.DeletionPolicyRetain, resourceID)
+	if err := SetResourceDeleted(ctx, c, obj, finalizer); err != nil {
+		msg := "failed to update instance as part of finalizer reconcile"
+		return croType.StatusMessage(msg), errorUtil.Wrap(err, msg)
+	}
+	return croType.StatusMessage(fmt.Sprintf("deletion policy is %s, %s kept", croType.DeletionPolicyRetain, resourceID)), nil
+}
+
+// recordRetainedResource keeps a config map for retained postgres and redis instances, these stay in the shared network
+// after their custom resource is gone and must be counted before the network is torn down
+func recordRetainedResource(ctx context.Context, c client.Client, obj client.Object, resourceID string) error {
+	var kind string
+	switch obj.(type) {
+	case *v1alpha1.Postgres:
+		kind = "postgres"
+	case *v1alpha1.Redis:
+		kind = "redis"
+	default:
+		return nil
+	}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("retained-%s-%s", kind, obj.GetName()),
+			Namespace: obj.GetNamespace(),
+		},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, c, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels[RetainedResourceLabel] = kind
+		cm.Data = map[string]string{retainedResourceIDKey: resourceID}
+		return nil
+	})
+	return err
+}
+
+// HasRetainedResources returns true if any postgres or redis cloud resources were kept by the retain deletion policy
+// and have not been cleaned up yet
+func HasRetainedResources(ctx context.Context, c client.Client) (bool, error) {
+	cms := &v1.ConfigMapList{}
+	if err := c.List(ctx, cms, client.HasLabels{RetainedResourceLabel}); err != nil {
+		return false, errorUtil.Wrap(err, "failed to list retained resources")
+	}
+	return len(cms.Items) > 0, nil
+}
@@ -0,0 +1,103 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRetainResource(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cases := []struct {
+		name           string
+		obj            client.Object
+		others         []client.Object
+		expectRecorded bool
+		expectLast     bool
+	}{
+		{
+			name: "test retained postgres is recorded and keeps the network",
+			obj: &v1alpha1.Postgres{
+				ObjectMeta: controllerruntime.ObjectMeta{Name: "test", Namespace: "test", Finalizers: []string{"test"}},
+				Spec:       v1alpha1.PostgresSpec{ResourceTypeSpec: croType.ResourceTypeSpec{DeletionPolicy: croType.DeletionPolicyRetain}},
+			},
+			expectRecorded: true,
+			expectLast:     false,
+		},
+		{
+			name: "test retained redis is recorded and keeps the network",
+			obj: &v1alpha1.Redis{
+				ObjectMeta: controllerruntime.ObjectMeta{Name: "test", Namespace: "test", Finalizers: []string{"test"}},
+				Spec:       v1alpha1.RedisSpec{ResourceTypeSpec: croType.ResourceTypeSpec{DeletionPolicy: croType.DeletionPolicyRetain}},
+			},
+			expectRecorded: true,
+			expectLast:     false,
+		},
+		{
+			name: "test retained blobstorage is not recorded",
+			obj: &v1alpha1.BlobStorage{
+				ObjectMeta: controllerruntime.ObjectMeta{Name: "test", Namespace: "test", Finalizers: []string{"test"}},
+				Spec:       v1alpha1.BlobStorageSpec{ResourceTypeSpec: croType.ResourceTypeSpec{DeletionPolicy: croType.DeletionPolicyRetain}},
+			},
+			others: []client.Object{
+				&v1alpha1.Postgres{ObjectMeta: controllerruntime.ObjectMeta{Name: "other", Namespace: "test"}},
+			},
+			expectRecorded: false,
+			expectLast:     true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []runtime.Object{tc.obj}
+			for _, o := range tc.others {
+				objs = append(objs, o)
+			}
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, objs...)
+			if _, err := RetainResource(context.TODO(), c, record.NewFakeRecorder(10), tc.obj, "test", "test-id"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			retained, err := HasRetainedResources(context.TODO(), c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if retained != tc.expectRecorded {
+				t.Fatalf("unexpected retained resources, expected %t but got %t", tc.expectRecorded, retained)
+			}
+			if tc.expectRecorded {
+				cm := &v1.ConfigMap{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: "retained-" + retainedKind(tc.obj) + "-test", Namespace: "test"}, cm); err != nil {
+					t.Fatalf("expected retained config map: %v", err)
+				}
+				if cm.Data[retainedResourceIDKey] != "test-id" {
+					t.Fatalf("unexpected retained resource id %q", cm.Data[retainedResourceIDKey])
+				}
+			}
+			last, err := IsLastResource(context.TODO(), c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if last != tc.expectLast {
+				t.Fatalf("unexpected last resource, expected %t but got %t", tc.expectLast, last)
+			}
+		})
+	}
+}
+
+func retainedKind(obj client.Object) string {
+	if _, ok := obj.(*v1alpha1.Redis); ok {
+		return "redis"
+	}
+	return "postgres"
+}
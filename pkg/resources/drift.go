@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DriftConditionType is the status condition reporting whether the cloud resource differs from the strategy
+	DriftConditionType = "Drifted"
+
+	DriftReasonDetected    = "DriftDetected"
+	DriftReasonNotDetected = "NoDriftDetected"
+)
+
+// DriftedAttribute is an attribute of a cloud resource whose current value differs from the strategy
+type DriftedAttribute struct {
+	Name    string
+	Current string
+	Desired string
+}
+
+// Drift collects the attributes of a cloud resource that differ from the effective strategy
+type Drift struct {
+	Attributes []DriftedAttribute
+}
+
+// Compare records the attribute as drifted when the current value differs from the desired value, attributes the
+// strategy does not set, a nil desired value, are skipped. pointers are dereferenced before comparing
+func (d *Drift) Compare(name string, current, desired interface{}) {
+	desiredValue := formatPendingValue(desired)
+	if desiredValue == "" {
+		return
+	}
+	if currentValue := formatPendingValue(current); currentValue != desiredValue {
+		d.Attributes = append(d.Attributes, DriftedAttribute{Name: name, Current: currentValue, Desired: desiredValue})
+	}
+}
+
+// CompareSet records the attribute as drifted when the current values differ from the desired values, ignoring
+// order. an empty desired set is skipped
+func (d *Drift) CompareSet(name string, current, desired []string) {
+	if len(desired) == 0 {
+		return
+	}
+	currentSorted := append([]string{}, current...)
+	desiredSorted := append([]string{}, desired...)
+	sort.Strings(currentSorted)
+	sort.Strings(desiredSorted)
+	d.Compare(name, strings.Join(currentSorted, ","), strings.Join(desiredSorted, ","))
+}
+
+// CompareTags records every desired tag that is missing from or differs in the current tags, tags added outside of
+// the operator are not drift
+func (d *Drift) CompareTags(name string, current, desired map[string]string) {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if currentValue, ok := current[k]; !ok || currentValue != desired[k] {
+			d.Attributes = append(d.Attributes, DriftedAttribute{Name: fmt.Sprintf("%s[%s]", name, k), Current: current[k], Desired: desired[k]})
+		}
+	}
+}
+
+// Len returns the number of drifted attributes
+func (d *Drift) Len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.Attributes)
+}
+
+// String describes the drifted attributes with their current and desired values
+func (d *Drift) String() string {
+	descriptions := make([]string, 0, d.Len())
+	for _, a := range d.Attributes {
+		descriptions = append(descriptions, fmt.Sprintf("%s (current %q, desired %q)", a.Name, a.Current, a.Desired))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// ReportDrift sets the drift condition in the status of the custom resource and exposes the number of drifted
+// attributes in the resource drift metric, a warning event is recorded when the drifted attributes change
+func ReportDrift(ctx context.Context, c client.Client, recorder record.EventRecorder, obj client.Object, status *croType.ResourceTypeStatus, drift *Drift, resourceType, instanceID, strategy string) {
+	condition := metav1.Condition{
+		Type:               DriftConditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             DriftReasonNotDetected,
+		Message:            "cloud resource matches the strategy",
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if drift.Len() > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = DriftReasonDetected
+		condition.Message = fmt.Sprintf("%d attributes differ from the strategy: %s", drift.Len(), drift.String())
+	}
+	previous := meta.FindStatusCondition(status.Conditions, DriftConditionType)
+	if drift.Len() > 0 && (previous == nil || previous.Message != condition.Message) {
+		RecordWarningEvent(recorder, obj, DriftReasonDetected, "%s", condition.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	clusterID, err := GetClusterID(ctx, c)
+	if err != nil {
+		logger.Errorf("failed to get cluster id while exposing drift metric for %s %s", resourceType, obj.GetName())
+		return
+	}
+	labels := BuildGenericMetricLabels(metav1.ObjectMeta{Name: obj.GetName(), Namespace: obj.GetNamespace(), Labels: obj.GetLabels()}, clusterID, instanceID, strategy)
+	labels[LabelResourceTypeKey] = resourceType
+	SetMetric(DefaultResourceDriftMetricName, labels, float64(drift.Len()))
+}
+
+// IsDriftRemediated returns true if the custom resource asks for drift from the strategy to be corrected outside of
+// the maintenance window
+func IsDriftRemediated(spec croType.ResourceTypeSpec, drift *Drift) bool {
+	return spec.DriftPolicy == croType.DriftPolicyRemediate && drift.Len() > 0
+}
@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+func TestDrift_Compare(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		desired interface{}
+		want    []DriftedAttribute
+	}{
+		{
+			name:    "test equal values are not drift",
+			current: ptr.To("db.t3.small"),
+			desired: "db.t3.small",
+		},
+		{
+			name:    "test unset desired value is not drift",
+			current: "db.t3.small",
+			desired: (*string)(nil),
+		},
+		{
+			name:    "test differing values are drift",
+			current: ptr.To(int64(7)),
+			desired: ptr.To(int64(14)),
+			want:    []DriftedAttribute{{Name: "test", Current: "7", Desired: "14"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Drift{}
+			d.Compare("test", tt.current, tt.desired)
+			if !reflect.DeepEqual(d.Attributes, tt.want) {
+				t.Errorf("Compare() = %v, want %v", d.Attributes, tt.want)
+			}
+		})
+	}
+}
+
+func TestDrift_CompareSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		desired []string
+		want    int
+	}{
+		{
+			name:    "test sets in a different order are not drift",
+			current: []string{"b", "a"},
+			desired: []string{"a", "b"},
+		},
+		{
+			name:    "test empty desired set is not drift",
+			current: []string{"a"},
+		},
+		{
+			name:    "test differing sets are drift",
+			current: []string{"a"},
+			desired: []string{"a", "b"},
+			want:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Drift{}
+			d.CompareSet("test", tt.current, tt.desired)
+			if d.Len() != tt.want {
+				t.Errorf("CompareSet() drifted attributes = %v, want %d", d.Attributes, tt.want)
+			}
+		})
+	}
+}
+
+func TestDrift_CompareTags(t *testing.T) {
+	d := &Drift{}
+	d.CompareTags("tags", map[string]string{"a": "1", "b": "1", "external": "1"}, map[string]string{"a": "1", "b": "2", "c": "3"})
+	want := []DriftedAttribute{
+		{Name: "tags[b]", Current: "1", Desired: "2"},
+		{Name: "tags[c]", Current: "", Desired: "3"},
+	}
+	if !reflect.DeepEqual(d.Attributes, want) {
+		t.Errorf("CompareTags() = %v, want %v", d.Attributes, want)
+	}
+}
+
+func TestReportDrift(t *testing.T) {
+	fakeScheme := runtime.NewScheme()
+	if err := configv1.Install(fakeScheme); err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name       string
+		drift      *Drift
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantEvents int
+	}{
+		{
+			name:       "test no drift sets condition false",
+			drift:      &Drift{},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: DriftReasonNotDetected,
+		},
+		{
+			name:       "test drift sets condition true and records an event",
+			drift:      &Drift{Attributes: []DriftedAttribute{{Name: "MultiAZ", Current: "false", Desired: "true"}}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: DriftReasonDetected,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			pg := &v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"}}
+			c := moqClient.NewSigsClientMoqWithScheme(fakeScheme, newFakeAwsInfrastructure())
+			ReportDrift(context.TODO(), c, recorder, pg, &pg.Status, tt.drift, "postgres", "test-id", "aws-rds")
+			condition := meta.FindStatusCondition(pg.Status.Conditions, DriftConditionType)
+			if condition == nil || condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("ReportDrift() condition = %v, want status %s reason %s", condition, tt.wantStatus, tt.wantReason)
+			}
+			// reporting the same drift again must not record another event
+			ReportDrift(context.TODO(), c, recorder, pg, &pg.Status, tt.drift, "postgres", "test-id", "aws-rds")
+			if len(recorder.Events) != tt.wantEvents {
+				t.Errorf("ReportDrift() recorded %d events, want %d", len(recorder.Events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestIsDriftRemediated(t *testing.T) {
+	drift := &Drift{Attributes: []DriftedAttribute{{Name: "test"}}}
+	if IsDriftRemediated(croType.ResourceTypeSpec{}, drift) {
+		t.Error("IsDriftRemediated() = true, want false for the default policy")
+	}
+	if IsDriftRemediated(croType.ResourceTypeSpec{DriftPolicy: croType.DriftPolicyRemediate}, &Drift{}) {
+		t.Error("IsDriftRemediated() = true, want false without drift")
+	}
+	if !IsDriftRemediated(croType.ResourceTypeSpec{DriftPolicy: croType.DriftPolicyRemediate}, drift) {
+		t.Error("IsDriftRemediated() = false, want true")
+	}
+}
@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// DryRunAnnotation enables dry-run mode for a single custom resource when set to "true"
+	DryRunAnnotation = "integreatly.org/dry-run"
+)
+
+// dryRun enables dry-run mode for every custom resource, it is set from the --dry-run operator flag
+var dryRun bool
+
+// SetDryRun enables or disables dry-run mode for every custom resource
+func SetDryRun(enabled bool) {
+	dryRun = enabled
+}
+
+// IsDryRun returns true if modifications to the cloud resource of the custom resource should be computed but not
+// applied, either because the operator runs in dry-run mode or the custom resource has the dry-run annotation
+func IsDryRun(obj metav1.Object) bool {
+	return dryRun || obj.GetAnnotations()[DryRunAnnotation] == "true"
+}
+
+// NewPendingChange builds a pending change of a field from its current and desired value, pointers are dereferenced
+// and values that are not scalars are rendered as json
+func NewPendingChange(field string, current, desired interface{}) croType.PendingChange {
+	return croType.PendingChange{
+		Field:   field,
+		Current: formatPendingValue(current),
+		Desired: formatPendingValue(desired),
+	}
+}
+
+// NewTagPendingChanges builds a pending change for each expected tag of a cloud resource which is missing or has a
+// different value, tags which are not expected are left as they are
+func NewTagPendingChanges(current, expected map[string]string) []croType.PendingChange {
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var changes []croType.PendingChange
+	for _, key := range keys {
+		if value, ok := current[key]; !ok || value != expected[key] {
+			changes = append(changes, NewPendingChange("Tags."+key, current[key], expected[key]))
+		}
+	}
+	return changes
+}
+
+// SetPendingChanges sets the pending changes in the status of the custom resource, an event is recorded when they
+// differ from the pending changes already in the status
+func SetPendingChanges(recorder record.EventRecorder, obj runtime.Object, status *croType.ResourceTypeStatus, changes []croType.PendingChange) {
+	if len(changes) == 0 {
+		changes = nil
+	}
+	if reflect.DeepEqual(status.PendingChanges, changes) {
+		return
+	}
+	status.PendingChanges = changes
+	if changes != nil {
+		RecordEvent(recorder, obj, EventReasonModifyPending, "dry-run mode enabled, %d modifications pending", len(changes))
+	}
+}
+
+func formatPendingValue(value interface{}) string {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return ""
+		}
+		fallthrough
+	case reflect.Struct, reflect.Array:
+		out, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Sprintf("%v", v.Interface())
+		}
+		return string(out)
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "test dry-run disabled by default",
+			want: false,
+		},
+		{
+			name:        "test dry-run enabled by annotation",
+			annotations: map[string]string{DryRunAnnotation: "true"},
+			want:        true,
+		},
+		{
+			name:        "test dry-run not enabled by other annotation values",
+			annotations: map[string]string{DryRunAnnotation: "false"},
+			want:        false,
+		},
+		{
+			name:   "test dry-run enabled operator-wide",
+			dryRun: true,
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDryRun(tt.dryRun)
+			defer SetDryRun(false)
+			obj := &metav1.ObjectMeta{Annotations: tt.annotations}
+			if got := IsDryRun(obj); got != tt.want {
+				t.Errorf("IsDryRun() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPendingChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		current interface{}
+		desired interface{}
+		want    croType.PendingChange
+	}{
+		{
+			name:    "test pointers are dereferenced",
+			current: ptr.To("db.t3.small"),
+			desired: ptr.To("db.t3.large"),
+			want:    croType.PendingChange{Field: "field", Current: "db.t3.small", Desired: "db.t3.large"},
+		},
+		{
+			name:    "test nil pointers are empty",
+			current: (*int64)(nil),
+			desired: ptr.To(int64(7)),
+			want:    croType.PendingChange{Field: "field", Desired: "7"},
+		},
+		{
+			name:    "test maps are rendered as json",
+			current: map[string]string{"a": "b"},
+			desired: map[string]string{"a": "c"},
+			want:    croType.PendingChange{Field: "field", Current: `{"a":"b"}`, Desired: `{"a":"c"}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewPendingChange("field", tt.current, tt.desired); got != tt.want {
+				t.Errorf("NewPendingChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTagPendingChanges(t *testing.T) {
+	current := map[string]string{"a": "1", "b": "2", "unexpected": "3"}
+	expected := map[string]string{"a": "1", "b": "3", "c": "4"}
+	want := []croType.PendingChange{
+		{Field: "Tags.b", Current: "2", Desired: "3"},
+		{Field: "Tags.c", Desired: "4"},
+	}
+	if got := NewTagPendingChanges(current, expected); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewTagPendingChanges() = %v, want %v", got, want)
+	}
+	if got := NewTagPendingChanges(expected, expected); got != nil {
+		t.Errorf("NewTagPendingChanges() = %v, want no changes", got)
+	}
+}
+
+func TestSetPendingChanges(t *testing.T) {
+	changes := []croType.PendingChange{{Field: "field", Current: "a", Desired: "b"}}
+	tests := []struct {
+		name       string
+		existing   []croType.PendingChange
+		changes    []croType.PendingChange
+		want       []croType.PendingChange
+		wantEvents int
+	}{
+		{
+			name:       "test new pending changes are set and recorded",
+			changes:    changes,
+			want:       changes,
+			wantEvents: 1,
+		},
+		{
+			name:     "test unchanged pending changes are not recorded again",
+			existing: changes,
+			changes:  []croType.PendingChange{{Field: "field", Current: "a", Desired: "b"}},
+			want:     changes,
+		},
+		{
+			name:     "test empty pending changes clear the status",
+			existing: changes,
+			changes:  []croType.PendingChange{},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			pg := &v1alpha1.Postgres{Status: croType.ResourceTypeStatus{PendingChanges: tt.existing}}
+			SetPendingChanges(recorder, pg, &pg.Status, tt.changes)
+			if !reflect.DeepEqual(pg.Status.PendingChanges, tt.want) {
+				t.Errorf("SetPendingChanges() status = %v, want %v", pg.Status.PendingChanges, tt.want)
+			}
+			if len(recorder.Events) != tt.wantEvents {
+				t.Errorf("SetPendingChanges() recorded %d events, want %d", len(recorder.Events), tt.wantEvents)
+			}
+		})
+	}
+}
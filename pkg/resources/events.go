@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"strings"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventRecorderName is the component name events are recorded under
+	EventRecorderName = "cloud-resource-operator"
+
+	// lifecycle event reasons
+	EventReasonCreateStarted        = "CreateStarted"
+	EventReasonCreateCompleted      = "CreateCompleted"
+	EventReasonUpdateCompleted      = "UpdateCompleted"
+	EventReasonAdopted              = "Adopted"
+	EventReasonModifyApplied        = "ModifyApplied"
+	EventReasonModifyPending        = "ModifyPending"
+	EventReasonServiceUpdateApplied = "ServiceUpdateApplied"
+	EventReasonMaintenancePending   = "MaintenancePending"
+	EventReasonSnapshotTaken        = "SnapshotTaken"
+	EventReasonDeletionBlocked      = "DeletionBlocked"
+	EventReasonFinalSnapshot        = "FinalSnapshot"
+	EventReasonRetained             = "Retained"
+	EventReasonMigrationStarted     = "MigrationStarted"
+	EventReasonMigrationSwitched    = "MigrationSwitched"
+	EventReasonMigrationCompleted   = "MigrationCompleted"
+	EventReasonCredentialsFailed    = "CredentialsFailed"
+
+	// phase event reasons, these match the status phases of the custom resources
+	EventReasonInProgress         = "InProgress"
+	EventReasonDeletionInProgress = "DeletionInProgress"
+	EventReasonComplete           = "Complete"
+	EventReasonPaused             = "Paused"
+	EventReasonFailed             = "Failed"
+
+	// CreatedConditionType is the status condition recording that the cloud resource completed its create, so later
+	// transitions to complete are recorded as updates
+	CreatedConditionType = "Created"
+	// MaintenancePendingConditionType is the status condition reporting the maintenance scheduled on the cloud resource
+	MaintenancePendingConditionType = "MaintenancePending"
+
+	MaintenanceReasonNotPending = "NoMaintenancePending"
+)
+
+// EventReasonForPhase returns the event reason matching a status phase
+func EventReasonForPhase(phase croType.StatusPhase) string {
+	switch phase {
+	case croType.PhaseInProgress:
+		return EventReasonInProgress
+	case croType.PhaseDeleteInProgress:
+		return EventReasonDeletionInProgress
+	case croType.PhaseComplete:
+		return EventReasonComplete
+	case croType.PhasePaused:
+		return EventReasonPaused
+	case croType.PhaseFailed:
+		return EventReasonFailed
+	}
+	return ""
+}
+
+// RecordEvent records a normal event against the object, providers built without a recorder skip recording
+func RecordEvent(recorder record.EventRecorder, obj runtime.Object, reason, messageFmt string, args ...interface{}) {
+	if recorder == nil {
+		return
+	}
+	recorder.Eventf(obj, corev1.EventTypeNormal, reason, messageFmt, args...)
+}
+
+// RecordWarningEvent records a warning event against the object, providers built without a recorder skip recording
+func RecordWarningEvent(recorder record.EventRecorder, obj runtime.Object, reason, messageFmt string, args ...interface{}) {
+	if recorder == nil {
+		return
+	}
+	recorder.Eventf(obj, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
+// RecordPhaseEvent records an event when the status phase of a resource changes
+//
+// a transition to complete from a new or in progress resource is recorded as create completed, or as update completed
+// once the resource has been created, failures are recorded as warnings and every other transition uses the reason
+// matching the new phase
+func RecordPhaseEvent(recorder record.EventRecorder, obj runtime.Object, from, to croType.StatusPhase, msg croType.StatusMessage, created bool) {
+	if from == to || to == "" {
+		return
+	}
+	switch {
+	case to == croType.PhaseFailed:
+		RecordWarningEvent(recorder, obj, EventReasonFailed, "%s", msg)
+	case to == croType.PhaseComplete && (from == "" || from == croType.PhaseInProgress) && created:
+		RecordEvent(recorder, obj, EventReasonUpdateCompleted, "%s", msg)
+	case to == croType.PhaseComplete && (from == "" || from == croType.PhaseInProgress):
+		RecordEvent(recorder, obj, EventReasonCreateCompleted, "%s", msg)
+	default:
+		RecordEvent(recorder, obj, EventReasonForPhase(to), "%s", msg)
+	}
+}
+
+// IsCreated returns true once the created condition is set on the status conditions
+func IsCreated(conditions []metav1.Condition) bool {
+	return meta.IsStatusConditionTrue(conditions, CreatedConditionType)
+}
+
+// SetCreated sets the created condition, it is set on every transition to complete so resources created before the
+// condition existed are marked on their next reconcile
+func SetCreated(conditions *[]metav1.Condition, generation int64) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               CreatedConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             EventReasonCreateCompleted,
+		Message:            "cloud resource has been created",
+		ObservedGeneration: generation,
+	})
+}
+
+// ReportMaintenance sets the maintenance pending condition from the maintenance scheduled on the cloud resource, a
+// maintenance pending event is only recorded when the scheduled maintenance changes rather than on every reconcile
+func ReportMaintenance(recorder record.EventRecorder, obj client.Object, status *croType.ResourceTypeStatus, pending []string) {
+	condition := metav1.Condition{
+		Type:               MaintenancePendingConditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             MaintenanceReasonNotPending,
+		Message:            "no maintenance is scheduled",
+		ObservedGeneration: obj.GetGeneration(),
+	}
+	if len(pending) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = EventReasonMaintenancePending
+		condition.Message = strings.Join(pending, "; ")
+	}
+	previous := meta.FindStatusCondition(status.Conditions, MaintenancePendingConditionType)
+	if len(pending) > 0 && (previous == nil || previous.Message != condition.Message) {
+		RecordEvent(recorder, obj, EventReasonMaintenancePending, "%s", condition.Message)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
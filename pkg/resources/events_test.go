@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestRecordPhaseEvent(t *testing.T) {
+	type args struct {
+		from    croType.StatusPhase
+		to      croType.StatusPhase
+		msg     croType.StatusMessage
+		created bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "test no event is recorded when the phase is unchanged",
+			args: args{from: croType.PhaseComplete, to: croType.PhaseComplete, msg: "rds instance is as expected"},
+			want: "",
+		},
+		{
+			name: "test create completed is recorded when an in progress resource completes",
+			args: args{from: croType.PhaseInProgress, to: croType.PhaseComplete, msg: "rds instance is as expected"},
+			want: "Normal CreateCompleted rds instance is as expected",
+		},
+		{
+			name: "test update completed is recorded when a created resource completes",
+			args: args{from: croType.PhaseInProgress, to: croType.PhaseComplete, msg: "rds instance is as expected", created: true},
+			want: "Normal UpdateCompleted rds instance is as expected",
+		},
+		{
+			name: "test failures are recorded as warnings",
+			args: args{from: croType.PhaseComplete, to: croType.PhaseFailed, msg: "failed to reconcile rds credentials"},
+			want: "Warning Failed failed to reconcile rds credentials",
+		},
+		{
+			name: "test other transitions use the reason matching the phase",
+			args: args{from: croType.PhaseComplete, to: croType.PhaseDeleteInProgress, msg: "delete detected"},
+			want: "Normal DeletionInProgress delete detected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			RecordPhaseEvent(recorder, &v1alpha1.Postgres{}, tt.args.from, tt.args.to, tt.args.msg, tt.args.created)
+			got := ""
+			select {
+			case got = <-recorder.Events:
+			default:
+			}
+			if got != tt.want {
+				t.Errorf("RecordPhaseEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordEvent_NilRecorder(t *testing.T) {
+	RecordEvent(nil, &v1alpha1.Postgres{}, EventReasonCreateStarted, "started provisioning rds instance %s", "test")
+	RecordWarningEvent(nil, &v1alpha1.Postgres{}, EventReasonCredentialsFailed, "failed to reconcile credentials")
+}
+
+func TestReportMaintenance(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	pg := &v1alpha1.Postgres{}
+	pending := []string{"maintenance scheduled for cloudSQL instance test at 2026-10-20T00:00:00Z"}
+
+	// the same pending maintenance is only recorded once
+	ReportMaintenance(recorder, pg, &pg.Status, pending)
+	ReportMaintenance(recorder, pg, &pg.Status, pending)
+	if got := len(recorder.Events); got != 1 {
+		t.Errorf("ReportMaintenance() recorded %d events, want 1", got)
+	}
+	if !meta.IsStatusConditionTrue(pg.Status.Conditions, MaintenancePendingConditionType) {
+		t.Errorf("ReportMaintenance() condition = %v, want true", pg.Status.Conditions)
+	}
+
+	ReportMaintenance(recorder, pg, &pg.Status, nil)
+	if got := len(recorder.Events); got != 1 {
+		t.Errorf("ReportMaintenance() recorded %d events once maintenance is done, want 1", got)
+	}
+	if !meta.IsStatusConditionFalse(pg.Status.Conditions, MaintenancePendingConditionType) {
+		t.Errorf("ReportMaintenance() condition = %v, want false", pg.Status.Conditions)
+	}
+
+	// maintenance scheduled again is recorded again
+	ReportMaintenance(recorder, pg, &pg.Status, pending)
+	if got := len(recorder.Events); got != 2 {
+		t.Errorf("ReportMaintenance() recorded %d events, want 2", got)
+	}
+}
@@ -2,23 +2,30 @@ package resources
 
 import (
 	"context"
-	"reflect"
+	"encoding/json"
+	"fmt"
 
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 
 	errorUtil "github.com/pkg/errors"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// jsonPatchOperation is a single json patch operation, see https://datatracker.ietf.org/doc/html/rfc6902
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
 func HasFinalizer(om *controllerruntime.ObjectMeta, finalizer string) bool {
 	return Contains(om.GetFinalizers(), finalizer)
 }
 
 func addFinalizer(om *controllerruntime.ObjectMeta, finalizer string) {
 	if !HasFinalizer(om, finalizer) {
-		om.SetFinalizers([]string{finalizer})
+		om.SetFinalizers(append(om.GetFinalizers(), finalizer))
 	}
 }
 
@@ -45,19 +52,53 @@ func remove(list []string, s string) []string {
 	return list
 }
 
+// CreateFinalizer adds the finalizer to the instance unless it is being deleted
+//
+// the finalizer is added using a json patch that appends to the existing finalizers, so finalizers added by other
+// controllers are kept. when the instance has no finalizers the patch is guarded by the resource version instead
 func CreateFinalizer(ctx context.Context, c client.Client, inst client.Object, df string) error {
-	dt := &v1.ObjectMeta{}
-	if err := runtime.Field(reflect.ValueOf(inst).Elem(), "ObjectMeta", dt); err != nil {
-		return errorUtil.Wrap(err, "failed to retrieve timestamp")
-	}
-	if dt.DeletionTimestamp == nil {
-		addFinalizer(dt, df)
-		if err := runtime.SetField(*dt, reflect.ValueOf(inst).Elem(), "ObjectMeta"); err != nil {
-			return errorUtil.Wrap(err, "failed to set object meta back to instance")
+	if inst.GetDeletionTimestamp() != nil || Contains(inst.GetFinalizers(), df) {
+		return nil
+	}
+	ops := []jsonPatchOperation{{Op: "add", Path: "/metadata/finalizers/-", Value: df}}
+	if len(inst.GetFinalizers()) == 0 {
+		ops = []jsonPatchOperation{
+			{Op: "test", Path: "/metadata/resourceVersion", Value: inst.GetResourceVersion()},
+			{Op: "add", Path: "/metadata/finalizers", Value: []string{df}},
 		}
-		if err := c.Update(ctx, inst); err != nil {
-			return errorUtil.Wrapf(err, "failed to add finalizer to instance")
+	}
+	if err := patchFinalizers(ctx, c, inst, ops); err != nil {
+		return errorUtil.Wrapf(err, "failed to add finalizer to instance")
+	}
+	return nil
+}
+
+// DeleteFinalizer removes the finalizer from the instance
+//
+// the finalizer is removed using a json patch guarded by a test of its position, so finalizers added or removed by
+// other controllers are kept and a concurrent change to the list fails the patch rather than removing the wrong entry
+func DeleteFinalizer(ctx context.Context, c client.Client, inst client.Object, df string) error {
+	for i, f := range inst.GetFinalizers() {
+		if f != df {
+			continue
+		}
+		path := fmt.Sprintf("/metadata/finalizers/%d", i)
+		ops := []jsonPatchOperation{
+			{Op: "test", Path: path, Value: df},
+			{Op: "remove", Path: path},
+		}
+		if err := patchFinalizers(ctx, c, inst, ops); err != nil {
+			return errorUtil.Wrapf(err, "failed to remove finalizer from instance")
 		}
+		return nil
 	}
 	return nil
 }
+
+func patchFinalizers(ctx context.Context, c client.Client, inst client.Object, ops []jsonPatchOperation) error {
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return errorUtil.Wrap(err, "failed to marshal finalizer patch")
+	}
+	return c.Patch(ctx, inst, client.RawPatch(types.JSONPatchType, data))
+}
@@ -1,8 +1,14 @@
 package resources
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
@@ -19,6 +25,12 @@ func TestAddFinalizer(t *testing.T) {
 			finalizer:          "test",
 			expectedLength:     1,
 		},
+		{
+			name:               "test finalizer is appended to finalizers of other controllers",
+			existingFinalizers: []string{"other"},
+			finalizer:          "test",
+			expectedLength:     2,
+		},
 		{
 			name:               "test finalizer is not appended when identical one already exists",
 			existingFinalizers: []string{"test"},
@@ -109,3 +121,120 @@ func TestHasFinalizer(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateFinalizer(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cases := []struct {
+		name               string
+		existingFinalizers []string
+		expectedFinalizers []string
+	}{
+		{
+			name:               "test finalizer is added when the instance has no finalizers",
+			existingFinalizers: nil,
+			expectedFinalizers: []string{"test"},
+		},
+		{
+			name:               "test finalizer is added after finalizers of other controllers",
+			existingFinalizers: []string{"other"},
+			expectedFinalizers: []string{"other", "test"},
+		},
+		{
+			name:               "test finalizer is not added twice",
+			existingFinalizers: []string{"test", "other"},
+			expectedFinalizers: []string{"test", "other"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pg := &v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", Finalizers: tc.existingFinalizers}}
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, pg)
+			inst := &v1alpha1.Postgres{}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, inst); err != nil {
+				t.Fatal("failed to get postgres", err)
+			}
+			if err := CreateFinalizer(context.TODO(), c, inst, "test"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, inst); err != nil {
+				t.Fatal("failed to get postgres", err)
+			}
+			if !reflect.DeepEqual(inst.GetFinalizers(), tc.expectedFinalizers) {
+				t.Fatalf("unexpected finalizers, expected %v but got %v", tc.expectedFinalizers, inst.GetFinalizers())
+			}
+		})
+	}
+}
+
+func TestDeleteFinalizer(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cases := []struct {
+		name               string
+		existingFinalizers []string
+		expectedFinalizers []string
+	}{
+		{
+			name:               "test finalizer is removed and finalizers of other controllers are kept",
+			existingFinalizers: []string{"other", "test", "another"},
+			expectedFinalizers: []string{"other", "another"},
+		},
+		{
+			name:               "test removing a missing finalizer does nothing",
+			existingFinalizers: []string{"other"},
+			expectedFinalizers: []string{"other"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pg := &v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", Finalizers: tc.existingFinalizers}}
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, pg)
+			inst := &v1alpha1.Postgres{}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, inst); err != nil {
+				t.Fatal("failed to get postgres", err)
+			}
+			if err := DeleteFinalizer(context.TODO(), c, inst, "test"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, inst); err != nil {
+				t.Fatal("failed to get postgres", err)
+			}
+			if !reflect.DeepEqual(inst.GetFinalizers(), tc.expectedFinalizers) {
+				t.Fatalf("unexpected finalizers, expected %v but got %v", tc.expectedFinalizers, inst.GetFinalizers())
+			}
+		})
+	}
+}
+
+func TestDeleteFinalizer_ConcurrentChange(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	pg := &v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test", Finalizers: []string{"test"}}}
+	c := moqClient.NewSigsClientMoqWithScheme(scheme, pg)
+	stale := &v1alpha1.Postgres{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, stale); err != nil {
+		t.Fatal("failed to get postgres", err)
+	}
+	// another controller prepends its finalizer after the instance was read
+	current := stale.DeepCopy()
+	current.Finalizers = []string{"other", "test"}
+	if err := c.Update(context.TODO(), current); err != nil {
+		t.Fatal("failed to update postgres", err)
+	}
+	if err := DeleteFinalizer(context.TODO(), c, stale, "test"); err == nil {
+		t.Fatal("expected an error removing the finalizer from a stale instance")
+	}
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: "test", Namespace: "test"}, current); err != nil {
+		t.Fatal("failed to get postgres", err)
+	}
+	if !reflect.DeepEqual(current.GetFinalizers(), []string{"other", "test"}) {
+		t.Fatalf("unexpected finalizers, expected %v but got %v", []string{"other", "test"}, current.GetFinalizers())
+	}
+}
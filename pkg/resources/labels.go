@@ -14,6 +14,16 @@ const (
 	LabelStrategyKey    = "strategy"
 	LabelStatusKey      = "status"
 	LabelStatusPhaseKey = "statusPhase"
+	// connection probe metric labels
+	LabelResourceTypeKey = "resourceType"
+	LabelSuccessKey      = "success"
+	LabelReasonKey       = "reason"
+	// cloud api metric labels
+	LabelProviderKey  = "provider"
+	LabelServiceKey   = "service"
+	LabelOperationKey = "operation"
+	LabelRegionKey    = "region"
+	LabelErrorCodeKey = "code"
 )
 
 // BuildGenericMetricLabels returns generic labels to be added to every metric
@@ -0,0 +1,192 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	migrationJobSuffix       = "-migration"
+	migrationJobBackoffLimit = 3
+)
+
+// MigrationScope is passed to a provider through the context while it reconciles or deletes one of the two cloud
+// resources of a custom resource being migrated. The provider acts on the cloud resource identified by
+// ResourceIdentifier rather than the one in the resource identifier annotation, and reports the identifier it assigns
+// and the completion of a delete here instead of patching the custom resource, see SetResourceIdentifier and
+// SetResourceDeleted
+type MigrationScope struct {
+	// ResourceIdentifier of the cloud resource the provider acts on, empty until the provider assigns one
+	ResourceIdentifier string
+	// Deleted is set once the provider deleted the cloud resource
+	Deleted bool
+}
+
+type migrationScopeKey struct{}
+
+// WithMigrationScope returns a context passing the migration scope to the provider
+func WithMigrationScope(ctx context.Context, scope *MigrationScope) context.Context {
+	return context.WithValue(ctx, migrationScopeKey{}, scope)
+}
+
+func migrationScopeFrom(ctx context.Context) *MigrationScope {
+	scope, _ := ctx.Value(migrationScopeKey{}).(*MigrationScope)
+	return scope
+}
+
+// GetResourceIdentifier returns the identifier of the cloud resource the provider acts on, the one of the migration
+// scope during a migration and the resource identifier annotation otherwise
+func GetResourceIdentifier(ctx context.Context, o client.Object) string {
+	if scope := migrationScopeFrom(ctx); scope != nil {
+		return scope.ResourceIdentifier
+	}
+	return annotations.Get(o, annotations.ResourceIdentifier)
+}
+
+// HasResourceIdentifier returns true if the identifier of the cloud resource the provider acts on is known
+func HasResourceIdentifier(ctx context.Context, o client.Object) bool {
+	if scope := migrationScopeFrom(ctx); scope != nil {
+		return scope.ResourceIdentifier != ""
+	}
+	return annotations.Has(o, annotations.ResourceIdentifier)
+}
+
+// SetResourceIdentifier records the identifier of the cloud resource the provider acts on, in the migration scope during
+// a migration and in the resource identifier annotation otherwise
+func SetResourceIdentifier(ctx context.Context, c client.Client, o client.Object, id string) error {
+	if scope := migrationScopeFrom(ctx); scope != nil {
+		scope.ResourceIdentifier = id
+		return nil
+	}
+	return PatchObject(ctx, c, o, func() { annotations.Add(o, annotations.ResourceIdentifier, id) })
+}
+
+// SetResourceDeleted records that the cloud resource the provider acts on is gone, in the migration scope during a
+// migration and by removing the finalizer from the custom resource otherwise. During a migration the finalizer protects
+// the cloud resource migrated to, so it is kept
+func SetResourceDeleted(ctx context.Context, c client.Client, o client.Object, finalizer string) error {
+	if scope := migrationScopeFrom(ctx); scope != nil {
+		scope.Deleted = true
+		return nil
+	}
+	return DeleteFinalizer(ctx, c, o, finalizer)
+}
+
+// MigrationJob copies data between the cloud resources of a custom resource migrated from one strategy to another
+type MigrationJob struct {
+	// Image runs the script, it must contain the clients of both cloud resources
+	Image string
+	// Script is run by bash, a non-zero exit fails the job
+	Script string
+	// Env is exposed to the script as environment variables, it is kept in a secret as it holds credentials
+	Env map[string]string
+}
+
+// BuildMigrationJobName returns the name of the job and secret copying the data of a custom resource
+func BuildMigrationJobName(name string) string {
+	return name + migrationJobSuffix
+}
+
+// ReconcileMigrationJob runs the job copying data for the custom resource, it returns true once the job succeeded and
+// an error once it failed
+func (r *ReconcileResourceProvider) ReconcileMigrationJob(ctx context.Context, o client.Object, mj MigrationJob) (bool, error) {
+	name := BuildMigrationJobName(o.GetName())
+	sec := &v1.Secret{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      name,
+			Namespace: o.GetNamespace(),
+		},
+	}
+	if _, err := controllerruntime.CreateOrUpdate(ctx, r.Client, sec, func() error {
+		if err := controllerutil.SetControllerReference(o, sec, r.Scheme); err != nil {
+			return errors.Wrapf(err, "failed to set owner on secret %s", sec.Name)
+		}
+		sec.Data = make(map[string][]byte, len(mj.Env))
+		for k, v := range mj.Env {
+			sec.Data[k] = []byte(v)
+		}
+		sec.Type = v1.SecretTypeOpaque
+		return nil
+	}); err != nil {
+		return false, errors.Wrapf(err, "failed to reconcile migration secret %s", sec.Name)
+	}
+
+	job := &batchv1.Job{}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: o.GetNamespace()}, job)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return false, errors.Wrapf(err, "failed to get migration job %s", name)
+	}
+	// the job is never updated, a running copy must not be restarted with a different script
+	if k8serr.IsNotFound(err) {
+		job = buildMigrationJob(name, o.GetNamespace(), mj)
+		if err = controllerutil.SetControllerReference(o, job, r.Scheme); err != nil {
+			return false, errors.Wrapf(err, "failed to set owner on job %s", name)
+		}
+		if err = r.Client.Create(ctx, job); err != nil {
+			return false, errors.Wrapf(err, "failed to create migration job %s", name)
+		}
+		return false, nil
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			return false, fmt.Errorf("migration job %s failed, delete the job to retry: %s", name, condition.Message)
+		}
+	}
+	return job.Status.Succeeded > 0, nil
+}
+
+// DeleteMigrationJob removes the job copying data for the custom resource and the secret holding its credentials
+func (r *ReconcileResourceProvider) DeleteMigrationJob(ctx context.Context, o client.Object) error {
+	name := BuildMigrationJobName(o.GetName())
+	job := &batchv1.Job{ObjectMeta: controllerruntime.ObjectMeta{Name: name, Namespace: o.GetNamespace()}}
+	if err := r.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serr.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete migration job %s", name)
+	}
+	sec := &v1.Secret{ObjectMeta: controllerruntime.ObjectMeta{Name: name, Namespace: o.GetNamespace()}}
+	if err := r.Client.Delete(ctx, sec); err != nil && !k8serr.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete migration secret %s", name)
+	}
+	return nil
+}
+
+func buildMigrationJob(name, namespace string, mj MigrationJob) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To(int32(migrationJobBackoffLimit)),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers: []v1.Container{
+						{
+							Name:    "migration",
+							Image:   mj.Image,
+							Command: []string{"/bin/bash", "-c", mj.Script},
+							EnvFrom: []v1.EnvFromSource{
+								{
+									SecretRef: &v1.SecretEnvSource{
+										LocalObjectReference: v1.LocalObjectReference{Name: name},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
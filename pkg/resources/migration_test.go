@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"github.com/integr8ly/cloud-resource-operator/pkg/annotations"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func buildTestMigrationJob(status batchv1.JobStatus) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: controllerruntime.ObjectMeta{
+			Name:      "test-migration",
+			Namespace: "test",
+		},
+		Status: status,
+	}
+}
+
+func TestReconcileResourceProvider_ReconcileMigrationJob(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	mj := MigrationJob{
+		Image:  "test-image",
+		Script: "echo test",
+		Env:    map[string]string{"FROM_HOST": "from", "TO_HOST": "to"},
+	}
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "test job is created when it does not exist",
+			objs: []runtime.Object{buildTestPostgresCR(false)},
+		},
+		{
+			name: "test job is running until it succeeds",
+			objs: []runtime.Object{buildTestPostgresCR(false), buildTestMigrationJob(batchv1.JobStatus{Active: 1})},
+		},
+		{
+			name: "test copy is complete when the job succeeded",
+			objs: []runtime.Object{buildTestPostgresCR(false), buildTestMigrationJob(batchv1.JobStatus{Succeeded: 1})},
+			want: true,
+		},
+		{
+			name: "test error when the job failed",
+			objs: []runtime.Object{buildTestPostgresCR(false), buildTestMigrationJob(batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}},
+			})},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, tt.objs...)
+			r := NewResourceProvider(c, scheme, logrus.NewEntry(logrus.StandardLogger()))
+			got, err := r.ReconcileMigrationJob(context.TODO(), buildTestPostgresCR(false), mj)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReconcileMigrationJob() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ReconcileMigrationJob() got = %v, want %v", got, tt.want)
+			}
+			sec := &corev1.Secret{}
+			if err = c.Get(context.TODO(), client.ObjectKey{Name: "test-migration", Namespace: "test"}, sec); err != nil {
+				t.Fatal("failed to get migration secret", err)
+			}
+			if string(sec.Data["FROM_HOST"]) != "from" || string(sec.Data["TO_HOST"]) != "to" {
+				t.Errorf("ReconcileMigrationJob() secret data = %v, want %v", sec.Data, mj.Env)
+			}
+			job := &batchv1.Job{}
+			if err = c.Get(context.TODO(), client.ObjectKey{Name: "test-migration", Namespace: "test"}, job); err != nil {
+				t.Fatal("failed to get migration job", err)
+			}
+		})
+	}
+}
+
+func TestReconcileResourceProvider_DeleteMigrationJob(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	sec := &corev1.Secret{ObjectMeta: controllerruntime.ObjectMeta{Name: "test-migration", Namespace: "test"}}
+	c := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestMigrationJob(batchv1.JobStatus{}), sec)
+	r := NewResourceProvider(c, scheme, logrus.NewEntry(logrus.StandardLogger()))
+	if err = r.DeleteMigrationJob(context.TODO(), buildTestPostgresCR(false)); err != nil {
+		t.Fatalf("DeleteMigrationJob() unexpected error = %v", err)
+	}
+	if err = c.Get(context.TODO(), client.ObjectKey{Name: "test-migration", Namespace: "test"}, &batchv1.Job{}); !k8serr.IsNotFound(err) {
+		t.Errorf("DeleteMigrationJob() job error = %v, want not found", err)
+	}
+	if err = c.Get(context.TODO(), client.ObjectKey{Name: "test-migration", Namespace: "test"}, &corev1.Secret{}); !k8serr.IsNotFound(err) {
+		t.Errorf("DeleteMigrationJob() secret error = %v, want not found", err)
+	}
+	// deleting again is a no-op
+	if err = r.DeleteMigrationJob(context.TODO(), buildTestPostgresCR(false)); err != nil {
+		t.Fatalf("DeleteMigrationJob() unexpected error = %v", err)
+	}
+}
+
+func TestMigrationScope(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cr := buildTestPostgresCR(false)
+	cr.Annotations = map[string]string{annotations.ResourceIdentifier: "current"}
+	cr.Finalizers = []string{"test"}
+	c := moqClient.NewSigsClientMoqWithScheme(scheme, cr)
+
+	scope := &MigrationScope{ResourceIdentifier: "previous"}
+	ctx := WithMigrationScope(context.TODO(), scope)
+	if got := GetResourceIdentifier(ctx, cr); got != "previous" {
+		t.Fatalf("unexpected resource identifier, expected previous but got %s", got)
+	}
+	if err = SetResourceIdentifier(ctx, c, cr, "next"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = SetResourceDeleted(ctx, c, cr, "test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if scope.ResourceIdentifier != "next" || !scope.Deleted {
+		t.Fatalf("unexpected migration scope %+v", scope)
+	}
+
+	found := &v1alpha1.Postgres{}
+	if err = c.Get(context.TODO(), client.ObjectKeyFromObject(cr), found); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := GetResourceIdentifier(context.TODO(), found); got != "current" {
+		t.Fatalf("unexpected resource identifier annotation, expected current but got %s", got)
+	}
+	if !Contains(found.Finalizers, "test") {
+		t.Fatalf("expected finalizer to be kept, got %v", found.Finalizers)
+	}
+}
+
+func TestSetResourceDeleted(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	cr := buildTestPostgresCR(false)
+	cr.Finalizers = []string{"test"}
+	c := moqClient.NewSigsClientMoqWithScheme(scheme, cr)
+
+	if err = SetResourceDeleted(context.TODO(), c, cr, "test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	found := &v1alpha1.Postgres{}
+	if err = c.Get(context.TODO(), client.ObjectKeyFromObject(cr), found); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if Contains(found.Finalizers, "test") {
+		t.Fatalf("expected finalizer to be removed outside of a migration, got %v", found.Finalizers)
+	}
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"sort"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	errorUtil "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	clusterConfigNamespace        = "kube-system"
+	clusterConfigName             = "cluster-config-v1"
+	clusterConfigInstallConfigKey = "install-config"
+)
+
+// installConfig is the part of the install config of the cluster describing the networks of the cluster nodes, older
+// clusters set a single machineCIDR
+type installConfig struct {
+	Networking struct {
+		MachineNetwork []struct {
+			CIDR string `json:"cidr"`
+		} `json:"machineNetwork"`
+		MachineCIDR string `json:"machineCIDR"`
+	} `json:"networking"`
+}
+
+// GetClusterMachineNetworkCIDRs returns the cidr blocks of the cluster nodes from the install config of the cluster,
+// traffic from the pods of the cluster to cloud resources leaves the cluster from the node addresses
+func GetClusterMachineNetworkCIDRs(ctx context.Context, c client.Client) ([]string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Name: clusterConfigName, Namespace: clusterConfigNamespace}, cm); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to get cluster install config")
+	}
+	config := &installConfig{}
+	if err := yaml.Unmarshal([]byte(cm.Data[clusterConfigInstallConfigKey]), config); err != nil {
+		return nil, errorUtil.Wrap(err, "failed to unmarshal cluster install config")
+	}
+	var cidrs []string
+	for _, machineNetwork := range config.Networking.MachineNetwork {
+		cidrs = append(cidrs, machineNetwork.CIDR)
+	}
+	if len(cidrs) == 0 && config.Networking.MachineCIDR != "" {
+		cidrs = append(cidrs, config.Networking.MachineCIDR)
+	}
+	if len(cidrs) == 0 {
+		return nil, errorUtil.New("cluster install config has no machine network")
+	}
+	return cidrs, nil
+}
+
+// GetNetworkAccessCIDRs returns the sorted cidr blocks allowed to connect to a cloud resource, the cluster cidr blocks
+// are replaced by the cluster machine network when only the cluster pods are allowed
+func GetNetworkAccessCIDRs(ctx context.Context, c client.Client, access *croType.NetworkAccess, clusterCIDRs ...string) ([]string, error) {
+	if access.ClusterPodsOnly {
+		machineCIDRs, err := GetClusterMachineNetworkCIDRs(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		clusterCIDRs = machineCIDRs
+	}
+	if _, err := ParseCIDRs(access.AllowedCIDRs...); err != nil {
+		return nil, errorUtil.Wrap(err, "invalid allowed cidr block in network access")
+	}
+	found := map[string]bool{}
+	var cidrs []string
+	for _, cidr := range append(append([]string{}, clusterCIDRs...), access.AllowedCIDRs...) {
+		if cidr == "" || found[cidr] {
+			continue
+		}
+		found[cidr] = true
+		cidrs = append(cidrs, cidr)
+	}
+	sort.Strings(cidrs)
+	return cidrs, nil
+}
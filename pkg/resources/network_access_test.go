@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func buildTestInstallConfig(installConfig string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterConfigName, Namespace: clusterConfigNamespace},
+		Data:       map[string]string{clusterConfigInstallConfigKey: installConfig},
+	}
+}
+
+func TestGetClusterMachineNetworkCIDRs(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "test machine networks are returned",
+			objs: []runtime.Object{buildTestInstallConfig("networking:\n  machineNetwork:\n  - cidr: 10.0.0.0/16\n  - cidr: 10.1.0.0/16\n")},
+			want: []string{"10.0.0.0/16", "10.1.0.0/16"},
+		},
+		{
+			name: "test machine cidr of older clusters is returned",
+			objs: []runtime.Object{buildTestInstallConfig("networking:\n  machineCIDR: 10.0.0.0/16\n")},
+			want: []string{"10.0.0.0/16"},
+		},
+		{
+			name:    "test error when the install config has no machine network",
+			objs:    []runtime.Object{buildTestInstallConfig("networking: {}\n")},
+			wantErr: true,
+		},
+		{
+			name:    "test error when the install config does not exist",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetClusterMachineNetworkCIDRs(context.TODO(), moqClient.NewSigsClientMoqWithScheme(scheme, tt.objs...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetClusterMachineNetworkCIDRs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClusterMachineNetworkCIDRs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetNetworkAccessCIDRs(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	tests := []struct {
+		name         string
+		access       *croType.NetworkAccess
+		clusterCIDRs []string
+		want         []string
+		wantErr      bool
+	}{
+		{
+			name:         "test allowed cidr blocks are added to the cluster cidr blocks",
+			access:       &croType.NetworkAccess{AllowedCIDRs: []string{"192.168.0.0/24", "10.0.0.0/16"}},
+			clusterCIDRs: []string{"10.0.0.0/16"},
+			want:         []string{"10.0.0.0/16", "192.168.0.0/24"},
+		},
+		{
+			name:         "test cluster cidr blocks are replaced by the machine network for cluster pods only",
+			access:       &croType.NetworkAccess{ClusterPodsOnly: true},
+			clusterCIDRs: []string{"10.0.0.0/8"},
+			want:         []string{"10.0.0.0/16"},
+		},
+		{
+			name:         "test error for an invalid allowed cidr block",
+			access:       &croType.NetworkAccess{AllowedCIDRs: []string{"192.168.0.0"}},
+			clusterCIDRs: []string{"10.0.0.0/16"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, buildTestInstallConfig("networking:\n  machineNetwork:\n  - cidr: 10.0.0.0/16\n"))
+			got, err := GetNetworkAccessCIDRs(context.TODO(), c, tt.access, tt.clusterCIDRs...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetNetworkAccessCIDRs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetNetworkAccessCIDRs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchObject applies mutate to the object and sends only the resulting changes to the api server as a merge patch,
+// fields and finalizers changed concurrently by other controllers are kept
+func PatchObject(ctx context.Context, c client.Client, obj client.Object, mutate func()) error {
+	base := obj.DeepCopyObject().(client.Object)
+	mutate()
+	return c.Patch(ctx, obj, client.MergeFrom(base))
+}
+
+// PatchStatus applies mutate to the object and sends only the resulting status changes to the api server as a merge
+// patch on the status subresource
+func PatchStatus(ctx context.Context, c client.Client, obj client.Object, mutate func()) error {
+	base := obj.DeepCopyObject().(client.Object)
+	mutate()
+	return c.Status().Patch(ctx, obj, client.MergeFrom(base))
+}
@@ -14,10 +14,14 @@ import (
 )
 
 // UpdatePhase Updates the custom resource with the current phase
-func UpdatePhase(ctx context.Context, client client.Client, inst client.Object, phase croType.StatusPhase, msg croType.StatusMessage) error {
+//
+// only the phase and message are sent to the api server using a merge patch, so the update does not conflict with
+// changes made to the rest of the custom resource
+func UpdatePhase(ctx context.Context, c client.Client, inst client.Object, phase croType.StatusPhase, msg croType.StatusMessage) error {
 	if msg == croType.StatusEmpty {
 		return nil
 	}
+	base := inst.DeepCopyObject().(client.Object)
 	rts := &croType.ResourceTypeStatus{}
 	if err := runtime.Field(reflect.ValueOf(inst).Elem(), "Status", rts); err != nil {
 		return errorUtil.Wrap(err, "failed to retrieve status block from object")
@@ -27,17 +31,18 @@ func UpdatePhase(ctx context.Context, client client.Client, inst client.Object,
 	if err := runtime.SetField(*rts, reflect.ValueOf(inst).Elem(), "Status"); err != nil {
 		return errorUtil.Wrap(err, "failed to set status block of object")
 	}
-	if err := client.Status().Update(ctx, inst); err != nil {
+	if err := c.Status().Patch(ctx, inst, client.MergeFrom(base)); err != nil {
 		return errorUtil.Wrap(err, "failed to update resource status phase and message")
 	}
 	return nil
 }
 
 // UpdateSnapshotPhase Updates the snapshot custom resource with the current phase
-func UpdateSnapshotPhase(ctx context.Context, client client.Client, inst client.Object, phase croType.StatusPhase, msg croType.StatusMessage) error {
+func UpdateSnapshotPhase(ctx context.Context, c client.Client, inst client.Object, phase croType.StatusPhase, msg croType.StatusMessage) error {
 	if msg == croType.StatusEmpty {
 		return nil
 	}
+	base := inst.DeepCopyObject().(client.Object)
 	rts := &croType.ResourceTypeSnapshotStatus{}
 	if err := runtime.Field(reflect.ValueOf(inst).Elem(), "Status", rts); err != nil {
 		return errorUtil.Wrap(err, "failed to retrieve status block from object")
@@ -47,7 +52,7 @@ func UpdateSnapshotPhase(ctx context.Context, client client.Client, inst client.
 	if err := runtime.SetField(*rts, reflect.ValueOf(inst).Elem(), "Status"); err != nil {
 		return errorUtil.Wrap(err, "failed to set status block of object")
 	}
-	if err := client.Status().Update(ctx, inst); err != nil {
+	if err := c.Status().Patch(ctx, inst, client.MergeFrom(base)); err != nil {
 		return errorUtil.Wrap(err, "failed to update resource status phase and message")
 	}
 	return nil
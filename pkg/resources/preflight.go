@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PreflightPermissionsConditionType is the status condition reporting whether the provider credentials allow the
+	// cloud resource to be created
+	PreflightPermissionsConditionType = "PreflightPermissions"
+	// PreflightQuotaConditionType is the status condition reporting whether the service quotas allow another cloud
+	// resource to be created
+	PreflightQuotaConditionType = "PreflightQuota"
+	// PreflightAvailabilityConditionType is the status condition reporting whether the instance class and engine version
+	// of the strategy are offered in the region
+	PreflightAvailabilityConditionType = "PreflightAvailability"
+	// PreflightNetworkCapacityConditionType is the status condition reporting whether the subnets the cloud resource is
+	// created in have free addresses
+	PreflightNetworkCapacityConditionType = "PreflightNetworkCapacity"
+
+	PreflightReasonPassed  = "PreflightPassed"
+	PreflightReasonFailed  = "PreflightFailed"
+	PreflightReasonUnknown = "PreflightUnknown"
+)
+
+// PreflightCheck is the result of a check run before a cloud resource is first created
+type PreflightCheck struct {
+	Type    string
+	Status  metav1.ConditionStatus
+	Message string
+}
+
+// Preflight collects the results of the checks run before a cloud resource is first created
+type Preflight struct {
+	Checks []PreflightCheck
+}
+
+// Pass records the check as passed
+func (p *Preflight) Pass(checkType, format string, args ...interface{}) {
+	p.add(checkType, metav1.ConditionTrue, format, args...)
+}
+
+// Fail records the check as failed, the cloud resource is not created while a check fails
+func (p *Preflight) Fail(checkType, format string, args ...interface{}) {
+	p.add(checkType, metav1.ConditionFalse, format, args...)
+}
+
+// Unknown records that the check could not be run, for example when the provider credentials may not call the api the
+// check relies on. it does not prevent the cloud resource from being created
+func (p *Preflight) Unknown(checkType, format string, args ...interface{}) {
+	p.add(checkType, metav1.ConditionUnknown, format, args...)
+}
+
+func (p *Preflight) add(checkType string, status metav1.ConditionStatus, format string, args ...interface{}) {
+	p.Checks = append(p.Checks, PreflightCheck{Type: checkType, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// Failed returns the checks which failed
+func (p *Preflight) Failed() []PreflightCheck {
+	if p == nil {
+		return nil
+	}
+	var failed []PreflightCheck
+	for _, c := range p.Checks {
+		if c.Status == metav1.ConditionFalse {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// String describes the failed checks
+func (p *Preflight) String() string {
+	failed := p.Failed()
+	descriptions := make([]string, 0, len(failed))
+	for _, c := range failed {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", c.Type, c.Message))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// ReportPreflight sets a status condition for each check in the status of the custom resource, a warning event is
+// recorded when a check fails with a new message. false is returned when any check failed
+func ReportPreflight(recorder record.EventRecorder, obj client.Object, status *croType.ResourceTypeStatus, preflight *Preflight) bool {
+	for _, c := range preflight.Checks {
+		condition := metav1.Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			Reason:             PreflightReasonPassed,
+			Message:            c.Message,
+			ObservedGeneration: obj.GetGeneration(),
+		}
+		switch c.Status {
+		case metav1.ConditionFalse:
+			condition.Reason = PreflightReasonFailed
+			previous := meta.FindStatusCondition(status.Conditions, c.Type)
+			if previous == nil || previous.Message != condition.Message {
+				RecordWarningEvent(recorder, obj, PreflightReasonFailed, "%s", condition.Message)
+			}
+		case metav1.ConditionUnknown:
+			condition.Reason = PreflightReasonUnknown
+		}
+		meta.SetStatusCondition(&status.Conditions, condition)
+	}
+	return len(preflight.Failed()) == 0
+}
@@ -0,0 +1,82 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestPreflight_Failed(t *testing.T) {
+	p := &Preflight{}
+	p.Pass(PreflightQuotaConditionType, "%d of %d instances used", 1, 40)
+	p.Unknown(PreflightPermissionsConditionType, "permissions could not be simulated")
+	p.Fail(PreflightAvailabilityConditionType, "instance class %s is not offered", "db.t3.small")
+	failed := p.Failed()
+	if len(failed) != 1 || failed[0].Type != PreflightAvailabilityConditionType {
+		t.Errorf("Failed() = %v, want only the availability check", failed)
+	}
+	if want := "PreflightAvailability: instance class db.t3.small is not offered"; p.String() != want {
+		t.Errorf("String() = %q, want %q", p.String(), want)
+	}
+}
+
+func TestReportPreflight(t *testing.T) {
+	tests := []struct {
+		name       string
+		preflight  *Preflight
+		want       bool
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantEvents int
+	}{
+		{
+			name: "test passed check sets condition true",
+			preflight: &Preflight{Checks: []PreflightCheck{
+				{Type: PreflightQuotaConditionType, Status: metav1.ConditionTrue, Message: "quota available"},
+			}},
+			want:       true,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: PreflightReasonPassed,
+		},
+		{
+			name: "test unknown check sets condition unknown and does not fail",
+			preflight: &Preflight{Checks: []PreflightCheck{
+				{Type: PreflightQuotaConditionType, Status: metav1.ConditionUnknown, Message: "quota could not be read"},
+			}},
+			want:       true,
+			wantStatus: metav1.ConditionUnknown,
+			wantReason: PreflightReasonUnknown,
+		},
+		{
+			name: "test failed check sets condition false and records an event",
+			preflight: &Preflight{Checks: []PreflightCheck{
+				{Type: PreflightQuotaConditionType, Status: metav1.ConditionFalse, Message: "40 of 40 instances used"},
+			}},
+			want:       false,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: PreflightReasonFailed,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			pg := &v1alpha1.Postgres{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "test"}}
+			if got := ReportPreflight(recorder, pg, &pg.Status, tt.preflight); got != tt.want {
+				t.Errorf("ReportPreflight() = %v, want %v", got, tt.want)
+			}
+			condition := meta.FindStatusCondition(pg.Status.Conditions, PreflightQuotaConditionType)
+			if condition == nil || condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("ReportPreflight() condition = %v, want status %s reason %s", condition, tt.wantStatus, tt.wantReason)
+			}
+			// reporting the same failure again must not record another event
+			ReportPreflight(recorder, pg, &pg.Status, tt.preflight)
+			if len(recorder.Events) != tt.wantEvents {
+				t.Errorf("ReportPreflight() recorded %d events, want %d", len(recorder.Events), tt.wantEvents)
+			}
+		})
+	}
+}
@@ -37,7 +37,12 @@ func (r *ReconcileResourceProvider) ReconcileResultSecret(ctx context.Context, o
 	obj := o.(metav1.Object)
 	secNs := obj.GetNamespace()
 	rts := &croType.ResourceTypeSpec{}
-	if err := runtime.Field(reflect.ValueOf(o).Elem(), "Spec", rts); err != nil {
+	spec, field := reflect.ValueOf(o).Elem(), "Spec"
+	// resource types with their own spec embed the spec shared by all resource types
+	if s := spec.FieldByName(field); s.IsValid() && s.Type() != reflect.TypeOf(*rts) {
+		spec, field = s, "ResourceTypeSpec"
+	}
+	if err := runtime.Field(spec, field, rts); err != nil {
 		return errors.Wrap(err, "failed to retrieve secret reference from instance")
 	}
 	if rts.SecretRef.Namespace != "" {
@@ -70,6 +75,13 @@ func (r *ReconcileResourceProvider) ReconcileResultSecret(ctx context.Context, o
 }
 
 func IsLastResource(ctx context.Context, c client.Client) (bool, error) {
+	retained, err := HasRetainedResources(ctx, c)
+	if err != nil {
+		return false, err
+	}
+	if retained {
+		return false, nil
+	}
 	listOptions := client.ListOptions{
 		Namespace: "",
 	}
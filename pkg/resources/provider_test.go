@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1"
+	croType "github.com/integr8ly/cloud-resource-operator/apis/integreatly/v1alpha1/types"
+	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileResourceProvider_ReconcileResultSecret(t *testing.T) {
+	scheme, err := buildTestScheme()
+	if err != nil {
+		t.Fatal("failed to build scheme", err)
+	}
+	secretRef := &croType.SecretRef{Name: "test-sec", Namespace: "test"}
+	cases := []struct {
+		name string
+		obj  client.Object
+	}{
+		{
+			name: "test secret is created from the secret ref embedded in the postgres spec",
+			obj: &v1alpha1.Postgres{
+				ObjectMeta: controllerruntime.ObjectMeta{Name: "test", Namespace: "test"},
+				Spec:       v1alpha1.PostgresSpec{ResourceTypeSpec: croType.ResourceTypeSpec{SecretRef: secretRef}},
+			},
+		},
+		{
+			name: "test secret is created from the secret ref embedded in the blobstorage spec",
+			obj: &v1alpha1.BlobStorage{
+				ObjectMeta: controllerruntime.ObjectMeta{Name: "test", Namespace: "test"},
+				Spec:       v1alpha1.BlobStorageSpec{ResourceTypeSpec: croType.ResourceTypeSpec{SecretRef: secretRef}},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := moqClient.NewSigsClientMoqWithScheme(scheme, []runtime.Object{tc.obj}...)
+			rp := NewResourceProvider(c, scheme, logrus.NewEntry(logrus.StandardLogger()))
+			if err := rp.ReconcileResultSecret(context.TODO(), tc.obj, map[string][]byte{"key": []byte("value")}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			sec := &v1.Secret{}
+			if err := c.Get(context.TODO(), types.NamespacedName{Name: secretRef.Name, Namespace: secretRef.Namespace}, sec); err != nil {
+				t.Fatalf("expected secret %s/%s: %v", secretRef.Namespace, secretRef.Name, err)
+			}
+			if string(sec.Data["key"]) != "value" {
+				t.Fatalf("unexpected secret data %v", sec.Data)
+			}
+		})
+	}
+}
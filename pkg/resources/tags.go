@@ -11,6 +11,8 @@ const (
 
 	TagManagedKey = "red-hat-managed"
 	TagManagedVal = "true"
+
+	TagOrphanedVal = "true"
 )
 
 // generic key-value tag
@@ -127,6 +129,15 @@ func GetUserInfraTags(ctx context.Context, c client.Client) ([]*Tag, error) {
 	return tags, nil
 }
 
+// BuildOrphanedTag returns the tag added to cloud resources kept by the Retain deletion policy after their custom
+// resource is deleted
+func BuildOrphanedTag() *Tag {
+	return &Tag{
+		Key:   GetOrganizationTag() + "orphaned",
+		Value: TagOrphanedVal,
+	}
+}
+
 func BuildManagedTag() *Tag {
 	return &Tag{
 		Key:   TagManagedKey,
@@ -11,6 +11,7 @@ import (
 	moqClient "github.com/integr8ly/cloud-resource-operator/pkg/client/fake"
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/cloud-credential-operator/pkg/apis"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -31,6 +32,10 @@ func buildTestScheme() (*runtime.Scheme, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = batchv1.AddToScheme(scheme)
+	if err != nil {
+		return nil, err
+	}
 	err = apis.AddToScheme(scheme)
 	if err != nil {
 		return nil, err
@@ -44,8 +49,10 @@ func buildTestPostgresCR(maintenanceWindow bool) *v1alpha1.Postgres {
 			Name:      "test",
 			Namespace: "test",
 		},
-		Spec: croType.ResourceTypeSpec{
-			MaintenanceWindow: maintenanceWindow,
+		Spec: v1alpha1.PostgresSpec{
+			ResourceTypeSpec: croType.ResourceTypeSpec{
+				MaintenanceWindow: maintenanceWindow,
+			},
 		},
 	}
 }
@@ -56,8 +63,10 @@ func buildTestRedisCR(maintenanceWindow bool) *v1alpha1.Redis {
 			Name:      "test",
 			Namespace: "test",
 		},
-		Spec: croType.ResourceTypeSpec{
-			MaintenanceWindow: maintenanceWindow,
+		Spec: v1alpha1.RedisSpec{
+			ResourceTypeSpec: croType.ResourceTypeSpec{
+				MaintenanceWindow: maintenanceWindow,
+			},
 		},
 	}
 }
@@ -91,13 +91,17 @@ cat <<EOM >"$MINIMAL_POLICY_NAME.json"
                 "elasticache:CreateReplicationGroup",
                 "elasticache:DeleteReplicationGroup",
                 "elasticache:DescribeCacheClusters",
+                "elasticache:DescribeCacheEngineVersions",
                 "elasticache:DescribeCacheSubnetGroups",
                 "elasticache:DescribeReplicationGroups",
                 "elasticache:DescribeSnapshots",
                 "elasticache:DescribeUpdateActions",
+                "iam:SimulatePrincipalPolicy",
+                "rds:DescribeAccountAttributes",
                 "rds:DescribeDBInstances",
                 "rds:DescribeDBSnapshots",
                 "rds:DescribeDBSubnetGroups",
+                "rds:DescribeOrderableDBInstanceOptions",
                 "rds:DescribePendingMaintenanceActions",
                 "rds:ListTagsForResource",
                 "s3:CreateBucket",
@@ -106,7 +110,10 @@ cat <<EOM >"$MINIMAL_POLICY_NAME.json"
                 "s3:ListBucket",
                 "s3:PutBucketPublicAccessBlock",
                 "s3:PutBucketTagging",
-                "s3:PutEncryptionConfiguration"
+                "s3:PutEncryptionConfiguration",
+                "s3:GetMetricsConfiguration",
+                "s3:PutMetricsConfiguration",
+                "sts:AssumeRole"
             ],
             "Resource": "*"
         },
@@ -163,6 +170,19 @@ cat <<EOM >"$MINIMAL_POLICY_NAME.json"
                     "aws:ResourceTag/red-hat-managed": "true"
                 }
             }
+        },
+        {
+            "Effect": "Allow",
+            "Action": [
+                "iam:CreateRole",
+                "iam:DeleteRole",
+                "iam:DeleteRolePolicy",
+                "iam:GetRole",
+                "iam:PutRolePolicy",
+                "iam:TagRole",
+                "iam:UpdateAssumeRolePolicy"
+            ],
+            "Resource": "arn:aws:iam::$(get_account_id):role/cro-*"
         }
     ]
 }
@@ -103,13 +103,15 @@ func getBasicBlobstorage(ctx *TestingContext, namespace string) (*v1alpha1.BlobS
 			Name:      blobstorageName,
 			Namespace: namespace,
 		},
-		Spec: t1.ResourceTypeSpec{
-			SecretRef: &t1.SecretRef{
-				Name:      "example-blobstorage-sec",
-				Namespace: namespace,
+		Spec: v1alpha1.BlobStorageSpec{
+			ResourceTypeSpec: t1.ResourceTypeSpec{
+				SecretRef: &t1.SecretRef{
+					Name:      "example-blobstorage-sec",
+					Namespace: namespace,
+				},
+				Tier: "development",
+				Type: "openshift",
 			},
-			Tier: "development",
-			Type: "openshift",
 		},
 	}, namespace, nil
 }
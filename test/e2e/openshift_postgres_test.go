@@ -423,13 +423,15 @@ func getBasicTestPostgres(ctx *TestingContext, namespace string) (*v1alpha1.Post
 			Name:      postgresName,
 			Namespace: namespace,
 		},
-		Spec: types2.ResourceTypeSpec{
-			SecretRef: &types2.SecretRef{
-				Name:      "example-postgres-sec",
-				Namespace: namespace,
+		Spec: v1alpha1.PostgresSpec{
+			ResourceTypeSpec: types2.ResourceTypeSpec{
+				SecretRef: &types2.SecretRef{
+					Name:      "example-postgres-sec",
+					Namespace: namespace,
+				},
+				Tier: "development",
+				Type: "openshift",
 			},
-			Tier: "development",
-			Type: "openshift",
 		},
 	}, namespace, nil
 }